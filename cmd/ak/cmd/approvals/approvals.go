@@ -0,0 +1,32 @@
+package approvals
+
+import (
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+)
+
+var approvalsCmd = common.StandardCommand(&cobra.Command{
+	Use:     "approvals",
+	Short:   "Session approval requests: list, get, approve, reject",
+	Aliases: []string{"approval", "apr"},
+	Args:    cobra.NoArgs,
+})
+
+// AddSubcommands adds this command, and its own subcommands, to the calling parent.
+func AddSubcommands(parentCmd *cobra.Command) {
+	parentCmd.AddCommand(approvalsCmd)
+}
+
+func init() {
+	// Subcommands.
+	approvalsCmd.AddCommand(listCmd)
+	approvalsCmd.AddCommand(getCmd)
+	approvalsCmd.AddCommand(approveCmd)
+	approvalsCmd.AddCommand(rejectCmd)
+}
+
+func approvals() sdkservices.Approvals {
+	return common.Client().Approvals()
+}
@@ -0,0 +1,55 @@
+package approvals
+
+import (
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+var comment string
+
+var approveCmd = common.StandardCommand(&cobra.Command{
+	Use:   "approve <approval ID> [--comment=...]",
+	Short: "Approve a pending approval",
+	Args:  cobra.ExactArgs(1),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return decide(args[0], true)
+	},
+})
+
+var rejectCmd = common.StandardCommand(&cobra.Command{
+	Use:   "reject <approval ID> [--comment=...]",
+	Short: "Reject a pending approval",
+	Args:  cobra.ExactArgs(1),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return decide(args[0], false)
+	},
+})
+
+func init() {
+	// Command-specific flags.
+	approveCmd.Flags().StringVarP(&comment, "comment", "c", "", "comment to record with the decision")
+	rejectCmd.Flags().StringVarP(&comment, "comment", "c", "", "comment to record with the decision")
+}
+
+func decide(rawID string, approve bool) error {
+	id, err := sdktypes.StrictParseApprovalID(rawID)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := common.LimitedContext()
+	defer cancel()
+
+	a, err := approvals().Decide(ctx, id, approve, comment)
+	if err != nil {
+		return err
+	}
+
+	common.RenderKVIfV("approval", a)
+
+	return nil
+}
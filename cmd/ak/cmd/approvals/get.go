@@ -0,0 +1,37 @@
+package approvals
+
+import (
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+var getCmd = common.StandardCommand(&cobra.Command{
+	Use:     "get <approval ID> [--fail]",
+	Short:   "Get approval details",
+	Aliases: []string{"g"},
+	Args:    cobra.ExactArgs(1),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := sdktypes.StrictParseApprovalID(args[0])
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := common.LimitedContext()
+		defer cancel()
+
+		a, err := approvals().Get(ctx, id)
+		err = common.AddNotFoundErrIfCond(err, a.IsValid())
+		if err = common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "approval"); err == nil {
+			common.RenderKVIfV("approval", a)
+		}
+		return err
+	},
+})
+
+func init() {
+	// Command-specific flags.
+	common.AddFailIfNotFoundFlag(getCmd)
+}
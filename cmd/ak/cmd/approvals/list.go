@@ -0,0 +1,73 @@
+package approvals
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+	"go.autokitteh.dev/autokitteh/internal/resolver"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+var (
+	project string
+	session string
+	state   string
+	limit   int
+)
+
+var listCmd = common.StandardCommand(&cobra.Command{
+	Use:     "list [filter flags] [--fail]",
+	Short:   "List approvals, newest first",
+	Aliases: []string{"ls", "l"},
+	Args:    cobra.NoArgs,
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := sdkservices.ListApprovalsFilter{Limit: limit}
+
+		r := resolver.Resolver{Client: common.Client()}
+		ctx, cancel := common.LimitedContext()
+		defer cancel()
+
+		if project != "" {
+			pid, err := r.ProjectNameOrID(ctx, sdktypes.InvalidOrgID, project)
+			if err = common.AddNotFoundErrIfCond(err, pid.IsValid()); err != nil {
+				return common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "project")
+			}
+			f.ProjectID = pid
+		}
+
+		if session != "" {
+			s, sid, err := r.SessionID(ctx, session)
+			if err = common.AddNotFoundErrIfCond(err, s.IsValid()); err != nil {
+				return common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "session")
+			}
+			f.SessionID = sid
+		}
+
+		var err error
+		if f.State, err = sdktypes.ParseApprovalState(state); err != nil {
+			return fmt.Errorf("invalid state %q: %w", state, err)
+		}
+
+		as, err := approvals().List(ctx, f)
+		err = common.AddNotFoundErrIfCond(err, len(as) > 0)
+		if err = common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "approvals"); err == nil {
+			common.RenderList(as)
+		}
+		return err
+	},
+})
+
+func init() {
+	// Command-specific flags.
+	listCmd.Flags().StringVarP(&project, "project", "p", "", "project name or ID")
+	listCmd.Flags().StringVarP(&session, "session", "s", "", "session ID")
+	listCmd.Flags().StringVar(&state, "state", "", strings.ToLower(strings.Join(sdktypes.PossibleApprovalStatesNames, "|")))
+	listCmd.Flags().IntVarP(&limit, "limit", "n", 0, "maximum number of approvals to list")
+
+	common.AddFailIfNotFoundFlag(listCmd)
+}
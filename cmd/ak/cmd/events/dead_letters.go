@@ -0,0 +1,20 @@
+package events
+
+import (
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+)
+
+var deadLettersCmd = common.StandardCommand(&cobra.Command{
+	Use:     "dead-letters",
+	Short:   "Events dropped during dispatch: list, retry",
+	Aliases: []string{"dlq"},
+	Args:    cobra.NoArgs,
+})
+
+func init() {
+	// Subcommands.
+	deadLettersCmd.AddCommand(listDeadLettersCmd)
+	deadLettersCmd.AddCommand(retryDeadLetterCmd)
+}
@@ -0,0 +1,67 @@
+package events
+
+import (
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+	"go.autokitteh.dev/autokitteh/internal/resolver"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+var (
+	deadLettersEvent string
+	includeRetried   bool
+	deadLettersLimit int
+)
+
+var listDeadLettersCmd = common.StandardCommand(&cobra.Command{
+	Use:     "list [filter flags] [--include-retried] [--fail]",
+	Short:   "List events that were dropped during dispatch",
+	Aliases: []string{"ls", "l"},
+	Args:    cobra.NoArgs,
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := sdkservices.ListDeadLettersFilter{
+			IncludeRetried: includeRetried,
+			Limit:          deadLettersLimit,
+		}
+
+		r := resolver.Resolver{Client: common.Client()}
+		ctx, cancel := common.LimitedContext()
+		defer cancel()
+
+		if project != "" {
+			pid, err := r.ProjectNameOrID(ctx, sdktypes.InvalidOrgID, project)
+			if err = common.AddNotFoundErrIfCond(err, pid.IsValid()); err != nil {
+				return common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "project")
+			}
+			f.ProjectID = pid
+		}
+
+		if deadLettersEvent != "" {
+			e, eid, err := r.EventID(ctx, deadLettersEvent)
+			if err = common.AddNotFoundErrIfCond(err, e.IsValid()); err != nil {
+				return common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "event")
+			}
+			f.EventID = eid
+		}
+
+		dls, err := common.Client().Dispatcher().ListDeadLetters(ctx, f)
+		err = common.AddNotFoundErrIfCond(err, len(dls) > 0)
+		if err = common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "dead letters"); err == nil {
+			common.RenderList(dls)
+		}
+		return err
+	},
+})
+
+func init() {
+	// Command-specific flags.
+	listDeadLettersCmd.Flags().StringVarP(&project, "project", "p", "", "project name or ID")
+	listDeadLettersCmd.Flags().StringVarP(&deadLettersEvent, "event", "e", "", "event ID")
+	listDeadLettersCmd.Flags().BoolVarP(&includeRetried, "include-retried", "r", false, "include dead letters that were already retried")
+	listDeadLettersCmd.Flags().IntVarP(&deadLettersLimit, "limit", "n", 0, "maximum number of dead letters to list")
+
+	common.AddFailIfNotFoundFlag(listDeadLettersCmd)
+}
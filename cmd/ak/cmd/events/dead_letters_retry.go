@@ -0,0 +1,46 @@
+package events
+
+import (
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+var waitForRetry bool
+
+var retryDeadLetterCmd = common.StandardCommand(&cobra.Command{
+	Use:   "retry <dead letter ID> [--wait]",
+	Short: "Redispatch the event of a dead letter",
+	Args:  cobra.ExactArgs(1),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := sdktypes.StrictParseDeadLetterID(args[0])
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := common.LimitedContext()
+		defer cancel()
+
+		resp, err := common.Client().Dispatcher().RetryDeadLetter(ctx, id, &sdkservices.DispatchOptions{Wait: waitForRetry})
+		if err != nil {
+			return err
+		}
+
+		common.RenderKVIfV("event_id", resp.EventID)
+
+		if waitForRetry {
+			common.RenderKVIfV("started_session_ids", resp.StartedSessionIDs)
+			common.RenderKVIfV("signaled_session_ids", resp.SignaledSessionIDs)
+		}
+
+		return nil
+	},
+})
+
+func init() {
+	// Command-specific flags.
+	retryDeadLetterCmd.Flags().BoolVarP(&waitForRetry, "wait", "w", false, "wait for the dispatch to complete")
+}
@@ -21,7 +21,7 @@ var (
 
 var eventCmd = common.StandardCommand(&cobra.Command{
 	Use:     "event",
-	Short:   "Events: save, get, list, (re)dispatch, record",
+	Short:   "Events: save, get, list, (re)dispatch, record, dead-letters",
 	Aliases: []string{"evt"},
 	Args:    cobra.NoArgs,
 })
@@ -33,6 +33,7 @@ func AddSubcommands(parentCmd *cobra.Command) {
 
 func init() {
 	// Subcommands.
+	eventCmd.AddCommand(deadLettersCmd)
 	eventCmd.AddCommand(dispatchCmd)
 	eventCmd.AddCommand(getCmd)
 	eventCmd.AddCommand(listCmd)
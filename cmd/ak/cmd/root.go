@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
+	"go.autokitteh.dev/autokitteh/cmd/ak/cmd/approvals"
 	"go.autokitteh.dev/autokitteh/cmd/ak/cmd/auth"
 	"go.autokitteh.dev/autokitteh/cmd/ak/cmd/builds"
 	"go.autokitteh.dev/autokitteh/cmd/ak/cmd/configuration"
@@ -27,6 +28,7 @@ import (
 	"go.autokitteh.dev/autokitteh/cmd/ak/cmd/runtimes"
 	"go.autokitteh.dev/autokitteh/cmd/ak/cmd/server"
 	"go.autokitteh.dev/autokitteh/cmd/ak/cmd/sessions"
+	"go.autokitteh.dev/autokitteh/cmd/ak/cmd/store"
 	"go.autokitteh.dev/autokitteh/cmd/ak/cmd/temporal"
 	"go.autokitteh.dev/autokitteh/cmd/ak/cmd/triggers"
 	"go.autokitteh.dev/autokitteh/cmd/ak/cmd/users"
@@ -116,6 +118,7 @@ func init() {
 	RootCmd.AddCommand(versionCmd)
 
 	// Top-level parent commands.
+	approvals.AddSubcommands(RootCmd)
 	auth.AddSubcommands(RootCmd)
 	builds.AddSubcommands(RootCmd)
 	configuration.AddSubcommands(RootCmd)
@@ -132,6 +135,7 @@ func init() {
 	runtimes.AddSubcommands(RootCmd)
 	server.AddSubcommands(RootCmd)
 	sessions.AddSubcommands(RootCmd)
+	store.AddSubcommands(RootCmd)
 	temporal.AddSubcommands(RootCmd)
 	triggers.AddSubcommands(RootCmd)
 	users.AddSubcommands(RootCmd)
@@ -0,0 +1,153 @@
+package sessions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+	"go.autokitteh.dev/autokitteh/internal/resolver"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+const bulkPollInterval = time.Second
+
+// Flags that select the sessions of bulk operations.
+var bulkFilterFlags = []string{"project", "deployment-id", "state", "tag", "after", "before"}
+
+var bulkWait bool
+
+var bulkStatusCmd = common.StandardCommand(&cobra.Command{
+	Use:   "bulk-status <operation ID> [--wait]",
+	Short: "Get progress of a bulk stop, delete or restart operation",
+	Args:  cobra.ExactArgs(1),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if bulkWait {
+			return waitBulkOperation(args[0])
+		}
+
+		ctx, cancel := common.LimitedContext()
+		defer cancel()
+
+		op, err := sessions().GetBulkOperation(ctx, args[0])
+		if err != nil {
+			return common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "operation")
+		}
+
+		common.Render(op)
+		return nil
+	},
+})
+
+func init() {
+	// Command-specific flags.
+	bulkStatusCmd.Flags().BoolVarP(&bulkWait, "wait", "w", false, "wait for the operation to complete")
+}
+
+// addBulkFlags adds flags to a single session command, which make it
+// operate on all matching sessions if no session ID is specified.
+func addBulkFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&project, "project", "p", "", "project name or ID")
+	cmd.Flags().StringVarP(&deploymentID, "deployment-id", "d", "", "deployment ID")
+	cmd.Flags().VarP(&stateType, "state", "s", strings.Join(possibleStates, "|"))
+	cmd.Flags().StringArrayVar(&tags, "tag", nil, `only sessions with this tag ("key=value"), can be repeated`)
+	cmd.Flags().StringVar(&createdAfter, "after", "", "created at or after this time")
+	cmd.Flags().StringVar(&createdBefore, "before", "", "created before this time")
+	cmd.Flags().BoolVar(&bulkWait, "wait", false, "wait for a bulk operation to complete")
+}
+
+func isBulk(cmd *cobra.Command, args []string) (bool, error) {
+	filtered := false
+	for _, name := range bulkFilterFlags {
+		filtered = filtered || cmd.Flags().Changed(name)
+	}
+
+	switch {
+	case len(args) == 0 && !filtered:
+		return false, common.NewExitCodeError(common.BadRequest, errors.New("session ID or filter flags required"))
+	case len(args) > 0 && filtered:
+		return false, common.NewExitCodeError(common.BadRequest, errors.New("session ID and filter flags are mutually exclusive"))
+	default:
+		return filtered, nil
+	}
+}
+
+// runBulk starts a bulk operation on all sessions matching the filter flags.
+func runBulk(cmd *cobra.Command, start func(context.Context, sdkservices.ListSessionsFilter) (string, error)) error {
+	r := resolver.Resolver{Client: common.Client()}
+	f := sdkservices.ListSessionsFilter{}
+
+	ctx, cancel := common.LimitedContext()
+	defer cancel()
+
+	if deploymentID != "" {
+		d, did, err := r.DeploymentID(ctx, deploymentID)
+		if err = common.AddNotFoundErrIfCond(err, d.IsValid()); err != nil {
+			return common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "deployment")
+		}
+		f.DeploymentID = did
+	}
+
+	if project != "" {
+		pid, err := r.ProjectNameOrID(ctx, sdktypes.InvalidOrgID, project)
+		if err = common.AddNotFoundErrIfCond(err, pid.IsValid()); err != nil {
+			return common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "project")
+		}
+		f.ProjectID = pid
+	}
+
+	var err error
+	if f.StateType, err = sdktypes.ParseSessionStateType(stateType.String()); err != nil {
+		return fmt.Errorf("invalid state %q: %w", stateType, err)
+	}
+
+	if f.Tags, err = parseTags(tags); err != nil {
+		return err
+	}
+
+	if f.CreatedAfter, err = parseSearchTime(createdAfter); err != nil {
+		return fmt.Errorf("invalid --after: %w", err)
+	}
+
+	if f.CreatedBefore, err = parseSearchTime(createdBefore); err != nil {
+		return fmt.Errorf("invalid --before: %w", err)
+	}
+
+	id, err := start(ctx, f)
+	if err != nil {
+		return err
+	}
+
+	common.RenderKV("operation_id", id)
+
+	if bulkWait {
+		return waitBulkOperation(id)
+	}
+
+	return nil
+}
+
+func waitBulkOperation(id string) error {
+	for {
+		ctx, cancel := common.LimitedContext()
+		op, err := sessions().GetBulkOperation(ctx, id)
+		cancel()
+
+		if err != nil {
+			return fmt.Errorf("get bulk operation: %w", err)
+		}
+
+		if op.Done {
+			common.Render(op)
+			return nil
+		}
+
+		time.Sleep(bulkPollInterval)
+	}
+}
@@ -8,12 +8,21 @@ import (
 )
 
 var deleteCmd = common.StandardCommand(&cobra.Command{
-	Use:     "delete <session ID> [--fail]",
-	Short:   "Delete non-running session",
+	Use:     "delete <session ID | filter flags> [--fail] [--wait]",
+	Short:   "Delete non-running session, or all sessions matching filter flags",
 	Aliases: []string{"d"},
-	Args:    cobra.ExactArgs(1),
+	Args:    cobra.MaximumNArgs(1),
 
 	RunE: func(cmd *cobra.Command, args []string) error {
+		bulk, err := isBulk(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		if bulk {
+			return runBulk(cmd, sessions().DeleteMany)
+		}
+
 		r := resolver.Resolver{Client: common.Client()}
 		ctx, cancel := common.LimitedContext()
 		defer cancel()
@@ -35,4 +44,5 @@ var deleteCmd = common.StandardCommand(&cobra.Command{
 func init() {
 	// Command-specific flags.
 	common.AddFailIfError(deleteCmd)
+	addBulkFlags(deleteCmd)
 }
@@ -0,0 +1,52 @@
+package sessions
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+)
+
+var exportOutput string
+
+var exportCmd = common.StandardCommand(&cobra.Command{
+	Use:   "export <session ID or project> [--output <path>] [--fail]",
+	Short: "Export a finished session as a bundle for reproduction",
+	Args:  cobra.ExactArgs(1),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		sid, err := acquireSessionID(args[0])
+		if err = common.AddNotFoundErrIfCond(err, sid.IsValid()); err != nil {
+			return common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "session")
+		}
+
+		ctx, done := common.LimitedContext()
+		defer done()
+
+		bundle, err := sessions().Export(ctx, sid)
+		if err != nil {
+			return fmt.Errorf("export session: %w", err)
+		}
+
+		path := exportOutput
+		if path == "" {
+			path = sid.String() + ".tar.gz"
+		}
+
+		if err := os.WriteFile(path, bundle, 0o644); err != nil {
+			return fmt.Errorf("failed to write to file %q: %w", path, err)
+		}
+
+		common.RenderKV("path", path)
+		return nil
+	},
+})
+
+func init() {
+	// Command-specific flags.
+	exportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "path to output file (default is ./<session_id>.tar.gz)")
+
+	common.AddFailIfNotFoundFlag(exportCmd)
+}
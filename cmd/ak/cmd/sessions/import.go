@@ -0,0 +1,49 @@
+package sessions
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/internal/resolver"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+var importCmd = common.StandardCommand(&cobra.Command{
+	Use:   "import <path> --project <name or ID>",
+	Short: "Import an exported session bundle into a project",
+	Args:  cobra.ExactArgs(1),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		bundle, err := os.ReadFile(args[0])
+		if err != nil {
+			return fmt.Errorf("read bundle: %w", err)
+		}
+
+		ctx, done := common.LimitedContext()
+		defer done()
+
+		r := resolver.Resolver{Client: common.Client()}
+		pid, err := r.ProjectNameOrID(ctx, sdktypes.InvalidOrgID, project)
+		if err = common.AddNotFoundErrIfCond(err, pid.IsValid()); err != nil {
+			return common.WrapError(err, "project")
+		}
+
+		sid, err := sessions().Import(ctx, pid, bundle)
+		if err != nil {
+			return fmt.Errorf("import session: %w", err)
+		}
+
+		common.RenderKVIfV("session_id", sid)
+		return nil
+	},
+})
+
+func init() {
+	// Command-specific flags.
+	importCmd.Flags().StringVarP(&project, "project", "p", "", "project name or ID")
+	kittehs.Must0(importCmd.MarkFlagRequired("project"))
+}
@@ -18,6 +18,7 @@ var (
 	nextPageToken string
 	pageSize      int
 	skipRows      int
+	tags          []string
 )
 
 var listCmd = common.StandardCommand(&cobra.Command{
@@ -73,6 +74,10 @@ var listCmd = common.StandardCommand(&cobra.Command{
 			return fmt.Errorf("invalid state %q: %w", stateType, err)
 		}
 
+		if f.Tags, err = parseTags(tags); err != nil {
+			return err
+		}
+
 		result, err := sessions().List(ctx, f)
 		if result == nil {
 			result = &sdkservices.ListSessionResult{}
@@ -99,6 +104,7 @@ func init() {
 	listCmd.Flags().StringVarP(&deploymentID, "deployment-id", "d", "", "deployment ID")
 	listCmd.Flags().StringVar(&eventID, "event-id", "", "event ID")
 	listCmd.Flags().VarP(&stateType, "state-type", "s", strings.Join(possibleStates, "|"))
+	listCmd.Flags().StringArrayVar(&tags, "tag", nil, `only sessions with this tag ("key=value"), can be repeated`)
 	listCmd.Flags().BoolVarP(&withInputs, "with-inputs", "i", false, "include input details")
 	listCmd.Flags().StringVar(&nextPageToken, "next-page-token", "", "provide the returned page token to get next")
 	listCmd.Flags().IntVar(&pageSize, "page-size", 50, "page size")
@@ -106,3 +112,21 @@ func init() {
 
 	common.AddFailIfNotFoundFlag(listCmd)
 }
+
+func parseTags(kvs []string) (map[string]string, error) {
+	if len(kvs) == 0 {
+		return nil, nil
+	}
+
+	tags := make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			return nil, fmt.Errorf(`invalid tag %q: expected "key=value"`, kv)
+		}
+
+		tags[k] = v
+	}
+
+	return tags, nil
+}
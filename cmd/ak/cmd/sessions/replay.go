@@ -0,0 +1,71 @@
+package sessions
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+)
+
+var (
+	stopAtSeq     uint32
+	replayValues  bool
+	replayNoCalls bool
+)
+
+var replayCmd = common.StandardCommand(&cobra.Command{
+	Use:   "replay <session ID | project> [--stop-at N] [--values] [--no-calls] [--fail]",
+	Short: "Replay a finished durable session using its recorded call results",
+	Args:  cobra.ExactArgs(1),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		sid, err := acquireSessionID(args[0])
+		if err = common.AddNotFoundErrIfCond(err, sid.IsValid()); err != nil {
+			return common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "session")
+		}
+
+		ctx, cancel := common.LimitedContext()
+		defer cancel()
+
+		r, err := sessions().Replay(ctx, sid, sdkservices.ReplaySessionOptions{StopAtSeq: stopAtSeq})
+		if err = common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "session"); err != nil {
+			return fmt.Errorf("replay: %w", err)
+		}
+
+		if !replayNoCalls {
+			for _, c := range r.Calls {
+				common.RenderKV("call", c.Spec)
+				common.RenderKV("result", c.Result)
+			}
+		}
+
+		for _, p := range r.Prints {
+			common.RenderKV("print", p)
+		}
+
+		if replayValues {
+			common.RenderKV("values", r.Values)
+		}
+
+		if r.Stopped {
+			common.RenderKV("stopped_at", stopAtSeq)
+			return nil
+		}
+
+		common.RenderKVIfV("return_value", r.ReturnValue)
+		common.RenderKVIfV("error", r.Error)
+
+		return nil
+	},
+})
+
+func init() {
+	// Command-specific flags.
+	replayCmd.Flags().Uint32VarP(&stopAtSeq, "stop-at", "s", 0, "stop at the call with this sequence number")
+	replayCmd.Flags().BoolVarP(&replayValues, "values", "v", false, "emit the entry point module values")
+	replayCmd.Flags().BoolVar(&replayNoCalls, "no-calls", false, "do not emit calls and their results")
+
+	common.AddFailIfNotFoundFlag(replayCmd)
+}
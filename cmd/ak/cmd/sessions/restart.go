@@ -10,11 +10,20 @@ import (
 )
 
 var restartCmd = common.StandardCommand(&cobra.Command{
-	Use:   "restart [session ID | project] [--watch [--watch-timeout <duration>] [--poll-interval <duration>] [--no-timestamps] [--quiet]]",
-	Short: "Start new instance of existing session",
-	Args:  cobra.ExactArgs(1),
+	Use:   "restart [session ID | project | filter flags] [--watch [--watch-timeout <duration>] [--poll-interval <duration>] [--no-timestamps] [--quiet]] [--wait]",
+	Short: "Start new instance of existing session, or of all sessions matching filter flags",
+	Args:  cobra.MaximumNArgs(1),
 
 	RunE: func(cmd *cobra.Command, args []string) error {
+		bulk, err := isBulk(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		if bulk {
+			return runBulk(cmd, sessions().RestartMany)
+		}
+
 		sid, err := acquireSessionID(args[0])
 		if err = common.AddNotFoundErrIfCond(err, sid.IsValid()); err != nil {
 			return common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "session")
@@ -57,4 +66,6 @@ func init() {
 	restartCmd.Flags().DurationVarP(&pollInterval, "poll-interval", "i", defaultPollInterval, "watch poll interval")
 	restartCmd.Flags().BoolVarP(&noTimestamps, "no-timestamps", "n", false, "omit timestamps from watch output")
 	restartCmd.Flags().BoolVarP(&quiet, "quiet", "q", false, "don't print anything, just wait to finish")
+
+	addBulkFlags(restartCmd)
 }
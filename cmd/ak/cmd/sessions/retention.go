@@ -0,0 +1,156 @@
+package sessions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/internal/resolver"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+var (
+	retentionOrg      string
+	retentionMaxAge   time.Duration
+	retentionMaxCount int
+	retentionStates   []string
+)
+
+var retentionCmd = common.StandardCommand(&cobra.Command{
+	Use:   "retention",
+	Short: "Session retention policies of orgs and projects: set, get, delete",
+	Args:  cobra.NoArgs,
+})
+
+var retentionSetCmd = common.StandardCommand(&cobra.Command{
+	Use:   "set <--org=... | --project=...> [--max-age=...] [--max-count=...] [--state=...]",
+	Short: "Set the session retention policy of an org or a project",
+	Args:  cobra.NoArgs,
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := common.LimitedContext()
+		defer cancel()
+
+		scope, err := resolveRetentionScope(ctx)
+		if err != nil {
+			return err
+		}
+
+		states, err := kittehs.TransformError(retentionStates, sdktypes.ParseSessionStateType)
+		if err != nil {
+			return common.NewExitCodeError(common.BadRequest, fmt.Errorf("invalid --state: %w", err))
+		}
+
+		policy := sdkservices.SessionRetentionPolicy{
+			SessionRetentionScope: scope,
+			MaxAge:                retentionMaxAge,
+			MaxCount:              retentionMaxCount,
+			States:                states,
+		}
+
+		if err := policy.Validate(); err != nil {
+			return common.NewExitCodeError(common.BadRequest, err)
+		}
+
+		if err := sessions().SetRetentionPolicy(ctx, policy); err != nil {
+			return fmt.Errorf("set retention policy: %w", err)
+		}
+
+		return nil
+	},
+})
+
+var retentionGetCmd = common.StandardCommand(&cobra.Command{
+	Use:   "get <--org=... | --project=...> [--fail]",
+	Short: "Get the session retention policy of an org or a project",
+	Args:  cobra.NoArgs,
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := common.LimitedContext()
+		defer cancel()
+
+		scope, err := resolveRetentionScope(ctx)
+		if err != nil {
+			return err
+		}
+
+		policy, err := sessions().GetRetentionPolicy(ctx, scope)
+		err = common.AddNotFoundErrIfCond(err, policy != nil)
+		if err != nil {
+			return common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "retention policy")
+		}
+
+		common.Render(policy)
+		return nil
+	},
+})
+
+var retentionDeleteCmd = common.StandardCommand(&cobra.Command{
+	Use:   "delete <--org=... | --project=...>",
+	Short: "Delete the session retention policy of an org or a project",
+	Args:  cobra.NoArgs,
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := common.LimitedContext()
+		defer cancel()
+
+		scope, err := resolveRetentionScope(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := sessions().DeleteRetentionPolicy(ctx, scope); err != nil {
+			return fmt.Errorf("delete retention policy: %w", err)
+		}
+
+		return nil
+	},
+})
+
+func init() {
+	// Subcommands.
+	retentionCmd.AddCommand(retentionSetCmd)
+	retentionCmd.AddCommand(retentionGetCmd)
+	retentionCmd.AddCommand(retentionDeleteCmd)
+
+	// Command-specific flags.
+	for _, cmd := range []*cobra.Command{retentionSetCmd, retentionGetCmd, retentionDeleteCmd} {
+		cmd.Flags().StringVarP(&retentionOrg, "org", "o", "", "org name or ID")
+		cmd.Flags().StringVarP(&project, "project", "p", "", "project name or ID")
+		cmd.MarkFlagsOneRequired("org", "project")
+		cmd.MarkFlagsMutuallyExclusive("org", "project")
+	}
+
+	common.AddFailIfNotFoundFlag(retentionGetCmd)
+
+	retentionSetCmd.Flags().DurationVar(&retentionMaxAge, "max-age", 0, "purge sessions older than this")
+	retentionSetCmd.Flags().IntVar(&retentionMaxCount, "max-count", 0, "purge all but this many most recent sessions per project")
+	retentionSetCmd.Flags().StringSliceVarP(&retentionStates, "state", "s", nil, "only purge sessions in these final states: "+strings.Join(kittehs.Transform(sdktypes.FinalSessionStateTypes, sdktypes.SessionStateType.String), "|"))
+	retentionSetCmd.MarkFlagsOneRequired("max-age", "max-count")
+}
+
+func resolveRetentionScope(ctx context.Context) (scope sdkservices.SessionRetentionScope, err error) {
+	r := resolver.Resolver{Client: common.Client()}
+
+	if retentionOrg != "" {
+		scope.OrgID, err = r.Org(ctx, retentionOrg)
+		if err = common.AddNotFoundErrIfCond(err, scope.OrgID.IsValid()); err != nil {
+			err = common.WrapError(err, "org")
+		}
+
+		return
+	}
+
+	scope.ProjectID, err = r.ProjectNameOrID(ctx, sdktypes.InvalidOrgID, project)
+	if err = common.AddNotFoundErrIfCond(err, scope.ProjectID.IsValid()); err != nil {
+		err = common.WrapError(err, "project")
+	}
+
+	return
+}
@@ -0,0 +1,133 @@
+package sessions
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+	"go.autokitteh.dev/autokitteh/internal/resolver"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+var (
+	printsContain, errorContains string
+	createdAfter, createdBefore  string
+	triggerName                  string
+)
+
+var searchCmd = common.StandardCommand(&cobra.Command{
+	Use:   "search [filter flags] [--fail]",
+	Short: "Search sessions by prints, errors and attributes",
+	Long: `Search sessions by prints, errors and attributes.
+
+Text matching is case insensitive. Times are either in RFC 3339 format
+(e.g. "2006-01-02T15:04:05Z") or a duration before now (e.g. "24h").`,
+	Args: cobra.NoArgs,
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		r := resolver.Resolver{Client: common.Client()}
+		f := sdkservices.SearchSessionsFilter{
+			PrintsContain: printsContain,
+			ErrorContains: errorContains,
+			TriggerName:   triggerName,
+			EntryPoint:    entryPoint,
+		}
+
+		ctx, cancel := common.LimitedContext()
+		defer cancel()
+
+		if deploymentID != "" {
+			d, did, err := r.DeploymentID(ctx, deploymentID)
+			if err = common.AddNotFoundErrIfCond(err, d.IsValid()); err != nil {
+				return common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "deployment")
+			}
+			f.DeploymentID = did
+		}
+
+		if project != "" {
+			pid, err := r.ProjectNameOrID(ctx, sdktypes.InvalidOrgID, project)
+			if err = common.AddNotFoundErrIfCond(err, pid.IsValid()); err != nil {
+				return common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "project")
+			}
+			f.ProjectID = pid
+		}
+
+		if nextPageToken != "" {
+			f.PageToken = nextPageToken
+		}
+
+		if pageSize > 0 {
+			f.PageSize = int32(pageSize)
+		}
+
+		if skipRows > 0 {
+			f.Skip = int32(skipRows)
+		}
+
+		var err error
+		if f.StateType, err = sdktypes.ParseSessionStateType(stateType.String()); err != nil {
+			return fmt.Errorf("invalid state %q: %w", stateType, err)
+		}
+
+		if f.Tags, err = parseTags(tags); err != nil {
+			return err
+		}
+
+		if f.CreatedAfter, err = parseSearchTime(createdAfter); err != nil {
+			return fmt.Errorf("invalid --after: %w", err)
+		}
+
+		if f.CreatedBefore, err = parseSearchTime(createdBefore); err != nil {
+			return fmt.Errorf("invalid --before: %w", err)
+		}
+
+		result, err := sessions().Search(ctx, f)
+		if result == nil {
+			result = &sdkservices.ListSessionResult{}
+		}
+		err = common.AddNotFoundErrIfCond(err, len(result.Sessions) > 0)
+		if err = common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "sessions"); err == nil {
+			common.RenderList(result.Sessions)
+			if result.NextPageToken != "" {
+				common.RenderKV("next-page-token", result.NextPageToken)
+			}
+		}
+		return err
+	},
+})
+
+func init() {
+	// Command-specific flags.
+	searchCmd.Flags().StringVarP(&project, "project", "p", "", "project name or ID")
+	searchCmd.Flags().StringVarP(&deploymentID, "deployment-id", "d", "", "deployment ID")
+	searchCmd.Flags().VarP(&stateType, "state-type", "s", strings.Join(possibleStates, "|"))
+	searchCmd.Flags().StringArrayVar(&tags, "tag", nil, `only sessions with this tag ("key=value"), can be repeated`)
+	searchCmd.Flags().StringVar(&printsContain, "prints", "", "text in any of the session's prints")
+	searchCmd.Flags().StringVar(&errorContains, "error", "", "text in the session's error")
+	searchCmd.Flags().StringVar(&createdAfter, "after", "", "created at or after this time")
+	searchCmd.Flags().StringVar(&createdBefore, "before", "", "created before this time")
+	searchCmd.Flags().StringVarP(&triggerName, "trigger", "t", "", "trigger name")
+	searchCmd.Flags().StringVarP(&entryPoint, "entrypoint", "e", "", `entry point ("file:function") or file`)
+	searchCmd.Flags().StringVar(&nextPageToken, "next-page-token", "", "provide the returned page token to get next")
+	searchCmd.Flags().IntVar(&pageSize, "page-size", 50, "page size")
+	searchCmd.Flags().IntVar(&skipRows, "skip-rows", 0, "skip rows")
+
+	common.AddFailIfNotFoundFlag(searchCmd)
+}
+
+// parseSearchTime parses either an absolute time, or a duration before now.
+func parseSearchTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	if d, err := time.ParseDuration(s); err == nil {
+		return time.Now().Add(-d), nil
+	}
+
+	return time.Parse(time.RFC3339, s)
+}
@@ -29,7 +29,7 @@ var (
 
 var sessionCmd = common.StandardCommand(&cobra.Command{
 	Use:     "session",
-	Short:   "Runtime sessions: (re)start, get, list, log, watch, test, stop, delete",
+	Short:   "Runtime sessions: (re)start, get, list, search, log, watch, replay, export, import, test, stop, delete, retention",
 	Aliases: []string{"ses"},
 	Args:    cobra.NoArgs,
 })
@@ -41,13 +41,19 @@ func AddSubcommands(parentCmd *cobra.Command) {
 
 func init() {
 	// Subcommands.
+	sessionCmd.AddCommand(bulkStatusCmd)
 	sessionCmd.AddCommand(deleteCmd)
 	sessionCmd.AddCommand(downloadLogsCmd)
+	sessionCmd.AddCommand(exportCmd)
 	sessionCmd.AddCommand(getCmd)
+	sessionCmd.AddCommand(importCmd)
 	sessionCmd.AddCommand(listCmd)
 	sessionCmd.AddCommand(logCmd)
 	sessionCmd.AddCommand(printsCmd)
+	sessionCmd.AddCommand(replayCmd)
 	sessionCmd.AddCommand(restartCmd)
+	sessionCmd.AddCommand(retentionCmd)
+	sessionCmd.AddCommand(searchCmd)
 	sessionCmd.AddCommand(startCmd)
 	sessionCmd.AddCommand(stopCmd)
 	sessionCmd.AddCommand(testCmd)
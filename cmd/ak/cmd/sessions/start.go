@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -20,10 +21,12 @@ var (
 	memos      []string
 	inputs     []string
 	durable    bool
+
+	executionTimeout time.Duration
 )
 
 var startCmd = common.StandardCommand(&cobra.Command{
-	Use:   "start {--deployment-id <ID>|--build-id <ID> --project <name or ID>|--project <name or ID>} --entrypoint <...> [--memo <...>] [--input <JSON> [...]] [--watch [--watch-timeout <duration>] [--poll-interval <duration>] [--no-timestamps] [--quiet]] [--durable]",
+	Use:   "start {--deployment-id <ID>|--build-id <ID> --project <name or ID>|--project <name or ID>} --entrypoint <...> [--memo <...>] [--input <JSON> [...]] [--watch [--watch-timeout <duration>] [--poll-interval <duration>] [--no-timestamps] [--quiet]] [--durable] [--execution-timeout <duration>]",
 	Short: "Start new session",
 	Args:  cobra.NoArgs,
 
@@ -55,7 +58,7 @@ var startCmd = common.StandardCommand(&cobra.Command{
 			did = ds[0].ID()
 		}
 
-		s := sdktypes.NewSession(bid, ep, nil, nil).WithProjectID(pid).WithDeploymentID(did).WithInputs(inputs).SetDurable(durable)
+		s := sdktypes.NewSession(bid, ep, nil, nil).WithProjectID(pid).WithDeploymentID(did).WithInputs(inputs).SetDurable(durable).WithTimeout(executionTimeout)
 		sid, err := sessions().Start(ctx, s)
 		if err != nil {
 			return fmt.Errorf("start session: %w", err)
@@ -90,6 +93,7 @@ func init() {
 	startCmd.Flags().BoolVarP(&noTimestamps, "no-timestamps", "n", false, "omit timestamps from watch output")
 	startCmd.Flags().BoolVarP(&quiet, "quiet", "q", false, "don't print anything, just wait to finish")
 	startCmd.Flags().BoolVarP(&durable, "durable", "D", false, "durable run")
+	startCmd.Flags().DurationVar(&executionTimeout, "execution-timeout", 0, "stop the session if still running after this long")
 
 	startCmd.Flags().StringArrayVarP(&inputs, "input", "I", nil, `zero or more "key=value" pairs, where value is a JSON value`)
 }
@@ -1,12 +1,14 @@
 package sessions
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/spf13/cobra"
 
 	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
 )
 
 var (
@@ -16,11 +18,22 @@ var (
 )
 
 var stopCmd = common.StandardCommand(&cobra.Command{
-	Use:   "stop [session ID | project] [--reason <...>] [--force] [--delay t]",
-	Short: "Stop running session",
+	Use:   "stop [session ID | project | filter flags] [--reason <...>] [--force] [--delay t] [--wait]",
+	Short: "Stop running session, or all sessions matching filter flags",
 	Args:  cobra.MaximumNArgs(1),
 
 	RunE: func(cmd *cobra.Command, args []string) error {
+		bulk, err := isBulk(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		if bulk {
+			return runBulk(cmd, func(ctx context.Context, f sdkservices.ListSessionsFilter) (string, error) {
+				return sessions().StopMany(ctx, f, reason, force)
+			})
+		}
+
 		sid, err := acquireSessionID(args[0])
 		if err = common.AddNotFoundErrIfCond(err, sid.IsValid()); err != nil {
 			return common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "session")
@@ -42,4 +55,6 @@ func init() {
 	stopCmd.Flags().StringVarP(&reason, "reason", "r", "", "optional reason for stopping")
 	stopCmd.Flags().BoolVarP(&force, "force", "f", false, "terminate forcefully")
 	stopCmd.Flags().DurationVar(&delay, "delay", 0, "delay termination by specified duration")
+
+	addBulkFlags(stopCmd)
 }
@@ -56,6 +56,9 @@ func init() {
 	common.AddFailIfNotFoundFlag(watchCmd)
 }
 
+// errWatchDone is used to stop streaming once the end condition is met.
+var errWatchDone = errors.New("done")
+
 func sessionWatch(sid sdktypes.SessionID, endState sdktypes.SessionStateType, endPrintRE string) ([]sdktypes.SessionLogRecord, error) {
 	matchPrint := func(string) bool { return false }
 
@@ -67,13 +70,6 @@ func sessionWatch(sid sdktypes.SessionID, endState sdktypes.SessionStateType, en
 		matchPrint = pre.MatchString
 	}
 
-	var state sdktypes.SessionStateType
-	var rs []sdktypes.SessionLogRecord
-
-	f := sdkservices.SessionLogRecordsFilter{SessionID: sid}
-	f.PageSize = int32(pageSize)
-	f.Ascending = true
-
 	ctx := context.Background()
 	if watchTimeout > 0 {
 		var cancel func()
@@ -81,6 +77,64 @@ func sessionWatch(sid sdktypes.SessionID, endState sdktypes.SessionStateType, en
 		defer cancel()
 	}
 
+	rs, err := streamSessionWatch(ctx, sid, endState, matchPrint)
+	if errors.Is(err, sdkerrors.ErrNotImplemented) {
+		// Server does not support streaming.
+		return pollSessionWatch(ctx, sid, endState, matchPrint)
+	}
+
+	return rs, err
+}
+
+func streamSessionWatch(ctx context.Context, sid sdktypes.SessionID, endState sdktypes.SessionStateType, matchPrint func(string) bool) ([]sdktypes.SessionLogRecord, error) {
+	var rs []sdktypes.SessionLogRecord
+
+	for {
+		err := sessions().WatchLog(ctx, sdkservices.SessionLogRecordsFilter{SessionID: sid}, func(r sdktypes.SessionLogRecord) error {
+			if p := r.GetPrint(); p.IsValid() {
+				if s, _ := p.ToString(); matchPrint(s) {
+					return errWatchDone
+				}
+			}
+
+			printLogs([]sdktypes.SessionLogRecord{r})
+
+			rs = append(rs, r)
+
+			if state := r.GetState(); state.IsValid() && !endState.IsZero() && state.Type() == endState {
+				return errWatchDone
+			}
+
+			return nil
+		})
+
+		if waitCreated && len(rs) == 0 && errors.Is(err, sdkerrors.ErrNotFound) {
+			// session might not have been created yet (in test mode we know
+			// in advance the session id).
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(pollInterval):
+				continue
+			}
+		}
+
+		if errors.Is(err, errWatchDone) {
+			return rs, nil
+		}
+
+		return rs, err
+	}
+}
+
+func pollSessionWatch(ctx context.Context, sid sdktypes.SessionID, endState sdktypes.SessionStateType, matchPrint func(string) bool) ([]sdktypes.SessionLogRecord, error) {
+	var state sdktypes.SessionStateType
+	var rs []sdktypes.SessionLogRecord
+
+	f := sdkservices.SessionLogRecordsFilter{SessionID: sid}
+	f.PageSize = int32(pageSize)
+	f.Ascending = true
+
 	first := true
 
 	for !state.IsFinal() && (endState.IsZero() || state != endState) {
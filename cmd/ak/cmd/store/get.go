@@ -0,0 +1,39 @@
+package store
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+)
+
+var getCmd = common.StandardCommand(&cobra.Command{
+	Use:     "get <key> [<key> ...] <--project=...>",
+	Short:   "Get stored value(s)",
+	Aliases: []string{"g"},
+	Args:    cobra.MinimumNArgs(1),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pid, err := resolveProject()
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := common.LimitedContext()
+		defer cancel()
+
+		vs, err := store().Get(ctx, pid, args)
+		if err != nil {
+			return fmt.Errorf("get value(s): %w", err)
+		}
+
+		for _, k := range slices.Sorted(maps.Keys(vs)) {
+			common.RenderKV(k, vs[k])
+		}
+
+		return nil
+	},
+})
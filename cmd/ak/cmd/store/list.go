@@ -0,0 +1,73 @@
+package store
+
+import (
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+)
+
+var (
+	prefix        string
+	publishedOnly bool
+	nextPageToken string
+	pageSize      int
+	skipRows      int
+)
+
+var listCmd = common.StandardCommand(&cobra.Command{
+	Use:     "list <--project=...> [--prefix=...] [--published] [--page-size=...] [--next-page-token=...]",
+	Short:   "List stored keys",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pid, err := resolveProject()
+		if err != nil {
+			return err
+		}
+
+		f := sdkservices.ListStoreValuesFilter{Prefix: prefix, PublishedOnly: publishedOnly}
+
+		if nextPageToken != "" {
+			f.PageToken = nextPageToken
+		}
+
+		if pageSize > 0 {
+			f.PageSize = int32(pageSize)
+		}
+
+		if skipRows > 0 {
+			f.Skip = int32(skipRows)
+		}
+
+		ctx, cancel := common.LimitedContext()
+		defer cancel()
+
+		result, err := store().List(ctx, pid, f)
+		if result == nil {
+			result = &sdkservices.ListStoreValuesResult{}
+		}
+		err = common.AddNotFoundErrIfCond(err, len(result.Keys) > 0)
+		if err = common.ToExitCodeWithSkipNotFoundFlag(cmd, err, "keys"); err == nil {
+			for _, k := range result.Keys {
+				common.Render(k)
+			}
+			if result.NextPageToken != "" {
+				common.RenderKV("next-page-token", result.NextPageToken)
+			}
+		}
+		return err
+	},
+})
+
+func init() {
+	// Command-specific flags.
+	listCmd.Flags().StringVar(&prefix, "prefix", "", "list only keys that start with this prefix")
+	listCmd.Flags().BoolVar(&publishedOnly, "published", false, "list only published keys")
+	listCmd.Flags().StringVar(&nextPageToken, "next-page-token", "", "provide the returned page token to get next")
+	listCmd.Flags().IntVar(&pageSize, "page-size", 50, "page size")
+	listCmd.Flags().IntVar(&skipRows, "skip-rows", 0, "skip rows")
+
+	common.AddFailIfNotFoundFlag(listCmd)
+}
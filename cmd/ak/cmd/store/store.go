@@ -0,0 +1,65 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"go.autokitteh.dev/autokitteh/cmd/ak/common"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/internal/resolver"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+// Flag shared by all the subcommands.
+var project string
+
+var storeCmd = common.StandardCommand(&cobra.Command{
+	Use:   "store",
+	Short: "Project store subcommands: list, get",
+	Args:  cobra.NoArgs,
+})
+
+// AddSubcommands adds this command, and its own subcommands, to the calling parent.
+func AddSubcommands(parentCmd *cobra.Command) {
+	parentCmd.AddCommand(storeCmd)
+}
+
+func init() {
+	// Flag shared by all subcommands.
+	// We don't define it as a single persistent flag here
+	// because then we wouldn't be able to mark it as required.
+	listCmd.Flags().StringVarP(&project, "project", "p", "", "project name or ID")
+	getCmd.Flags().StringVarP(&project, "project", "p", "", "project name or ID")
+
+	kittehs.Must0(listCmd.MarkFlagRequired("project"))
+	kittehs.Must0(getCmd.MarkFlagRequired("project"))
+
+	// Subcommands.
+	storeCmd.AddCommand(listCmd)
+	storeCmd.AddCommand(getCmd)
+}
+
+func store() sdkservices.Store {
+	return common.Client().Store()
+}
+
+func resolveProject() (sdktypes.ProjectID, error) {
+	r := resolver.Resolver{Client: common.Client()}
+
+	ctx, cancel := common.LimitedContext()
+	defer cancel()
+
+	pid, err := r.ProjectNameOrID(ctx, sdktypes.InvalidOrgID, project)
+	if err != nil {
+		return sdktypes.InvalidProjectID, err
+	}
+
+	if !pid.IsValid() {
+		err = fmt.Errorf("project %q not found", project)
+		return sdktypes.InvalidProjectID, common.NewExitCodeError(common.NotFoundExitCode, err)
+	}
+
+	return pid, nil
+}
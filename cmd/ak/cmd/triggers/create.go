@@ -14,13 +14,14 @@ import (
 
 var (
 	call, event, filter, name, project, schedule, timezone string
-	webhook                                                bool
+	webhook, store                                         bool
 )
 
 var createCmd = common.StandardCommand(&cobra.Command{
 	Use: `create -n name [--call file:func] [-p project] -c connection [-E event] [-f filter]
              create -n name [--call file:func] [-p project] -s "schedule" [-z timezone]
              create -n name [--call file:func] [-p project] --webhook
+             create -n name [--call file:func] [-p project] --store [-E event] [-f filter]
 `,
 
 	Short: "Create event trigger",
@@ -73,8 +74,10 @@ var createCmd = common.StandardCommand(&cobra.Command{
 			}
 		} else if webhook {
 			t = t.WithWebhook()
+		} else if store {
+			t = t.WithSourceType(sdktypes.TriggerSourceTypeStore)
 		} else {
-			return errors.New("missing connection, schedule or webhook")
+			return errors.New("missing connection, schedule, webhook or store")
 		}
 
 		tid, err := triggers().Create(ctx, t)
@@ -98,15 +101,16 @@ func init() {
 
 	createCmd.Flags().VarP(common.NewNonEmptyString("", &connection), "connection", "c", "connection name or ID")
 	createCmd.Flags().BoolVarP(&webhook, "webhook", "w", false, "trigger uses a webhook")
+	createCmd.Flags().BoolVar(&store, "store", false, "trigger on project store changes")
 
 	createCmd.Flags().VarP(common.NewNonEmptyString("", &schedule), "schedule", "s", "schedule expression (cron or extended)")
 	createCmd.Flags().StringVarP(&timezone, "timezone", "z", "", "timezone for schedule (e.g., America/New_York, Europe/London)")
-	createCmd.MarkFlagsOneRequired("connection", "schedule", "webhook")
-	createCmd.MarkFlagsMutuallyExclusive("connection", "schedule", "webhook")
+	createCmd.MarkFlagsOneRequired("connection", "schedule", "webhook", "store")
+	createCmd.MarkFlagsMutuallyExclusive("connection", "schedule", "webhook", "store")
 
 	createCmd.Flags().StringVarP(&event, "event", "E", "", "optional event type, based on connection")
 	createCmd.Flags().StringVarP(&filter, "filter", "f", "", "optional event data filter expression")
 	createCmd.MarkFlagsMutuallyExclusive("schedule", "webhook", "event")
 	createCmd.MarkFlagsMutuallyExclusive("schedule", "webhook")
-	createCmd.MarkFlagsOneRequired("event", "schedule", "webhook")
+	createCmd.MarkFlagsOneRequired("event", "schedule", "webhook", "store")
 }
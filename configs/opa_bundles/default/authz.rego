@@ -95,10 +95,10 @@ allow if {
 	input.authn_user.org_memberships[input.subject.id].status in ["ACTIVE", "INVITED"]
 }
 
-# Org admins can delete and update an org.
+# Org admins can delete and update an org, and manage its session retention.
 allow if {
 	input.subject.kind == "org"
-	input.action.name in ["delete", "update"]
+	input.action.name in ["delete", "update", "set-session-retention", "delete-session-retention"]
 	is_subject_org_admin
 }
 
@@ -190,7 +190,7 @@ allow if {
 
 allow if {
 	input.subject.kind == "prj"
-	input.action.name in ["set-resources", "build", "delete", "update"]
+	input.action.name in ["set-resources", "build", "delete", "update", "set-session-retention", "delete-session-retention"]
 	is_active_member_of_subject_org
 }
 
@@ -313,17 +313,23 @@ allow if {
 
 allow if {
 	input.subject.kind == "evt"
-	input.action.name in ["list", "redispatch"]
+	input.action.name in ["list", "list-dead-letters", "redispatch"]
 	is_active_member_of_single_assosicated_org_id
 }
 
+allow if {
+	input.subject.kind == "dlq"
+	input.action.name == "retry-dead-letter"
+	is_active_member_of_subject_org
+}
+
 #
 # Sessions
 #
 
 allow if {
 	input.subject.kind == "ses"
-	input.action.name == "start"
+	input.action.name in ["start", "import"]
 	is_active_member_of_single_assosicated_org_id
 }
 
@@ -335,10 +341,33 @@ allow if {
 
 allow if {
 	input.subject.kind == "ses"
+	input.action.name in ["list", "search", "get-bulk-operation"]
+	is_active_member_of_single_assosicated_org_id
+}
+
+allow if {
+	input.subject.kind == "ses"
+	input.action.name in ["stop-many", "delete-many", "restart-many"]
+	is_active_member_of_single_assosicated_org_id
+}
+
+#
+# Approvals
+#
+
+allow if {
+	input.subject.kind == "apr"
 	input.action.name == "list"
 	is_active_member_of_single_assosicated_org_id
 }
 
+# The approval's approvers list, if any, is enforced by the service.
+allow if {
+	input.subject.kind == "apr"
+	input.action.name == "decide"
+	is_active_member_of_subject_org
+}
+
 #
 # Vars
 #
@@ -0,0 +1,122 @@
+package approvals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/auth/authcontext"
+	"go.autokitteh.dev/autokitteh/internal/backend/auth/authz"
+	"go.autokitteh.dev/autokitteh/internal/backend/db"
+	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+type Approvals struct {
+	db       db.DB
+	temporal temporalclient.Client
+	l        *zap.Logger
+}
+
+var _ sdkservices.Approvals = (*Approvals)(nil)
+
+func New(db db.DB, temporal temporalclient.Client, l *zap.Logger) *Approvals {
+	return &Approvals{db: db, temporal: temporal, l: l}
+}
+
+func (a *Approvals) Get(ctx context.Context, id sdktypes.ApprovalID) (sdktypes.Approval, error) {
+	if err := authz.CheckContext(ctx, id, authz.OpApprovalReadGet, authz.WithConvertForbiddenToNotFound); err != nil {
+		return sdktypes.InvalidApproval, err
+	}
+
+	return a.db.GetApproval(ctx, id)
+}
+
+func (a *Approvals) List(ctx context.Context, filter sdkservices.ListApprovalsFilter) ([]sdktypes.Approval, error) {
+	if !filter.AnyIDSpecified() {
+		filter.OrgID = authcontext.GetAuthnInferredOrgID(ctx)
+	}
+
+	if err := authz.CheckContext(
+		ctx,
+		sdktypes.InvalidApprovalID,
+		authz.OpApprovalReadList,
+		authz.WithData("filter", filter),
+		authz.WithAssociationWithID("project", filter.ProjectID),
+		authz.WithAssociationWithID("session", filter.SessionID),
+		authz.WithAssociationWithID("org", filter.OrgID),
+	); err != nil {
+		return nil, err
+	}
+
+	return a.db.ListApprovals(ctx, filter)
+}
+
+func (a *Approvals) Decide(ctx context.Context, id sdktypes.ApprovalID, approve bool, comment string) (sdktypes.Approval, error) {
+	if err := authz.CheckContext(ctx, id, authz.OpApprovalWriteDecide, authz.WithConvertForbiddenToNotFound); err != nil {
+		return sdktypes.InvalidApproval, err
+	}
+
+	approval, err := a.db.GetApproval(ctx, id)
+	if err != nil {
+		return sdktypes.InvalidApproval, err
+	}
+
+	if state := approval.State(); state != sdktypes.ApprovalStatePending {
+		return sdktypes.InvalidApproval, fmt.Errorf("%w: approval is %v", sdkerrors.ErrFailedPrecondition, state)
+	}
+
+	if t := approval.ExpiresAt(); !t.IsZero() && kittehs.Now().After(t) {
+		return sdktypes.InvalidApproval, fmt.Errorf("%w: approval has expired", sdkerrors.ErrFailedPrecondition)
+	}
+
+	email := authcontext.GetAuthnUser(ctx).Email()
+
+	// An empty approvers list means any project member may decide.
+	if approvers := approval.Approvers(); len(approvers) != 0 {
+		if !slices.ContainsFunc(approvers, func(a string) bool { return strings.EqualFold(a, email) }) {
+			return sdktypes.InvalidApproval, sdkerrors.ErrUnauthorized
+		}
+	}
+
+	state := sdktypes.ApprovalStateRejected
+	if approve {
+		state = sdktypes.ApprovalStateApproved
+	}
+
+	if approval, err = a.db.DecideApproval(ctx, id, state, email, comment); err != nil {
+		return sdktypes.InvalidApproval, err
+	}
+
+	l := a.l.With(zap.String("approval_id", id.String()), zap.String("session_id", approval.SessionID().String()))
+
+	// The session waits for a signal named after the approval. The decision itself
+	// is read back from the database by the session, the payload is informational.
+	if err := a.temporal.TemporalClient().SignalWorkflow(
+		ctx,
+		approval.SessionID().String(),
+		"",
+		id.String(),
+		sdktypes.NewStringValue(state.String()),
+	); err != nil {
+		var notFound *serviceerror.NotFound
+		if !errors.As(err, &notFound) {
+			return sdktypes.InvalidApproval, fmt.Errorf("signal session: %w", err)
+		}
+
+		// The session is already gone, so there is nobody to tell.
+		l.Warn("session not found for decided approval")
+	}
+
+	l.Info("approval decided", zap.Stringer("state", state), zap.String("decided_by", email))
+
+	return approval, nil
+}
@@ -0,0 +1,53 @@
+package approvals
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/auth/authz"
+	"go.autokitteh.dev/autokitteh/internal/backend/db"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func TestMain(m *testing.M) {
+	authz.DisableCheckForTesting()
+	kittehs.FreezeTimeForTest()
+	m.Run()
+}
+
+var errDecided = errors.New("decided")
+
+type testDB struct {
+	db.DB
+	approval sdktypes.Approval
+}
+
+func (db *testDB) GetApproval(context.Context, sdktypes.ApprovalID) (sdktypes.Approval, error) {
+	return db.approval, nil
+}
+
+func (db *testDB) DecideApproval(context.Context, sdktypes.ApprovalID, sdktypes.ApprovalState, string, string) (sdktypes.Approval, error) {
+	return sdktypes.InvalidApproval, errDecided
+}
+
+func TestDecideExpired(t *testing.T) {
+	approval := sdktypes.NewApproval(sdktypes.NewSessionID(), sdktypes.NewProjectID(), "meow", nil)
+
+	decide := func(expiresAt time.Time) error {
+		a := New(&testDB{approval: approval.WithExpiresAt(expiresAt)}, nil, zap.NewNop())
+		_, err := a.Decide(t.Context(), approval.ID(), true, "")
+		return err
+	}
+
+	assert.ErrorIs(t, decide(kittehs.Now().Add(-time.Nanosecond)), sdkerrors.ErrFailedPrecondition)
+
+	// Expires only after the expiration time.
+	assert.ErrorIs(t, decide(kittehs.Now()), errDecided)
+}
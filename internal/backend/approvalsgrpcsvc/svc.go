@@ -0,0 +1,103 @@
+package approvalsgrpcsvc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/muxes"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/proto"
+	approvalsv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/approvals/v1"
+	"go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/approvals/v1/approvalsv1connect"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+type server struct{ approvals sdkservices.Approvals }
+
+var _ approvalsv1connect.ApprovalsServiceHandler = (*server)(nil)
+
+func Init(muxes *muxes.Muxes, approvals sdkservices.Approvals) {
+	s := &server{approvals: approvals}
+	path, namer := approvalsv1connect.NewApprovalsServiceHandler(s)
+	muxes.Auth.Handle(path, namer)
+}
+
+func (s *server) Get(ctx context.Context, req *connect.Request[approvalsv1.GetRequest]) (*connect.Response[approvalsv1.GetResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	id, err := sdktypes.StrictParseApprovalID(msg.ApprovalId)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	a, err := s.approvals.Get(ctx, id)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	return connect.NewResponse(&approvalsv1.GetResponse{Approval: a.ToProto()}), nil
+}
+
+func (s *server) List(ctx context.Context, req *connect.Request[approvalsv1.ListRequest]) (*connect.Response[approvalsv1.ListResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	filter := sdkservices.ListApprovalsFilter{Limit: int(msg.Limit)}
+
+	var err error
+
+	if filter.OrgID, err = sdktypes.ParseOrgID(msg.OrgId); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	if filter.ProjectID, err = sdktypes.ParseProjectID(msg.ProjectId); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	if filter.SessionID, err = sdktypes.ParseSessionID(msg.SessionId); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	if filter.State, err = sdktypes.ApprovalStateFromProto(msg.State); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	as, err := s.approvals.List(ctx, filter)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	return connect.NewResponse(&approvalsv1.ListResponse{
+		Approvals: kittehs.Transform(as, sdktypes.ToProto),
+	}), nil
+}
+
+func (s *server) Decide(ctx context.Context, req *connect.Request[approvalsv1.DecideRequest]) (*connect.Response[approvalsv1.DecideResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	id, err := sdktypes.StrictParseApprovalID(msg.ApprovalId)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	a, err := s.approvals.Decide(ctx, id, msg.Approve, msg.Comment)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	return connect.NewResponse(&approvalsv1.DecideResponse{Approval: a.ToProto()}), nil
+}
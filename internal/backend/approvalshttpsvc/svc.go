@@ -0,0 +1,86 @@
+package approvalshttpsvc
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/muxes"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+type svc struct {
+	l         *zap.Logger
+	approvals sdkservices.Approvals
+}
+
+// Init exposes approvals over plain HTTP, so they can be decided
+// from a link in a chat message or an email.
+func Init(muxes *muxes.Muxes, approvals sdkservices.Approvals, l *zap.Logger) {
+	s := &svc{approvals: approvals, l: l}
+
+	muxes.Auth.HandleFunc("GET /approvals/{id}", s.get)
+	muxes.Auth.HandleFunc("POST /approvals/{id}/approve", func(w http.ResponseWriter, r *http.Request) { s.decide(w, r, true) })
+	muxes.Auth.HandleFunc("POST /approvals/{id}/reject", func(w http.ResponseWriter, r *http.Request) { s.decide(w, r, false) })
+}
+
+func (s *svc) get(w http.ResponseWriter, r *http.Request) {
+	id, err := sdktypes.StrictParseApprovalID(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "invalid approval ID", http.StatusBadRequest)
+		return
+	}
+
+	a, err := s.approvals.Get(r.Context(), id)
+	if err != nil {
+		s.error(w, id, err)
+		return
+	}
+
+	s.respond(w, id, a)
+}
+
+// The optional "comment" form value is recorded with the decision.
+func (s *svc) decide(w http.ResponseWriter, r *http.Request, approve bool) {
+	id, err := sdktypes.StrictParseApprovalID(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "invalid approval ID", http.StatusBadRequest)
+		return
+	}
+
+	a, err := s.approvals.Decide(r.Context(), id, approve, r.FormValue("comment"))
+	if err != nil {
+		s.error(w, id, err)
+		return
+	}
+
+	s.respond(w, id, a)
+}
+
+func (s *svc) respond(w http.ResponseWriter, id sdktypes.ApprovalID, a sdktypes.Approval) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(a); err != nil {
+		s.l.Error("failed to encode approval", zap.String("approval_id", id.String()), zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *svc) error(w http.ResponseWriter, id sdktypes.ApprovalID, err error) {
+	switch {
+	case errors.Is(err, sdkerrors.ErrNotFound):
+		http.Error(w, "not found", http.StatusNotFound)
+	case errors.Is(err, sdkerrors.ErrUnauthorized):
+		http.Error(w, "not an approver", http.StatusForbidden)
+	case errors.Is(err, sdkerrors.ErrFailedPrecondition):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		s.l.Error("approval request failed", zap.String("approval_id", id.String()), zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
@@ -19,8 +19,9 @@ var (
 	p                    = sdktypes.NewProject().WithNewID().WithName(sdktypes.NewSymbol("project")).WithOrgID(cats.ID())
 	tr                   = sdktypes.NewTrigger(sdktypes.NewSymbol("trigger")).WithWebhook().WithNewID().WithProjectID(p.ID())
 	nonexistentTriggerID = sdktypes.NewTriggerID()
+	dl                   = sdktypes.NewDeadLetter(sdktypes.NewEventID(), p.ID(), sdktypes.DeadLetterReasonNoDestinations)
 )
 
 func setupDB(t *testing.T) db.DB {
-	return dbtest.NewTestDB(t, zumi, gizmo, shoogy, sufi, cats, zumiInCats, sufiInCats, p, tr)
+	return dbtest.NewTestDB(t, zumi, gizmo, shoogy, sufi, cats, zumiInCats, sufiInCats, p, tr, dl)
 }
@@ -68,6 +68,26 @@ func TestDefaultPolicy(t *testing.T) {
 			id:     zumi.ID(),
 			action: "read:get",
 		},
+		{
+			name:   "allow retry dead letter",
+			authn:  zumi,
+			id:     dl.ID(),
+			action: "write:retry-dead-letter",
+		},
+		{
+			name:   "deny retry dead letter",
+			authn:  shoogy,
+			id:     dl.ID(),
+			action: "write:retry-dead-letter",
+			err:    sdkerrors.ErrUnauthorized, // shoogy is not a member of cats.
+		},
+		{
+			name:   "deny retry nonexistent dead letter",
+			authn:  zumi,
+			id:     sdktypes.NewDeadLetterID(),
+			action: "write:retry-dead-letter",
+			err:    sdkerrors.ErrUnauthorized,
+		},
 	}
 
 	decide, err := opapolicy.New(nil, zaptest.NewLogger(t))
@@ -65,8 +65,15 @@ const (
 	OpTriggerReadList     = "read:list"
 
 	// Dispatcher operations
-	OpDispatch   = "dispatch"
-	OpRedispatch = "redispatch"
+	OpDispatch        = "dispatch"
+	OpRedispatch      = "redispatch"
+	OpListDeadLetters = "read:list-dead-letters"
+	OpRetryDeadLetter = "write:retry-dead-letter"
+
+	// Approval operations
+	OpApprovalReadGet     = "read:get"
+	OpApprovalReadList    = "read:list"
+	OpApprovalWriteDecide = "write:decide"
 
 	// User operations
 	OpUserCreateCreate = "create:create"
@@ -96,12 +103,26 @@ const (
 	OpConnectionReadGet      = "read:get"
 
 	// Session operations
-	OpSessionReadGetPrints   = "read:get-prints"
-	OpSessionReadGetLog      = "read:get-log"
-	OpSessionReadDownloadLog = "read:download-log"
-	OpSessionReadGet         = "read:get"
-	OpSessionWriteStop       = "write:stop"
-	OpSessionReadList        = "read:list"
-	OpSessionDeleteDelete    = "delete:delete"
-	OpSessionCreateStart     = "create:start"
+	OpSessionReadGetPrints        = "read:get-prints"
+	OpSessionReadGetLog           = "read:get-log"
+	OpSessionReadWatchLog         = "read:watch-log"
+	OpSessionReadDownloadLog      = "read:download-log"
+	OpSessionReadReplay           = "read:replay"
+	OpSessionReadExport           = "read:export"
+	OpSessionReadGet              = "read:get"
+	OpSessionReadGetBulkOperation = "read:get-bulk-operation"
+	OpSessionWriteStop            = "write:stop"
+	OpSessionWriteStopMany        = "write:stop-many"
+	OpSessionReadList             = "read:list"
+	OpSessionReadSearch           = "read:search"
+	OpSessionDeleteDelete         = "delete:delete"
+	OpSessionDeleteDeleteMany     = "delete:delete-many"
+	OpSessionCreateStart          = "create:start"
+	OpSessionCreateImport         = "create:import"
+	OpSessionCreateRestartMany    = "create:restart-many"
+
+	// Session retention operations, whose subject is either an org or a project.
+	OpSessionRetentionWriteSet     = "write:set-session-retention"
+	OpSessionRetentionReadGet      = "read:get-session-retention"
+	OpSessionRetentionDeleteDelete = "delete:delete-session-retention"
 )
@@ -28,6 +28,7 @@ import (
 
 	"go.autokitteh.dev/autokitteh/integrations/oauth"
 	"go.autokitteh.dev/autokitteh/internal/backend/configset"
+	"go.autokitteh.dev/autokitteh/internal/backend/db"
 	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
 	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
 )
@@ -43,12 +44,18 @@ type Config struct {
 	Workflow temporalclient.WorkflowConfig `koanf:"workflow"`
 	Activity temporalclient.ActivityConfig `koanf:"activity"`
 	Enabled  bool
+
+	Retention RetentionConfig `koanf:"retention"`
 }
 
 var (
 	Configs = configset.Set[Config]{
 		Default: &Config{
 			Enabled: true,
+			Retention: RetentionConfig{
+				BatchSize:  100,
+				MaxBatches: 50,
+			},
 		},
 		Test: &Config{
 			Enabled: false,
@@ -73,13 +80,17 @@ type Cron struct {
 	cfg         *Config
 	logger      *zap.Logger
 	temporal    temporalclient.Client
+	db          db.DB
 	connections sdkservices.Connections
 	vars        sdkservices.Vars
 	oauth       *oauth.OAuth
+	retention   []retentionFilter
 }
 
-func New(c *Config, l *zap.Logger, t temporalclient.Client) *Cron {
-	return &Cron{cfg: c, logger: l, temporal: t}
+func New(c *Config, l *zap.Logger, t temporalclient.Client, db db.DB) *Cron {
+	initMetrics()
+
+	return &Cron{cfg: c, logger: l, temporal: t, db: db}
 }
 
 func (cr *Cron) Start(ctx context.Context, c sdkservices.Connections, v sdkservices.Vars, o *oauth.OAuth) error {
@@ -92,6 +103,11 @@ func (cr *Cron) Start(ctx context.Context, c sdkservices.Connections, v sdkservi
 	cr.vars = v
 	cr.oauth = o
 
+	var err error
+	if cr.retention, err = parseRetentionPolicies(cr.cfg.Retention.Policies); err != nil {
+		return fmt.Errorf("cron: %w", err)
+	}
+
 	// Configure a worker for the internal maintenance workflow.
 	w := temporalclient.NewWorker(cr.logger, cr.temporal.TemporalClient(), taskQueueName, cr.cfg.Worker)
 	if w == nil {
@@ -120,13 +136,19 @@ func (cr *Cron) Start(ctx context.Context, c sdkservices.Connections, v sdkservi
 	w.RegisterActivity(cr.listJiraConnectionsActivity)
 	w.RegisterActivity(cr.renewJiraEventWatchActivity)
 
+	w.RegisterWorkflow(cr.deleteExpiredStoreValuesWorkflow)
+	w.RegisterActivity(cr.deleteExpiredStoreValuesActivity)
+
+	w.RegisterWorkflow(cr.purgeSessionsWorkflow)
+	w.RegisterActivity(cr.listRetentionFiltersActivity)
+	w.RegisterActivity(cr.purgeSessionsActivity)
+
 	// Start the worker.
 	if err := w.Start(); err != nil {
 		return fmt.Errorf("cron: start worker: %w", err)
 	}
 
 	// Create or update the internal maintenance schedule.
-	var err error
 	if handle, ok := cr.scheduleAlreadyCreated(ctx); ok {
 		err = cr.updateSchedule(ctx, handle)
 	} else {
@@ -191,6 +213,8 @@ func (cr *Cron) workflow(wctx workflow.Context) error {
 	cwfs = append(cwfs, workflow.ExecuteChildWorkflow(wctx, cr.renewGoogleDriveEventWatchesWorkflow))
 	cwfs = append(cwfs, workflow.ExecuteChildWorkflow(wctx, cr.renewGoogleFormsEventWatchesWorkflow))
 	cwfs = append(cwfs, workflow.ExecuteChildWorkflow(wctx, cr.renewJiraEventWatchesWorkflow))
+	cwfs = append(cwfs, workflow.ExecuteChildWorkflow(wctx, cr.deleteExpiredStoreValuesWorkflow))
+	cwfs = append(cwfs, workflow.ExecuteChildWorkflow(wctx, cr.purgeSessionsWorkflow))
 
 	// Report an error if any child workflow failed.
 	errs := make([]error, 0)
@@ -0,0 +1,17 @@
+package cron
+
+import (
+	"go.opentelemetry.io/otel/metric"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/telemetry"
+)
+
+var (
+	sessionsPurgedCounter metric.Int64Counter
+	eventsPurgedCounter   metric.Int64Counter
+)
+
+func initMetrics() {
+	sessionsPurgedCounter, _ = telemetry.NewCounter("cron.sessions_purged", "Sessions purged by retention policies counter")
+	eventsPurgedCounter, _ = telemetry.NewCounter("cron.events_purged", "Orphaned events purged by retention policies counter")
+}
@@ -0,0 +1,285 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/db"
+	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+type RetentionConfig struct {
+	Policies []RetentionPolicy `koanf:"policies"`
+
+	// Number of sessions purged by each activity.
+	BatchSize int `koanf:"batch_size"`
+
+	// Maximum number of batches per policy in each run, so that
+	// a large backlog is purged gradually over multiple runs.
+	MaxBatches int `koanf:"max_batches"`
+}
+
+// RetentionPolicy determines which sessions are purged, along with their
+// log records and events that are not referenced by other sessions.
+// Sessions are governed by the policy of their project if there is one,
+// otherwise by the policy of their org, otherwise by the policy that
+// specifies neither, if any. Sessions that are not in a final state are
+// never purged. Policies set through the API override configured policies
+// of the same org or project.
+type RetentionPolicy struct {
+	// At most one may be specified.
+	OrgID     string `koanf:"org_id"`
+	ProjectID string `koanf:"project_id"`
+
+	// Purge sessions older than this. Zero means no age limit.
+	MaxAge time.Duration `koanf:"max_age"`
+
+	// Purge all but this many most recent sessions per project.
+	// Zero means no count limit.
+	MaxCount int `koanf:"max_count"`
+
+	// Only purge sessions in these states ("completed", "error" or
+	// "stopped"). Empty means all final states.
+	States []string `koanf:"states"`
+}
+
+// retentionFilter is a parsed retention policy.
+type retentionFilter struct {
+	db.SessionRetentionFilter
+
+	MaxAge time.Duration
+}
+
+func parseRetentionPolicies(ps []RetentionPolicy) ([]retentionFilter, error) {
+	fs := make([]retentionFilter, len(ps))
+
+	var (
+		oids       []sdktypes.OrgID
+		pids       []sdktypes.ProjectID
+		hasDefault bool
+	)
+
+	for i, p := range ps {
+		f := &fs[i]
+
+		if p.MaxAge <= 0 && p.MaxCount <= 0 {
+			return nil, sdkerrors.NewInvalidArgumentError("retention policy %d: either max_age or max_count must be positive", i)
+		}
+
+		f.MaxAge, f.KeepLatest = p.MaxAge, p.MaxCount
+
+		var err error
+		if f.OrgID, err = sdktypes.ParseOrgID(p.OrgID); err != nil {
+			return nil, fmt.Errorf("retention policy %d: org_id: %w", i, err)
+		}
+
+		if f.ProjectID, err = sdktypes.ParseProjectID(p.ProjectID); err != nil {
+			return nil, fmt.Errorf("retention policy %d: project_id: %w", i, err)
+		}
+
+		switch {
+		case f.OrgID.IsValid() && f.ProjectID.IsValid():
+			return nil, sdkerrors.NewInvalidArgumentError("retention policy %d: org_id and project_id are mutually exclusive", i)
+		case f.OrgID.IsValid():
+			if kittehs.ContainedIn(oids...)(f.OrgID) {
+				return nil, sdkerrors.NewInvalidArgumentError("retention policy %d: duplicate org_id", i)
+			}
+
+			oids = append(oids, f.OrgID)
+		case f.ProjectID.IsValid():
+			if kittehs.ContainedIn(pids...)(f.ProjectID) {
+				return nil, sdkerrors.NewInvalidArgumentError("retention policy %d: duplicate project_id", i)
+			}
+
+			pids = append(pids, f.ProjectID)
+		default:
+			if hasDefault {
+				return nil, sdkerrors.NewInvalidArgumentError("retention policy %d: duplicate policy without org_id or project_id", i)
+			}
+
+			hasDefault = true
+		}
+
+		if len(p.States) == 0 {
+			f.StateTypes = sdktypes.FinalSessionStateTypes
+		}
+
+		for _, s := range p.States {
+			st, err := sdktypes.ParseSessionStateType(s)
+			if err != nil {
+				return nil, fmt.Errorf("retention policy %d: state: %w", i, err)
+			}
+
+			if !st.IsFinal() {
+				return nil, sdkerrors.NewInvalidArgumentError("retention policy %d: state %q is not final", i, s)
+			}
+
+			f.StateTypes = append(f.StateTypes, st)
+		}
+	}
+
+	return fs, nil
+}
+
+// mergeRetentionFilters overrides the configured policies with the policies
+// set through the API for the same orgs and projects, and then excludes
+// sessions governed by more specific policies from less specific ones.
+func mergeRetentionFilters(cfg []retentionFilter, ps []sdkservices.SessionRetentionPolicy) []retentionFilter {
+	fs := make([]retentionFilter, 0, len(cfg)+len(ps))
+
+	set := make(map[string]bool, len(ps))
+
+	for _, p := range ps {
+		f := retentionFilter{MaxAge: p.MaxAge}
+		f.OrgID, f.ProjectID, f.KeepLatest, f.StateTypes = p.OrgID, p.ProjectID, p.MaxCount, p.States
+
+		if len(f.StateTypes) == 0 {
+			f.StateTypes = sdktypes.FinalSessionStateTypes
+		}
+
+		fs = append(fs, f)
+
+		set[f.OrgID.String()+f.ProjectID.String()] = true
+	}
+
+	for _, f := range cfg {
+		if (f.OrgID.IsValid() || f.ProjectID.IsValid()) && set[f.OrgID.String()+f.ProjectID.String()] {
+			continue
+		}
+
+		fs = append(fs, f)
+	}
+
+	var (
+		oids []sdktypes.OrgID
+		pids []sdktypes.ProjectID
+	)
+
+	for _, f := range fs {
+		switch {
+		case f.ProjectID.IsValid():
+			pids = append(pids, f.ProjectID)
+		case f.OrgID.IsValid():
+			oids = append(oids, f.OrgID)
+		}
+	}
+
+	// More specific policies take precedence.
+	for i := range fs {
+		f := &fs[i]
+
+		switch {
+		case f.ProjectID.IsValid():
+		case f.OrgID.IsValid():
+			f.ExcludeProjectIDs = pids
+		default:
+			f.ExcludeProjectIDs, f.ExcludeOrgIDs = pids, oids
+		}
+	}
+
+	return fs
+}
+
+// listRetentionFiltersActivity returns the configured retention policies,
+// merged with the policies set through the API.
+func (cr *Cron) listRetentionFiltersActivity(ctx context.Context) ([]retentionFilter, error) {
+	ps, err := cr.db.ListSessionRetentionPolicies(ctx)
+	if err != nil {
+		cr.logger.Error("failed to list session retention policies", zap.Error(err))
+		return nil, err
+	}
+
+	return mergeRetentionFilters(cr.retention, ps), nil
+}
+
+// purgeSessionsWorkflow purges sessions according to the configured
+// retention policies and the policies set through the API, a batch at a
+// time.
+func (cr *Cron) purgeSessionsWorkflow(wctx workflow.Context) error {
+	actx := temporalclient.WithActivityOptions(wctx, taskQueueName, cr.cfg.Activity)
+
+	var fs []retentionFilter
+	if err := workflow.ExecuteActivity(actx, cr.listRetentionFiltersActivity).Get(wctx, &fs); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, f := range fs {
+		if f.MaxAge > 0 {
+			f.CreatedBefore = workflow.Now(wctx).Add(-f.MaxAge)
+		}
+
+		for range cr.cfg.Retention.MaxBatches {
+			var n int
+			if err := workflow.ExecuteActivity(actx, cr.purgeSessionsActivity, f.SessionRetentionFilter).Get(wctx, &n); err != nil {
+				errs = append(errs, err)
+				break
+			}
+
+			if n < cr.cfg.Retention.BatchSize {
+				break
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// purgeSessionsActivity deletes a single batch of sessions, and then the
+// events that started them if no other session refers to them. Returns
+// the number of deleted sessions.
+func (cr *Cron) purgeSessionsActivity(ctx context.Context, f db.SessionRetentionFilter) (int, error) {
+	l := cr.logger.With(zap.String("org_id", f.OrgID.String()), zap.String("project_id", f.ProjectID.String()))
+
+	ss, err := cr.db.ListSessionsToPurge(ctx, f, cr.cfg.Retention.BatchSize)
+	if err != nil {
+		l.Error("failed to list sessions to purge", zap.Error(err))
+		return 0, err
+	}
+
+	var (
+		n    int
+		eids []sdktypes.EventID
+	)
+
+	for _, s := range ss {
+		if err := cr.db.DeleteSession(ctx, s.ID()); err != nil {
+			if errors.Is(err, sdkerrors.ErrNotFound) {
+				continue // Already deleted.
+			}
+
+			l.Error("failed to purge session", zap.String("session_id", s.ID().String()), zap.Error(err))
+			return n, err
+		}
+
+		n++
+
+		sessionsPurgedCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("state", s.State().String())))
+
+		if eid := s.EventID(); eid.IsValid() {
+			eids = append(eids, eid)
+		}
+	}
+
+	m, err := cr.db.DeleteOrphanedEvents(ctx, eids)
+	if err != nil {
+		l.Error("failed to purge orphaned events", zap.Error(err))
+		return n, err
+	}
+
+	eventsPurgedCounter.Add(ctx, m)
+
+	l.Info("purged sessions", zap.Int("sessions", n), zap.Int64("events", m))
+	return len(ss), nil
+}
@@ -0,0 +1,55 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func TestMergeRetentionFilters(t *testing.T) {
+	oid1, oid2, pid := sdktypes.NewOrgID(), sdktypes.NewOrgID(), sdktypes.NewProjectID()
+
+	cfg, err := parseRetentionPolicies([]RetentionPolicy{
+		{MaxAge: time.Hour},
+		{OrgID: oid1.String(), MaxAge: 2 * time.Hour},
+		{OrgID: oid2.String(), MaxCount: 10},
+	})
+	require.NoError(t, err)
+
+	fs := mergeRetentionFilters(cfg, []sdkservices.SessionRetentionPolicy{
+		{SessionRetentionScope: sdkservices.SessionRetentionScope{OrgID: oid1}, MaxCount: 5},
+		{SessionRetentionScope: sdkservices.SessionRetentionScope{ProjectID: pid}, MaxAge: time.Minute, States: []sdktypes.SessionStateType{sdktypes.SessionStateTypeError}},
+	})
+
+	require.Len(t, fs, 4)
+
+	// The API policy of oid1 overrides the configured one.
+	assert.Equal(t, oid1, fs[0].OrgID)
+	assert.Equal(t, 5, fs[0].KeepLatest)
+	assert.Zero(t, fs[0].MaxAge)
+	assert.Equal(t, sdktypes.FinalSessionStateTypes, fs[0].StateTypes)
+	assert.Equal(t, []sdktypes.ProjectID{pid}, fs[0].ExcludeProjectIDs)
+
+	assert.Equal(t, pid, fs[1].ProjectID)
+	assert.Equal(t, []sdktypes.SessionStateType{sdktypes.SessionStateTypeError}, fs[1].StateTypes)
+	assert.Empty(t, fs[1].ExcludeProjectIDs)
+	assert.Empty(t, fs[1].ExcludeOrgIDs)
+
+	// The default policy excludes all orgs and projects with their own policies.
+	assert.False(t, fs[2].OrgID.IsValid())
+	assert.Equal(t, time.Hour, fs[2].MaxAge)
+	assert.ElementsMatch(t, []sdktypes.OrgID{oid1, oid2}, fs[2].ExcludeOrgIDs)
+	assert.Equal(t, []sdktypes.ProjectID{pid}, fs[2].ExcludeProjectIDs)
+
+	assert.Equal(t, oid2, fs[3].OrgID)
+	assert.Equal(t, 10, fs[3].KeepLatest)
+
+	// The configured policies are left intact.
+	assert.Equal(t, 2*time.Hour, cfg[1].MaxAge)
+	assert.Empty(t, cfg[0].ExcludeOrgIDs)
+}
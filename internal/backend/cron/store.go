@@ -0,0 +1,28 @@
+package cron
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
+)
+
+// deleteExpiredStoreValuesWorkflow sweeps store values whose TTL has passed.
+// Reads already ignore such values, so this only reclaims their storage.
+func (cr *Cron) deleteExpiredStoreValuesWorkflow(wctx workflow.Context) error {
+	actx := temporalclient.WithActivityOptions(wctx, taskQueueName, cr.cfg.Activity)
+	return workflow.ExecuteActivity(actx, cr.deleteExpiredStoreValuesActivity).Get(wctx, nil)
+}
+
+func (cr *Cron) deleteExpiredStoreValuesActivity(ctx context.Context) error {
+	n, err := cr.db.DeleteExpiredStoreValues(ctx)
+	if err != nil {
+		cr.logger.Error("failed to delete expired store values", zap.Error(err))
+		return err
+	}
+
+	cr.logger.Info("deleted expired store values", zap.Int64("count", n))
+	return nil
+}
@@ -3,8 +3,12 @@ package dashboardsvc
 import (
 	"encoding/json"
 	"fmt"
+	"html"
 	"html/template"
+	"maps"
 	"net/http"
+	"slices"
+	"strings"
 
 	"go.autokitteh.dev/autokitteh/internal/kittehs"
 	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
@@ -24,8 +28,22 @@ func (p session) FieldsOrder() []string {
 	return []string{"created_at", "session_id", "name", "connection_id", "project_id"}
 }
 
-func (p session) HideFields() []string        { return nil }
-func (p session) ExtraFields() map[string]any { return nil }
+func (p session) HideFields() []string { return nil }
+
+func (p session) ExtraFields() map[string]any {
+	tags := p.Tags()
+	if len(tags) == 0 {
+		return map[string]any{"session_tags": ""}
+	}
+
+	kvs := make([]string, 0, len(tags))
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		// Tags are set by user code, so must be escaped.
+		kvs = append(kvs, html.EscapeString(k+"="+tags[k]))
+	}
+
+	return map[string]any{"session_tags": strings.Join(kvs, ", ")}
+}
 
 func toSession(sdkP sdktypes.Session) session { return session{sdkP} }
 
@@ -63,6 +81,17 @@ func (s *svc) sessions(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	tags := make(map[string]string)
+	for _, kv := range r.URL.Query()["tag"] {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			http.Error(w, fmt.Sprintf("invalid tag %q", kv), http.StatusBadRequest)
+			return
+		}
+
+		tags[k] = v
+	}
+
 	ts, err := s.listSessions(w, r, sdkservices.ListSessionsFilter{
 		PaginationRequest: sdktypes.PaginationRequest{
 			PageSize:  int32(getQueryNum(r, "sessions_page_size", 50)),
@@ -70,6 +99,7 @@ func (s *svc) sessions(w http.ResponseWriter, r *http.Request) {
 			PageToken: r.URL.Query().Get("sessions_page_token"),
 		},
 		ProjectID: pid,
+		Tags:      tags,
 	})
 	if err != nil {
 		return
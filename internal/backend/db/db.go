@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -12,6 +13,35 @@ import (
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
 )
 
+// SessionRetentionFilter selects sessions that should be purged
+// according to a retention policy.
+type SessionRetentionFilter struct {
+	// At most one is valid. If none is, sessions of all orgs are selected.
+	OrgID     sdktypes.OrgID
+	ProjectID sdktypes.ProjectID
+
+	// Sessions governed by more specific policies.
+	ExcludeOrgIDs     []sdktypes.OrgID
+	ExcludeProjectIDs []sdktypes.ProjectID
+
+	// Must all be final.
+	StateTypes []sdktypes.SessionStateType
+
+	// Sessions created before this are selected. Zero means no age limit.
+	CreatedBefore time.Time
+
+	// If positive, sessions beyond this many most recent ones in each
+	// project are selected.
+	KeepLatest int
+}
+
+// TriggerQueueEntry is an event in a trigger's concurrency queue.
+type TriggerQueueEntry struct {
+	Seq        uint64
+	EventID    sdktypes.EventID
+	WorkflowID string // of the workflow waiting for the event to be admitted.
+}
+
 type Shared interface {
 	Connect(context.Context) error
 	Setup(context.Context) error
@@ -54,12 +84,42 @@ type Shared interface {
 	FindConnectionIDsWithActiveDeploymentByVar(context.Context, sdktypes.IntegrationID, sdktypes.Symbol, string) ([]sdktypes.ConnectionID, error)
 
 	// -----------------------------------------------------------------------
-	// This is idempotent.
+	// This is idempotent. Returns sdkerrors.ErrAlreadyExists if the event has an
+	// idempotency key that is already used by another event to the same destination.
 	SaveEvent(context.Context, sdktypes.Event) error
 	GetEventByID(context.Context, sdktypes.EventID) (sdktypes.Event, error)
+	// Returns the earliest event to the destination with the given idempotency key
+	// that was created after the given time, or sdkerrors.ErrNotFound if there is none.
+	GetEventByIdempotencyKey(ctx context.Context, did sdktypes.EventDestinationID, key string, after time.Time) (sdktypes.Event, error)
+	// Clears the idempotency key of events to the destination that were created
+	// at or before the given time, so the key can be used again.
+	ReleaseEventIdempotencyKey(ctx context.Context, did sdktypes.EventDestinationID, key string, before time.Time) error
+	// Deletes the specified events that are not referenced by any session, dead
+	// letter or trigger queue entry. Returns the number of deleted events.
+	DeleteOrphanedEvents(context.Context, []sdktypes.EventID) (int64, error)
 	ListEvents(context.Context, sdkservices.ListEventsFilter) ([]sdktypes.Event, error)
 	GetLatestEventSequence(context.Context) (uint64, error)
 
+	// -----------------------------------------------------------------------
+	SaveDeadLetter(context.Context, sdktypes.DeadLetter) error
+	GetDeadLetter(context.Context, sdktypes.DeadLetterID) (sdktypes.DeadLetter, error)
+	ListDeadLetters(context.Context, sdkservices.ListDeadLettersFilter) ([]sdktypes.DeadLetter, error)
+	// Marks the dead letter as retried. Returns sdkerrors.ErrFailedPrecondition if
+	// it was already marked, so each dead letter is retried at most once.
+	ClaimDeadLetterRetry(context.Context, sdktypes.DeadLetterID) error
+	// Unmarks a dead letter marked by ClaimDeadLetterRetry, if its retry failed.
+	ReleaseDeadLetterRetry(context.Context, sdktypes.DeadLetterID) error
+	SetDeadLetterRetried(ctx context.Context, id sdktypes.DeadLetterID, retryEventID sdktypes.EventID) error
+
+	// -----------------------------------------------------------------------
+	CreateApproval(context.Context, sdktypes.Approval) error
+	// Returns sdkerrors.ErrNotFound if not found.
+	GetApproval(context.Context, sdktypes.ApprovalID) (sdktypes.Approval, error)
+	ListApprovals(context.Context, sdkservices.ListApprovalsFilter) ([]sdktypes.Approval, error)
+	// Moves a pending approval to the given final state and returns it.
+	// Returns sdkerrors.ErrFailedPrecondition if the approval is no longer pending.
+	DecideApproval(ctx context.Context, id sdktypes.ApprovalID, state sdktypes.ApprovalState, decidedBy, comment string) (sdktypes.Approval, error)
+
 	// -----------------------------------------------------------------------
 	CreateTrigger(context.Context, sdktypes.Trigger) error
 	UpdateTrigger(context.Context, sdktypes.Trigger) error
@@ -67,7 +127,23 @@ type Shared interface {
 	GetTriggerWithActiveDeploymentByID(context.Context, uuid.UUID) (sdktypes.Trigger, bool, error)
 	DeleteTrigger(context.Context, sdktypes.TriggerID) error
 	ListTriggers(context.Context, sdkservices.ListTriggersFilter) ([]sdktypes.Trigger, error)
-	GetTriggerWithActiveDeploymentByWebhookSlug(ctx context.Context, slug string) (sdktypes.Trigger, error)
+	// Returns the triggers with the slug, oldest first. Multiple triggers share a slug
+	// only if they have webhook routes. Returns an empty list if there are none.
+	ListTriggersWithActiveDeploymentByWebhookSlug(ctx context.Context, slug string) ([]sdktypes.Trigger, error)
+
+	// Events admitted by or waiting for a trigger's concurrency limit. Admitted
+	// events take a slot, as do active sessions of the trigger, until dequeued.
+	//
+	// Atomically admits the event if the trigger has a free slot and no events
+	// are waiting before it. Otherwise, if wait is set, queues it to be admitted
+	// later by AdmitQueuedTriggerEvents. Returns the entry's sequence number, or
+	// zero if the event was neither admitted nor queued.
+	AdmitTriggerEvent(ctx context.Context, trigger sdktypes.Trigger, e TriggerQueueEntry, did sdktypes.DeploymentID, wait bool) (seq uint64, admitted bool, err error)
+	// Admits the oldest queued events for the trigger's free slots, and returns them.
+	AdmitQueuedTriggerEvents(ctx context.Context, trigger sdktypes.Trigger) ([]TriggerQueueEntry, error)
+	// Returns sdkerrors.ErrNotFound if seq is not queued.
+	IsTriggerEventAdmitted(ctx context.Context, seq uint64) (bool, error)
+	DequeueTriggerEvent(ctx context.Context, seq uint64) error
 
 	// -----------------------------------------------------------------------
 	GetBuild(ctx context.Context, buildID sdktypes.BuildID) (sdktypes.Build, error)
@@ -100,18 +176,46 @@ type Shared interface {
 
 	// -----------------------------------------------------------------------
 	CreateSession(ctx context.Context, session sdktypes.Session) error
+	// Creates the session in its current state, along with its log.
+	ImportSession(ctx context.Context, session sdktypes.Session, log []sdktypes.SessionLogRecord) error
 	GetSession(ctx context.Context, sessionID sdktypes.SessionID) (sdktypes.Session, error)
 	GetSessionLog(ctx context.Context, filter sdkservices.SessionLogRecordsFilter) (*sdkservices.GetLogResults, error)
 	UpdateSessionState(ctx context.Context, sessionID sdktypes.SessionID, state sdktypes.SessionState) error
 	AddSessionPrint(ctx context.Context, sessionID sdktypes.SessionID, v sdktypes.Value, callSeq uint32) error
 	AddSessionStopRequest(ctx context.Context, sessionID sdktypes.SessionID, reason string) error
 	AddSessionOutcome(ctx context.Context, sessionID sdktypes.SessionID, v sdktypes.Value, eid sdktypes.EventID) error
+	// Records that a registered compensation was run. perr is set if it failed.
+	AddSessionCompensation(ctx context.Context, sessionID sdktypes.SessionID, fn sdktypes.Value, callSeq uint32, perr sdktypes.ProgramError) error
 	ListSessions(ctx context.Context, f sdkservices.ListSessionsFilter) (*sdkservices.ListSessionResult, error)
+	SearchSessions(ctx context.Context, f sdkservices.SearchSessionsFilter) (*sdkservices.ListSessionResult, error)
+	// SetSessionTag sets a tag on the session. An empty value removes it.
+	SetSessionTag(ctx context.Context, sessionID sdktypes.SessionID, key, value string) error
 	DeleteSession(ctx context.Context, sessionID sdktypes.SessionID) error
+	// Returns up to limit sessions that match the retention filter, oldest first.
+	// Returned sessions do not include their inputs.
+	ListSessionsToPurge(ctx context.Context, f SessionRetentionFilter, limit int) ([]sdktypes.Session, error)
+	// Replaces the policy of the same org or project, if any.
+	SetSessionRetentionPolicy(ctx context.Context, p sdkservices.SessionRetentionPolicy) error
+	// Returns sdkerrors.ErrNotFound if the org or project has no policy.
+	GetSessionRetentionPolicy(ctx context.Context, scope sdkservices.SessionRetentionScope) (*sdkservices.SessionRetentionPolicy, error)
+	// Does nothing if the org or project has no policy.
+	DeleteSessionRetentionPolicy(ctx context.Context, scope sdkservices.SessionRetentionScope) error
+	ListSessionRetentionPolicies(ctx context.Context) ([]sdkservices.SessionRetentionPolicy, error)
+	// Returns created or running sessions started by the trigger, oldest first.
+	ListActiveTriggerSessions(ctx context.Context, tid sdktypes.TriggerID) ([]sdktypes.SessionID, error)
 
 	// If no new outcome is available, returns (InvalidValue, InvalidSessionID, lastSeq, nil).
 	GetNextSessionOutcomeForEvent(ctx context.Context, eventID sdktypes.EventID, lastSeq uint64) (sdktypes.Value, sdktypes.SessionID, uint64, error)
 
+	// Returns a channel that receives a value when new outcomes might be available
+	// for the event. Notifications are best effort, and might be coalesced.
+	// The returned function must be called to unsubscribe.
+	SubscribeSessionOutcomes(eventID sdktypes.EventID) (<-chan struct{}, func())
+
+	// Reports whether outcomes added by any process are currently notified about,
+	// so subscribers can poll less often. Otherwise, notifications might be missed.
+	SessionOutcomeNotificationsRelayed() bool
+
 	// -----------------------------------------------------------------------
 	// TODO(ENG-917): Do not expose scheme outside of DB.
 	SaveSignal(ctx context.Context, signal *types.Signal) error
@@ -141,17 +245,33 @@ type Shared interface {
 	GetSecret(ctx context.Context, key string) (string, error)
 	DeleteSecret(ctx context.Context, key string) error
 
-	SetStoreValue(ctx context.Context, pid sdktypes.ProjectID, key string, v sdktypes.Value) error
+	// If expiresAt is nil, the current expiry of the value, if any, is retained.
+	// If it points to a zero time, the value never expires.
+	// If lockedBy is valid, the value is a lock held by that session and is
+	// deleted when the session reaches a final state.
+	SetStoreValue(ctx context.Context, pid sdktypes.ProjectID, key string, v sdktypes.Value, expiresAt *time.Time, lockedBy sdktypes.SessionID) error
 	GetStoreValue(ctx context.Context, pid sdktypes.ProjectID, key string) (sdktypes.Value, error)
+
+	// Returns the session holding the lock on the key, or InvalidSessionID
+	// if the key is not an unexpired lock.
+	GetStoreLockOwner(ctx context.Context, pid sdktypes.ProjectID, key string) (sdktypes.SessionID, error)
+
 	PublishStoreValue(ctx context.Context, pid sdktypes.ProjectID, key string) error
 	UnpublishStoreValue(ctx context.Context, pid sdktypes.ProjectID, key string) error
 	IsStoreValuePublished(ctx context.Context, pid sdktypes.ProjectID, key string) (bool, error)
-	CountStoreValues(ctx context.Context, pid sdktypes.ProjectID) (int64, error)
+
+	// If prefix is not empty, only values with keys that start with it are counted.
+	CountStoreValues(ctx context.Context, pid sdktypes.ProjectID, prefix string) (int64, error)
 
 	// If len(keys) == 0, it returns all keys.
 	// if getValues is true, it returns values for the keys. Otherwise, it returns only keys without values.
 	ListStoreValues(ctx context.Context, pid sdktypes.ProjectID, keys []string, getValues bool) (map[string]sdktypes.Value, error)
 
+	ListStoreKeys(ctx context.Context, pid sdktypes.ProjectID, f sdkservices.ListStoreValuesFilter) (*sdkservices.ListStoreValuesResult, error)
+
+	// Deletes all expired store values across all projects. Returns the number of deleted values.
+	DeleteExpiredStoreValues(ctx context.Context) (int64, error)
+
 	// -----------------------------------------------------------------------
 
 	// Get the org id of an object.
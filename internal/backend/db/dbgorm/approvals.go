@@ -0,0 +1,149 @@
+package dbgorm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/db/dbgorm/scheme"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func (gdb *gormdb) createApproval(ctx context.Context, a *scheme.Approval) error {
+	return gdb.writer.WithContext(ctx).Create(a).Error
+}
+
+func (gdb *gormdb) getApproval(ctx context.Context, id uuid.UUID) (*scheme.Approval, error) {
+	return getOne[scheme.Approval](gdb.reader.WithContext(ctx), "approval_id = ?", id)
+}
+
+func (gdb *gormdb) listApprovals(ctx context.Context, filter sdkservices.ListApprovalsFilter) ([]scheme.Approval, error) {
+	q := gdb.reader.WithContext(ctx)
+
+	if filter.OrgID.IsValid() {
+		q = q.Where("org_id = ?", filter.OrgID.UUIDValue())
+	}
+
+	if filter.ProjectID.IsValid() {
+		q = q.Where("project_id = ?", filter.ProjectID.UUIDValue())
+	}
+
+	if filter.SessionID.IsValid() {
+		q = q.Where("session_id = ?", filter.SessionID.UUIDValue())
+	}
+
+	if filter.State != sdktypes.ApprovalStateUnspecified {
+		q = q.Where("state = ?", int32(filter.State.ToProto()))
+	}
+
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	var as []scheme.Approval
+	if err := q.Order("created_at desc").Find(&as).Error; err != nil {
+		return nil, err
+	}
+	return as, nil
+}
+
+func (gdb *gormdb) decideApproval(ctx context.Context, id uuid.UUID, state int32, decidedBy, comment string) (*scheme.Approval, error) {
+	var a *scheme.Approval
+
+	err := gdb.writeTransaction(ctx, func(tx *gormdb) error {
+		q := tx.writer.WithContext(ctx).
+			Model(&scheme.Approval{}).
+			Where("approval_id = ? AND state = ?", id, int32(sdktypes.ApprovalStatePending.ToProto())).
+			Updates(map[string]any{
+				"state":      state,
+				"decided_by": decidedBy,
+				"comment":    comment,
+				"decided_at": kittehs.Now().UTC(),
+			})
+
+		if q.Error != nil {
+			return q.Error
+		}
+
+		var err error
+		if a, err = tx.getApproval(ctx, id); err != nil {
+			return err
+		}
+
+		if q.RowsAffected == 0 {
+			return fmt.Errorf("%w: approval is %v", sdkerrors.ErrFailedPrecondition, sdktypes.ApprovalStatePB(a.State))
+		}
+
+		return nil
+	})
+
+	return a, err
+}
+
+func (db *gormdb) CreateApproval(ctx context.Context, a sdktypes.Approval) error {
+	if err := a.Strict(); err != nil {
+		return err
+	}
+
+	oid, err := db.GetOrgIDOf(ctx, a.ProjectID())
+	if err != nil {
+		return fmt.Errorf("get org id: %w", err)
+	}
+
+	approvers, err := json.Marshal(a.Approvers())
+	if err != nil {
+		return fmt.Errorf("approvers: %w", err)
+	}
+
+	r := scheme.Approval{
+		ApprovalID: a.ID().UUIDValue(),
+		SessionID:  a.SessionID().UUIDValue(),
+		ProjectID:  a.ProjectID().UUIDValue(),
+		OrgID:      oid.UUIDValue(),
+		Title:      a.Title(),
+		Approvers:  approvers,
+		State:      int32(a.State().ToProto()),
+		CreatedAt:  kittehs.Now().UTC(),
+	}
+
+	if t := a.ExpiresAt(); !t.IsZero() {
+		t = t.UTC()
+		r.ExpiresAt = &t
+	}
+
+	return translateError(db.createApproval(ctx, &r))
+}
+
+func (db *gormdb) GetApproval(ctx context.Context, id sdktypes.ApprovalID) (sdktypes.Approval, error) {
+	r, err := db.getApproval(ctx, id.UUIDValue())
+	if r == nil || err != nil {
+		return sdktypes.InvalidApproval, translateError(err)
+	}
+	return scheme.ParseApproval(*r)
+}
+
+func (db *gormdb) ListApprovals(ctx context.Context, filter sdkservices.ListApprovalsFilter) ([]sdktypes.Approval, error) {
+	rs, err := db.listApprovals(ctx, filter)
+	if rs == nil || err != nil {
+		return nil, translateError(err)
+	}
+	return kittehs.TransformError(rs, scheme.ParseApproval)
+}
+
+func (db *gormdb) DecideApproval(ctx context.Context, id sdktypes.ApprovalID, state sdktypes.ApprovalState, decidedBy, comment string) (sdktypes.Approval, error) {
+	r, err := db.decideApproval(ctx, id.UUIDValue(), int32(state.ToProto()), decidedBy, comment)
+	if err != nil {
+		if errors.Is(err, sdkerrors.ErrFailedPrecondition) {
+			return sdktypes.InvalidApproval, err
+		}
+
+		return sdktypes.InvalidApproval, translateError(err)
+	}
+	return scheme.ParseApproval(*r)
+}
@@ -0,0 +1,107 @@
+package dbgorm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/db/dbgorm/scheme"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func (f *dbFixture) newApproval(s scheme.Session, approvers ...string) sdktypes.Approval {
+	return sdktypes.NewApproval(
+		sdktypes.NewIDFromUUID[sdktypes.SessionID](s.SessionID),
+		sdktypes.NewIDFromUUID[sdktypes.ProjectID](s.ProjectID),
+		"deploy to production?",
+		approvers,
+	)
+}
+
+func TestCreateApproval(t *testing.T) {
+	f, p, b := preSessionTest(t)
+	findAndAssertCount[scheme.Approval](t, f, 0, "") // no approvals
+
+	s := f.newSession(sdktypes.SessionStateTypeRunning, p, b)
+	f.createSessionsAndAssert(t, s)
+
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	a := f.newApproval(s, "zumi@cats", "gizmo@cats").WithExpiresAt(expiresAt)
+
+	require.NoError(t, f.gormdb.CreateApproval(f.ctx, a))
+
+	got, err := f.gormdb.GetApproval(f.ctx, a.ID())
+	require.NoError(t, err)
+
+	assert.Equal(t, a.ID(), got.ID())
+	assert.Equal(t, a.SessionID(), got.SessionID())
+	assert.Equal(t, "deploy to production?", got.Title())
+	assert.Equal(t, []string{"zumi@cats", "gizmo@cats"}, got.Approvers())
+	assert.Equal(t, sdktypes.ApprovalStatePending, got.State())
+	assert.True(t, expiresAt.Equal(got.ExpiresAt()))
+	assert.True(t, got.DecidedAt().IsZero())
+
+	r := findAndAssertCount[scheme.Approval](t, f, 1, "approval_id = ?", a.ID().UUIDValue())
+	assert.Equal(t, p.OrgID, r[0].OrgID)
+
+	oid, err := f.gormdb.GetOrgIDOf(f.ctx, a.ID())
+	require.NoError(t, err)
+	assert.Equal(t, p.OrgID, oid.UUIDValue())
+
+	_, err = f.gormdb.GetApproval(f.ctx, sdktypes.NewApprovalID())
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
+
+func TestListAndDecideApprovals(t *testing.T) {
+	f, p, b := preSessionTest(t)
+
+	s1 := f.newSession(sdktypes.SessionStateTypeRunning, p, b)
+	s2 := f.newSession(sdktypes.SessionStateTypeRunning, p, b)
+	f.createSessionsAndAssert(t, s1, s2)
+
+	a1, a2, a3 := f.newApproval(s1), f.newApproval(s1), f.newApproval(s2)
+
+	for _, a := range []sdktypes.Approval{a1, a2, a3} {
+		require.NoError(t, f.gormdb.CreateApproval(f.ctx, a))
+	}
+
+	as, err := f.gormdb.ListApprovals(f.ctx, sdkservices.ListApprovalsFilter{SessionID: a1.SessionID()})
+	require.NoError(t, err)
+	assert.Len(t, as, 2)
+
+	got, err := f.gormdb.DecideApproval(f.ctx, a1.ID(), sdktypes.ApprovalStateApproved, "zumi@cats", "lgtm")
+	require.NoError(t, err)
+	assert.Equal(t, sdktypes.ApprovalStateApproved, got.State())
+	assert.Equal(t, "zumi@cats", got.DecidedBy())
+	assert.Equal(t, "lgtm", got.Comment())
+	assert.False(t, got.DecidedAt().IsZero())
+
+	// Only pending approvals can be decided.
+	_, err = f.gormdb.DecideApproval(f.ctx, a1.ID(), sdktypes.ApprovalStateRejected, "gizmo@cats", "")
+	assert.ErrorIs(t, err, sdkerrors.ErrFailedPrecondition)
+
+	_, err = f.gormdb.DecideApproval(f.ctx, sdktypes.NewApprovalID(), sdktypes.ApprovalStateRejected, "gizmo@cats", "")
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	as, err = f.gormdb.ListApprovals(f.ctx, sdkservices.ListApprovalsFilter{
+		ProjectID: a1.ProjectID(),
+		State:     sdktypes.ApprovalStatePending,
+	})
+	require.NoError(t, err)
+	assert.Len(t, as, 2)
+
+	// Pending approvals expire when their session ends.
+	require.NoError(t, f.gormdb.UpdateSessionState(f.ctx, a1.SessionID(), sdktypes.NewSessionStateCompleted(nil, nil, sdktypes.Nothing)))
+
+	got, err = f.gormdb.GetApproval(f.ctx, a2.ID())
+	require.NoError(t, err)
+	assert.Equal(t, sdktypes.ApprovalStateExpired, got.State())
+
+	got, err = f.gormdb.GetApproval(f.ctx, a3.ID())
+	require.NoError(t, err)
+	assert.Equal(t, sdktypes.ApprovalStatePending, got.State())
+}
@@ -24,6 +24,8 @@ type gormdb struct {
 	cfg *Config
 
 	writer, reader *gorm.DB
+
+	notifier *notifier
 }
 
 // Migrate implements db.DB.
@@ -52,7 +54,7 @@ func New(z *zap.Logger, cfg *Config) (db.DB, error) {
 		return nil, err
 	}
 
-	return &gormdb{z: z, cfg: cfg}, nil
+	return &gormdb{z: z, cfg: cfg, notifier: newNotifier()}, nil
 }
 
 func (db *gormdb) GormDB() (r, w *gorm.DB) { return db.reader, db.writer }
@@ -108,7 +110,15 @@ func connect(_ context.Context, z *zap.Logger, cfg *Config) (r *gorm.DB, w *gorm
 }
 
 func (db *gormdb) Connect(ctx context.Context) (err error) {
-	db.reader, db.writer, err = connect(ctx, db.z.Named("gorm"), db.cfg)
+	if db.reader, db.writer, err = connect(ctx, db.z.Named("gorm"), db.cfg); err != nil {
+		return
+	}
+
+	if db.cfg.Type == "postgres" {
+		// Listens for the lifetime of the process, not just of ctx.
+		go db.notifier.listen(context.Background(), db.z.Named("notifier"), db.cfg.DSN)
+	}
+
 	return
 }
 
@@ -226,9 +236,10 @@ func (db *gormdb) Setup(ctx context.Context) error {
 // TODO: not sure this will work with the connect method
 func (db *gormdb) Debug() db.DB {
 	return &gormdb{
-		z:      db.z,
-		reader: db.reader.Debug(),
-		writer: db.writer.Debug(),
+		z:        db.z,
+		reader:   db.reader.Debug(),
+		writer:   db.writer.Debug(),
+		notifier: db.notifier,
 	}
 }
 
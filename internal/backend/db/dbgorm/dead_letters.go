@@ -0,0 +1,130 @@
+package dbgorm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/db/dbgorm/scheme"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func (gdb *gormdb) saveDeadLetter(ctx context.Context, dl *scheme.DeadLetter) error {
+	return gdb.writer.WithContext(ctx).Create(dl).Error
+}
+
+func (gdb *gormdb) getDeadLetter(ctx context.Context, id uuid.UUID) (*scheme.DeadLetter, error) {
+	return getOne[scheme.DeadLetter](gdb.reader.WithContext(ctx), "dead_letter_id = ?", id)
+}
+
+func (gdb *gormdb) listDeadLetters(ctx context.Context, filter sdkservices.ListDeadLettersFilter) ([]scheme.DeadLetter, error) {
+	q := gdb.reader.WithContext(ctx)
+
+	if filter.OrgID.IsValid() {
+		q = q.Where("org_id = ?", filter.OrgID.UUIDValue())
+	}
+
+	if filter.ProjectID.IsValid() {
+		q = q.Where("project_id = ?", filter.ProjectID.UUIDValue())
+	}
+
+	if filter.EventID.IsValid() {
+		q = q.Where("event_id = ?", filter.EventID.UUIDValue())
+	}
+
+	if !filter.IncludeRetried {
+		q = q.Where("retried_at IS NULL")
+	}
+
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	var dls []scheme.DeadLetter
+	if err := q.Order("created_at desc").Find(&dls).Error; err != nil {
+		return nil, err
+	}
+	return dls, nil
+}
+
+func (db *gormdb) SaveDeadLetter(ctx context.Context, dl sdktypes.DeadLetter) error {
+	if err := dl.Strict(); err != nil {
+		return err
+	}
+
+	oid, err := db.GetOrgIDOf(ctx, dl.ProjectID())
+	if err != nil {
+		return fmt.Errorf("get org id: %w", err)
+	}
+
+	r := scheme.DeadLetter{
+		DeadLetterID: dl.ID().UUIDValue(),
+		ProjectID:    dl.ProjectID().UUIDValue(),
+		OrgID:        oid.UUIDValue(),
+		EventID:      dl.EventID().UUIDValue(),
+		TriggerID:    dl.TriggerID().UUIDValuePtr(),
+		DeploymentID: dl.DeploymentID().UUIDValuePtr(),
+		Reason:       int32(dl.Reason().ToProto()),
+		Error:        dl.Error(),
+		CreatedAt:    kittehs.Now().UTC(),
+	}
+
+	return translateError(db.saveDeadLetter(ctx, &r))
+}
+
+func (db *gormdb) GetDeadLetter(ctx context.Context, id sdktypes.DeadLetterID) (sdktypes.DeadLetter, error) {
+	r, err := db.getDeadLetter(ctx, id.UUIDValue())
+	if r == nil || err != nil {
+		return sdktypes.InvalidDeadLetter, translateError(err)
+	}
+	return scheme.ParseDeadLetter(*r)
+}
+
+func (db *gormdb) ListDeadLetters(ctx context.Context, filter sdkservices.ListDeadLettersFilter) ([]sdktypes.DeadLetter, error) {
+	rs, err := db.listDeadLetters(ctx, filter)
+	if rs == nil || err != nil {
+		return nil, translateError(err)
+	}
+	return kittehs.TransformError(rs, scheme.ParseDeadLetter)
+}
+
+// updateDeadLetter updates the dead letter only if it matches cond, which is
+// checked in the same statement. If it does not, returns sdkerrors.ErrNotFound
+// if the dead letter does not exist, or sdkerrors.ErrFailedPrecondition otherwise.
+func (db *gormdb) updateDeadLetter(ctx context.Context, id sdktypes.DeadLetterID, cond string, values map[string]any) error {
+	q := db.writer.WithContext(ctx).
+		Model(&scheme.DeadLetter{}).
+		Where("dead_letter_id = ?", id.UUIDValue()).
+		Where(cond).
+		Updates(values)
+
+	if q.Error != nil {
+		return translateError(q.Error)
+	}
+
+	if q.RowsAffected != 0 {
+		return nil
+	}
+
+	if _, err := db.getDeadLetter(ctx, id.UUIDValue()); err != nil {
+		return translateError(err)
+	}
+
+	return sdkerrors.ErrFailedPrecondition
+}
+
+func (db *gormdb) ClaimDeadLetterRetry(ctx context.Context, id sdktypes.DeadLetterID) error {
+	return db.updateDeadLetter(ctx, id, "retried_at IS NULL", map[string]any{"retried_at": kittehs.Now().UTC()})
+}
+
+func (db *gormdb) ReleaseDeadLetterRetry(ctx context.Context, id sdktypes.DeadLetterID) error {
+	return db.updateDeadLetter(ctx, id, "retry_event_id IS NULL", map[string]any{"retried_at": nil})
+}
+
+func (db *gormdb) SetDeadLetterRetried(ctx context.Context, id sdktypes.DeadLetterID, retryEventID sdktypes.EventID) error {
+	return db.updateDeadLetter(ctx, id, "retried_at IS NOT NULL", map[string]any{"retry_event_id": retryEventID.UUIDValue()})
+}
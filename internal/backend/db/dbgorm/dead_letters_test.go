@@ -0,0 +1,125 @@
+package dbgorm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/db/dbgorm/scheme"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func (f *dbFixture) newDeadLetter(p scheme.Project, reason sdktypes.DeadLetterReason) sdktypes.DeadLetter {
+	return sdktypes.NewDeadLetter(
+		sdktypes.NewEventID(),
+		sdktypes.NewIDFromUUID[sdktypes.ProjectID](p.ProjectID),
+		reason,
+	).WithError("meow")
+}
+
+func TestSaveDeadLetter(t *testing.T) {
+	f := newDBFixture()
+	findAndAssertCount[scheme.DeadLetter](t, f, 0, "") // no dead letters
+
+	p := f.newProject()
+	f.createProjectsAndAssert(t, p)
+
+	tid := sdktypes.NewTriggerID()
+	dl := f.newDeadLetter(p, sdktypes.DeadLetterReasonSessionStartFailed).WithTriggerID(tid)
+
+	require.NoError(t, f.gormdb.SaveDeadLetter(f.ctx, dl))
+
+	got, err := f.gormdb.GetDeadLetter(f.ctx, dl.ID())
+	require.NoError(t, err)
+
+	assert.Equal(t, dl.ID(), got.ID())
+	assert.Equal(t, dl.EventID(), got.EventID())
+	assert.Equal(t, tid, got.TriggerID())
+	assert.False(t, got.DeploymentID().IsValid())
+	assert.Equal(t, sdktypes.DeadLetterReasonSessionStartFailed, got.Reason())
+	assert.Equal(t, "meow", got.Error())
+	assert.False(t, got.RetryEventID().IsValid())
+
+	r := findAndAssertCount[scheme.DeadLetter](t, f, 1, "dead_letter_id = ?", dl.ID().UUIDValue())
+	assert.Equal(t, p.OrgID, r[0].OrgID)
+
+	_, err = f.gormdb.GetDeadLetter(f.ctx, sdktypes.NewDeadLetterID())
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
+
+func TestListAndRetryDeadLetters(t *testing.T) {
+	f := newDBFixture()
+
+	p1, p2 := f.newProject(), f.newProject()
+	f.createProjectsAndAssert(t, p1, p2)
+
+	dl1 := f.newDeadLetter(p1, sdktypes.DeadLetterReasonNoDestinations)
+	dl2 := f.newDeadLetter(p1, sdktypes.DeadLetterReasonResourceExhausted)
+	dl3 := f.newDeadLetter(p2, sdktypes.DeadLetterReasonSessionInitFailed)
+
+	for _, dl := range []sdktypes.DeadLetter{dl1, dl2, dl3} {
+		require.NoError(t, f.gormdb.SaveDeadLetter(f.ctx, dl))
+	}
+
+	pid1 := sdktypes.NewIDFromUUID[sdktypes.ProjectID](p1.ProjectID)
+
+	dls, err := f.gormdb.ListDeadLetters(f.ctx, sdkservices.ListDeadLettersFilter{ProjectID: pid1})
+	require.NoError(t, err)
+	assert.Len(t, dls, 2)
+
+	dls, err = f.gormdb.ListDeadLetters(f.ctx, sdkservices.ListDeadLettersFilter{EventID: dl3.EventID()})
+	require.NoError(t, err)
+	require.Len(t, dls, 1)
+	assert.Equal(t, dl3.ID(), dls[0].ID())
+
+	retryEventID := sdktypes.NewEventID()
+	require.NoError(t, f.gormdb.ClaimDeadLetterRetry(f.ctx, dl1.ID()))
+	require.NoError(t, f.gormdb.SetDeadLetterRetried(f.ctx, dl1.ID(), retryEventID))
+
+	dls, err = f.gormdb.ListDeadLetters(f.ctx, sdkservices.ListDeadLettersFilter{ProjectID: pid1})
+	require.NoError(t, err)
+	require.Len(t, dls, 1)
+	assert.Equal(t, dl2.ID(), dls[0].ID())
+
+	dls, err = f.gormdb.ListDeadLetters(f.ctx, sdkservices.ListDeadLettersFilter{ProjectID: pid1, IncludeRetried: true})
+	require.NoError(t, err)
+	assert.Len(t, dls, 2)
+
+	got, err := f.gormdb.GetDeadLetter(f.ctx, dl1.ID())
+	require.NoError(t, err)
+	assert.Equal(t, retryEventID, got.RetryEventID())
+	assert.False(t, got.RetriedAt().IsZero())
+
+	assert.ErrorIs(t, f.gormdb.SetDeadLetterRetried(f.ctx, sdktypes.NewDeadLetterID(), retryEventID), sdkerrors.ErrNotFound)
+}
+
+func TestClaimDeadLetterRetry(t *testing.T) {
+	f := newDBFixture()
+
+	p := f.newProject()
+	f.createProjectsAndAssert(t, p)
+
+	dl := f.newDeadLetter(p, sdktypes.DeadLetterReasonNoDestinations)
+	require.NoError(t, f.gormdb.SaveDeadLetter(f.ctx, dl))
+
+	// Not claimed yet.
+	assert.ErrorIs(t, f.gormdb.SetDeadLetterRetried(f.ctx, dl.ID(), sdktypes.NewEventID()), sdkerrors.ErrFailedPrecondition)
+
+	require.NoError(t, f.gormdb.ClaimDeadLetterRetry(f.ctx, dl.ID()))
+	assert.ErrorIs(t, f.gormdb.ClaimDeadLetterRetry(f.ctx, dl.ID()), sdkerrors.ErrFailedPrecondition)
+
+	// Released after a failed retry, so it can be claimed again.
+	require.NoError(t, f.gormdb.ReleaseDeadLetterRetry(f.ctx, dl.ID()))
+	require.NoError(t, f.gormdb.ClaimDeadLetterRetry(f.ctx, dl.ID()))
+
+	require.NoError(t, f.gormdb.SetDeadLetterRetried(f.ctx, dl.ID(), sdktypes.NewEventID()))
+
+	// Cannot be released once it has a retry event.
+	assert.ErrorIs(t, f.gormdb.ReleaseDeadLetterRetry(f.ctx, dl.ID()), sdkerrors.ErrFailedPrecondition)
+	assert.ErrorIs(t, f.gormdb.ClaimDeadLetterRetry(f.ctx, dl.ID()), sdkerrors.ErrFailedPrecondition)
+
+	assert.ErrorIs(t, f.gormdb.ClaimDeadLetterRetry(f.ctx, sdktypes.NewDeadLetterID()), sdkerrors.ErrNotFound)
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"go.autokitteh.dev/autokitteh/internal/backend/db/dbgorm/scheme"
 	"go.autokitteh.dev/autokitteh/internal/kittehs"
@@ -15,17 +18,64 @@ import (
 )
 
 func (gdb *gormdb) saveEvent(ctx context.Context, event *scheme.Event) error {
-	return gdb.writer.WithContext(ctx).Create(event).Error
+	if event.IdempotencyKey == nil {
+		return gdb.writer.WithContext(ctx).Create(event).Error
+	}
+
+	// Drivers report unique violations differently, so detect them by the affected rows.
+	res := gdb.writer.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(event)
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return gorm.ErrDuplicatedKey
+	}
+
+	return nil
 }
 
 func (gdb *gormdb) deleteEvent(ctx context.Context, eventID uuid.UUID) error {
 	return gdb.writer.WithContext(ctx).Delete(&scheme.Event{}, "event_id = ?", eventID).Error // NOTE: eventID isn't a primary key
 }
 
+func (gdb *gormdb) deleteOrphanedEvents(ctx context.Context, eventIDs []uuid.UUID) (int64, error) {
+	q := gdb.writer.WithContext(ctx).
+		Where("event_id IN ?", eventIDs).
+		Where("NOT EXISTS (SELECT 1 FROM sessions WHERE sessions.event_id = events.event_id)").
+		Where("NOT EXISTS (SELECT 1 FROM dead_letters WHERE dead_letters.event_id = events.event_id)").
+		Where("NOT EXISTS (SELECT 1 FROM trigger_queue_entries WHERE trigger_queue_entries.event_id = events.event_id)").
+		Delete(&scheme.Event{})
+
+	return q.RowsAffected, q.Error
+}
+
 func (gdb *gormdb) getEvent(ctx context.Context, eventID uuid.UUID) (*scheme.Event, error) {
 	return getOne[scheme.Event](gdb.reader.WithContext(ctx), "event_id = ?", eventID)
 }
 
+func (gdb *gormdb) getEventByIdempotencyKey(ctx context.Context, did uuid.UUID, key string, after time.Time) (*scheme.Event, error) {
+	var e scheme.Event
+	err := gdb.reader.WithContext(ctx).
+		Where("destination_id = ? AND idempotency_key = ? AND created_at > ?", did, key, after).
+		Order("seq asc").
+		First(&e).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &e, nil
+}
+
+func (gdb *gormdb) releaseEventIdempotencyKey(ctx context.Context, did uuid.UUID, key string, before time.Time) error {
+	return gdb.writer.WithContext(ctx).
+		Model(&scheme.Event{}).
+		Where("destination_id = ? AND idempotency_key = ? AND created_at <= ?", did, key, before).
+		Update("idempotency_key", nil).
+		Error
+}
+
 func (gdb *gormdb) listEvents(ctx context.Context, filter sdkservices.ListEventsFilter) ([]scheme.Event, error) {
 	q := gdb.reader.WithContext(ctx)
 
@@ -109,6 +159,10 @@ func (db *gormdb) SaveEvent(ctx context.Context, event sdktypes.Event) error {
 		Memo:          kittehs.Must1(json.Marshal(event.Memo())),
 	}
 
+	if key := event.IdempotencyKey(); key != "" {
+		e.IdempotencyKey = &key
+	}
+
 	if cid.IsValid() { // only if exists
 		conn, err := db.GetConnection(ctx, cid)
 		if err != nil {
@@ -134,6 +188,27 @@ func (db *gormdb) GetEventByID(ctx context.Context, eventID sdktypes.EventID) (s
 	return scheme.ParseEvent(*e)
 }
 
+func (db *gormdb) GetEventByIdempotencyKey(ctx context.Context, did sdktypes.EventDestinationID, key string, after time.Time) (sdktypes.Event, error) {
+	e, err := db.getEventByIdempotencyKey(ctx, did.UUIDValue(), key, after)
+	if e == nil || err != nil {
+		return sdktypes.InvalidEvent, translateError(err)
+	}
+	return scheme.ParseEvent(*e)
+}
+
+func (db *gormdb) ReleaseEventIdempotencyKey(ctx context.Context, did sdktypes.EventDestinationID, key string, before time.Time) error {
+	return translateError(db.releaseEventIdempotencyKey(ctx, did.UUIDValue(), key, before))
+}
+
+func (db *gormdb) DeleteOrphanedEvents(ctx context.Context, eventIDs []sdktypes.EventID) (int64, error) {
+	if len(eventIDs) == 0 {
+		return 0, nil
+	}
+
+	n, err := db.deleteOrphanedEvents(ctx, kittehs.Transform(eventIDs, sdktypes.EventID.UUIDValue))
+	return n, translateError(err)
+}
+
 func (db *gormdb) ListEvents(ctx context.Context, filter sdkservices.ListEventsFilter) ([]sdktypes.Event, error) {
 	events, err := db.listEvents(ctx, filter)
 	if events == nil || err != nil {
@@ -2,6 +2,7 @@ package dbgorm
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -9,7 +10,9 @@ import (
 	"gorm.io/gorm"
 
 	"go.autokitteh.dev/autokitteh/internal/backend/db/dbgorm/scheme"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
 	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
 )
 
 func (f *dbFixture) createEventsAndAssert(t *testing.T, events ...scheme.Event) {
@@ -98,6 +101,29 @@ func TestDeleteEventNotDeletingSessions(t *testing.T) {
 	assert.Equal(t, ses.SessionID, s.SessionID)
 }
 
+func TestDeleteOrphanedEvents(t *testing.T) {
+	f := preEventTest(t)
+	p := f.newProject()
+	f.createProjectsAndAssert(t, p)
+	b := f.newBuild(p)
+	f.saveBuildsAndAssert(t, b)
+
+	e1, e2, e3 := f.newEvent(p), f.newEvent(p), f.newEvent(p)
+	f.createEventsAndAssert(t, e1, e2, e3)
+
+	s := f.newSession(e1, p, b)
+	f.createSessionsAndAssert(t, s)
+
+	// e1 is referenced by a session, e3 is not specified.
+	n, err := f.gormdb.deleteOrphanedEvents(f.ctx, []uuid.UUID{e1.EventID, e2.EventID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	findAndAssertCount[scheme.Event](t, f, 0, "event_id = ?", e2.EventID)
+	findAndAssertOne(t, f, e1, "event_id = ?", e1.EventID)
+	findAndAssertOne(t, f, e3, "event_id = ?", e3.EventID)
+}
+
 func TestGetEvent(t *testing.T) {
 	f := preEventTest(t)
 	p := f.newProject()
@@ -156,3 +182,56 @@ func TestListEventsOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEventByIdempotencyKey(t *testing.T) {
+	f := preEventTest(t)
+	p := f.newProject()
+	f.createProjectsAndAssert(t, p)
+	tr := f.newTrigger(p)
+	f.createTriggersAndAssert(t, tr)
+
+	did := sdktypes.NewEventDestinationID(sdktypes.NewIDFromUUID[sdktypes.TriggerID](tr.TriggerID))
+
+	key, other := "meow", "woof"
+
+	e1, e2, e3 := f.newEvent(p, tr), f.newEvent(p, tr), f.newEvent(p, tr)
+	e1.IdempotencyKey, e2.IdempotencyKey, e3.IdempotencyKey = &key, &key, &other
+	e2.CreatedAt = now.Add(time.Minute)
+
+	for _, e := range []*scheme.Event{&e1, &e2, &e3} {
+		e.DestinationID = tr.TriggerID
+	}
+
+	f.createEventsAndAssert(t, e1, e3)
+
+	e, err := f.gormdb.GetEventByIdempotencyKey(f.ctx, did, key, now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, e1.EventID, e.ID().UUIDValue())
+	assert.Equal(t, key, e.IdempotencyKey())
+
+	// e1 is too old.
+	_, err = f.gormdb.GetEventByIdempotencyKey(f.ctx, did, key, now)
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	_, err = f.gormdb.GetEventByIdempotencyKey(f.ctx, did, "nope", now.Add(-time.Minute))
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// The key is still held by e1.
+	assert.ErrorIs(t, f.gormdb.saveEvent(f.ctx, &e2), gorm.ErrDuplicatedKey)
+
+	// Not created at or before e1.
+	require.NoError(t, f.gormdb.ReleaseEventIdempotencyKey(f.ctx, did, key, now.Add(-time.Minute)))
+	assert.ErrorIs(t, f.gormdb.saveEvent(f.ctx, &e2), gorm.ErrDuplicatedKey)
+
+	require.NoError(t, f.gormdb.ReleaseEventIdempotencyKey(f.ctx, did, key, now))
+	f.createEventsAndAssert(t, e2)
+
+	e, err = f.gormdb.GetEventByIdempotencyKey(f.ctx, did, key, now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, e2.EventID, e.ID().UUIDValue())
+
+	// Other keys are left alone.
+	e, err = f.gormdb.GetEventByIdempotencyKey(f.ctx, did, other, now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, e3.EventID, e.ID().UUIDValue())
+}
@@ -58,7 +58,7 @@ func TestMain(m *testing.M) {
 	cfg, _ = cfg.Explicit()
 	db := setupDB(cfg)
 	z := kittehs.Must1(zap.NewDevelopment())
-	gormDB = gormdb{reader: db, writer: db, cfg: cfg, z: z}
+	gormDB = gormdb{reader: db, writer: db, cfg: cfg, z: z, notifier: newNotifier()}
 
 	ctx := context.Background()
 	if err := gormDB.Setup(ctx); err != nil { // ensure migration/schemas
@@ -323,6 +323,8 @@ func (f *dbFixture) newSession(args ...any) scheme.Session {
 			s.ProjectID = a.ProjectID
 		case scheme.Event:
 			s.EventID = &a.EventID
+		case scheme.Trigger:
+			s.TriggerID = &a.TriggerID
 		}
 	}
 
@@ -0,0 +1,99 @@
+package dbgorm
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessionnotify"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+// Postgres channel on which the event IDs of added session outcomes are published.
+const sessionOutcomesChannel = "ak_session_outcomes"
+
+const listenRetryInterval = 5 * time.Second
+
+// notifier fans out notifications about new session outcomes to subscribers
+// in this process. With postgres, outcomes added by any process are published
+// using NOTIFY and relayed by a listener. With sqlite, which is used by a single
+// process, outcomes are published directly.
+type notifier struct {
+	*sessionnotify.Notifier[sdktypes.EventID]
+
+	listening atomic.Bool // set while the postgres listener is connected.
+}
+
+func newNotifier() *notifier {
+	return &notifier{Notifier: sessionnotify.New[sdktypes.EventID]()}
+}
+
+// listen relays notifications published by all processes using the database
+// to local subscribers. It uses a dedicated connection, which is reestablished
+// if lost, until ctx is done.
+func (n *notifier) listen(ctx context.Context, z *zap.Logger, dsn string) {
+	for {
+		err := n.listenOnce(ctx, dsn)
+		if ctx.Err() != nil {
+			return
+		}
+
+		z.Warn("session outcomes listener failed, retrying", zap.Error(err))
+
+		select {
+		case <-time.After(listenRetryInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (n *notifier) listenOnce(ctx context.Context, dsn string) error {
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+sessionOutcomesChannel); err != nil {
+		return err
+	}
+
+	n.listening.Store(true)
+	defer n.listening.Store(false)
+
+	for {
+		notif, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		if eid, err := sdktypes.ParseEventID(notif.Payload); err == nil {
+			n.Notify(eid)
+		}
+	}
+}
+
+func (db *gormdb) notifySessionOutcome(ctx context.Context, eid sdktypes.EventID) {
+	if db.cfg.Type != "postgres" {
+		db.notifier.Notify(eid)
+		return
+	}
+
+	if err := db.writer.WithContext(ctx).Exec("SELECT pg_notify(?, ?)", sessionOutcomesChannel, eid.String()).Error; err != nil {
+		// Subscribers also poll, so they will eventually get the outcome.
+		db.z.Warn("failed to notify session outcome", zap.String("event_id", eid.String()), zap.Error(err))
+	}
+}
+
+func (db *gormdb) SubscribeSessionOutcomes(eventID sdktypes.EventID) (<-chan struct{}, func()) {
+	return db.notifier.Subscribe(eventID)
+}
+
+func (db *gormdb) SessionOutcomeNotificationsRelayed() bool {
+	return db.notifier.listening.Load()
+}
@@ -92,6 +92,10 @@ func (gdb *gormdb) GetOrgIDOf(ctx context.Context, id sdktypes.ID) (sdktypes.Org
 		return gdb.getRecordProjectOwner(ctx, scheme.Deployment{}, id)
 	case sdktypes.EventIDKind:
 		return gdb.getRecordProjectOwner(ctx, scheme.Event{}, id)
+	case sdktypes.ApprovalIDKind:
+		return gdb.getRecordProjectOwner(ctx, scheme.Approval{}, id)
+	case sdktypes.DeadLetterIDKind:
+		return gdb.getRecordProjectOwner(ctx, scheme.DeadLetter{}, id)
 	case sdktypes.IntegrationIDKind, sdktypes.UserIDKind:
 		return sdktypes.InvalidOrgID, nil
 	default:
@@ -118,6 +122,10 @@ func (gdb *gormdb) GetProjectIDOf(ctx context.Context, id sdktypes.ID) (sdktypes
 		m = scheme.Deployment{}
 	case sdktypes.EventIDKind:
 		m = scheme.Event{}
+	case sdktypes.ApprovalIDKind:
+		m = scheme.Approval{}
+	case sdktypes.DeadLetterIDKind:
+		m = scheme.DeadLetter{}
 	case sdktypes.IntegrationIDKind, sdktypes.OrgIDKind, sdktypes.UserIDKind:
 		return sdktypes.InvalidProjectID, nil
 	default:
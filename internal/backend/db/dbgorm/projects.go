@@ -91,6 +91,10 @@ func (gdb *gormdb) deleteProjectAndDependents(ctx context.Context, projectID uui
 		return err
 	}
 
+	if err = gdb.writer.Delete(&scheme.TriggerQueueEntry{}, "project_id = ?", projectID).Error; err != nil {
+		return err
+	}
+
 	// Connection is referenced by signals and triggers, so delete them first.
 	// NOTE that signals, triggers and connections are hard-deleted now
 	if err = gdb.writer.Delete(&scheme.Trigger{}, "project_id = ?", projectID).Error; err != nil {
@@ -113,6 +117,14 @@ func (gdb *gormdb) deleteProjectAndDependents(ctx context.Context, projectID uui
 		return err
 	}
 
+	if err = gdb.writer.Delete(&scheme.DeadLetter{}, "project_id = ?", projectID).Error; err != nil {
+		return err
+	}
+
+	if err = gdb.writer.Delete(&scheme.Approval{}, "project_id = ?", projectID).Error; err != nil {
+		return err
+	}
+
 	if err = gdb.deleteProjectVars(ctx, projectID); err != nil {
 		return err
 	}
@@ -0,0 +1,92 @@
+package dbgorm
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/auth/authcontext"
+	"go.autokitteh.dev/autokitteh/internal/backend/db/dbgorm/scheme"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func retentionScopeID(scope sdkservices.SessionRetentionScope) uuid.UUID {
+	if scope.ProjectID.IsValid() {
+		return scope.ProjectID.UUIDValue()
+	}
+
+	return scope.OrgID.UUIDValue()
+}
+
+func (db *gormdb) SetSessionRetentionPolicy(ctx context.Context, p sdkservices.SessionRetentionPolicy) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	states, err := json.Marshal(kittehs.Transform(p.States, sdktypes.SessionStateType.String))
+	if err != nil {
+		return err
+	}
+
+	by := authcontext.GetAuthnUserID(ctx).UUIDValue()
+
+	r := scheme.SessionRetentionPolicy{
+		Base:      based(ctx),
+		ScopeID:   retentionScopeID(p.SessionRetentionScope),
+		OrgID:     p.OrgID.UUIDValuePtr(),
+		ProjectID: p.ProjectID.UUIDValuePtr(),
+		MaxAge:    p.MaxAge,
+		MaxCount:  p.MaxCount,
+		States:    states,
+		UpdatedBy: by,
+		UpdatedAt: kittehs.Now().UTC(),
+	}
+
+	return translateError(db.writer.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "scope_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"max_age", "max_count", "states", "updated_by", "updated_at"}),
+	}).Create(&r).Error)
+}
+
+func (db *gormdb) GetSessionRetentionPolicy(ctx context.Context, scope sdkservices.SessionRetentionScope) (*sdkservices.SessionRetentionPolicy, error) {
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+
+	r, err := getOne[scheme.SessionRetentionPolicy](db.reader.WithContext(ctx), "scope_id = ?", retentionScopeID(scope))
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return scheme.ParseSessionRetentionPolicy(*r)
+}
+
+func (db *gormdb) DeleteSessionRetentionPolicy(ctx context.Context, scope sdkservices.SessionRetentionScope) error {
+	if err := scope.Validate(); err != nil {
+		return err
+	}
+
+	return translateError(
+		db.writer.WithContext(ctx).Where("scope_id = ?", retentionScopeID(scope)).Delete(&scheme.SessionRetentionPolicy{}).Error,
+	)
+}
+
+func (db *gormdb) ListSessionRetentionPolicies(ctx context.Context) ([]sdkservices.SessionRetentionPolicy, error) {
+	var rs []scheme.SessionRetentionPolicy
+	if err := db.reader.WithContext(ctx).Order("created_at").Find(&rs).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	return kittehs.TransformError(rs, func(r scheme.SessionRetentionPolicy) (sdkservices.SessionRetentionPolicy, error) {
+		p, err := scheme.ParseSessionRetentionPolicy(r)
+		if err != nil {
+			return sdkservices.SessionRetentionPolicy{}, err
+		}
+
+		return *p, nil
+	})
+}
@@ -0,0 +1,55 @@
+package dbgorm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func TestSessionRetentionPolicies(t *testing.T) {
+	f := newDBFixture()
+
+	org := sdkservices.SessionRetentionScope{OrgID: sdktypes.NewOrgID()}
+	prj := sdkservices.SessionRetentionScope{ProjectID: sdktypes.NewProjectID()}
+
+	_, err := f.gormdb.GetSessionRetentionPolicy(f.ctx, org)
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// Scope must be either an org or a project.
+	assert.True(t, sdkerrors.IsInvalidArgumentError(f.gormdb.SetSessionRetentionPolicy(f.ctx, sdkservices.SessionRetentionPolicy{MaxCount: 1})))
+
+	p1 := sdkservices.SessionRetentionPolicy{SessionRetentionScope: org, MaxAge: time.Hour}
+	require.NoError(t, f.gormdb.SetSessionRetentionPolicy(f.ctx, p1))
+
+	p2 := sdkservices.SessionRetentionPolicy{
+		SessionRetentionScope: prj,
+		MaxCount:              3,
+		States:                []sdktypes.SessionStateType{sdktypes.SessionStateTypeCompleted},
+	}
+	require.NoError(t, f.gormdb.SetSessionRetentionPolicy(f.ctx, p2))
+
+	got, err := f.gormdb.GetSessionRetentionPolicy(f.ctx, prj)
+	require.NoError(t, err)
+	assert.Equal(t, p2, *got)
+
+	// Setting again replaces the policy.
+	p1.MaxAge, p1.MaxCount = 0, 10
+	require.NoError(t, f.gormdb.SetSessionRetentionPolicy(f.ctx, p1))
+
+	ps, err := f.gormdb.ListSessionRetentionPolicies(f.ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []sdkservices.SessionRetentionPolicy{p1, p2}, ps)
+
+	require.NoError(t, f.gormdb.DeleteSessionRetentionPolicy(f.ctx, org))
+	require.NoError(t, f.gormdb.DeleteSessionRetentionPolicy(f.ctx, org))
+
+	ps, err = f.gormdb.ListSessionRetentionPolicies(f.ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []sdkservices.SessionRetentionPolicy{p2}, ps)
+}
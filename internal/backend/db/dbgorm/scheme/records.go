@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
@@ -17,6 +18,7 @@ import (
 	deploymentsv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/deployments/v1"
 	sessionsv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/sessions/v1"
 	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
 )
 
@@ -173,7 +175,7 @@ type Event struct {
 	ProjectID     uuid.UUID  `gorm:"index;type:uuid"`                      // TODO(authz-migration): not null.
 	OrgID         *uuid.UUID `gorm:"index;index:idx_org_id_seq;type:uuid"` // use only for list.
 	EventID       uuid.UUID  `gorm:"uniqueIndex;type:uuid;not null"`
-	DestinationID uuid.UUID  `gorm:"index;type:uuid;not null"`
+	DestinationID uuid.UUID  `gorm:"index;uniqueIndex:idx_event_idempotency_key,priority:1,where:idempotency_key is not null;type:uuid;not null"`
 	IntegrationID *uuid.UUID `gorm:"index;type:uuid"`
 	ConnectionID  *uuid.UUID `gorm:"index;type:uuid"`
 	TriggerID     *uuid.UUID `gorm:"index;type:uuid"`
@@ -183,6 +185,8 @@ type Event struct {
 	Memo      datatypes.JSON
 	Seq       uint64 `gorm:"primaryKey;autoIncrement:true;index:idx_event_type_seq,priority:2;index:idx_org_id_seq"`
 
+	IdempotencyKey *string `gorm:"uniqueIndex:idx_event_idempotency_key,priority:2,where:idempotency_key is not null"`
+
 	// enforce foreign keys
 	Connection *Connection `gorm:"constraint:OnDelete:SET NULL"`
 	Trigger    *Trigger    `gorm:"constraint:OnDelete:SET NULL"`
@@ -209,6 +213,11 @@ func ParseEvent(e Event) (sdktypes.Event, error) {
 		return sdktypes.InvalidEvent, fmt.Errorf("event memo: %w", err)
 	}
 
+	var idempotencyKey string
+	if e.IdempotencyKey != nil {
+		idempotencyKey = *e.IdempotencyKey
+	}
+
 	var did sdktypes.EventDestinationID
 
 	if uuid := e.ConnectionID; uuid != nil {
@@ -220,13 +229,14 @@ func ParseEvent(e Event) (sdktypes.Event, error) {
 	}
 
 	return sdktypes.StrictEventFromProto(&sdktypes.EventPB{
-		EventId:       sdktypes.NewIDFromUUID[sdktypes.EventID](e.EventID).String(),
-		EventType:     e.EventType,
-		Data:          kittehs.TransformMapValues(data, sdktypes.ToProto),
-		Memo:          memo,
-		CreatedAt:     timestamppb.New(e.CreatedAt),
-		Seq:           e.Seq,
-		DestinationId: did.String(),
+		EventId:        sdktypes.NewIDFromUUID[sdktypes.EventID](e.EventID).String(),
+		EventType:      e.EventType,
+		Data:           kittehs.TransformMapValues(data, sdktypes.ToProto),
+		Memo:           memo,
+		CreatedAt:      timestamppb.New(e.CreatedAt),
+		Seq:            e.Seq,
+		DestinationId:  did.String(),
+		IdempotencyKey: idempotencyKey,
 	})
 }
 
@@ -237,20 +247,28 @@ type Trigger struct {
 	TriggerID    uuid.UUID  `gorm:"primaryKey;type:uuid;not null"`
 	ConnectionID *uuid.UUID `gorm:"index;type:uuid"`
 
-	SourceType   string `gorm:"index"`
-	EventType    string
-	Filter       string
-	CodeLocation string
-	Timezone     string
-	IsDurable    *bool
-	IsSync       *bool
+	SourceType         string `gorm:"index"`
+	EventType          string
+	Filter             string
+	CodeLocation       string
+	Timezone           string
+	IsDurable          *bool
+	IsSync             *bool
+	Concurrency        datatypes.JSON
+	Batch              datatypes.JSON
+	Retry              datatypes.JSON
+	Timeout            *time.Duration
+	OnStop             *string
+	WebhookAuth        datatypes.JSON
+	WebhookIdempotency datatypes.JSON
 
 	Name string
 	// Makes sure name is unique - this is the project_id with name.
 	UniqueName string `gorm:"uniqueIndex;not null"` // project_id + name
 
-	WebhookSlug string `gorm:"index"`
-	Schedule    string
+	WebhookSlug  string `gorm:"index"`
+	WebhookRoute string
+	Schedule     string
 
 	UpdatedBy uuid.UUID `gorm:"type:uuid"`
 	UpdatedAt time.Time
@@ -269,6 +287,13 @@ func ParseTrigger(e Trigger) (sdktypes.Trigger, error) {
 		return sdktypes.InvalidTrigger, fmt.Errorf("loc: %w", err)
 	}
 
+	var onStop sdktypes.CodeLocation
+	if e.OnStop != nil {
+		if onStop, err = sdktypes.ParseCodeLocation(*e.OnStop); err != nil {
+			return sdktypes.InvalidTrigger, fmt.Errorf("on_stop: %w", err)
+		}
+	}
+
 	srcType, err := sdktypes.ParseTriggerSourceType(e.SourceType)
 	if err != nil {
 		return sdktypes.InvalidTrigger, fmt.Errorf("source type: %w", err)
@@ -294,20 +319,83 @@ func ParseTrigger(e Trigger) (sdktypes.Trigger, error) {
 		isSync = *e.IsSync
 	}
 
+	var concurrency *sdktypes.ConcurrencyPolicyPB
+	if len(e.Concurrency) != 0 {
+		var c sdktypes.ConcurrencyPolicy
+		if err := json.Unmarshal(e.Concurrency, &c); err != nil {
+			return sdktypes.InvalidTrigger, fmt.Errorf("concurrency: %w", err)
+		}
+
+		concurrency = c.ToProto()
+	}
+
+	var batch *sdktypes.BatchPolicyPB
+	if len(e.Batch) != 0 {
+		var b sdktypes.BatchPolicy
+		if err := json.Unmarshal(e.Batch, &b); err != nil {
+			return sdktypes.InvalidTrigger, fmt.Errorf("batch: %w", err)
+		}
+
+		batch = b.ToProto()
+	}
+
+	var timeout *durationpb.Duration
+	if e.Timeout != nil && *e.Timeout > 0 {
+		timeout = durationpb.New(*e.Timeout)
+	}
+
+	var retry *sdktypes.RetryPolicyPB
+	if len(e.Retry) != 0 {
+		var r sdktypes.RetryPolicy
+		if err := json.Unmarshal(e.Retry, &r); err != nil {
+			return sdktypes.InvalidTrigger, fmt.Errorf("retry: %w", err)
+		}
+
+		retry = r.ToProto()
+	}
+
+	var webhookAuth *sdktypes.WebhookAuthPB
+	if len(e.WebhookAuth) != 0 {
+		var a sdktypes.WebhookAuth
+		if err := json.Unmarshal(e.WebhookAuth, &a); err != nil {
+			return sdktypes.InvalidTrigger, fmt.Errorf("webhook auth: %w", err)
+		}
+
+		webhookAuth = a.ToProto()
+	}
+
+	var webhookIdempotency *sdktypes.WebhookIdempotencyPB
+	if len(e.WebhookIdempotency) != 0 {
+		var i sdktypes.WebhookIdempotency
+		if err := json.Unmarshal(e.WebhookIdempotency, &i); err != nil {
+			return sdktypes.InvalidTrigger, fmt.Errorf("webhook idempotency: %w", err)
+		}
+
+		webhookIdempotency = i.ToProto()
+	}
+
 	return sdktypes.StrictTriggerFromProto(&sdktypes.TriggerPB{
-		TriggerId:    sdktypes.NewIDFromUUID[sdktypes.TriggerID](e.TriggerID).String(),
-		SourceType:   srcType.ToProto(),
-		ConnectionId: sdktypes.NewIDFromUUIDPtr[sdktypes.ConnectionID](e.ConnectionID).String(),
-		ProjectId:    sdktypes.NewIDFromUUID[sdktypes.ProjectID](e.ProjectID).String(),
-		EventType:    e.EventType,
-		Filter:       filter,
-		CodeLocation: loc.ToProto(),
-		Name:         e.Name,
-		WebhookSlug:  e.WebhookSlug,
-		Schedule:     e.Schedule,
-		Timezone:     e.Timezone,
-		IsDurable:    isDurable,
-		IsSync:       isSync,
+		TriggerId:          sdktypes.NewIDFromUUID[sdktypes.TriggerID](e.TriggerID).String(),
+		SourceType:         srcType.ToProto(),
+		ConnectionId:       sdktypes.NewIDFromUUIDPtr[sdktypes.ConnectionID](e.ConnectionID).String(),
+		ProjectId:          sdktypes.NewIDFromUUID[sdktypes.ProjectID](e.ProjectID).String(),
+		EventType:          e.EventType,
+		Filter:             filter,
+		CodeLocation:       loc.ToProto(),
+		Name:               e.Name,
+		WebhookSlug:        e.WebhookSlug,
+		WebhookRoute:       e.WebhookRoute,
+		Schedule:           e.Schedule,
+		Timezone:           e.Timezone,
+		IsDurable:          isDurable,
+		IsSync:             isSync,
+		Concurrency:        concurrency,
+		Batch:              batch,
+		Retry:              retry,
+		Timeout:            timeout,
+		OnStop:             onStop.ToProto(),
+		WebhookAuth:        webhookAuth,
+		WebhookIdempotency: webhookIdempotency,
 	})
 }
 
@@ -371,11 +459,17 @@ type Session struct {
 	BuildID          uuid.UUID  `gorm:"index;type:uuid;not null"`
 	DeploymentID     *uuid.UUID `gorm:"index;index:idx_active_sessions;type:uuid"`
 	EventID          *uuid.UUID `gorm:"index;type:uuid"`
+	TriggerID        *uuid.UUID `gorm:"index;type:uuid"`
+	RetryOfSessionID *uuid.UUID `gorm:"index;type:uuid"`
+	Attempt          uint32     `gorm:"not null;default:0"`
 	CurrentStateType int        `gorm:"index:idx_active_sessions,where:current_state_type = 1 OR current_state_type = 2"`
 	Entrypoint       string
 	Inputs           datatypes.JSON
 	Memo             datatypes.JSON
-	IsDurable        bool `gorm:"not null;default:false"`
+	Tags             datatypes.JSON
+	IsDurable        bool          `gorm:"not null;default:false"`
+	Timeout          time.Duration `gorm:"not null;default:0"`
+	OnStop           string
 
 	UpdatedBy uuid.UUID `gorm:"type:uuid"`
 	UpdatedAt time.Time
@@ -398,6 +492,11 @@ func ParseSession(s Session) (sdktypes.Session, error) {
 		return sdktypes.InvalidSession, fmt.Errorf("entrypoint: %w", err)
 	}
 
+	onStop, err := sdktypes.ParseCodeLocation(s.OnStop)
+	if err != nil {
+		return sdktypes.InvalidSession, fmt.Errorf("on_stop: %w", err)
+	}
+
 	var inputs map[string]sdktypes.Value
 	if len(s.Inputs) != 0 {
 		if err := json.Unmarshal(s.Inputs, &inputs); err != nil {
@@ -412,19 +511,38 @@ func ParseSession(s Session) (sdktypes.Session, error) {
 		}
 	}
 
+	var tags map[string]string
+	if len(s.Tags) != 0 {
+		if err := json.Unmarshal(s.Tags, &tags); err != nil {
+			return sdktypes.InvalidSession, fmt.Errorf("tags: %w", err)
+		}
+	}
+
+	var timeout *durationpb.Duration
+	if s.Timeout > 0 {
+		timeout = durationpb.New(s.Timeout)
+	}
+
 	session, err := sdktypes.StrictSessionFromProto(&sdktypes.SessionPB{
 		SessionId:    sdktypes.NewIDFromUUID[sdktypes.SessionID](s.SessionID).String(),
 		BuildId:      sdktypes.NewIDFromUUID[sdktypes.BuildID](s.BuildID).String(),
 		ProjectId:    sdktypes.NewIDFromUUID[sdktypes.ProjectID](s.ProjectID).String(),
 		DeploymentId: sdktypes.NewIDFromUUIDPtr[sdktypes.DeploymentID](s.DeploymentID).String(),
 		EventId:      sdktypes.NewIDFromUUIDPtr[sdktypes.EventID](s.EventID).String(),
+		TriggerId:    sdktypes.NewIDFromUUIDPtr[sdktypes.TriggerID](s.TriggerID).String(),
 		Entrypoint:   ep.ToProto(),
 		Inputs:       kittehs.TransformMapValues(inputs, sdktypes.ToProto),
 		CreatedAt:    timestamppb.New(s.CreatedAt),
 		UpdatedAt:    timestamppb.New(s.UpdatedAt),
 		State:        sessionsv1.SessionStateType(s.CurrentStateType),
 		Memo:         memo,
+		Tags:         tags,
 		IsDurable:    s.IsDurable,
+
+		RetryOfSessionId: sdktypes.NewIDFromUUIDPtr[sdktypes.SessionID](s.RetryOfSessionID).String(),
+		Attempt:          s.Attempt,
+		Timeout:          timeout,
+		OnStop:           onStop.ToProto(),
 	})
 	if err != nil {
 		return sdktypes.InvalidSession, err
@@ -572,6 +690,10 @@ type StoreValue struct {
 
 	UpdatedBy uuid.UUID `gorm:"type:uuid"`
 	UpdatedAt time.Time
+	ExpiresAt *time.Time `gorm:"index"`
+
+	// Set if the value is a lock held by a session.
+	LockedBy *uuid.UUID `gorm:"index;type:uuid"`
 
 	Project *Project
 }
@@ -662,3 +784,150 @@ func ParseOrgMember(r OrgMember) (sdktypes.OrgMember, error) {
 		sdktypes.NewIDFromUUID[sdktypes.UserID](r.UserID),
 	).WithStatus(s).WithRoles(roles...), nil
 }
+
+type DeadLetter struct {
+	DeadLetterID uuid.UUID  `gorm:"primaryKey;type:uuid;not null"`
+	ProjectID    uuid.UUID  `gorm:"index;type:uuid;not null"`
+	OrgID        uuid.UUID  `gorm:"index;type:uuid"` // use only for list.
+	EventID      uuid.UUID  `gorm:"index;type:uuid;not null"`
+	TriggerID    *uuid.UUID `gorm:"type:uuid"`
+	DeploymentID *uuid.UUID `gorm:"type:uuid"`
+	Reason       int32
+	Error        string
+	CreatedAt    time.Time  `gorm:"index"`
+	RetryEventID *uuid.UUID `gorm:"type:uuid"`
+	RetriedAt    *time.Time
+}
+
+func (DeadLetter) IDFieldName() string { return "dead_letter_id" }
+
+func ParseDeadLetter(r DeadLetter) (sdktypes.DeadLetter, error) {
+	var retriedAt *timestamppb.Timestamp
+	if r.RetriedAt != nil {
+		retriedAt = timestamppb.New(*r.RetriedAt)
+	}
+
+	return sdktypes.StrictDeadLetterFromProto(&sdktypes.DeadLetterPB{
+		DeadLetterId: sdktypes.NewIDFromUUID[sdktypes.DeadLetterID](r.DeadLetterID).String(),
+		EventId:      sdktypes.NewIDFromUUID[sdktypes.EventID](r.EventID).String(),
+		ProjectId:    sdktypes.NewIDFromUUID[sdktypes.ProjectID](r.ProjectID).String(),
+		TriggerId:    sdktypes.NewIDFromUUIDPtr[sdktypes.TriggerID](r.TriggerID).String(),
+		DeploymentId: sdktypes.NewIDFromUUIDPtr[sdktypes.DeploymentID](r.DeploymentID).String(),
+		Reason:       sdktypes.DeadLetterReasonPB(r.Reason),
+		Error:        r.Error,
+		CreatedAt:    timestamppb.New(r.CreatedAt),
+		RetryEventId: sdktypes.NewIDFromUUIDPtr[sdktypes.EventID](r.RetryEventID).String(),
+		RetriedAt:    retriedAt,
+	})
+}
+
+// TriggerQueueEntry is an event admitted by or waiting for a trigger's
+// concurrency limit to allow a new session to start. Waiting entries are
+// admitted in Seq order.
+type TriggerQueueEntry struct {
+	Seq          uint64     `gorm:"primaryKey;autoIncrement:true"`
+	TriggerID    uuid.UUID  `gorm:"index;type:uuid;not null"`
+	ProjectID    uuid.UUID  `gorm:"index;type:uuid;not null"`
+	EventID      uuid.UUID  `gorm:"type:uuid;not null"`
+	DeploymentID *uuid.UUID `gorm:"type:uuid"`
+	WorkflowID   string
+	CreatedAt    time.Time
+	AdmittedAt   *time.Time
+}
+
+// SessionRetentionPolicy is the session retention policy of an org or a
+// project. ScopeID is the id of either, and exactly one of OrgID and
+// ProjectID is set.
+type SessionRetentionPolicy struct {
+	Base
+
+	ScopeID   uuid.UUID  `gorm:"primaryKey;type:uuid;not null"`
+	OrgID     *uuid.UUID `gorm:"type:uuid"`
+	ProjectID *uuid.UUID `gorm:"type:uuid"`
+	MaxAge    time.Duration
+	MaxCount  int
+	States    datatypes.JSON // session state type names.
+
+	UpdatedBy uuid.UUID `gorm:"type:uuid"`
+	UpdatedAt time.Time
+}
+
+func ParseSessionRetentionPolicy(r SessionRetentionPolicy) (*sdkservices.SessionRetentionPolicy, error) {
+	var names []string
+	if len(r.States) != 0 {
+		if err := json.Unmarshal(r.States, &names); err != nil {
+			return nil, fmt.Errorf("states: %w", err)
+		}
+	}
+
+	states, err := kittehs.TransformError(names, sdktypes.ParseSessionStateType)
+	if err != nil {
+		return nil, fmt.Errorf("states: %w", err)
+	}
+
+	p := sdkservices.SessionRetentionPolicy{
+		MaxAge:   r.MaxAge,
+		MaxCount: r.MaxCount,
+		States:   states,
+	}
+
+	if r.OrgID != nil {
+		p.OrgID = sdktypes.NewIDFromUUID[sdktypes.OrgID](*r.OrgID)
+	}
+
+	if r.ProjectID != nil {
+		p.ProjectID = sdktypes.NewIDFromUUID[sdktypes.ProjectID](*r.ProjectID)
+	}
+
+	return &p, nil
+}
+
+type Approval struct {
+	ApprovalID uuid.UUID `gorm:"primaryKey;type:uuid;not null"`
+	SessionID  uuid.UUID `gorm:"index;type:uuid;not null"`
+	ProjectID  uuid.UUID `gorm:"index;type:uuid;not null"`
+	OrgID      uuid.UUID `gorm:"index;type:uuid"` // use only for list.
+	Title      string
+	Approvers  datatypes.JSON
+	State      int32 `gorm:"index"`
+	DecidedBy  string
+	Comment    string
+	CreatedAt  time.Time `gorm:"index"`
+	ExpiresAt  *time.Time
+	DecidedAt  *time.Time
+}
+
+func (Approval) IDFieldName() string { return "approval_id" }
+
+func ParseApproval(r Approval) (sdktypes.Approval, error) {
+	var approvers []string
+	if len(r.Approvers) != 0 {
+		if err := json.Unmarshal(r.Approvers, &approvers); err != nil {
+			return sdktypes.InvalidApproval, fmt.Errorf("approvers: %w", err)
+		}
+	}
+
+	var expiresAt, decidedAt *timestamppb.Timestamp
+
+	if r.ExpiresAt != nil {
+		expiresAt = timestamppb.New(*r.ExpiresAt)
+	}
+
+	if r.DecidedAt != nil {
+		decidedAt = timestamppb.New(*r.DecidedAt)
+	}
+
+	return sdktypes.StrictApprovalFromProto(&sdktypes.ApprovalPB{
+		ApprovalId: sdktypes.NewIDFromUUID[sdktypes.ApprovalID](r.ApprovalID).String(),
+		SessionId:  sdktypes.NewIDFromUUID[sdktypes.SessionID](r.SessionID).String(),
+		ProjectId:  sdktypes.NewIDFromUUID[sdktypes.ProjectID](r.ProjectID).String(),
+		Title:      r.Title,
+		Approvers:  approvers,
+		State:      sdktypes.ApprovalStatePB(r.State),
+		DecidedBy:  r.DecidedBy,
+		Comment:    r.Comment,
+		CreatedAt:  timestamppb.New(r.CreatedAt),
+		ExpiresAt:  expiresAt,
+		DecidedAt:  decidedAt,
+	})
+}
@@ -1,8 +1,10 @@
 package scheme
 
 var Tables = []any{
+	&Approval{},
 	&Build{},
 	&Connection{},
+	&DeadLetter{},
 	&Deployment{},
 	&Event{},
 	&Org{},
@@ -10,11 +12,13 @@ var Tables = []any{
 	&Project{},
 	&Secret{},
 	&Session{},
+	&SessionRetentionPolicy{},
 	&SessionCallAttempt{},
 	&SessionCallSpec{},
 	&SessionLogRecord{},
 	&Signal{},
 	&Trigger{},
+	&TriggerQueueEntry{},
 	&User{},
 	&StoreValue{},
 	&Var{},
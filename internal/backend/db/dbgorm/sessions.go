@@ -4,27 +4,51 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"go.autokitteh.dev/autokitteh/internal/backend/db"
 	"go.autokitteh.dev/autokitteh/internal/backend/db/dbgorm/scheme"
 	"go.autokitteh.dev/autokitteh/internal/backend/fixtures"
 	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
 	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
 )
 
 const (
-	printSessionLogRecordType   = "print"
-	stateSessionLogRecordType   = "state"
-	stopSessionLogRecordType    = "stop_request"
-	outcomeSessionLogRecordType = "outcome"
+	printSessionLogRecordType        = "print"
+	stateSessionLogRecordType        = "state"
+	stopSessionLogRecordType         = "stop_request"
+	outcomeSessionLogRecordType      = "outcome"
+	compensationSessionLogRecordType = "compensation"
+
+	// Call records are normally kept only in the workflow history, and are
+	// stored in the db only for imported sessions.
+	callSpecSessionLogRecordType            = "call_spec"
+	callAttemptStartSessionLogRecordType    = "call_attempt_start"
+	callAttemptCompleteSessionLogRecordType = "call_attempt_complete"
 )
 
+var sessionLogRecordTypeNames = map[sdktypes.SessionLogRecordType]string{
+	sdktypes.PrintSessionLogRecordType:               printSessionLogRecordType,
+	sdktypes.StateSessionLogRecordType:               stateSessionLogRecordType,
+	sdktypes.StopRequestSessionLogRecordType:         stopSessionLogRecordType,
+	sdktypes.OutcomeSessionLogRecordType:             outcomeSessionLogRecordType,
+	sdktypes.CompensationSessionLogRecordType:        compensationSessionLogRecordType,
+	sdktypes.CallSpecSessionLogRecordType:            callSpecSessionLogRecordType,
+	sdktypes.CallAttemptStartSessionLogRecordType:    callAttemptStartSessionLogRecordType,
+	sdktypes.CallAttemptCompleteSessionLogRecordType: callAttemptCompleteSessionLogRecordType,
+}
+
 func (gdb *gormdb) createSession(ctx context.Context, session *scheme.Session) error {
 	logr, err := toSessionLogRecord(session.SessionID, sdktypes.NewStateSessionLogRecord(kittehs.Now(), sdktypes.NewSessionStateCreated()))
 	if err != nil {
@@ -39,6 +63,20 @@ func (gdb *gormdb) createSession(ctx context.Context, session *scheme.Session) e
 	}))
 }
 
+func (gdb *gormdb) importSession(ctx context.Context, session *scheme.Session, logrs []scheme.SessionLogRecord) error {
+	return translateError(gdb.writeTransaction(ctx, func(tx *gormdb) error {
+		if err := tx.writer.Create(session).Error; err != nil {
+			return err
+		}
+
+		if len(logrs) == 0 {
+			return nil
+		}
+
+		return tx.writer.WithContext(ctx).CreateInBatches(logrs, 100).Error
+	}))
+}
+
 func (gdb *gormdb) deleteSession(ctx context.Context, sessionID uuid.UUID) error {
 	return gdb.writeTransaction(ctx, func(tx *gormdb) error {
 		var session scheme.Session
@@ -52,6 +90,10 @@ func (gdb *gormdb) deleteSession(ctx context.Context, sessionID uuid.UUID) error
 			return err
 		}
 
+		if err := tx.writer.Where("session_id = ?", sessionID).Delete(&scheme.Approval{}).Error; err != nil {
+			return err
+		}
+
 		return tx.writer.Delete(&session).Error
 	})
 }
@@ -83,15 +125,69 @@ func (gdb *gormdb) updateSessionState(ctx context.Context, sessionID uuid.UUID,
 			}
 		}
 
+		if state.Type().IsFinal() {
+			// Release all locks held by the session.
+			if err := tx.writer.Where("locked_by = ?", sessionID).Delete(&scheme.StoreValue{}).Error; err != nil {
+				return err
+			}
+
+			// Nobody is waiting for pending approvals anymore.
+			if err := tx.writer.Model(&scheme.Approval{}).
+				Where("session_id = ? AND state = ?", sessionID, int32(sdktypes.ApprovalStatePending.ToProto())).
+				Updates(map[string]any{
+					"state":      int32(sdktypes.ApprovalStateExpired.ToProto()),
+					"decided_at": kittehs.Now().UTC(),
+				}).Error; err != nil {
+				return err
+			}
+		}
+
 		return createLogRecord(tx.writer, ctx, logr, stateSessionLogRecordType)
 	})
 }
 
+func (gdb *gormdb) setSessionTag(ctx context.Context, sessionID uuid.UUID, key, value string) error {
+	return gdb.writeTransaction(ctx, func(tx *gormdb) error {
+		var session scheme.Session
+		if err := tx.writer.Select("tags").First(&session, "session_id = ?", sessionID).Error; err != nil {
+			return err
+		}
+
+		tags := make(map[string]string)
+		if len(session.Tags) != 0 {
+			if err := json.Unmarshal(session.Tags, &tags); err != nil {
+				return fmt.Errorf("tags: %w", err)
+			}
+		}
+
+		if value == "" {
+			maps.DeleteFunc(tags, func(k, _ string) bool { return k == key })
+		} else {
+			if _, ok := tags[key]; !ok && len(tags) >= sdktypes.MaxSessionTags {
+				return sdkerrors.NewInvalidArgumentError("number of session tags exceeds maximum allowed (%d)", sdktypes.MaxSessionTags)
+			}
+
+			tags[key] = value
+		}
+
+		data, err := json.Marshal(tags)
+		if err != nil {
+			return err
+		}
+
+		return tx.writer.Model(&scheme.Session{SessionID: sessionID}).Update("tags", datatypes.JSON(data)).Error
+	})
+}
+
 func (gdb *gormdb) getSession(ctx context.Context, sessionID uuid.UUID) (*scheme.Session, error) {
 	return getOne[scheme.Session](gdb.reader.WithContext(ctx), "session_id = ?", sessionID)
 }
 
 func (gdb *gormdb) listSessions(ctx context.Context, f sdkservices.ListSessionsFilter) ([]scheme.Session, int64, error) {
+	return gdb.searchSessions(ctx, sdkservices.SearchSessionsFilter{ListSessionsFilter: f})
+}
+
+func (gdb *gormdb) searchSessions(ctx context.Context, f sdkservices.SearchSessionsFilter) ([]scheme.Session, int64, error) {
 	q := gdb.reader.WithContext(ctx)
 
 	q = withProjectID(q, "sessions", f.ProjectID)
@@ -112,6 +208,20 @@ func (gdb *gormdb) listSessions(ctx context.Context, f sdkservices.ListSessionsF
 		q = q.Where("current_state_type = ?", f.StateType.ToProto())
 	}
 
+	for k, v := range f.Tags {
+		q = q.Where(gdb.jsonTextParam("sessions.tags")+" = ?", k, v)
+	}
+
+	if !f.CreatedAfter.IsZero() {
+		q = q.Where("sessions.created_at >= ?", f.CreatedAfter.UTC())
+	}
+
+	if !f.CreatedBefore.IsZero() {
+		q = q.Where("sessions.created_at < ?", f.CreatedBefore.UTC())
+	}
+
+	q = gdb.withSessionSearch(q, f)
+
 	var n int64
 	err := q.Model(&scheme.Session{}).Count(&n).Error
 	if err != nil {
@@ -147,6 +257,146 @@ func (gdb *gormdb) listSessions(ctx context.Context, f sdkservices.ListSessionsF
 	return rs, n, nil
 }
 
+func (gdb *gormdb) listSessionsToPurge(ctx context.Context, f db.SessionRetentionFilter, limit int) ([]scheme.Session, error) {
+	q := gdb.reader.WithContext(ctx).Model(&scheme.Session{})
+
+	q = withProjectID(q, "sessions", f.ProjectID)
+
+	q = withProjectOrgID(q, f.OrgID, "sessions")
+
+	if len(f.ExcludeProjectIDs) != 0 {
+		q = q.Where("sessions.project_id NOT IN ?", kittehs.Transform(f.ExcludeProjectIDs, sdktypes.ProjectID.UUIDValue))
+	}
+
+	if len(f.ExcludeOrgIDs) != 0 {
+		q = q.Where(
+			"sessions.project_id NOT IN (SELECT project_id FROM projects WHERE org_id IN ?)",
+			kittehs.Transform(f.ExcludeOrgIDs, sdktypes.OrgID.UUIDValue),
+		)
+	}
+
+	q = q.Where("sessions.current_state_type IN ?", kittehs.Transform(f.StateTypes, func(st sdktypes.SessionStateType) int {
+		return int(st.ToProto())
+	}))
+
+	// Sessions are ranked within their project, newest first, after
+	// filtering, so KeepLatest only counts sessions the policy applies to.
+	q = q.Select("sessions.*, ROW_NUMBER() OVER (PARTITION BY sessions.project_id ORDER BY sessions.created_at DESC, sessions.session_id DESC) AS retention_rank")
+
+	var conds []string
+	var args []any
+
+	if !f.CreatedBefore.IsZero() {
+		conds = append(conds, "created_at < ?")
+		args = append(args, f.CreatedBefore.UTC())
+	}
+
+	if f.KeepLatest > 0 {
+		conds = append(conds, "retention_rank > ?")
+		args = append(args, f.KeepLatest)
+	}
+
+	if len(conds) == 0 {
+		return nil, nil
+	}
+
+	var rs []scheme.Session
+	err := gdb.reader.WithContext(ctx).
+		Table("(?) AS ranked", q).
+		Where(strings.Join(conds, " OR "), args...).
+		Order("created_at").
+		Limit(limit).
+		Omit("inputs").
+		Find(&rs).Error
+
+	return rs, err
+}
+
+// withSessionSearch adds the search specific conditions of the filter.
+func (gdb *gormdb) withSessionSearch(q *gorm.DB, f sdkservices.SearchSessionsFilter) *gorm.DB {
+	if f.TriggerName != "" {
+		// Trigger names are unique only within a project. Triggers are deleted
+		// for good, so there is no need to filter out deleted ones.
+		q = q.Where("sessions.trigger_id IN (SELECT triggers.trigger_id FROM triggers WHERE triggers.name = ? AND triggers.project_id = sessions.project_id)", f.TriggerName)
+	}
+
+	if ep := f.EntryPoint; ep != "" {
+		if strings.Contains(ep, ":") {
+			q = q.Where("sessions.entrypoint = ?", ep)
+		} else {
+			q = q.Where("(sessions.entrypoint = ? OR sessions.entrypoint LIKE ? ESCAPE '\\')", ep, escapeLike(ep)+":%")
+		}
+	}
+
+	if f.PrintsContain != "" {
+		q = q.Where(
+			fmt.Sprintf(
+				"EXISTS (SELECT 1 FROM session_log_records l WHERE l.session_id = sessions.session_id AND l.type = ? AND (LOWER(%s) LIKE ? ESCAPE '\\' OR LOWER(%s) LIKE ? ESCAPE '\\'))",
+				gdb.jsonText("l.data", "print", "value", "string", "v"),
+				gdb.jsonText("l.data", "print", "text"), // deprecated, but still might be found in old records.
+			),
+			printSessionLogRecordType,
+			containsPattern(f.PrintsContain),
+			containsPattern(f.PrintsContain),
+		)
+	}
+
+	if f.ErrorContains != "" {
+		q = q.Where(
+			fmt.Sprintf(
+				"EXISTS (SELECT 1 FROM session_log_records l WHERE l.session_id = sessions.session_id AND l.type = ? AND LOWER(%s) LIKE ? ESCAPE '\\')",
+				gdb.jsonText("l.data", "state", "error", "error", "value", "string", "v"),
+			),
+			stateSessionLogRecordType,
+			containsPattern(f.ErrorContains),
+		)
+	}
+
+	return q
+}
+
+// jsonText returns an SQL expression that extracts the text at the
+// given path of a json column. Keys must be trusted, as they are
+// embedded as is.
+func (gdb *gormdb) jsonText(column string, keys ...string) string {
+	if gdb.cfg.Type == "postgres" {
+		for i, k := range keys {
+			op := "->"
+			if i == len(keys)-1 {
+				op = "->>"
+			}
+
+			column += fmt.Sprintf("%s'%s'", op, k)
+		}
+
+		return column
+	}
+
+	return fmt.Sprintf("json_extract(%s, '$.%s')", column, strings.Join(keys, "."))
+}
+
+// jsonTextParam is like jsonText, but for a single top level key
+// which is given as a query parameter.
+func (gdb *gormdb) jsonTextParam(column string) string {
+	if gdb.cfg.Type == "postgres" {
+		return column + "->>?"
+	}
+
+	// The key is quoted so it can contain any character but quotes,
+	// which are excluded from tag keys.
+	return "json_extract(" + column + ", '$.\"' || ? || '\"')"
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// containsPattern returns a case insensitive LIKE pattern for s,
+// to be used with LOWER(...) and ESCAPE '\'.
+func containsPattern(s string) string {
+	return "%" + escapeLike(strings.ToLower(s)) + "%"
+}
+
 // --- log records ---
 func createLogRecord(db *gorm.DB, ctx context.Context, logr *scheme.SessionLogRecord, typ string) error {
 	logr.Seq = uint64(time.Now().UnixMicro())
@@ -193,10 +443,9 @@ func (gdb *gormdb) getSessionLogRecords(ctx context.Context, filter sdkservices.
 				}
 			}
 
-			specific(sdktypes.PrintSessionLogRecordType, printSessionLogRecordType)
-			specific(sdktypes.StateSessionLogRecordType, stateSessionLogRecordType)
-			specific(sdktypes.StopRequestSessionLogRecordType, stopSessionLogRecordType)
-			specific(sdktypes.OutcomeSessionLogRecordType, outcomeSessionLogRecordType)
+			for t, name := range sessionLogRecordTypeNames {
+				specific(t, name)
+			}
 
 			q = q.Where("type in (?)", qtypes)
 		}
@@ -210,31 +459,91 @@ func (gdb *gormdb) getSessionLogRecords(ctx context.Context, filter sdkservices.
 	return logs, n, nil
 }
 
-func (db *gormdb) CreateSession(ctx context.Context, session sdktypes.Session) error {
-	if err := session.Strict(); err != nil {
-		return err
-	}
-
-	s := scheme.Session{
+func toSchemeSession(ctx context.Context, session sdktypes.Session, state sdktypes.SessionStateType) scheme.Session {
+	return scheme.Session{
 		Base:             based(ctx),
 		ProjectID:        session.ProjectID().UUIDValue(),
 		SessionID:        session.ID().UUIDValue(),
 		BuildID:          session.BuildID().UUIDValue(),
 		DeploymentID:     uuidPtrOrNil(session.DeploymentID()),
 		EventID:          uuidPtrOrNil(session.EventID()),
+		TriggerID:        uuidPtrOrNil(session.TriggerID()),
+		RetryOfSessionID: uuidPtrOrNil(session.RetryOfSessionID()),
+		Attempt:          uint32(session.Attempt()),
+		Timeout:          session.Timeout(),
+		OnStop:           session.OnStop().CanonicalString(),
 		Entrypoint:       session.EntryPoint().CanonicalString(),
-		CurrentStateType: int(sdktypes.SessionStateTypeCreated.ToProto()),
+		CurrentStateType: int(state.ToProto()),
 		Inputs:           kittehs.Must1(json.Marshal(session.Inputs())),
 		Memo:             kittehs.Must1(json.Marshal(session.Memo())),
+		Tags:             kittehs.Must1(json.Marshal(session.Tags())),
 		IsDurable:        session.IsDurable(),
 	}
+}
+
+func (db *gormdb) CreateSession(ctx context.Context, session sdktypes.Session) error {
+	if err := session.Strict(); err != nil {
+		return err
+	}
+
+	s := toSchemeSession(ctx, session, sdktypes.SessionStateTypeCreated)
 	return translateError(db.createSession(ctx, &s))
 }
 
+// ImportSession creates a session in its current state together with a log
+// recorded elsewhere. Unlike sessions created by CreateSession, the log might
+// contain call records, as imported sessions have no workflow history.
+func (db *gormdb) ImportSession(ctx context.Context, session sdktypes.Session, log []sdktypes.SessionLogRecord) error {
+	if err := session.Strict(); err != nil {
+		return err
+	}
+
+	s := toSchemeSession(ctx, session, session.State())
+
+	log = slices.Clone(log)
+	slices.SortStableFunc(log, func(a, b sdktypes.SessionLogRecord) int { return a.Timestamp().Compare(b.Timestamp()) })
+
+	logrs := make([]scheme.SessionLogRecord, len(log))
+
+	var seq uint64
+
+	for i, r := range log {
+		typ, ok := sessionLogRecordTypeNames[r.Type()]
+		if !ok {
+			return sdkerrors.NewInvalidArgumentError("log record %d: unknown type", i)
+		}
+
+		data, err := json.Marshal(r)
+		if err != nil {
+			return fmt.Errorf("marshal session log record: %w", err)
+		}
+
+		// Records are ordered by seq, which must be unique per session.
+		seq = max(seq+1, uint64(r.Timestamp().UnixMicro()))
+
+		logrs[i] = scheme.SessionLogRecord{SessionID: s.SessionID, Seq: seq, Data: data, Type: typ}
+	}
+
+	return db.importSession(ctx, &s, logrs)
+}
+
 func (db *gormdb) DeleteSession(ctx context.Context, sessionID sdktypes.SessionID) error {
 	return translateError(db.deleteSession(ctx, sessionID.UUIDValue()))
 }
 
+func (db *gormdb) ListSessionsToPurge(ctx context.Context, f db.SessionRetentionFilter, limit int) ([]sdktypes.Session, error) {
+	rs, err := db.listSessionsToPurge(ctx, f, limit)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return kittehs.TransformError(rs, scheme.ParseSession)
+}
+
+func (db *gormdb) SetSessionTag(ctx context.Context, sessionID sdktypes.SessionID, key, value string) error {
+	return translateError(db.setSessionTag(ctx, sessionID.UUIDValue(), key, value))
+}
+
 func (db *gormdb) UpdateSessionState(ctx context.Context, sessionID sdktypes.SessionID, state sdktypes.SessionState) error {
 	return translateError(db.updateSessionState(ctx, sessionID.UUIDValue(), state))
 }
@@ -248,7 +557,11 @@ func (db *gormdb) GetSession(ctx context.Context, id sdktypes.SessionID) (sdktyp
 }
 
 func (db *gormdb) ListSessions(ctx context.Context, f sdkservices.ListSessionsFilter) (*sdkservices.ListSessionResult, error) {
-	rs, cnt, err := db.listSessions(ctx, f)
+	return db.SearchSessions(ctx, sdkservices.SearchSessionsFilter{ListSessionsFilter: f})
+}
+
+func (db *gormdb) SearchSessions(ctx context.Context, f sdkservices.SearchSessionsFilter) (*sdkservices.ListSessionResult, error) {
+	rs, cnt, err := db.searchSessions(ctx, f)
 	if err != nil {
 		return nil, translateError(err)
 	}
@@ -297,6 +610,14 @@ func (db *gormdb) AddSessionStopRequest(ctx context.Context, sessionID sdktypes.
 	return translateError(db.addSessionLogRecord(ctx, logr, stopSessionLogRecordType))
 }
 
+func (db *gormdb) AddSessionCompensation(ctx context.Context, sessionID sdktypes.SessionID, fn sdktypes.Value, callSeq uint32, perr sdktypes.ProgramError) error {
+	logr, err := toSessionLogRecord(sessionID.UUIDValue(), sdktypes.NewCompensationSessionLogRecord(kittehs.Now(), fn, callSeq, perr))
+	if err != nil {
+		return err
+	}
+	return translateError(db.addSessionLogRecord(ctx, logr, compensationSessionLogRecordType))
+}
+
 func (db *gormdb) AddSessionOutcome(ctx context.Context, sessionID sdktypes.SessionID, v sdktypes.Value, eid sdktypes.EventID) error {
 	logr, err := toSessionLogRecord(sessionID.UUIDValue(), sdktypes.NewOutcomeSessionLogRecord(kittehs.Now(), v, eid))
 	if err != nil {
@@ -305,7 +626,15 @@ func (db *gormdb) AddSessionOutcome(ctx context.Context, sessionID sdktypes.Sess
 
 	logr.OutcomeEventID = eid.UUIDValuePtr()
 
-	return translateError(db.addSessionLogRecord(ctx, logr, outcomeSessionLogRecordType))
+	if err := db.addSessionLogRecord(ctx, logr, outcomeSessionLogRecordType); err != nil {
+		return translateError(err)
+	}
+
+	if eid.IsValid() {
+		db.notifySessionOutcome(ctx, eid)
+	}
+
+	return nil
 }
 
 func (db *gormdb) GetSessionLog(ctx context.Context, filter sdkservices.SessionLogRecordsFilter) (*sdkservices.GetLogResults, error) {
@@ -357,3 +686,19 @@ func (db *gormdb) GetNextSessionOutcomeForEvent(ctx context.Context, eventID sdk
 	sid := sdktypes.NewIDFromUUID[sdktypes.SessionID](lr.SessionID)
 	return out, sid, lr.Seq, nil
 }
+
+func (db *gormdb) ListActiveTriggerSessions(ctx context.Context, tid sdktypes.TriggerID) ([]sdktypes.SessionID, error) {
+	var ids []uuid.UUID
+	if err := db.reader.WithContext(ctx).
+		Model(&scheme.Session{}).
+		Where("trigger_id = ? AND current_state_type IN ?", tid.UUIDValue(), []int{
+			int(sdktypes.SessionStateTypeCreated.ToProto()),
+			int(sdktypes.SessionStateTypeRunning.ToProto()),
+		}).
+		Order("created_at").
+		Pluck("session_id", &ids).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	return kittehs.Transform(ids, sdktypes.NewIDFromUUID[sdktypes.SessionID]), nil
+}
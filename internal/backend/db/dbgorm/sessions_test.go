@@ -1,16 +1,23 @@
 package dbgorm
 
 import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 
+	"go.autokitteh.dev/autokitteh/internal/backend/db"
 	"go.autokitteh.dev/autokitteh/internal/backend/db/dbgorm/scheme"
 	"go.autokitteh.dev/autokitteh/internal/backend/fixtures"
 	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
 	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
 )
@@ -153,6 +160,136 @@ func TestGetSession(t *testing.T) {
 	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
 }
 
+func TestGetSessionRetry(t *testing.T) {
+	f, p, b := preSessionTest(t)
+
+	s := f.newSession(sdktypes.SessionStateTypeError, p, b)
+	r := f.newSession(sdktypes.SessionStateTypeCompleted, p, b)
+	r.RetryOfSessionID, r.Attempt = &s.SessionID, 2
+	f.createSessionsAndAssert(t, s, r)
+
+	session, err := f.gormdb.getSession(f.ctx, r.SessionID)
+	require.NoError(t, err)
+
+	parsed, err := scheme.ParseSession(*session)
+	require.NoError(t, err)
+	assert.Equal(t, sdktypes.NewIDFromUUID[sdktypes.SessionID](s.SessionID), parsed.RetryOfSessionID())
+	assert.Equal(t, 2, parsed.Attempt())
+
+	session, err = f.gormdb.getSession(f.ctx, s.SessionID)
+	require.NoError(t, err)
+
+	parsed, err = scheme.ParseSession(*session)
+	require.NoError(t, err)
+	assert.False(t, parsed.RetryOfSessionID().IsValid())
+	assert.Zero(t, parsed.Attempt())
+}
+
+func TestGetSessionTimeout(t *testing.T) {
+	f, p, b := preSessionTest(t)
+
+	s := f.newSession(sdktypes.SessionStateTypeCompleted, p, b)
+	s.Timeout = 10 * time.Minute
+	f.createSessionsAndAssert(t, s)
+
+	session, err := f.gormdb.getSession(f.ctx, s.SessionID)
+	require.NoError(t, err)
+
+	parsed, err := scheme.ParseSession(*session)
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, parsed.Timeout())
+}
+
+func TestGetSessionOnStop(t *testing.T) {
+	f, p, b := preSessionTest(t)
+
+	s := f.newSession(sdktypes.SessionStateTypeCompleted, p, b)
+	s.OnStop = "main.py:cleanup"
+	f.createSessionsAndAssert(t, s)
+
+	session, err := f.gormdb.getSession(f.ctx, s.SessionID)
+	require.NoError(t, err)
+
+	parsed, err := scheme.ParseSession(*session)
+	require.NoError(t, err)
+	assert.Equal(t, "main.py:cleanup", parsed.OnStop().CanonicalString())
+}
+
+func TestUpdateSessionStateReleasesLocks(t *testing.T) {
+	f, p, b := preSessionTest(t)
+
+	s := f.newSession(sdktypes.SessionStateTypeCreated, p, b)
+	f.createSessionsAndAssert(t, s)
+
+	sid := sdktypes.NewIDFromUUID[sdktypes.SessionID](s.SessionID)
+	pid := sdktypes.NewIDFromUUID[sdktypes.ProjectID](p.ProjectID)
+	owner := sdktypes.NewStringValue(sid.String())
+
+	require.NoError(t, f.gormdb.SetStoreValue(f.ctx, pid, "lock", owner, nil, sid))
+	require.NoError(t, f.gormdb.SetStoreValue(f.ctx, pid, "value", owner, nil, sdktypes.InvalidSessionID))
+
+	require.NoError(t, f.gormdb.UpdateSessionState(f.ctx, sid, sdktypes.NewSessionStateRunning(sdktypes.NewRunID(), sdktypes.InvalidValue)))
+
+	vs, err := f.gormdb.ListStoreValues(f.ctx, pid, nil, false)
+	require.NoError(t, err)
+	assert.Len(t, vs, 2)
+
+	require.NoError(t, f.gormdb.UpdateSessionState(f.ctx, sid, sdktypes.NewSessionStateCompleted(nil, nil, sdktypes.Nothing)))
+
+	vs, err = f.gormdb.ListStoreValues(f.ctx, pid, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"value"}, slices.Collect(maps.Keys(vs)))
+}
+
+func TestImportSession(t *testing.T) {
+	f, p, b := preSessionTest(t)
+
+	pid := sdktypes.NewIDFromUUID[sdktypes.ProjectID](p.ProjectID)
+	bid := sdktypes.NewIDFromUUID[sdktypes.BuildID](b.BuildID)
+	ep := kittehs.Must1(sdktypes.ParseCodeLocation("main.py:main"))
+
+	session := sdktypes.NewSession(bid, ep, nil, map[string]string{"meow": "woof"}).
+		WithNewID().
+		WithProjectID(pid).
+		WithState(sdktypes.SessionStateTypeCompleted).
+		SetDurable(true)
+
+	fn := kittehs.Must1(sdktypes.NewFunctionValue(sdktypes.NewExecutorID(sdktypes.NewRunID()), "f", nil, nil, sdktypes.InvalidModuleFunction))
+
+	// Records with identical timestamps must keep their relative order.
+	now := kittehs.Now()
+	log := []sdktypes.SessionLogRecord{
+		sdktypes.NewStateSessionLogRecord(now, sdktypes.NewSessionStateCreated()),
+		sdktypes.NewCallSpecSessionLogRecord(now, sdktypes.NewSessionCallSpec(fn, nil, nil, 1)),
+		sdktypes.NewCallAttemptCompleteSessionLogRecord(now, sdktypes.NewSessionCallAttemptComplete(now, true, sdktypes.NewSessionCallAttemptResult(sdktypes.NewIntegerValue(1), nil))),
+		sdktypes.NewPrintSessionLogRecord(now, sdktypes.NewStringValue("meow"), 0),
+		sdktypes.NewStateSessionLogRecord(now.Add(time.Second), sdktypes.NewSessionStateCompleted(nil, nil, sdktypes.Nothing)),
+	}
+
+	require.NoError(t, f.gormdb.ImportSession(f.ctx, session, log))
+
+	got, err := f.gormdb.GetSession(f.ctx, session.ID())
+	require.NoError(t, err)
+	assert.Equal(t, sdktypes.SessionStateTypeCompleted, got.State())
+	assert.Equal(t, map[string]string{"meow": "woof"}, got.Memo())
+
+	all, err := f.gormdb.GetSessionLog(f.ctx, sdkservices.SessionLogRecordsFilter{SessionID: session.ID(), PaginationRequest: sdktypes.PaginationRequest{Ascending: true}})
+	require.NoError(t, err)
+	require.Len(t, all.Records, len(log))
+
+	for i, r := range all.Records {
+		assert.Equal(t, log[i].Type(), r.Type())
+	}
+
+	calls, err := f.gormdb.GetSessionLog(f.ctx, sdkservices.SessionLogRecordsFilter{SessionID: session.ID(), Types: sdktypes.CallSpecSessionLogRecordType})
+	require.NoError(t, err)
+	require.Len(t, calls.Records, 1)
+	assert.Equal(t, uint32(1), calls.Records[0].GetCallSpec().Seq())
+
+	// Log records must be typed.
+	assert.Error(t, f.gormdb.ImportSession(f.ctx, session.WithNewID(), []sdktypes.SessionLogRecord{sdktypes.InvalidSessionLogRecord}))
+}
+
 func TestListSessions(t *testing.T) {
 	f, p, b := preSessionTest(t)
 
@@ -207,6 +344,152 @@ func TestListSessionsNoSessionsCountOnly(t *testing.T) {
 	require.Nil(t, sessions)
 }
 
+func TestSearchSessions(t *testing.T) {
+	f, p, b := preSessionTest(t)
+
+	trg := f.newTrigger(p, "meow")
+	f.createTriggersAndAssert(t, trg)
+
+	s1 := f.newSession(sdktypes.SessionStateTypeCompleted, p, b, trg)
+	s1.Entrypoint = "main.py:on_event"
+
+	s2 := f.newSession(sdktypes.SessionStateTypeCompleted, p, b)
+	s2.Entrypoint = "other.py:on_event"
+	s2.CreatedAt = now.Add(-time.Hour)
+
+	f.createSessionsAndAssert(t, s1, s2)
+
+	sid1 := sdktypes.NewIDFromUUID[sdktypes.SessionID](s1.SessionID)
+	sid2 := sdktypes.NewIDFromUUID[sdktypes.SessionID](s2.SessionID)
+
+	require.NoError(t, f.gormdb.AddSessionPrint(f.ctx, sid1, sdktypes.NewStringValue("Got Rate Limited, 100% sure"), 0))
+	require.NoError(t, f.gormdb.AddSessionPrint(f.ctx, sid2, sdktypes.NewStringValue("all good"), 0))
+	require.NoError(t, f.gormdb.UpdateSessionState(f.ctx, sid2, sdktypes.NewSessionStateError(errors.New("ValueError: boom"), nil)))
+
+	search := func(f *dbFixture, flt sdkservices.SearchSessionsFilter) []uuid.UUID {
+		sessions, cnt, err := f.gormdb.searchSessions(f.ctx, flt)
+		require.NoError(t, err)
+		require.Equal(t, cnt, int64(len(sessions)))
+
+		return kittehs.Transform(sessions, func(s scheme.Session) uuid.UUID { return s.SessionID })
+	}
+
+	tests := []struct {
+		name string
+		flt  sdkservices.SearchSessionsFilter
+		want []uuid.UUID
+	}{
+		{"all", sdkservices.SearchSessionsFilter{}, []uuid.UUID{s1.SessionID, s2.SessionID}},
+		{"prints", sdkservices.SearchSessionsFilter{PrintsContain: "rate limited"}, []uuid.UUID{s1.SessionID}},
+		{"prints escaped", sdkservices.SearchSessionsFilter{PrintsContain: "100%"}, []uuid.UUID{s1.SessionID}},
+		{"prints not escaped", sdkservices.SearchSessionsFilter{PrintsContain: "got%sure"}, []uuid.UUID{}},
+		{"error", sdkservices.SearchSessionsFilter{ErrorContains: "valueerror"}, []uuid.UUID{s2.SessionID}},
+		{"error is not print", sdkservices.SearchSessionsFilter{PrintsContain: "boom"}, []uuid.UUID{}},
+		{"created after", sdkservices.SearchSessionsFilter{ListSessionsFilter: sdkservices.ListSessionsFilter{CreatedAfter: now.Add(-time.Minute)}}, []uuid.UUID{s1.SessionID}},
+		{"created before", sdkservices.SearchSessionsFilter{ListSessionsFilter: sdkservices.ListSessionsFilter{CreatedBefore: now.Add(-time.Minute)}}, []uuid.UUID{s2.SessionID}},
+		{"trigger", sdkservices.SearchSessionsFilter{TriggerName: "meow"}, []uuid.UUID{s1.SessionID}},
+		{"no trigger", sdkservices.SearchSessionsFilter{TriggerName: "woof"}, []uuid.UUID{}},
+		{"entry point", sdkservices.SearchSessionsFilter{EntryPoint: "other.py:on_event"}, []uuid.UUID{s2.SessionID}},
+		{"entry point path", sdkservices.SearchSessionsFilter{EntryPoint: "main.py"}, []uuid.UUID{s1.SessionID}},
+		{
+			"combined",
+			sdkservices.SearchSessionsFilter{
+				ListSessionsFilter: sdkservices.ListSessionsFilter{StateType: sdktypes.SessionStateTypeError},
+				PrintsContain:      "good",
+			},
+			[]uuid.UUID{s2.SessionID},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, search(f, test.flt))
+		})
+	}
+}
+
+func TestSearchSessionsByTriggerNameInProject(t *testing.T) {
+	f, p1, b := preSessionTest(t)
+
+	p2 := f.newProject()
+	f.createProjectsAndAssert(t, p2)
+
+	// Both projects have a trigger with the same name.
+	trg1, trg2 := f.newTrigger(p1, "meow"), f.newTrigger(p2, "meow")
+	f.createTriggersAndAssert(t, trg1, trg2)
+
+	s1 := f.newSession(sdktypes.SessionStateTypeCompleted, p1, b, trg1)
+
+	// Mismatching project and trigger, which can only be the case with bad data.
+	s2 := f.newSession(sdktypes.SessionStateTypeCompleted, p1, b, trg2)
+
+	s3 := f.newSession(sdktypes.SessionStateTypeCompleted, p2, b, trg2)
+
+	f.createSessionsAndAssert(t, s1, s2, s3)
+
+	search := func(flt sdkservices.SearchSessionsFilter) []uuid.UUID {
+		sessions, _, err := f.gormdb.searchSessions(f.ctx, flt)
+		require.NoError(t, err)
+
+		return kittehs.Transform(sessions, func(s scheme.Session) uuid.UUID { return s.SessionID })
+	}
+
+	pid1 := sdktypes.NewIDFromUUID[sdktypes.ProjectID](p1.ProjectID)
+	pid2 := sdktypes.NewIDFromUUID[sdktypes.ProjectID](p2.ProjectID)
+
+	assert.Equal(t, []uuid.UUID{s1.SessionID}, search(sdkservices.SearchSessionsFilter{ListSessionsFilter: sdkservices.ListSessionsFilter{ProjectID: pid1}, TriggerName: "meow"}))
+	assert.Equal(t, []uuid.UUID{s3.SessionID}, search(sdkservices.SearchSessionsFilter{ListSessionsFilter: sdkservices.ListSessionsFilter{ProjectID: pid2}, TriggerName: "meow"}))
+	assert.ElementsMatch(t, []uuid.UUID{s1.SessionID, s3.SessionID}, search(sdkservices.SearchSessionsFilter{TriggerName: "meow"}))
+}
+
+func TestSessionTags(t *testing.T) {
+	f, p, b := preSessionTest(t)
+
+	s1 := f.newSession(sdktypes.SessionStateTypeRunning, p, b)
+	s2 := f.newSession(sdktypes.SessionStateTypeRunning, p, b)
+	f.createSessionsAndAssert(t, s1, s2)
+
+	sid1 := sdktypes.NewIDFromUUID[sdktypes.SessionID](s1.SessionID)
+	sid2 := sdktypes.NewIDFromUUID[sdktypes.SessionID](s2.SessionID)
+
+	require.NoError(t, f.gormdb.SetSessionTag(f.ctx, sid1, "customer_id", "42"))
+	require.NoError(t, f.gormdb.SetSessionTag(f.ctx, sid1, "tier", "gold"))
+	require.NoError(t, f.gormdb.SetSessionTag(f.ctx, sid2, "customer_id", "43"))
+
+	session, err := f.gormdb.GetSession(f.ctx, sid1)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"customer_id": "42", "tier": "gold"}, session.Tags())
+
+	list := func(tags map[string]string) []uuid.UUID {
+		sessions, _, err := f.gormdb.listSessions(f.ctx, sdkservices.ListSessionsFilter{Tags: tags})
+		require.NoError(t, err)
+		return kittehs.Transform(sessions, func(s scheme.Session) uuid.UUID { return s.SessionID })
+	}
+
+	assert.Equal(t, []uuid.UUID{s1.SessionID}, list(map[string]string{"customer_id": "42"}))
+	assert.Equal(t, []uuid.UUID{s2.SessionID}, list(map[string]string{"customer_id": "43"}))
+	assert.Equal(t, []uuid.UUID{s1.SessionID}, list(map[string]string{"customer_id": "42", "tier": "gold"}))
+	assert.Empty(t, list(map[string]string{"customer_id": "43", "tier": "gold"}))
+
+	// Empty value removes the tag.
+	require.NoError(t, f.gormdb.SetSessionTag(f.ctx, sid1, "tier", ""))
+	assert.Empty(t, list(map[string]string{"tier": "gold"}))
+
+	session, err = f.gormdb.GetSession(f.ctx, sid1)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"customer_id": "42"}, session.Tags())
+
+	for i := range sdktypes.MaxSessionTags - 1 {
+		require.NoError(t, f.gormdb.SetSessionTag(f.ctx, sid1, fmt.Sprintf("k%d", i), "v"))
+	}
+
+	// Overwriting is fine when full.
+	require.NoError(t, f.gormdb.SetSessionTag(f.ctx, sid1, "customer_id", "44"))
+	assert.ErrorAs(t, f.gormdb.SetSessionTag(f.ctx, sid1, "one_too_many", "v"), &sdkerrors.InvalidArgumentError{})
+
+	assert.ErrorIs(t, f.gormdb.SetSessionTag(f.ctx, sdktypes.NewSessionID(), "k", "v"), sdkerrors.ErrNotFound)
+}
+
 func TestListPaginatedSession(t *testing.T) {
 	f, p, b := preSessionTest(t)
 
@@ -252,6 +535,65 @@ func TestDeleteSessionLogRecords(t *testing.T) {
 	f.assertSessionsLogRecordsDeleted(t, lr)
 }
 
+func TestListSessionsToPurge(t *testing.T) {
+	f, p1, b1 := preSessionTest(t)
+	p2, b2 := f.createProjectBuild(t)
+
+	s1 := f.newSession(sdktypes.SessionStateTypeCompleted, p1, b1)
+	s1.CreatedAt = now.Add(-3 * time.Hour)
+
+	s2 := f.newSession(sdktypes.SessionStateTypeError, p1, b1)
+	s2.CreatedAt = now.Add(-2 * time.Hour)
+
+	s3 := f.newSession(sdktypes.SessionStateTypeCompleted, p1, b1)
+
+	s4 := f.newSession(sdktypes.SessionStateTypeRunning, p1, b1)
+	s4.CreatedAt = now.Add(-5 * time.Hour)
+
+	s5 := f.newSession(sdktypes.SessionStateTypeStopped, p2, b2)
+	s5.CreatedAt = now.Add(-3 * time.Hour)
+
+	f.createSessionsAndAssert(t, s1, s2, s3, s4, s5)
+
+	pid1 := sdktypes.NewIDFromUUID[sdktypes.ProjectID](p1.ProjectID)
+	oid2 := sdktypes.NewIDFromUUID[sdktypes.OrgID](p2.OrgID)
+	final := sdktypes.FinalSessionStateTypes
+	hourAgo := now.Add(-time.Hour)
+
+	tests := []struct {
+		name string
+		flt  db.SessionRetentionFilter
+		want []uuid.UUID
+	}{
+		{"no limits", db.SessionRetentionFilter{StateTypes: final}, nil},
+		{"age", db.SessionRetentionFilter{ProjectID: pid1, StateTypes: final, CreatedBefore: hourAgo}, []uuid.UUID{s1.SessionID, s2.SessionID}},
+		{"count", db.SessionRetentionFilter{ProjectID: pid1, StateTypes: final, KeepLatest: 1}, []uuid.UUID{s1.SessionID, s2.SessionID}},
+		{"count per state", db.SessionRetentionFilter{ProjectID: pid1, StateTypes: []sdktypes.SessionStateType{sdktypes.SessionStateTypeCompleted}, KeepLatest: 1}, []uuid.UUID{s1.SessionID}},
+		{"count per project", db.SessionRetentionFilter{StateTypes: final, KeepLatest: 2}, []uuid.UUID{s1.SessionID}},
+		{"org", db.SessionRetentionFilter{OrgID: oid2, StateTypes: final, CreatedBefore: hourAgo}, []uuid.UUID{s5.SessionID}},
+		{"exclude project", db.SessionRetentionFilter{ExcludeProjectIDs: []sdktypes.ProjectID{pid1}, StateTypes: final, CreatedBefore: hourAgo}, []uuid.UUID{s5.SessionID}},
+		{"exclude org", db.SessionRetentionFilter{ExcludeOrgIDs: []sdktypes.OrgID{oid2}, StateTypes: final, CreatedBefore: hourAgo}, []uuid.UUID{s1.SessionID, s2.SessionID}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rs, err := f.gormdb.listSessionsToPurge(f.ctx, test.flt, 10)
+			require.NoError(t, err)
+
+			got := kittehs.Transform(rs, func(s scheme.Session) uuid.UUID { return s.SessionID })
+			if test.want == nil {
+				assert.Empty(t, got)
+			} else {
+				assert.Equal(t, test.want, got)
+			}
+		})
+	}
+
+	rs, err := f.gormdb.listSessionsToPurge(f.ctx, db.SessionRetentionFilter{StateTypes: final, CreatedBefore: hourAgo}, 1)
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+}
+
 /*
 func TestDeleteSessionForeignKeys(t *testing.T) {
     // session is soft-deleted, so no need to check foreign keys meanwhile
@@ -287,6 +629,89 @@ func TestAddSessionPrintLogRecord(t *testing.T) {
 	testLastLogRecord(t, f, 2, s.SessionID, l)
 }
 
+func TestAddSessionCompensation(t *testing.T) {
+	f, p, b := preSessionTest(t)
+
+	s := f.newSession(sdktypes.SessionStateTypeError, p, b)
+	f.createSessionsAndAssert(t, s)
+
+	sid := sdktypes.NewIDFromUUID[sdktypes.SessionID](s.SessionID)
+	fn := kittehs.Must1(sdktypes.NewFunctionValue(sdktypes.NewExecutorID(sdktypes.NewRunID()), "undo", nil, nil, sdktypes.InvalidModuleFunction))
+	perr := sdktypes.NewProgramError(sdktypes.NewStringValue("meow"), nil, nil)
+
+	require.NoError(t, f.gormdb.AddSessionCompensation(f.ctx, sid, fn, 2, sdktypes.InvalidProgramError))
+	require.NoError(t, f.gormdb.AddSessionCompensation(f.ctx, sid, fn, 3, perr))
+
+	res, err := f.gormdb.GetSessionLog(f.ctx, sdkservices.SessionLogRecordsFilter{
+		SessionID:         sid,
+		Types:             sdktypes.CompensationSessionLogRecordType,
+		PaginationRequest: sdktypes.PaginationRequest{Ascending: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 2)
+
+	rfn, seq, rperr, ok := res.Records[0].GetCompensation()
+	assert.True(t, ok)
+	assert.True(t, fn.Equal(rfn))
+	assert.Equal(t, uint32(2), seq)
+	assert.False(t, rperr.IsValid())
+
+	_, seq, rperr, ok = res.Records[1].GetCompensation()
+	assert.True(t, ok)
+	assert.Equal(t, uint32(3), seq)
+	assert.True(t, perr.Equal(rperr))
+}
+
+func TestSubscribeSessionOutcomes(t *testing.T) {
+	f, p, b := preSessionTest(t)
+
+	if f.gormdb.cfg.Type == "postgres" {
+		t.Skip("postgres notifications are relayed by a listener, which is not started in tests")
+	}
+
+	s := f.newSession(sdktypes.SessionStateTypeCompleted, p, b)
+	f.createSessionsAndAssert(t, s)
+
+	sid := sdktypes.NewIDFromUUID[sdktypes.SessionID](s.SessionID)
+	eid, other := sdktypes.NewEventID(), sdktypes.NewEventID()
+
+	ch, unsubscribe := f.gormdb.SubscribeSessionOutcomes(eid)
+
+	require.NoError(t, f.gormdb.AddSessionOutcome(f.ctx, sid, sdktypes.NewStringValue("meow"), other))
+
+	select {
+	case <-ch:
+		t.Fatal("notified about another event")
+	default:
+	}
+
+	// Notifications are coalesced.
+	require.NoError(t, f.gormdb.AddSessionOutcome(f.ctx, sid, sdktypes.NewStringValue("meow"), eid))
+	require.NoError(t, f.gormdb.AddSessionOutcome(f.ctx, sid, sdktypes.NewStringValue("woof"), eid))
+
+	select {
+	case <-ch:
+	default:
+		t.Fatal("not notified")
+	}
+
+	select {
+	case <-ch:
+		t.Fatal("notified twice")
+	default:
+	}
+
+	unsubscribe()
+
+	require.NoError(t, f.gormdb.AddSessionOutcome(f.ctx, sid, sdktypes.NewStringValue("purr"), eid))
+
+	select {
+	case <-ch:
+		t.Fatal("notified after unsubscribe")
+	default:
+	}
+}
+
 func TestSessionLogRecordListOrder(t *testing.T) {
 	f, p, b := preSessionTest(t)
 
@@ -3,18 +3,23 @@ package dbgorm
 import (
 	"context"
 	"errors"
+	"time"
+	"unicode/utf8"
 
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
 	"go.autokitteh.dev/autokitteh/internal/backend/auth/authcontext"
 	"go.autokitteh.dev/autokitteh/internal/backend/db/dbgorm/scheme"
 	"go.autokitteh.dev/autokitteh/internal/kittehs"
 	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
 )
 
-func (db *gormdb) SetStoreValue(ctx context.Context, pid sdktypes.ProjectID, key string, v sdktypes.Value) error {
+func (db *gormdb) SetStoreValue(ctx context.Context, pid sdktypes.ProjectID, key string, v sdktypes.Value, expiresAt *time.Time, lockedBy sdktypes.SessionID) error {
 	if !pid.IsValid() {
 		return sdkerrors.NewInvalidArgumentError("invalid project id")
 	}
@@ -34,22 +39,46 @@ func (db *gormdb) SetStoreValue(ctx context.Context, pid sdktypes.ProjectID, key
 
 	by := authcontext.GetAuthnUserID(ctx).UUIDValue()
 
-	err = q.Clauses(clause.OnConflict{
-		Columns: []clause.Column{{Name: "project_id"}, {Name: "key"}},
-		DoUpdates: clause.Assignments(map[string]any{
-			"value":      bs,
-			"updated_by": by,
-			"updated_at": kittehs.Now(),
-			// DO NOT reset "published" flag on update.
-		}),
-	}).Create(&scheme.StoreValue{
-		Base:      based(ctx),
-		ProjectID: pid.UUIDValue(),
-		Key:       key,
-		Value:     bs,
-		UpdatedBy: by,
-		UpdatedAt: kittehs.Now(),
-	}).Error
+	owner := lockedBy.UUIDValuePtr()
+
+	updates := map[string]any{
+		"value":      bs,
+		"updated_by": by,
+		"updated_at": kittehs.Now(),
+		"locked_by":  owner,
+		// DO NOT reset "published" flag on update.
+	}
+
+	var exp *time.Time
+	if expiresAt != nil {
+		if !expiresAt.IsZero() {
+			exp = expiresAt
+		}
+
+		updates["expires_at"] = exp
+	}
+
+	err = db.writeTransaction(ctx, func(tx *gormdb) error {
+		// An expired value that was not swept yet is treated as absent, so
+		// nothing about it, such as its expiry or published flag, carries over.
+		if err := tx.writer.Where("project_id = ? AND key = ?", pid.UUIDValue(), key).Scopes(expired).Delete(&scheme.StoreValue{}).Error; err != nil {
+			return err
+		}
+
+		return tx.writer.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "project_id"}, {Name: "key"}},
+			DoUpdates: clause.Assignments(updates),
+		}).Create(&scheme.StoreValue{
+			Base:      based(ctx),
+			ProjectID: pid.UUIDValue(),
+			Key:       key,
+			Value:     bs,
+			UpdatedBy: by,
+			UpdatedAt: kittehs.Now(),
+			ExpiresAt: exp,
+			LockedBy:  owner,
+		}).Error
+	})
 
 	return translateError(err)
 }
@@ -71,6 +100,28 @@ func (db *gormdb) GetStoreValue(ctx context.Context, pid sdktypes.ProjectID, key
 	return v, nil
 }
 
+func (db *gormdb) GetStoreLockOwner(ctx context.Context, pid sdktypes.ProjectID, key string) (sdktypes.SessionID, error) {
+	var owners []uuid.UUID
+
+	err := db.reader.
+		WithContext(ctx).
+		Model(&scheme.StoreValue{}).
+		Where("project_id = ? AND key = ? AND locked_by IS NOT NULL", pid.UUIDValue(), key).
+		Scopes(unexpired).
+		Limit(1).
+		Pluck("locked_by", &owners).
+		Error
+	if err != nil {
+		return sdktypes.InvalidSessionID, translateError(err)
+	}
+
+	if len(owners) == 0 {
+		return sdktypes.InvalidSessionID, nil
+	}
+
+	return sdktypes.NewIDFromUUID[sdktypes.SessionID](owners[0]), nil
+}
+
 func (db *gormdb) PublishStoreValue(ctx context.Context, pid sdktypes.ProjectID, key string) error {
 	if !pid.IsValid() {
 		return sdkerrors.NewInvalidArgumentError("invalid project id")
@@ -115,21 +166,67 @@ func (db *gormdb) IsStoreValuePublished(ctx context.Context, pid sdktypes.Projec
 	return sv.Published, nil
 }
 
-func (db *gormdb) CountStoreValues(ctx context.Context, pid sdktypes.ProjectID) (n int64, err error) {
+func (db *gormdb) CountStoreValues(ctx context.Context, pid sdktypes.ProjectID, prefix string) (n int64, err error) {
 	err = translateError(
 		db.reader.
 			WithContext(ctx).
 			Model(&scheme.StoreValue{}).
 			Where("project_id = ?", pid.UUIDValue()).
+			Scopes(unexpired, withKeyPrefix(prefix)).
 			Count(&n).
 			Error,
 	)
 	return
 }
 
+func (db *gormdb) ListStoreKeys(ctx context.Context, pid sdktypes.ProjectID, f sdkservices.ListStoreValuesFilter) (*sdkservices.ListStoreValuesResult, error) {
+	q := db.reader.
+		WithContext(ctx).
+		Model(&scheme.StoreValue{}).
+		Where("project_id = ?", pid.UUIDValue()).
+		Scopes(unexpired, withKeyPrefix(f.Prefix))
+
+	if f.PublishedOnly {
+		q = q.Where("published = ?", true)
+	}
+
+	var n int64
+	if err := q.Count(&n).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	if f.PageSize != 0 {
+		q = q.Limit(int(f.PageSize))
+	}
+
+	if f.Skip != 0 {
+		q = q.Offset(int(f.Skip))
+	}
+
+	if f.PageToken != "" {
+		q = q.Where("key > ?", f.PageToken)
+	}
+
+	var keys []string
+	if err := q.Order("key").Pluck("key", &keys).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	// Only if we have a full page, there might be more keys.
+	var next string
+	if f.PageSize > 0 && len(keys) == int(f.PageSize) {
+		next = keys[len(keys)-1]
+	}
+
+	return &sdkservices.ListStoreValuesResult{
+		Keys:             keys,
+		PaginationResult: sdktypes.PaginationResult{TotalCount: n, NextPageToken: next},
+	}, nil
+}
+
 func (db *gormdb) ListStoreValues(ctx context.Context, pid sdktypes.ProjectID, keys []string, getValues bool) (map[string]sdktypes.Value, error) {
 	var rs []*scheme.StoreValue
-	q := db.reader.WithContext(ctx).Where("project_id = ?", pid.UUIDValue())
+	q := db.reader.WithContext(ctx).Where("project_id = ?", pid.UUIDValue()).Scopes(unexpired)
 
 	if len(keys) > 0 {
 		q = q.Where("key IN (?)", keys)
@@ -162,3 +259,33 @@ func (db *gormdb) ListStoreValues(ctx context.Context, pid sdktypes.ProjectID, k
 		return r.Key, v, err
 	})
 }
+
+// LIKE is case insensitive in sqlite, so compare the actual prefix instead.
+func withKeyPrefix(prefix string) func(*gorm.DB) *gorm.DB {
+	return func(q *gorm.DB) *gorm.DB {
+		if prefix == "" {
+			return q
+		}
+
+		return q.Where("substr(key, 1, ?) = ?", utf8.RuneCountInString(prefix), prefix)
+	}
+}
+
+// Expired values are lazily filtered out on read until they are
+// swept by DeleteExpiredStoreValues.
+func unexpired(q *gorm.DB) *gorm.DB {
+	return q.Where("expires_at IS NULL OR expires_at > ?", kittehs.Now())
+}
+
+func expired(q *gorm.DB) *gorm.DB {
+	return q.Where("expires_at IS NOT NULL AND expires_at <= ?", kittehs.Now())
+}
+
+func (db *gormdb) DeleteExpiredStoreValues(ctx context.Context) (int64, error) {
+	q := db.writer.WithContext(ctx).Scopes(expired).Delete(&scheme.StoreValue{})
+	if err := q.Error; err != nil {
+		return 0, translateError(err)
+	}
+
+	return q.RowsAffected, nil
+}
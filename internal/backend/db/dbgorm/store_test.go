@@ -2,12 +2,14 @@ package dbgorm_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	"go.autokitteh.dev/autokitteh/internal/backend/db/dbgorm"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
 )
 
@@ -29,9 +31,9 @@ func TestValues(t *testing.T) {
 	require.NoError(t, db.CreateProject(ctx, sdktypes.NewProject().WithName(sdktypes.NewSymbol("test2")).WithID(pids[1]).WithOrgID(oid)))
 	require.NoError(t, db.CreateProject(ctx, sdktypes.NewProject().WithName(sdktypes.NewSymbol("test3")).WithID(pids[2]).WithOrgID(oid)))
 
-	require.NoError(t, db.SetStoreValue(ctx, pids[0], "key0", sdktypes.NewIntegerValue(10)))
-	require.NoError(t, db.SetStoreValue(ctx, pids[0], "key1", sdktypes.NewIntegerValue(11)))
-	require.NoError(t, db.SetStoreValue(ctx, pids[1], "key", sdktypes.NewIntegerValue(2)))
+	require.NoError(t, db.SetStoreValue(ctx, pids[0], "key0", sdktypes.NewIntegerValue(10), nil, sdktypes.InvalidSessionID))
+	require.NoError(t, db.SetStoreValue(ctx, pids[0], "key1", sdktypes.NewIntegerValue(11), nil, sdktypes.InvalidSessionID))
+	require.NoError(t, db.SetStoreValue(ctx, pids[1], "key", sdktypes.NewIntegerValue(2), nil, sdktypes.InvalidSessionID))
 
 	vs, err := db.ListStoreValues(ctx, pids[0], nil, true)
 	if assert.NoError(t, err) {
@@ -51,7 +53,7 @@ func TestValues(t *testing.T) {
 		assert.Equal(t, map[string]sdktypes.Value{}, vs)
 	}
 
-	require.NoError(t, db.SetStoreValue(ctx, pids[0], "key0", sdktypes.NewIntegerValue(100)))
+	require.NoError(t, db.SetStoreValue(ctx, pids[0], "key0", sdktypes.NewIntegerValue(100), nil, sdktypes.InvalidSessionID))
 
 	vs, err = db.ListStoreValues(ctx, pids[0], nil, true)
 	if assert.NoError(t, err) {
@@ -108,7 +110,7 @@ func TestValues(t *testing.T) {
 		assert.True(t, pub)
 	}
 
-	require.NoError(t, db.SetStoreValue(ctx, pids[1], "key", sdktypes.NewIntegerValue(3)))
+	require.NoError(t, db.SetStoreValue(ctx, pids[1], "key", sdktypes.NewIntegerValue(3), nil, sdktypes.InvalidSessionID))
 
 	// Check that the published flag is still true after update.
 	pub, err = db.IsStoreValuePublished(ctx, pids[1], "key")
@@ -121,3 +123,150 @@ func TestValues(t *testing.T) {
 		assert.Equal(t, sdktypes.NewIntegerValue(3), v)
 	}
 }
+
+func TestExpiredValues(t *testing.T) {
+	pid := sdktypes.NewProjectID()
+
+	db, err := dbgorm.New(zap.NewNop(), &dbgorm.Config{})
+	require.NoError(t, err)
+
+	ctx := t.Context()
+
+	require.NoError(t, db.Connect(ctx))
+	require.NoError(t, db.Setup(ctx))
+
+	oid, err := db.CreateOrg(ctx, sdktypes.NewOrg().WithID(sdktypes.NewOrgID()))
+	require.NoError(t, err)
+
+	require.NoError(t, db.CreateProject(ctx, sdktypes.NewProject().WithName(sdktypes.NewSymbol("test")).WithID(pid).WithOrgID(oid)))
+
+	past, future := time.Now().Add(-time.Minute), time.Now().Add(time.Hour)
+
+	require.NoError(t, db.SetStoreValue(ctx, pid, "expired", sdktypes.NewIntegerValue(1), &past, sdktypes.InvalidSessionID))
+	require.NoError(t, db.SetStoreValue(ctx, pid, "live", sdktypes.NewIntegerValue(2), &future, sdktypes.InvalidSessionID))
+	require.NoError(t, db.SetStoreValue(ctx, pid, "forever", sdktypes.NewIntegerValue(3), nil, sdktypes.InvalidSessionID))
+
+	vs, err := db.ListStoreValues(ctx, pid, nil, true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]sdktypes.Value{
+			"live":    sdktypes.NewIntegerValue(2),
+			"forever": sdktypes.NewIntegerValue(3),
+		}, vs)
+	}
+
+	v, err := db.GetStoreValue(ctx, pid, "expired")
+	if assert.NoError(t, err) {
+		assert.Equal(t, sdktypes.Nothing, v)
+	}
+
+	n, err := db.CountStoreValues(ctx, pid, "")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(2), n)
+	}
+
+	require.NoError(t, db.PublishStoreValue(ctx, pid, "live"))
+
+	// An expired value is treated as absent, so nothing carries over from it.
+	require.NoError(t, db.PublishStoreValue(ctx, pid, "expired"))
+	require.NoError(t, db.SetStoreValue(ctx, pid, "expired", sdktypes.NewIntegerValue(4), nil, sdktypes.InvalidSessionID))
+
+	v, err = db.GetStoreValue(ctx, pid, "expired")
+	if assert.NoError(t, err) {
+		assert.Equal(t, sdktypes.NewIntegerValue(4), v)
+	}
+
+	published, err := db.IsStoreValuePublished(ctx, pid, "expired")
+	if assert.NoError(t, err) {
+		assert.False(t, published)
+	}
+
+	n, err = db.DeleteExpiredStoreValues(ctx)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(0), n)
+	}
+
+	// nil expiry retains the current expiry of a live value.
+	require.NoError(t, db.SetStoreValue(ctx, pid, "live", sdktypes.NewIntegerValue(2), nil, sdktypes.InvalidSessionID))
+
+	published, err = db.IsStoreValuePublished(ctx, pid, "live")
+	if assert.NoError(t, err) {
+		assert.True(t, published)
+	}
+
+	// zero expiry clears the expiry.
+	require.NoError(t, db.SetStoreValue(ctx, pid, "live", sdktypes.NewIntegerValue(5), &time.Time{}, sdktypes.InvalidSessionID))
+
+	n, err = db.DeleteExpiredStoreValues(ctx)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(0), n)
+	}
+
+	vs, err = db.ListStoreValues(ctx, pid, nil, true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]sdktypes.Value{
+			"expired": sdktypes.NewIntegerValue(4),
+			"live":    sdktypes.NewIntegerValue(5),
+			"forever": sdktypes.NewIntegerValue(3),
+		}, vs)
+	}
+}
+
+func TestListStoreKeys(t *testing.T) {
+	pid := sdktypes.NewProjectID()
+
+	db, err := dbgorm.New(zap.NewNop(), &dbgorm.Config{})
+	require.NoError(t, err)
+
+	ctx := t.Context()
+
+	require.NoError(t, db.Connect(ctx))
+	require.NoError(t, db.Setup(ctx))
+
+	oid, err := db.CreateOrg(ctx, sdktypes.NewOrg().WithID(sdktypes.NewOrgID()))
+	require.NoError(t, err)
+
+	require.NoError(t, db.CreateProject(ctx, sdktypes.NewProject().WithName(sdktypes.NewSymbol("test")).WithID(pid).WithOrgID(oid)))
+
+	for _, k := range []string{"users/3", "users/1", "Users/2", "cache/1", "users/2", "users"} {
+		require.NoError(t, db.SetStoreValue(ctx, pid, k, sdktypes.NewIntegerValue(1), nil, sdktypes.InvalidSessionID))
+	}
+
+	require.NoError(t, db.PublishStoreValue(ctx, pid, "users/2"))
+
+	res, err := db.ListStoreKeys(ctx, pid, sdkservices.ListStoreValuesFilter{})
+	if assert.NoError(t, err) {
+		assert.ElementsMatch(t, []string{"Users/2", "cache/1", "users", "users/1", "users/2", "users/3"}, res.Keys)
+		assert.Equal(t, int64(6), res.TotalCount)
+		assert.Empty(t, res.NextPageToken)
+	}
+
+	f := sdkservices.ListStoreValuesFilter{
+		Prefix:            "users/",
+		PaginationRequest: sdktypes.PaginationRequest{PageSize: 2},
+	}
+
+	res, err = db.ListStoreKeys(ctx, pid, f)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"users/1", "users/2"}, res.Keys)
+		assert.Equal(t, int64(3), res.TotalCount)
+		assert.Equal(t, "users/2", res.NextPageToken)
+	}
+
+	f.PageToken = res.NextPageToken
+
+	res, err = db.ListStoreKeys(ctx, pid, f)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"users/3"}, res.Keys)
+		assert.Empty(t, res.NextPageToken)
+	}
+
+	res, err = db.ListStoreKeys(ctx, pid, sdkservices.ListStoreValuesFilter{Prefix: "users/", PublishedOnly: true})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"users/2"}, res.Keys)
+	}
+
+	n, err := db.CountStoreValues(ctx, pid, "cache/")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(1), n)
+	}
+}
@@ -0,0 +1,179 @@
+package dbgorm
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/db"
+	"go.autokitteh.dev/autokitteh/internal/backend/db/dbgorm/scheme"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func (gdb *gormdb) deleteTriggerQueue(ctx context.Context, triggerID uuid.UUID) error {
+	return gdb.writer.WithContext(ctx).Delete(&scheme.TriggerQueueEntry{}, "trigger_id = ?", triggerID).Error
+}
+
+// lockTrigger serializes admissions for the trigger until the transaction ends.
+func (gdb *gormdb) lockTrigger(ctx context.Context, triggerID uuid.UUID) error {
+	var t scheme.Trigger
+	return gdb.writer.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Model(&scheme.Trigger{}).
+		Where("trigger_id = ?", triggerID).
+		Select("trigger_id").
+		First(&t).Error
+}
+
+// usedTriggerSlots returns the number of active sessions of the trigger and
+// of its admitted events, whose sessions might not be created yet.
+func (gdb *gormdb) usedTriggerSlots(ctx context.Context, triggerID uuid.UUID) (int, error) {
+	var sessions, admitted int64
+
+	if err := gdb.writer.WithContext(ctx).
+		Model(&scheme.Session{}).
+		Where("trigger_id = ? AND current_state_type IN ?", triggerID, []int{
+			int(sdktypes.SessionStateTypeCreated.ToProto()),
+			int(sdktypes.SessionStateTypeRunning.ToProto()),
+		}).
+		Count(&sessions).Error; err != nil {
+		return 0, err
+	}
+
+	if err := gdb.writer.WithContext(ctx).
+		Model(&scheme.TriggerQueueEntry{}).
+		Where("trigger_id = ? AND admitted_at IS NOT NULL", triggerID).
+		Count(&admitted).Error; err != nil {
+		return 0, err
+	}
+
+	return int(sessions + admitted), nil
+}
+
+func (gdb *gormdb) AdmitTriggerEvent(ctx context.Context, trigger sdktypes.Trigger, qe db.TriggerQueueEntry, did sdktypes.DeploymentID, wait bool) (seq uint64, admitted bool, err error) {
+	tid := trigger.ID().UUIDValue()
+
+	err = gdb.writeTransaction(ctx, func(tx *gormdb) error {
+		if err := tx.lockTrigger(ctx, tid); err != nil {
+			return err
+		}
+
+		used, err := tx.usedTriggerSlots(ctx, tid)
+		if err != nil {
+			return err
+		}
+
+		var waiting int64
+		if err := tx.writer.WithContext(ctx).
+			Model(&scheme.TriggerQueueEntry{}).
+			Where("trigger_id = ? AND admitted_at IS NULL", tid).
+			Count(&waiting).Error; err != nil {
+			return err
+		}
+
+		now := kittehs.Now().UTC()
+
+		e := scheme.TriggerQueueEntry{
+			TriggerID:    tid,
+			ProjectID:    trigger.ProjectID().UUIDValue(),
+			EventID:      qe.EventID.UUIDValue(),
+			DeploymentID: did.UUIDValuePtr(),
+			WorkflowID:   qe.WorkflowID,
+			CreatedAt:    now,
+		}
+
+		if waiting == 0 && used < trigger.Concurrency().Limit() {
+			e.AdmittedAt = &now
+		} else if !wait {
+			return nil
+		}
+
+		if err := tx.writer.WithContext(ctx).Create(&e).Error; err != nil {
+			return err
+		}
+
+		seq, admitted = e.Seq, e.AdmittedAt != nil
+
+		return nil
+	})
+
+	return seq, admitted, translateError(err)
+}
+
+func (gdb *gormdb) AdmitQueuedTriggerEvents(ctx context.Context, trigger sdktypes.Trigger) ([]db.TriggerQueueEntry, error) {
+	tid := trigger.ID().UUIDValue()
+
+	var es []scheme.TriggerQueueEntry
+
+	err := gdb.writeTransaction(ctx, func(tx *gormdb) error {
+		if err := tx.lockTrigger(ctx, tid); err != nil {
+			return err
+		}
+
+		q := tx.writer.WithContext(ctx).
+			Where("trigger_id = ? AND admitted_at IS NULL", tid).
+			Order("seq")
+
+		// A trigger without a limit, ie it was removed, admits everything.
+		if limit := trigger.Concurrency().Limit(); limit != 0 {
+			used, err := tx.usedTriggerSlots(ctx, tid)
+			if err != nil {
+				return err
+			}
+
+			if used >= limit {
+				return nil
+			}
+
+			q = q.Limit(limit - used)
+		}
+
+		if err := q.Find(&es).Error; err != nil {
+			return err
+		}
+
+		if len(es) == 0 {
+			return nil
+		}
+
+		return tx.writer.WithContext(ctx).
+			Model(&scheme.TriggerQueueEntry{}).
+			Where("seq IN ?", kittehs.Transform(es, func(e scheme.TriggerQueueEntry) uint64 { return e.Seq })).
+			Update("admitted_at", kittehs.Now().UTC()).Error
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return kittehs.Transform(es, func(e scheme.TriggerQueueEntry) db.TriggerQueueEntry {
+		return db.TriggerQueueEntry{
+			Seq:        e.Seq,
+			EventID:    sdktypes.NewIDFromUUID[sdktypes.EventID](e.EventID),
+			WorkflowID: e.WorkflowID,
+		}
+	}), nil
+}
+
+func (db *gormdb) IsTriggerEventAdmitted(ctx context.Context, seq uint64) (bool, error) {
+	e, err := getOne[scheme.TriggerQueueEntry](db.reader.WithContext(ctx), "seq = ?", seq)
+	if err != nil {
+		return false, translateError(err)
+	}
+
+	return e.AdmittedAt != nil, nil
+}
+
+func (db *gormdb) DequeueTriggerEvent(ctx context.Context, seq uint64) error {
+	q := db.writer.WithContext(ctx).Delete(&scheme.TriggerQueueEntry{}, "seq = ?", seq)
+	if q.Error != nil {
+		return translateError(q.Error)
+	}
+
+	if q.RowsAffected == 0 {
+		return sdkerrors.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package dbgorm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/db"
+	"go.autokitteh.dev/autokitteh/internal/backend/db/dbgorm/scheme"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func (f *dbFixture) createQueueTrigger(t *testing.T, limit int) sdktypes.Trigger {
+	p, c := f.createProjectConnection(t)
+
+	tr := f.newTrigger(p, c)
+	f.createTriggersAndAssert(t, tr)
+
+	trigger, err := f.gormdb.GetTriggerByID(f.ctx, sdktypes.NewIDFromUUID[sdktypes.TriggerID](tr.TriggerID))
+	require.NoError(t, err)
+
+	return trigger.WithConcurrency(sdktypes.NewConcurrencyPolicy(limit, sdktypes.ConcurrencyOverlapQueue))
+}
+
+func newTriggerQueueEntry() db.TriggerQueueEntry {
+	eid := sdktypes.NewEventID()
+	return db.TriggerQueueEntry{EventID: eid, WorkflowID: eid.String()}
+}
+
+func TestTriggerQueue(t *testing.T) {
+	f := newDBFixture()
+
+	tr1, tr2 := f.createQueueTrigger(t, 1), f.createQueueTrigger(t, 1)
+
+	admit := func(tr sdktypes.Trigger, wait bool) (uint64, bool) {
+		seq, admitted, err := f.gormdb.AdmitTriggerEvent(f.ctx, tr, newTriggerQueueEntry(), sdktypes.InvalidDeploymentID, wait)
+		require.NoError(t, err)
+		return seq, admitted
+	}
+
+	seq1, admitted := admit(tr1, true)
+	assert.True(t, admitted)
+
+	// No free slot.
+	seq2, admitted := admit(tr1, true)
+	assert.False(t, admitted)
+	assert.Less(t, seq1, seq2)
+
+	seq, admitted := admit(tr1, false)
+	assert.False(t, admitted)
+	assert.Zero(t, seq)
+
+	// Other triggers' entries do not count.
+	_, admitted = admit(tr2, true)
+	assert.True(t, admitted)
+
+	es, err := f.gormdb.AdmitQueuedTriggerEvents(f.ctx, tr1)
+	require.NoError(t, err)
+	assert.Empty(t, es)
+
+	require.NoError(t, f.gormdb.DequeueTriggerEvent(f.ctx, seq1))
+	assert.ErrorIs(t, f.gormdb.DequeueTriggerEvent(f.ctx, seq1), sdkerrors.ErrNotFound)
+
+	// Waiting events come before new ones.
+	_, admitted = admit(tr1, true)
+	assert.False(t, admitted)
+
+	admitted, err = f.gormdb.IsTriggerEventAdmitted(f.ctx, seq2)
+	require.NoError(t, err)
+	assert.False(t, admitted)
+
+	es, err = f.gormdb.AdmitQueuedTriggerEvents(f.ctx, tr1)
+	require.NoError(t, err)
+	if assert.Len(t, es, 1) {
+		assert.Equal(t, seq2, es[0].Seq)
+		assert.NotEmpty(t, es[0].WorkflowID)
+	}
+
+	admitted, err = f.gormdb.IsTriggerEventAdmitted(f.ctx, seq2)
+	require.NoError(t, err)
+	assert.True(t, admitted)
+
+	// Deleting the trigger drops its queue.
+	require.NoError(t, f.gormdb.DeleteTrigger(f.ctx, tr1.ID()))
+
+	_, err = f.gormdb.IsTriggerEventAdmitted(f.ctx, seq2)
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	findAndAssertCount[scheme.TriggerQueueEntry](t, f, 1, "")
+}
+
+func TestTriggerQueueActiveSessions(t *testing.T) {
+	f, p, b := preSessionTest(t)
+
+	c := f.newConnection(p)
+	f.createConnectionsAndAssert(t, c)
+
+	tr := f.newTrigger(p, c)
+	f.createTriggersAndAssert(t, tr)
+
+	s := f.newSession(sdktypes.SessionStateTypeRunning, p, b, tr)
+	f.createSessionsAndAssert(t, s)
+
+	trigger, err := f.gormdb.GetTriggerByID(f.ctx, sdktypes.NewIDFromUUID[sdktypes.TriggerID](tr.TriggerID))
+	require.NoError(t, err)
+
+	trigger = trigger.WithConcurrency(sdktypes.NewConcurrencyPolicy(2, sdktypes.ConcurrencyOverlapQueue))
+
+	// One slot is taken by the running session.
+	_, admitted, err := f.gormdb.AdmitTriggerEvent(f.ctx, trigger, newTriggerQueueEntry(), sdktypes.InvalidDeploymentID, true)
+	require.NoError(t, err)
+	assert.True(t, admitted)
+
+	seq, admitted, err := f.gormdb.AdmitTriggerEvent(f.ctx, trigger, newTriggerQueueEntry(), sdktypes.InvalidDeploymentID, true)
+	require.NoError(t, err)
+	assert.False(t, admitted)
+
+	require.NoError(t, f.gormdb.UpdateSessionState(f.ctx, sdktypes.NewIDFromUUID[sdktypes.SessionID](s.SessionID), sdktypes.NewSessionStateCompleted(nil, nil, sdktypes.InvalidValue)))
+
+	es, err := f.gormdb.AdmitQueuedTriggerEvents(f.ctx, trigger)
+	require.NoError(t, err)
+	if assert.Len(t, es, 1) {
+		assert.Equal(t, seq, es[0].Seq)
+	}
+}
+
+func TestListActiveTriggerSessions(t *testing.T) {
+	f, p, b := preSessionTest(t)
+
+	c := f.newConnection(p)
+	f.createConnectionsAndAssert(t, c)
+
+	tr := f.newTrigger(p, c)
+	f.createTriggersAndAssert(t, tr)
+
+	s1 := f.newSession(sdktypes.SessionStateTypeRunning, p, b, tr)
+	s2 := f.newSession(sdktypes.SessionStateTypeCompleted, p, b, tr)
+	s3 := f.newSession(sdktypes.SessionStateTypeCreated, p, b, tr)
+	s4 := f.newSession(sdktypes.SessionStateTypeRunning, p, b) // no trigger.
+
+	f.createSessionsAndAssert(t, s1, s2, s3, s4)
+
+	sids, err := f.gormdb.ListActiveTriggerSessions(f.ctx, sdktypes.NewIDFromUUID[sdktypes.TriggerID](tr.TriggerID))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []sdktypes.SessionID{
+		sdktypes.NewIDFromUUID[sdktypes.SessionID](s1.SessionID),
+		sdktypes.NewIDFromUUID[sdktypes.SessionID](s3.SessionID),
+	}, sids)
+}
@@ -2,6 +2,7 @@ package dbgorm
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -33,7 +34,8 @@ func (gdb *gormdb) deleteTrigger(ctx context.Context, triggerID uuid.UUID) error
 }
 
 func (gdb *gormdb) updateTrigger(ctx context.Context, trigger *scheme.Trigger) error {
-	return gdb.writer.WithContext(ctx).Model(&scheme.Trigger{TriggerID: trigger.TriggerID}).Updates(trigger).Error
+	// All fields are written, otherwise zero values such as a removed route are skipped.
+	return gdb.writer.WithContext(ctx).Model(&scheme.Trigger{TriggerID: trigger.TriggerID}).Select("*").Updates(trigger).Error
 }
 
 func (gdb *gormdb) getTriggerByID(ctx context.Context, triggerID uuid.UUID) (*scheme.Trigger, error) {
@@ -79,30 +81,46 @@ func (db *gormdb) CreateTrigger(ctx context.Context, trigger sdktypes.Trigger) e
 
 	isDurable := trigger.IsDurable()
 	isSync := trigger.IsSync()
+	timeout := trigger.Timeout()
+	onStop := trigger.OnStop().CanonicalString()
 
 	t := &scheme.Trigger{
-		Base:         based(ctx),
-		ProjectID:    trigger.ProjectID().UUIDValue(),
-		TriggerID:    trigger.ID().UUIDValue(),
-		ConnectionID: trigger.ConnectionID().UUIDValuePtr(),
-		SourceType:   trigger.SourceType().String(),
-		EventType:    trigger.EventType(),
-		Filter:       trigger.Filter(),
-		CodeLocation: trigger.CodeLocation().CanonicalString(),
-		Name:         trigger.Name().String(),
-		UniqueName:   uniqueName,
-		WebhookSlug:  trigger.WebhookSlug(),
-		Timezone:     trigger.Timezone(),
-		Schedule:     trigger.Schedule(),
-		IsDurable:    &isDurable,
-		IsSync:       &isSync,
+		Base:               based(ctx),
+		ProjectID:          trigger.ProjectID().UUIDValue(),
+		TriggerID:          trigger.ID().UUIDValue(),
+		ConnectionID:       trigger.ConnectionID().UUIDValuePtr(),
+		SourceType:         trigger.SourceType().String(),
+		EventType:          trigger.EventType(),
+		Filter:             trigger.Filter(),
+		CodeLocation:       trigger.CodeLocation().CanonicalString(),
+		Name:               trigger.Name().String(),
+		UniqueName:         uniqueName,
+		WebhookSlug:        trigger.WebhookSlug(),
+		WebhookRoute:       trigger.WebhookRoute().String(),
+		Timezone:           trigger.Timezone(),
+		Schedule:           trigger.Schedule(),
+		IsDurable:          &isDurable,
+		IsSync:             &isSync,
+		Concurrency:        kittehs.Must1(json.Marshal(trigger.Concurrency())),
+		Batch:              kittehs.Must1(json.Marshal(trigger.Batch())),
+		Retry:              kittehs.Must1(json.Marshal(trigger.Retry())),
+		Timeout:            &timeout,
+		OnStop:             &onStop,
+		WebhookAuth:        kittehs.Must1(json.Marshal(trigger.WebhookAuth())),
+		WebhookIdempotency: kittehs.Must1(json.Marshal(trigger.WebhookIdempotency())),
 	}
 
 	return translateError(db.createTrigger(ctx, t))
 }
 
 func (db *gormdb) DeleteTrigger(ctx context.Context, id sdktypes.TriggerID) error {
-	return translateError(db.deleteTrigger(ctx, id.UUIDValue()))
+	return translateError(db.writeTransaction(ctx, func(tx *gormdb) error {
+		if err := tx.deleteTriggerQueue(ctx, id.UUIDValue()); err != nil {
+			return err
+		}
+
+		return tx.deleteTrigger(ctx, id.UUIDValue())
+	}))
 }
 
 func (db *gormdb) UpdateTrigger(ctx context.Context, trigger sdktypes.Trigger) error {
@@ -114,14 +132,18 @@ func (db *gormdb) UpdateTrigger(ctx context.Context, trigger sdktypes.Trigger) e
 	// We're not checking anything else here and modifying only the following fields.
 	// This means that there'll be no error if an unmodifyable field is requested
 	// to be changed by the caller, and it will not be modified in the DB.
+	// The webhook slug is modified only if set.
 
 	isDurable := trigger.IsDurable()
 	isSync := trigger.IsSync()
+	timeout := trigger.Timeout()
+	onStop := trigger.OnStop().CanonicalString()
 
 	r.CodeLocation = trigger.CodeLocation().CanonicalString()
 	r.EventType = trigger.EventType()
 	r.Filter = trigger.Filter()
 	r.Schedule = trigger.Schedule()
+	r.WebhookRoute = trigger.WebhookRoute().String()
 	r.Timezone = trigger.Timezone()
 	r.Name = trigger.Name().String()
 	r.UniqueName = triggerUniqueName(r.ProjectID.String(), trigger.Name())
@@ -129,6 +151,17 @@ func (db *gormdb) UpdateTrigger(ctx context.Context, trigger sdktypes.Trigger) e
 	r.UpdatedBy = authcontext.GetAuthnUserID(ctx).UUIDValue()
 	r.IsSync = &isSync
 	r.IsDurable = &isDurable
+	r.Concurrency = kittehs.Must1(json.Marshal(trigger.Concurrency()))
+	r.Batch = kittehs.Must1(json.Marshal(trigger.Batch()))
+	r.Retry = kittehs.Must1(json.Marshal(trigger.Retry()))
+	r.Timeout = &timeout
+	r.OnStop = &onStop
+	r.WebhookAuth = kittehs.Must1(json.Marshal(trigger.WebhookAuth()))
+	r.WebhookIdempotency = kittehs.Must1(json.Marshal(trigger.WebhookIdempotency()))
+
+	if slug := trigger.WebhookSlug(); slug != "" {
+		r.WebhookSlug = slug
+	}
 
 	return translateError(db.updateTrigger(ctx, r))
 }
@@ -174,18 +207,18 @@ func (db *gormdb) ListTriggers(ctx context.Context, filter sdkservices.ListTrigg
 	return kittehs.TransformError(ts, scheme.ParseTrigger)
 }
 
-func (db *gormdb) GetTriggerWithActiveDeploymentByWebhookSlug(ctx context.Context, slug string) (sdktypes.Trigger, error) {
-	var trigger scheme.Trigger
+func (db *gormdb) ListTriggersWithActiveDeploymentByWebhookSlug(ctx context.Context, slug string) ([]sdktypes.Trigger, error) {
+	var ts []scheme.Trigger
 	err := db.reader.WithContext(ctx).
 		Model(&scheme.Trigger{}).
-		Joins("JOIN deployments ON triggers.project_id = deployments.project_id").
-		Where("triggers.webhook_slug = ? AND deployments.state = ? AND deployments.deleted_at IS NULL",
-			slug,
+		Where("triggers.webhook_slug = ?", slug).
+		Where("EXISTS (SELECT 1 FROM deployments WHERE deployments.project_id = triggers.project_id AND deployments.state = ? AND deployments.deleted_at IS NULL)",
 			int32(sdktypes.DeploymentStateActive.ToProto())).
-		First(&trigger).Error
+		Order("triggers.created_at").
+		Find(&ts).Error
 	if err != nil {
-		return sdktypes.InvalidTrigger, translateError(err)
+		return nil, translateError(err)
 	}
 
-	return scheme.ParseTrigger(trigger)
+	return kittehs.TransformError(ts, scheme.ParseTrigger)
 }
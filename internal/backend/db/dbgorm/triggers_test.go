@@ -1,13 +1,16 @@
 package dbgorm
 
 import (
+	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 
 	"go.autokitteh.dev/autokitteh/internal/backend/db/dbgorm/scheme"
+	triggersv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/triggers/v1"
 	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
 	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
@@ -201,17 +204,20 @@ func TestGetTriggerWithActiveDeploymentByID(t *testing.T) {
 	assert.NotEqual(t, sdktypes.InvalidTrigger, trigger)
 }
 
-func TestGetTriggerWithActiveDeploymentByWebhookSlug(t *testing.T) {
+func TestListTriggersWithActiveDeploymentByWebhookSlug(t *testing.T) {
 	f := preTriggerTest(t)
 
 	p, c := f.createProjectConnection(t)
-	tr := f.newTrigger(p, c)
-	tr.WebhookSlug = "test-webhook"
-	f.createTriggersAndAssert(t, tr)
+	tr1, tr2, tr3 := f.newTrigger(p, c), f.newTrigger(p, c), f.newTrigger(p, c)
+	tr1.WebhookSlug, tr2.WebhookSlug, tr3.WebhookSlug = "test-webhook", "test-webhook", "other-webhook"
+	tr1.WebhookRoute, tr2.WebhookRoute = "GET /orders", "POST /orders/{id}"
+	tr1.CreatedAt, tr2.CreatedAt = now, now.Add(time.Second)
+	f.createTriggersAndAssert(t, tr1, tr2, tr3)
 
 	// test without active deployment
-	_, err := f.gormdb.GetTriggerWithActiveDeploymentByWebhookSlug(f.ctx, "test-webhook")
-	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	triggers, err := f.gormdb.ListTriggersWithActiveDeploymentByWebhookSlug(f.ctx, "test-webhook")
+	assert.NoError(t, err)
+	assert.Empty(t, triggers)
 
 	// create active deployment
 	b := f.newBuild(p)
@@ -221,9 +227,13 @@ func TestGetTriggerWithActiveDeploymentByWebhookSlug(t *testing.T) {
 	f.createDeploymentsAndAssert(t, d)
 
 	// test with active deployment
-	trigger, err := f.gormdb.GetTriggerWithActiveDeploymentByWebhookSlug(f.ctx, "test-webhook")
+	triggers, err = f.gormdb.ListTriggersWithActiveDeploymentByWebhookSlug(f.ctx, "test-webhook")
 	assert.NoError(t, err)
-	assert.NotEqual(t, sdktypes.InvalidTrigger, trigger)
+	if assert.Len(t, triggers, 2) {
+		assert.Equal(t, tr1.TriggerID, triggers[0].ID().UUIDValue())
+		assert.Equal(t, "GET /orders", triggers[0].WebhookRoute().String())
+		assert.Equal(t, tr2.TriggerID, triggers[1].ID().UUIDValue())
+	}
 }
 
 func TestCreateTriggerWithTimezone(t *testing.T) {
@@ -264,3 +274,183 @@ func TestUpdateTriggerTimezone(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "Europe/London", retrieved.Timezone)
 }
+
+func TestTriggerConcurrency(t *testing.T) {
+	f := preTriggerTest(t)
+
+	p, c := f.createProjectConnection(t)
+
+	pid := sdktypes.NewIDFromUUID[sdktypes.ProjectID](p.ProjectID)
+	cid := sdktypes.NewIDFromUUID[sdktypes.ConnectionID](c.ConnectionID)
+	tid := sdktypes.NewTriggerID()
+
+	tr := sdktypes.NewTrigger(sdktypes.NewSymbol("test")).WithProjectID(pid).WithID(tid).WithConnectionID(cid)
+	assert.NoError(t, f.gormdb.CreateTrigger(f.ctx, tr))
+
+	got, err := f.gormdb.GetTriggerByID(f.ctx, tid)
+	assert.NoError(t, err)
+	assert.False(t, got.Concurrency().IsValid())
+
+	policy := sdktypes.NewConcurrencyPolicy(2, sdktypes.ConcurrencyOverlapSkip)
+	assert.NoError(t, f.gormdb.UpdateTrigger(f.ctx, got.WithConcurrency(policy)))
+
+	got, err = f.gormdb.GetTriggerByID(f.ctx, tid)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, got.Concurrency().MaxConcurrent())
+	assert.Equal(t, sdktypes.ConcurrencyOverlapSkip, got.Concurrency().Overlap())
+
+	// Removing the policy must be persisted as well.
+	assert.NoError(t, f.gormdb.UpdateTrigger(f.ctx, got.WithConcurrency(sdktypes.InvalidConcurrencyPolicy)))
+
+	got, err = f.gormdb.GetTriggerByID(f.ctx, tid)
+	assert.NoError(t, err)
+	assert.False(t, got.Concurrency().IsValid())
+}
+
+func TestTriggerBatch(t *testing.T) {
+	f := preTriggerTest(t)
+
+	p, c := f.createProjectConnection(t)
+
+	pid := sdktypes.NewIDFromUUID[sdktypes.ProjectID](p.ProjectID)
+	cid := sdktypes.NewIDFromUUID[sdktypes.ConnectionID](c.ConnectionID)
+	tid := sdktypes.NewTriggerID()
+
+	batch, err := sdktypes.NewBatchPolicy("data.repo", 30*time.Second, 10)
+	assert.NoError(t, err)
+
+	tr := sdktypes.NewTrigger(sdktypes.NewSymbol("test")).WithProjectID(pid).WithID(tid).WithConnectionID(cid).WithBatch(batch)
+	assert.NoError(t, f.gormdb.CreateTrigger(f.ctx, tr))
+
+	got, err := f.gormdb.GetTriggerByID(f.ctx, tid)
+	assert.NoError(t, err)
+	assert.Equal(t, "data.repo", got.Batch().Key())
+	assert.Equal(t, 30*time.Second, got.Batch().Window())
+	assert.Equal(t, 10, got.Batch().MaxEvents())
+
+	_, err = sdktypes.NewBatchPolicy("", 0, 0)
+	assert.Error(t, err)
+}
+
+func TestTriggerRetry(t *testing.T) {
+	f := preTriggerTest(t)
+
+	p, c := f.createProjectConnection(t)
+
+	pid := sdktypes.NewIDFromUUID[sdktypes.ProjectID](p.ProjectID)
+	cid := sdktypes.NewIDFromUUID[sdktypes.ConnectionID](c.ConnectionID)
+	tid := sdktypes.NewTriggerID()
+
+	retry, err := sdktypes.NewRetryPolicy(3, 10*time.Second, 0, time.Minute, true)
+	assert.NoError(t, err)
+
+	tr := sdktypes.NewTrigger(sdktypes.NewSymbol("test")).WithProjectID(pid).WithID(tid).WithConnectionID(cid).WithRetry(retry)
+	assert.NoError(t, f.gormdb.CreateTrigger(f.ctx, tr))
+
+	got, err := f.gormdb.GetTriggerByID(f.ctx, tid)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, got.Retry().MaxAttempts())
+	assert.Equal(t, 10*time.Second, got.Retry().InitialInterval())
+	assert.Equal(t, 2.0, got.Retry().BackoffCoefficient())
+	assert.Equal(t, time.Minute, got.Retry().MaxInterval())
+	assert.True(t, got.Retry().RetryOnProgramError())
+
+	// Removing the policy.
+	assert.NoError(t, f.gormdb.UpdateTrigger(f.ctx, got.WithRetry(sdktypes.InvalidRetryPolicy)))
+
+	got, err = f.gormdb.GetTriggerByID(f.ctx, tid)
+	assert.NoError(t, err)
+	assert.False(t, got.Retry().IsValid())
+}
+
+func TestTriggerWebhookAuth(t *testing.T) {
+	f := preTriggerTest(t)
+
+	p, _ := f.createProjectConnection(t)
+
+	pid := sdktypes.NewIDFromUUID[sdktypes.ProjectID](p.ProjectID)
+	tid := sdktypes.NewTriggerID()
+
+	auth, err := sdktypes.WebhookAuthFromProto(&sdktypes.WebhookAuthPB{
+		Signature: &triggersv1.WebhookAuth_Signature{
+			Header:    "X-Hub-Signature-256",
+			SecretVar: "WEBHOOK_SECRET",
+			Format:    triggersv1.WebhookAuth_SIGNATURE_FORMAT_HEX,
+		},
+		AllowedCidrs: []string{"10.0.0.0/8"},
+	})
+	assert.NoError(t, err)
+
+	tr := sdktypes.NewTrigger(sdktypes.NewSymbol("test")).
+		WithProjectID(pid).
+		WithID(tid).
+		WithSourceType(sdktypes.TriggerSourceTypeWebhook).
+		WithWebhookAuth(auth)
+	assert.NoError(t, f.gormdb.CreateTrigger(f.ctx, tr))
+
+	got, err := f.gormdb.GetTriggerByID(f.ctx, tid)
+	assert.NoError(t, err)
+
+	header, secretVar, format, ok := got.WebhookAuth().Signature()
+	assert.True(t, ok)
+	assert.Equal(t, "X-Hub-Signature-256", header)
+	assert.Equal(t, "WEBHOOK_SECRET", secretVar.String())
+	assert.Equal(t, sdktypes.WebhookSignatureFormatHex, format)
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, got.WebhookAuth().AllowedCIDRs())
+
+	// Removing the authentication.
+	assert.NoError(t, f.gormdb.UpdateTrigger(f.ctx, got.WithWebhookAuth(sdktypes.InvalidWebhookAuth)))
+
+	got, err = f.gormdb.GetTriggerByID(f.ctx, tid)
+	assert.NoError(t, err)
+	assert.False(t, got.WebhookAuth().IsValid())
+}
+
+func TestTriggerTimeout(t *testing.T) {
+	f := preTriggerTest(t)
+
+	p, c := f.createProjectConnection(t)
+
+	pid := sdktypes.NewIDFromUUID[sdktypes.ProjectID](p.ProjectID)
+	cid := sdktypes.NewIDFromUUID[sdktypes.ConnectionID](c.ConnectionID)
+	tid := sdktypes.NewTriggerID()
+
+	tr := sdktypes.NewTrigger(sdktypes.NewSymbol("test")).WithProjectID(pid).WithID(tid).WithConnectionID(cid).WithTimeout(10 * time.Minute)
+	assert.NoError(t, f.gormdb.CreateTrigger(f.ctx, tr))
+
+	got, err := f.gormdb.GetTriggerByID(f.ctx, tid)
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, got.Timeout())
+
+	assert.NoError(t, f.gormdb.UpdateTrigger(f.ctx, got.WithTimeout(0)))
+
+	got, err = f.gormdb.GetTriggerByID(f.ctx, tid)
+	assert.NoError(t, err)
+	assert.Zero(t, got.Timeout())
+}
+
+func TestTriggerOnStop(t *testing.T) {
+	f := preTriggerTest(t)
+
+	p, c := f.createProjectConnection(t)
+
+	pid := sdktypes.NewIDFromUUID[sdktypes.ProjectID](p.ProjectID)
+	cid := sdktypes.NewIDFromUUID[sdktypes.ConnectionID](c.ConnectionID)
+	tid := sdktypes.NewTriggerID()
+
+	loc, err := sdktypes.ParseCodeLocation("main.py:cleanup")
+	assert.NoError(t, err)
+
+	tr := sdktypes.NewTrigger(sdktypes.NewSymbol("test")).WithProjectID(pid).WithID(tid).WithConnectionID(cid).WithOnStop(loc)
+	assert.NoError(t, f.gormdb.CreateTrigger(f.ctx, tr))
+
+	got, err := f.gormdb.GetTriggerByID(f.ctx, tid)
+	assert.NoError(t, err)
+	assert.Equal(t, loc.CanonicalString(), got.OnStop().CanonicalString())
+
+	assert.NoError(t, f.gormdb.UpdateTrigger(f.ctx, got.WithOnStop(sdktypes.CodeLocation{})))
+
+	got, err = f.gormdb.GetTriggerByID(f.ctx, tid)
+	assert.NoError(t, err)
+	assert.False(t, got.OnStop().IsValid())
+}
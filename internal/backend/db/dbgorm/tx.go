@@ -18,10 +18,11 @@ func (db *gormdb) writeTransaction(ctx context.Context, f func(tx *gormdb) error
 	return db.writer.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		return f(
 			&gormdb{
-				z:      db.z.With(zap.String("txid", uuid.NewString())),
-				writer: tx,
-				reader: tx,
-				cfg:    db.cfg,
+				z:        db.z.With(zap.String("txid", uuid.NewString())),
+				writer:   tx,
+				reader:   tx,
+				cfg:      db.cfg,
+				notifier: db.notifier,
 			},
 		)
 	})
@@ -31,10 +32,11 @@ func (db *gormdb) readTransaction(ctx context.Context, f func(tx *gormdb) error)
 	return db.reader.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		return f(
 			&gormdb{
-				z:      db.z.With(zap.String("txid", uuid.NewString())),
-				writer: nil, // panic on writes.
-				reader: tx,
-				cfg:    db.cfg,
+				z:        db.z.With(zap.String("txid", uuid.NewString())),
+				writer:   nil, // panic on writes.
+				reader:   tx,
+				cfg:      db.cfg,
+				notifier: db.notifier,
 			},
 		)
 	})
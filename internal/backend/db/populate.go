@@ -31,6 +31,8 @@ func Populate(ctx context.Context, db DB, objs ...sdktypes.Object) (err error) {
 			_, err = db.CreateOrg(ctx, obj)
 		case sdktypes.OrgMember:
 			err = db.AddOrgMember(ctx, obj)
+		case sdktypes.DeadLetter:
+			err = db.SaveDeadLetter(ctx, obj)
 		default:
 			err = sdkerrors.NewInvalidArgumentError("unsupported object type: %T", obj)
 		}
@@ -24,6 +24,14 @@ const (
 	listWaitingSignalsActivityName  = "list_waiting_signals"
 	removeSignalActivityName        = "remove_signal"
 	getTriggerActivityName          = "get_trigger"
+	saveDeadLetterActivityName      = "save_dead_letter"
+
+	listActiveTriggerSessionsActivityName = "list_active_trigger_sessions"
+	admitTriggerEventActivityName         = "admit_trigger_event"
+	checkTriggerQueueActivityName         = "check_trigger_queue"
+	dequeueTriggerEventActivityName       = "dequeue_trigger_event"
+	stopSessionActivityName               = "stop_session"
+	batchEventActivityName                = "batch_event"
 )
 
 func (d *Dispatcher) registerActivities(w worker.Worker) {
@@ -51,6 +59,41 @@ func (d *Dispatcher) registerActivities(w worker.Worker) {
 		d.getTriggerActivity,
 		activity.RegisterOptions{Name: getTriggerActivityName},
 	)
+
+	w.RegisterActivityWithOptions(
+		d.saveDeadLetterActivity,
+		activity.RegisterOptions{Name: saveDeadLetterActivityName},
+	)
+
+	w.RegisterActivityWithOptions(
+		d.listActiveTriggerSessionsActivity,
+		activity.RegisterOptions{Name: listActiveTriggerSessionsActivityName},
+	)
+
+	w.RegisterActivityWithOptions(
+		d.admitTriggerEventActivity,
+		activity.RegisterOptions{Name: admitTriggerEventActivityName},
+	)
+
+	w.RegisterActivityWithOptions(
+		d.checkTriggerQueueActivity,
+		activity.RegisterOptions{Name: checkTriggerQueueActivityName},
+	)
+
+	w.RegisterActivityWithOptions(
+		d.dequeueTriggerEventActivity,
+		activity.RegisterOptions{Name: dequeueTriggerEventActivityName},
+	)
+
+	w.RegisterActivityWithOptions(
+		d.stopSessionActivity,
+		activity.RegisterOptions{Name: stopSessionActivityName},
+	)
+
+	w.RegisterActivityWithOptions(
+		d.batchEventActivity,
+		activity.RegisterOptions{Name: batchEventActivityName},
+	)
 }
 
 type sessionData struct {
@@ -61,6 +104,38 @@ type sessionData struct {
 	OrgID        sdktypes.OrgID
 }
 
+type deadLetterInput struct {
+	Event        sdktypes.Event
+	Reason       sdktypes.DeadLetterReason
+	Error        string
+	TriggerID    sdktypes.TriggerID
+	DeploymentID sdktypes.DeploymentID
+}
+
+func (d *Dispatcher) saveDeadLetterActivity(ctx context.Context, in deadLetterInput) (sdktypes.DeadLetterID, error) {
+	ctx = authcontext.SetAuthnSystemUser(ctx)
+
+	dstid := in.Event.DestinationID()
+
+	pid, err := d.svcs.DB.GetProjectIDOf(ctx, dstid)
+	if err != nil {
+		return sdktypes.InvalidDeadLetterID, temporalclient.TranslateError(err, "get project id for %v", dstid)
+	}
+
+	tid := in.TriggerID
+	if !tid.IsValid() {
+		tid = dstid.ToTriggerID()
+	}
+
+	dl := sdktypes.NewDeadLetter(in.Event.ID(), pid, in.Reason).
+		WithError(in.Error).
+		WithTriggerID(tid).
+		WithDeploymentID(in.DeploymentID)
+
+	err = d.svcs.DB.SaveDeadLetter(ctx, dl)
+	return dl.ID(), temporalclient.TranslateError(err, "save dead letter for %v", in.Event.ID())
+}
+
 func (d *Dispatcher) getTriggerActivity(ctx context.Context, tid sdktypes.TriggerID) (sdktypes.Trigger, error) {
 	t, err := d.svcs.DB.GetTriggerByID(ctx, tid)
 	return t, temporalclient.TranslateError(err, "get trigger %v", tid)
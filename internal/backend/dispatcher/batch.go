@@ -0,0 +1,304 @@
+package dispatcher
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/fixtures"
+	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+const (
+	batchWorkflowName = "event_batch"
+	batchSignalName   = "event"
+)
+
+// Signaled by the batch workflow to the events workflows of the events in the batch.
+// An event might be batched by multiple triggers, each reporting its own session.
+func batchSessionSignalName(tid sdktypes.TriggerID) string { return "batch_session_" + tid.String() }
+
+type batchSessionResult struct {
+	SessionID sdktypes.SessionID // invalid if no session was started.
+	Error     string             // set if the batch workflow failed.
+}
+
+type batchWorkflowInput struct {
+	Key     string
+	Data    sessionData
+	Pending []sdktypes.Event // carried over from the previous run.
+}
+
+type batchEventInput struct {
+	Event sdktypes.Event
+	Key   string
+	Data  sessionData
+}
+
+// There is a single batch workflow per trigger, deployment and key.
+func batchWorkflowID(data sessionData, key string) string {
+	h := kittehs.Must1(kittehs.SHA256Hash(key))
+
+	return fmt.Sprintf("batch_%s_%s_%s", data.Trigger.ID().UUIDValue(), data.Deployment.ID().UUIDValue(), h[:16])
+}
+
+func (d *Dispatcher) batchEventActivity(ctx context.Context, in batchEventInput) error {
+	t := in.Data.Trigger
+
+	wid := batchWorkflowID(in.Data, in.Key)
+
+	memo := map[string]string{
+		"trigger_id":    t.ID().String(),
+		"deployment_id": in.Data.Deployment.ID().String(),
+		"batch_key":     in.Key,
+		"process_id":    fixtures.ProcessID(),
+	}
+
+	_, err := d.svcs.Temporal.TemporalClient().SignalWithStartWorkflow(
+		ctx,
+		wid,
+		batchSignalName,
+		in.Event,
+		d.cfg.Workflow.ToStartWorkflowOptions(taskQueueName, wid, fmt.Sprintf("batch %v", t.ID()), memo),
+		batchWorkflowName,
+		batchWorkflowInput{Key: in.Key, Data: in.Data},
+	)
+
+	return temporalclient.TranslateError(err, "signal batch %v with %v", wid, in.Event.ID())
+}
+
+// batchEvent hands the event over to the batch workflow of its key. Returns
+// false if the event was not batched. The reason is recorded as a dead letter.
+func (d *Dispatcher) batchEvent(wctx workflow.Context, event sdktypes.Event, sd sessionData) bool {
+	sl := d.sl.With("event_id", event.ID(), "deployment_id", sd.Deployment.ID(), "trigger_id", sd.Trigger.ID())
+
+	dl := deadLetterInput{
+		Event:        event,
+		TriggerID:    sd.Trigger.ID(),
+		DeploymentID: sd.Deployment.ID(),
+	}
+
+	key, err := event.Key(sd.Trigger.Batch().Key())
+	if err != nil {
+		sl.With("err", err).Infof("batch key error: %v", err)
+
+		dl.Reason, dl.Error = sdktypes.DeadLetterReasonSessionInitFailed, fmt.Sprintf("batch key: %v", err)
+		d.saveDeadLetter(wctx, dl)
+
+		return false
+	}
+
+	sl = sl.With("batch_key", key)
+
+	if err := workflow.ExecuteActivity(wctx, batchEventActivityName, batchEventInput{Event: event, Key: key, Data: sd}).Get(wctx, nil); err != nil {
+		sl.With("err", err).Errorf("could not batch %v: %v", event.ID(), err)
+
+		dl.Reason, dl.Error = sdktypes.DeadLetterReasonSessionStartFailed, err.Error()
+		d.saveDeadLetter(wctx, dl)
+
+		return false
+	}
+
+	sl.Infof("batched %v", event.ID())
+
+	return true
+}
+
+// waitForBatchSession waits for the batch workflow to report the session it
+// started for the batch the event is in. If the batch workflow failed, or did
+// not report in time, the event is recorded as a dead letter.
+func (d *Dispatcher) waitForBatchSession(wctx workflow.Context, event sdktypes.Event, sd sessionData) sdktypes.SessionID {
+	tid := sd.Trigger.ID()
+
+	sl := d.sl.With("event_id", event.ID(), "deployment_id", sd.Deployment.ID(), "trigger_id", tid)
+
+	var (
+		res      batchSessionResult
+		received bool
+		err      error
+	)
+
+	tctx, cancel := workflow.WithCancel(wctx)
+
+	workflow.NewSelector(wctx).
+		AddReceive(workflow.GetSignalChannel(wctx, batchSessionSignalName(tid)), func(c workflow.ReceiveChannel, _ bool) {
+			c.Receive(wctx, &res)
+			received = true
+		}).
+		AddFuture(workflow.NewTimer(tctx, sd.Trigger.Batch().Window()+d.cfg.BatchSessionTimeout), func(f workflow.Future) {
+			err = f.Get(wctx, nil)
+		}).
+		Select(wctx)
+
+	cancel()
+
+	if err != nil {
+		// Only if the workflow itself is canceled.
+		sl.With("err", err).Errorf("batch session wait for %v interrupted: %v", tid, err)
+		return sdktypes.InvalidSessionID
+	}
+
+	dl := deadLetterInput{
+		Event:        event,
+		Reason:       sdktypes.DeadLetterReasonSessionStartFailed,
+		TriggerID:    tid,
+		DeploymentID: sd.Deployment.ID(),
+	}
+
+	switch {
+	case !received:
+		sl.Errorf("timed out waiting for batch session of %v", event.ID())
+
+		dl.Error = "timed out waiting for batch session"
+		d.saveDeadLetter(wctx, dl)
+
+		return sdktypes.InvalidSessionID
+	case res.Error != "":
+		sl.Errorf("batch of %v failed: %s", event.ID(), res.Error)
+
+		dl.Error = fmt.Sprintf("batch: %s", res.Error)
+		d.saveDeadLetter(wctx, dl)
+
+		return sdktypes.InvalidSessionID
+	}
+
+	return res.SessionID
+}
+
+// notifyBatchSession reports the outcome of the batch to the events workflows
+// of all events in it, so each event is attributed the session.
+func (d *Dispatcher) notifyBatchSession(wctx workflow.Context, sl *zap.SugaredLogger, tid sdktypes.TriggerID, batch []sdktypes.Event, res batchSessionResult) {
+	fs := kittehs.Transform(batch, func(e sdktypes.Event) workflow.Future {
+		return workflow.SignalExternalWorkflow(wctx, e.ID().String(), "", batchSessionSignalName(tid), res)
+	})
+
+	for i, f := range fs {
+		// The events workflow might be gone already, in which case no one is waiting.
+		if err := f.Get(wctx, nil); err != nil {
+			sl.With("err", err, "event_id", batch[i].ID()).Warnf("could not notify events workflow of %v: %v", batch[i].ID(), err)
+		}
+	}
+}
+
+// batchWorkflow collects events until the batch window passes or the maximum
+// number of events is reached, and then starts a single session for all of them.
+// The batch policy in effect is the one the trigger had when the batch started.
+func (d *Dispatcher) batchWorkflow(wctx workflow.Context, in batchWorkflowInput) (err error) {
+	sd := in.Data
+	policy := sd.Trigger.Batch()
+	tid := sd.Trigger.ID()
+
+	sl := d.sl.With("trigger_id", tid, "deployment_id", sd.Deployment.ID(), "batch_key", in.Key)
+
+	wctx = temporalclient.WithActivityOptions(wctx, taskQueueName, d.cfg.Activity)
+
+	ch := workflow.GetSignalChannel(wctx, batchSignalName)
+
+	receive := func(events []sdktypes.Event) []sdktypes.Event {
+		var e sdktypes.Event
+		ch.Receive(wctx, &e)
+		return append(events, e)
+	}
+
+	events := in.Pending
+
+	// On failure, the events workflows are notified so they do not wait in vain.
+	notified := false
+
+	defer func() {
+		if err == nil || notified {
+			return
+		}
+
+		for {
+			var e sdktypes.Event
+			if !ch.ReceiveAsync(&e) {
+				break
+			}
+
+			events = append(events, e)
+		}
+
+		// Still notify if the workflow is canceled.
+		dctx, _ := workflow.NewDisconnectedContext(wctx)
+
+		d.notifyBatchSession(dctx, sl, tid, events, batchSessionResult{Error: err.Error()})
+	}()
+
+	if len(events) == 0 {
+		events = receive(events)
+	}
+
+	full := func() bool { n := policy.MaxEvents(); return n > 0 && len(events) >= n }
+
+	if !full() {
+		sel := workflow.NewSelector(wctx)
+
+		sel.AddReceive(ch, func(workflow.ReceiveChannel, bool) { events = receive(events) })
+
+		expired := false
+
+		if w := policy.Window(); w > 0 {
+			tctx, cancel := workflow.WithCancel(wctx)
+			defer cancel()
+
+			sel.AddFuture(workflow.NewTimer(tctx, w), func(workflow.Future) { expired = true })
+		}
+
+		for !expired && !full() {
+			sel.Select(wctx)
+		}
+	}
+
+	batch, rest := events, []sdktypes.Event(nil)
+	if n := policy.MaxEvents(); n > 0 && len(events) > n {
+		batch, rest = events[:n], events[n:]
+	}
+
+	list, err := sdktypes.NewListValue(kittehs.Transform(batch, func(e sdktypes.Event) sdktypes.Value {
+		return sdktypes.NewDictValueFromStringMap(e.ToValues())
+	}))
+	if err != nil {
+		return fmt.Errorf("batch events: %w", err)
+	}
+
+	inputs := map[string]sdktypes.Value{
+		"events": list,
+		"key":    sdktypes.NewStringValue(in.Key),
+	}
+
+	// The session is created for the latest event in the batch, and is
+	// then reported to the events workflows of all events in the batch.
+	last := batch[len(batch)-1]
+
+	sl.Infof("batch of %d events closed", len(batch))
+
+	sid := d.startSession(wctx, last, inputs, sd)
+	if sid.IsValid() {
+		sl.With("session_id", sid).Infof("started session %v for batch of %d events", sid, len(batch))
+	}
+
+	d.notifyBatchSession(wctx, sl, tid, batch, batchSessionResult{SessionID: sid})
+
+	notified = true
+
+	// Events that arrived meanwhile belong to the next batch.
+	for {
+		var e sdktypes.Event
+		if !ch.ReceiveAsync(&e) {
+			break
+		}
+
+		rest = append(rest, e)
+	}
+
+	if len(rest) == 0 {
+		return nil
+	}
+
+	return workflow.NewContinueAsNewError(wctx, batchWorkflowName, batchWorkflowInput{Key: in.Key, Data: sd, Pending: rest})
+}
@@ -0,0 +1,173 @@
+package dispatcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func TestBatchWorkflow(t *testing.T) {
+	policy, err := sdktypes.NewBatchPolicy("", time.Minute, 3)
+	require.NoError(t, err)
+
+	sd := sessionData{
+		Deployment:   sdktypes.NewDeployment(sdktypes.NewDeploymentID(), sdktypes.NewProjectID(), sdktypes.NewBuildID()),
+		CodeLocation: kittehs.Must1(sdktypes.ParseCodeLocation("main.py:on_event")),
+		Trigger:      sdktypes.NewTrigger(sdktypes.NewSymbol("t")).WithNewID().WithBatch(policy),
+	}
+
+	events := []sdktypes.Event{
+		sdktypes.NewEvent(sd.Trigger.ID()),
+		sdktypes.NewEvent(sd.Trigger.ID()),
+	}
+
+	sid := sdktypes.NewSessionID()
+
+	var started []sdktypes.Session
+
+	d := &Dispatcher{sl: zap.NewNop().Sugar(), cfg: &Config{}}
+
+	var suite testsuite.WorkflowTestSuite
+
+	env := suite.NewTestWorkflowEnvironment()
+
+	env.RegisterActivityWithOptions(
+		func(_ context.Context, s sdktypes.Session) (sdktypes.SessionID, error) {
+			started = append(started, s)
+			return sid, nil
+		},
+		activity.RegisterOptions{Name: startSessionActivityName},
+	)
+
+	// Every event in the batch is attributed the session.
+	for _, e := range events {
+		env.OnSignalExternalWorkflow("default-test-namespace", e.ID().String(), "", batchSessionSignalName(sd.Trigger.ID()), batchSessionResult{SessionID: sid}).Return(nil).Once()
+	}
+
+	for _, e := range events {
+		env.RegisterDelayedCallback(func() { env.SignalWorkflow(batchSignalName, e) }, time.Second)
+	}
+
+	env.ExecuteWorkflow(d.batchWorkflow, batchWorkflowInput{Key: "k", Data: sd})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	env.AssertExpectations(t)
+
+	if assert.Len(t, started, 1) {
+		s := started[0]
+
+		assert.Equal(t, events[1].ID(), s.EventID())
+
+		batch := s.Inputs()["events"]
+		if assert.True(t, batch.IsList()) {
+			assert.Len(t, batch.GetList().Values(), len(events))
+		}
+	}
+}
+
+func TestWaitForBatchSession(t *testing.T) {
+	policy, err := sdktypes.NewBatchPolicy("", time.Minute, 3)
+	require.NoError(t, err)
+
+	newSessionData := func() sessionData {
+		return sessionData{
+			Deployment: sdktypes.NewDeployment(sdktypes.NewDeploymentID(), sdktypes.NewProjectID(), sdktypes.NewBuildID()),
+			Trigger:    sdktypes.NewTrigger(sdktypes.NewSymbol("t")).WithNewID().WithBatch(policy),
+		}
+	}
+
+	sd, other := newSessionData(), newSessionData()
+
+	event := sdktypes.NewEvent(sd.Trigger.ID())
+
+	sid := sdktypes.NewSessionID()
+
+	tests := []struct {
+		name    string
+		signals map[string]batchSessionResult
+		sid     sdktypes.SessionID
+		dl      string // expected dead letter error, if any.
+	}{
+		{
+			name: "session",
+			signals: map[string]batchSessionResult{
+				batchSessionSignalName(other.Trigger.ID()): {SessionID: sdktypes.NewSessionID()},
+				batchSessionSignalName(sd.Trigger.ID()):    {SessionID: sid},
+			},
+			sid: sid,
+		},
+		{
+			name: "failed",
+			signals: map[string]batchSessionResult{
+				batchSessionSignalName(sd.Trigger.ID()): {Error: "meow"},
+			},
+			dl: "batch: meow",
+		},
+		{
+			name: "timeout",
+			signals: map[string]batchSessionResult{
+				batchSessionSignalName(other.Trigger.ID()): {SessionID: sdktypes.NewSessionID()},
+			},
+			dl: "timed out waiting for batch session",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := &Dispatcher{sl: zap.NewNop().Sugar(), cfg: &Config{BatchSessionTimeout: time.Minute}}
+
+			var dls []deadLetterInput
+
+			var suite testsuite.WorkflowTestSuite
+
+			env := suite.NewTestWorkflowEnvironment()
+
+			env.RegisterActivityWithOptions(
+				func(_ context.Context, in deadLetterInput) (sdktypes.DeadLetterID, error) {
+					dls = append(dls, in)
+					return sdktypes.NewDeadLetterID(), nil
+				},
+				activity.RegisterOptions{Name: saveDeadLetterActivityName},
+			)
+
+			for name, res := range test.signals {
+				env.RegisterDelayedCallback(func() { env.SignalWorkflow(name, res) }, time.Second)
+			}
+
+			env.ExecuteWorkflow(func(wctx workflow.Context) (sdktypes.SessionID, error) {
+				wctx = workflow.WithActivityOptions(wctx, workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+				return d.waitForBatchSession(wctx, event, sd), nil
+			})
+
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+
+			var got sdktypes.SessionID
+			require.NoError(t, env.GetWorkflowResult(&got))
+			assert.Equal(t, test.sid, got)
+
+			if test.dl == "" {
+				assert.Empty(t, dls)
+				return
+			}
+
+			if assert.Len(t, dls, 1) {
+				assert.Equal(t, test.dl, dls[0].Error)
+				assert.Equal(t, sdktypes.DeadLetterReasonSessionStartFailed, dls[0].Reason)
+				assert.Equal(t, sd.Trigger.ID(), dls[0].TriggerID)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/auth/authcontext"
+	"go.autokitteh.dev/autokitteh/internal/backend/db"
+	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
+	"go.autokitteh.dev/autokitteh/internal/backend/triggerqueue"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+type admitTriggerEventInput struct {
+	Trigger      sdktypes.Trigger
+	EventID      sdktypes.EventID
+	DeploymentID sdktypes.DeploymentID
+	WorkflowID   string
+	Wait         bool
+}
+
+type triggerQueueInput struct {
+	TriggerID sdktypes.TriggerID
+	Seq       uint64
+}
+
+type triggerQueueState struct {
+	Seq      uint64 // zero if the event was neither admitted nor queued.
+	Admitted bool
+	Gone     bool // the queued event was removed, ie the trigger was deleted.
+}
+
+type stopSessionInput struct {
+	SessionID sdktypes.SessionID
+	Reason    string
+}
+
+func (d *Dispatcher) listActiveTriggerSessionsActivity(ctx context.Context, tid sdktypes.TriggerID) ([]sdktypes.SessionID, error) {
+	sids, err := d.svcs.DB.ListActiveTriggerSessions(ctx, tid)
+	return sids, temporalclient.TranslateError(err, "list active sessions for %v", tid)
+}
+
+func (d *Dispatcher) admitTriggerEventActivity(ctx context.Context, in admitTriggerEventInput) (*triggerQueueState, error) {
+	e := db.TriggerQueueEntry{EventID: in.EventID, WorkflowID: in.WorkflowID}
+
+	seq, admitted, err := d.svcs.DB.AdmitTriggerEvent(ctx, in.Trigger, e, in.DeploymentID, in.Wait)
+	if err != nil {
+		return nil, temporalclient.TranslateError(err, "admit %v for %v", in.EventID, in.Trigger.ID())
+	}
+
+	return &triggerQueueState{Seq: seq, Admitted: admitted}, nil
+}
+
+// admitQueuedTriggerEvents admits queued events of the trigger, if it still exists.
+func (d *Dispatcher) admitQueuedTriggerEvents(ctx context.Context, tid sdktypes.TriggerID) error {
+	t, err := d.svcs.DB.GetTriggerByID(ctx, tid)
+	if err != nil {
+		if errors.Is(err, sdkerrors.ErrNotFound) {
+			// its queue was deleted with it.
+			return nil
+		}
+
+		return fmt.Errorf("get trigger %v: %w", tid, err)
+	}
+
+	return triggerqueue.AdmitQueuedEvents(ctx, d.svcs.DB, d.svcs.Temporal.TemporalClient(), t)
+}
+
+// checkTriggerQueueActivity admits queued events in case a slot was freed
+// without doing so, and returns the state of the given queued event.
+func (d *Dispatcher) checkTriggerQueueActivity(ctx context.Context, in triggerQueueInput) (*triggerQueueState, error) {
+	if err := d.admitQueuedTriggerEvents(ctx, in.TriggerID); err != nil {
+		return nil, temporalclient.TranslateError(err, "admit queued events")
+	}
+
+	state := triggerQueueState{Seq: in.Seq}
+
+	var err error
+	if state.Admitted, err = d.svcs.DB.IsTriggerEventAdmitted(ctx, in.Seq); errors.Is(err, sdkerrors.ErrNotFound) {
+		state.Gone, err = true, nil
+	}
+
+	return &state, temporalclient.TranslateError(err, "get queued event %d", in.Seq)
+}
+
+func (d *Dispatcher) dequeueTriggerEventActivity(ctx context.Context, in triggerQueueInput) error {
+	if err := d.svcs.DB.DequeueTriggerEvent(ctx, in.Seq); err != nil && !errors.Is(err, sdkerrors.ErrNotFound) {
+		// not found means it was already removed, ie the trigger was deleted.
+		return temporalclient.TranslateError(err, "dequeue %d", in.Seq)
+	}
+
+	// The slot is now either taken by the started session, or free if it did not start.
+	return temporalclient.TranslateError(d.admitQueuedTriggerEvents(ctx, in.TriggerID), "admit queued events")
+}
+
+func (d *Dispatcher) stopSessionActivity(ctx context.Context, in stopSessionInput) error {
+	err := d.svcs.Sessions.Stop(authcontext.SetAuthnSystemUser(ctx), in.SessionID, in.Reason, false, 0)
+	return temporalclient.TranslateError(err, "stop session %v", in.SessionID)
+}
+
+// acquireConcurrency enforces the trigger's concurrency policy before a
+// session is started for it. If ok is false, no session should be started
+// and the reason was already recorded. Otherwise, release must be called
+// once the session start was attempted.
+//
+// Events are admitted atomically in the DB: an admitted event takes a slot
+// until its session is created, which then takes the slot until it ends.
+// Queued events are admitted in order when slots are freed, and their
+// workflows are signaled. Previous sessions are stopped asynchronously,
+// so the limit might be briefly exceeded when canceling them.
+func (d *Dispatcher) acquireConcurrency(wctx workflow.Context, event sdktypes.Event, sd sessionData) (release func(), ok bool) {
+	release = func() {}
+
+	t := sd.Trigger
+	policy := t.Concurrency()
+
+	limit := policy.Limit()
+	if limit == 0 {
+		return release, true
+	}
+
+	eid, tid := event.ID(), t.ID()
+
+	sl := d.sl.With("event_id", eid, "trigger_id", tid, "limit", limit, "overlap", policy.Overlap())
+
+	if policy.Overlap() == sdktypes.ConcurrencyOverlapCancelPrevious {
+		d.cancelPrevious(wctx, sl, eid, tid, limit)
+		return release, true
+	}
+
+	queue := policy.Overlap() == sdktypes.ConcurrencyOverlapQueue
+
+	var state triggerQueueState
+	in := admitTriggerEventInput{
+		Trigger:      t,
+		EventID:      eid,
+		DeploymentID: sd.Deployment.ID(),
+		WorkflowID:   workflow.GetInfo(wctx).WorkflowExecution.ID,
+		Wait:         queue,
+	}
+	if err := workflow.ExecuteActivity(wctx, admitTriggerEventActivityName, in).Get(wctx, &state); err != nil {
+		// Better to run too many sessions than to lose the event.
+		sl.With("err", err).Errorf("could not check concurrency for %v, ignoring limit: %v", tid, err)
+		return release, true
+	}
+
+	dl := deadLetterInput{
+		Event:        event,
+		Reason:       sdktypes.DeadLetterReasonConcurrencyLimit,
+		TriggerID:    tid,
+		DeploymentID: sd.Deployment.ID(),
+	}
+
+	if !state.Admitted && !queue {
+		sl.Infof("%d sessions already running for %v, skipping %v", limit, tid, eid)
+
+		dl.Error = fmt.Sprintf("%d sessions already running", limit)
+		d.saveDeadLetter(wctx, dl)
+
+		return nil, false
+	}
+
+	sl = sl.With("seq", state.Seq)
+
+	release = func() {
+		if err := workflow.ExecuteActivity(wctx, dequeueTriggerEventActivityName, triggerQueueInput{TriggerID: tid, Seq: state.Seq}).Get(wctx, nil); err != nil {
+			sl.With("err", err).Errorf("could not dequeue %v from %v: %v", eid, tid, err)
+		}
+	}
+
+	if state.Admitted {
+		return release, true
+	}
+
+	sl.Infof("queued %v for %v", eid, tid)
+
+	admitted, err := d.waitForAdmission(wctx, sl, tid, state.Seq)
+	if err != nil {
+		// Only if the workflow itself is canceled.
+		sl.With("err", err).Errorf("queue wait for %v interrupted: %v", tid, err)
+		return nil, false
+	}
+
+	if !admitted {
+		sl.Infof("%v removed from queue of %v", eid, tid)
+
+		dl.Error = "removed from trigger queue"
+		d.saveDeadLetter(wctx, dl)
+
+		return nil, false
+	}
+
+	sl.Infof("%v admitted by queue of %v", eid, tid)
+
+	return release, true
+}
+
+func (d *Dispatcher) cancelPrevious(wctx workflow.Context, sl *zap.SugaredLogger, eid sdktypes.EventID, tid sdktypes.TriggerID, limit int) {
+	var active []sdktypes.SessionID
+	if err := workflow.ExecuteActivity(wctx, listActiveTriggerSessionsActivityName, tid).Get(wctx, &active); err != nil {
+		sl.With("err", err).Errorf("could not check concurrency for %v, ignoring limit: %v", tid, err)
+		return
+	}
+
+	n := max(len(active)-limit+1, 0)
+
+	for _, sid := range active[:n] {
+		sl := sl.With("session_id", sid)
+
+		in := stopSessionInput{
+			SessionID: sid,
+			Reason:    fmt.Sprintf("canceled by trigger %v concurrency policy for event %v", tid, eid),
+		}
+
+		if err := workflow.ExecuteActivity(wctx, stopSessionActivityName, in).Get(wctx, nil); err != nil {
+			sl.With("err", err).Errorf("could not stop previous session %v: %v", sid, err)
+			continue
+		}
+
+		sl.Infof("stopped previous session %v for %v", sid, eid)
+	}
+}
+
+// waitForAdmission waits until the queued event is admitted, which is signaled
+// by whoever admitted it. In case the signal was missed, ie a session workflow
+// was terminated before it could free its slot, it also checks periodically,
+// backing off up to the configured maximum interval to keep the history small.
+// Returns false if the event was removed from the queue.
+func (d *Dispatcher) waitForAdmission(wctx workflow.Context, sl *zap.SugaredLogger, tid sdktypes.TriggerID, seq uint64) (bool, error) {
+	signals := workflow.GetSignalChannel(wctx, triggerqueue.SignalName(seq))
+
+	interval := d.cfg.ConcurrencyQueuePollInterval
+
+	for {
+		var (
+			signaled bool
+			err      error
+		)
+
+		tctx, cancel := workflow.WithCancel(wctx)
+
+		workflow.NewSelector(wctx).
+			AddReceive(signals, func(c workflow.ReceiveChannel, _ bool) {
+				c.Receive(wctx, nil)
+				signaled = true
+			}).
+			AddFuture(workflow.NewTimer(tctx, interval), func(f workflow.Future) {
+				err = f.Get(wctx, nil)
+			}).
+			Select(wctx)
+
+		cancel()
+
+		if err != nil {
+			return false, err
+		}
+
+		for signals.ReceiveAsync(nil) {
+		}
+
+		if !signaled {
+			interval = min(interval*2, d.cfg.ConcurrencyQueueMaxPollInterval)
+		}
+
+		var state triggerQueueState
+		if err := workflow.ExecuteActivity(wctx, checkTriggerQueueActivityName, triggerQueueInput{TriggerID: tid, Seq: seq}).Get(wctx, &state); err != nil {
+			sl.With("err", err).Errorf("could not check queue of %v, will retry: %v", tid, err)
+			continue
+		}
+
+		if state.Gone || state.Admitted {
+			return state.Admitted, nil
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package dispatcher
 
 import (
+	"time"
+
 	"go.autokitteh.dev/autokitteh/internal/backend/configset"
 	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
 )
@@ -14,6 +16,19 @@ type Config struct {
 	Workflow            temporalclient.WorkflowConfig `koanf:"workflow"`
 	Activity            temporalclient.ActivityConfig `koanf:"activity"`
 	ExternalDispatching ExternalDispatchingConfig     `koanf:"external_dispatching"`
+
+	// Events waiting for a trigger's concurrency limit are signaled when they can start.
+	// In case a signal is missed, they also check at intervals that start at the poll
+	// interval and double up to the max poll interval.
+	ConcurrencyQueuePollInterval    time.Duration `koanf:"concurrency_queue_poll_interval"`
+	ConcurrencyQueueMaxPollInterval time.Duration `koanf:"concurrency_queue_max_poll_interval"`
+
+	// How long an event waits, beyond its trigger's batch window, for the session
+	// of its batch before it is recorded as a dead letter.
+	BatchSessionTimeout time.Duration `koanf:"batch_session_timeout"`
+
+	// How long an event's idempotency key prevents dispatching events with the same key. Zero disables.
+	IdempotencyKeyTTL time.Duration `koanf:"idempotency_key_ttl"`
 }
 
 var Configs = configset.Set[Config]{
@@ -21,5 +36,9 @@ var Configs = configset.Set[Config]{
 		ExternalDispatching: ExternalDispatchingConfig{
 			Enabled: false,
 		},
+		ConcurrencyQueuePollInterval:    5 * time.Second,
+		ConcurrencyQueueMaxPollInterval: 10 * time.Minute,
+		IdempotencyKeyTTL:               24 * time.Hour,
+		BatchSessionTimeout:             time.Hour,
 	},
 }
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
-	"time"
 
 	"go.temporal.io/sdk/workflow"
 	"go.uber.org/fx"
@@ -18,6 +17,7 @@ import (
 	"go.autokitteh.dev/autokitteh/internal/backend/externalclient"
 	"go.autokitteh.dev/autokitteh/internal/backend/fixtures"
 	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
 	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
 	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
@@ -26,6 +26,10 @@ import (
 const (
 	taskQueueName = "events"
 	workflowName  = "event"
+
+	// A save is retried after an expired idempotency key is released. Bounded
+	// in case the key keeps being contended.
+	maxSaveEventAttempts = 3
 )
 
 type Svcs struct {
@@ -74,7 +78,7 @@ func (d *Dispatcher) DispatchExternal(ctx context.Context, event sdktypes.Event,
 }
 
 func (d *Dispatcher) Dispatch(ctx context.Context, event sdktypes.Event, opts *sdkservices.DispatchOptions) (*sdkservices.DispatchResponse, error) {
-	event = event.WithCreatedAt(time.Now())
+	event = event.WithCreatedAt(kittehs.Now())
 
 	did := event.DestinationID()
 
@@ -119,10 +123,15 @@ func (d *Dispatcher) Dispatch(ctx context.Context, event sdktypes.Event, opts *s
 }
 
 func (d *Dispatcher) dispatchOneEvent(ctx context.Context, event sdktypes.Event, opts *sdkservices.DispatchOptions) (*sdkservices.DispatchResponse, error) {
-	eid, err := d.svcs.Events.Save(ctx, event)
+	eid, orig, err := d.saveEvent(ctx, event)
 	if err != nil {
-		return nil, fmt.Errorf("save event: %w", err)
+		return nil, err
+	}
+
+	if orig.IsValid() {
+		return d.dispatchDuplicate(ctx, orig, opts)
 	}
+
 	event = event.WithID(eid)
 
 	sl := d.sl.With("event_id", eid)
@@ -173,6 +182,83 @@ func (d *Dispatcher) dispatchOneEvent(ctx context.Context, event sdktypes.Event,
 	return &sdkservices.DispatchResponse{EventID: eid, StartedSessionIDs: out.Started, SignaledSessionIDs: out.Signaled}, nil
 }
 
+// saveEvent saves the event, unless it has an idempotency key that is held by an
+// earlier event to the same destination that was created within the TTL. In that
+// case nothing is saved and the earlier event is returned instead. Keys held by
+// events older than the TTL are released so the event can take them over.
+func (d *Dispatcher) saveEvent(ctx context.Context, event sdktypes.Event) (sdktypes.EventID, sdktypes.Event, error) {
+	did, key := event.DestinationID(), event.IdempotencyKey()
+
+	for range maxSaveEventAttempts {
+		eid, err := d.svcs.Events.Save(ctx, event)
+		if err == nil {
+			return eid, sdktypes.InvalidEvent, nil
+		}
+
+		if key == "" || !errors.Is(err, sdkerrors.ErrAlreadyExists) {
+			return sdktypes.InvalidEventID, sdktypes.InvalidEvent, fmt.Errorf("save event: %w", err)
+		}
+
+		// A zero TTL disables deduplication, so any holder of the key is released.
+		since := kittehs.Now().Add(-max(d.cfg.IdempotencyKeyTTL, 0))
+
+		orig, err := d.svcs.DB.GetEventByIdempotencyKey(ctx, did, key, since)
+		if err == nil {
+			return sdktypes.InvalidEventID, orig, nil
+		}
+
+		if !errors.Is(err, sdkerrors.ErrNotFound) {
+			return sdktypes.InvalidEventID, sdktypes.InvalidEvent, fmt.Errorf("get event by idempotency key: %w", err)
+		}
+
+		if err := d.svcs.DB.ReleaseEventIdempotencyKey(ctx, did, key, since); err != nil {
+			return sdktypes.InvalidEventID, sdktypes.InvalidEvent, fmt.Errorf("release idempotency key: %w", err)
+		}
+	}
+
+	return sdktypes.InvalidEventID, sdktypes.InvalidEvent, fmt.Errorf("%w: idempotency key %q is contended", sdkerrors.ErrConflict, key)
+}
+
+// dispatchDuplicate returns the response for orig, an earlier event to the same destination
+// with the same idempotency key. If the caller waits, it waits for the earlier dispatch to complete.
+func (d *Dispatcher) dispatchDuplicate(ctx context.Context, orig sdktypes.Event, opts *sdkservices.DispatchOptions) (*sdkservices.DispatchResponse, error) {
+	key := orig.IdempotencyKey()
+
+	eid := orig.ID()
+
+	if err := authz.CheckContext(ctx, eid, authz.OpDispatch, authz.WithData("event", orig), authz.WithData("opts", opts)); err != nil {
+		return nil, err
+	}
+
+	sl := d.sl.With("event_id", eid, "idempotency_key", key)
+
+	sl.Infof("duplicate of event %v, not dispatching again", eid)
+
+	resp := sdkservices.DispatchResponse{EventID: eid, Duplicate: true}
+
+	if opts == nil || !opts.Wait {
+		return &resp, nil
+	}
+
+	var out eventsWorkflowOutput
+	if err := d.svcs.Temporal.TemporalClient().GetWorkflow(ctx, eid.String(), "").Get(ctx, &out); err == nil {
+		resp.StartedSessionIDs, resp.SignaledSessionIDs = out.Started, out.Signaled
+		return &resp, nil
+	} else {
+		// The workflow might have already been removed from temporal due to retention.
+		sl.With("err", err).Warnf("get dispatch workflow for %v: %v", eid, err)
+	}
+
+	sessions, err := d.svcs.DB.ListSessions(ctx, sdkservices.ListSessionsFilter{EventID: eid})
+	if err != nil {
+		return nil, fmt.Errorf("list sessions for event %v: %w", eid, err)
+	}
+
+	resp.StartedSessionIDs = kittehs.Transform(sessions.Sessions, sdktypes.Session.ID)
+
+	return &resp, nil
+}
+
 func (d *Dispatcher) Redispatch(ctx context.Context, eventID sdktypes.EventID, opts *sdkservices.DispatchOptions) (*sdkservices.DispatchResponse, error) {
 	sl := d.sl.With("event_id", eventID)
 
@@ -194,7 +280,7 @@ func (d *Dispatcher) Redispatch(ctx context.Context, eventID sdktypes.EventID, o
 		memo = make(map[string]string)
 	}
 	memo["redispatch_of"] = eventID.String()
-	event = event.WithMemo(memo)
+	event = event.WithMemo(memo).WithIdempotencyKey("") // explicitly requested, so never a duplicate.
 
 	resp, err := d.Dispatch(authcontext.SetAuthnSystemUser(ctx), event, opts)
 	if err != nil {
@@ -207,6 +293,101 @@ func (d *Dispatcher) Redispatch(ctx context.Context, eventID sdktypes.EventID, o
 	return resp, err
 }
 
+func (d *Dispatcher) ListDeadLetters(ctx context.Context, filter sdkservices.ListDeadLettersFilter) ([]sdktypes.DeadLetter, error) {
+	if !filter.AnyIDSpecified() {
+		filter.OrgID = authcontext.GetAuthnInferredOrgID(ctx)
+	}
+
+	if err := authz.CheckContext(
+		ctx,
+		sdktypes.InvalidEventID,
+		authz.OpListDeadLetters,
+		authz.WithData("filter", filter),
+		authz.WithAssociationWithID("project", filter.ProjectID),
+		authz.WithAssociationWithID("org", filter.OrgID),
+		authz.WithAssociationWithID("event", filter.EventID),
+	); err != nil {
+		return nil, err
+	}
+
+	return d.svcs.DB.ListDeadLetters(ctx, filter)
+}
+
+func (d *Dispatcher) RetryDeadLetter(ctx context.Context, id sdktypes.DeadLetterID, opts *sdkservices.DispatchOptions) (*sdkservices.DispatchResponse, error) {
+	sl := d.sl.With("dead_letter_id", id)
+
+	// Checked before anything is read, so unauthorized callers cannot tell if it exists.
+	if err := authz.CheckContext(ctx, id, authz.OpRetryDeadLetter, authz.WithData("opts", opts)); err != nil {
+		return nil, err
+	}
+
+	dl, err := d.svcs.DB.GetDeadLetter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	eid := dl.EventID()
+
+	event, err := d.svcs.Events.Get(ctx, eid)
+	if err != nil {
+		return nil, err
+	}
+
+	if !event.IsValid() {
+		return nil, sdkerrors.ErrNotFound
+	}
+
+	// Only retry the trigger that failed, not every trigger of the original destination.
+	if tid := dl.TriggerID(); tid.IsValid() {
+		event = event.WithTriggerDestinationID(tid)
+	}
+
+	memo := event.Memo()
+	if memo == nil {
+		memo = make(map[string]string)
+	}
+	memo["redispatch_of"] = eid.String()
+	memo["dead_letter_id"] = id.String()
+	event = event.WithMemo(memo).WithIdempotencyKey("") // explicitly requested, so never a duplicate.
+
+	var dopts sdkservices.DispatchOptions
+	if opts != nil {
+		dopts = *opts
+	}
+
+	if !dopts.DeploymentID.IsValid() {
+		dopts.DeploymentID = dl.DeploymentID()
+	}
+
+	// Concurrent retries of the same dead letter race here, and only one gets to dispatch.
+	if err := d.svcs.DB.ClaimDeadLetterRetry(ctx, id); err != nil {
+		if errors.Is(err, sdkerrors.ErrFailedPrecondition) {
+			return nil, fmt.Errorf("%w: dead letter %v was already retried", sdkerrors.ErrFailedPrecondition, id)
+		}
+
+		return nil, fmt.Errorf("mark dead letter %v as retried: %w", id, err)
+	}
+
+	resp, err := d.Dispatch(authcontext.SetAuthnSystemUser(ctx), event, &dopts)
+	if err != nil {
+		sl.With("err", err).Errorf("failed retrying dead letter %v: %v", id, err)
+
+		if err := d.svcs.DB.ReleaseDeadLetterRetry(ctx, id); err != nil {
+			sl.With("err", err).Errorf("failed unmarking dead letter %v as retried: %v", id, err)
+		}
+
+		return nil, fmt.Errorf("failed retrying dead letter %v: %w", id, err)
+	}
+
+	if err := d.svcs.DB.SetDeadLetterRetried(ctx, id, resp.EventID); err != nil {
+		return nil, fmt.Errorf("set retry event of dead letter %v: %w", id, err)
+	}
+
+	sl.With("response", resp).Infof("retried dead letter %v as %v", id, resp.EventID)
+
+	return resp, nil
+}
+
 func (d *Dispatcher) Start(context.Context) error {
 	w := temporalclient.NewWorker(d.sl.Desugar(), d.svcs.Temporal.TemporalClient(), taskQueueName, d.cfg.Worker)
 	if w == nil {
@@ -218,6 +399,11 @@ func (d *Dispatcher) Start(context.Context) error {
 		workflow.RegisterOptions{Name: workflowName},
 	)
 
+	w.RegisterWorkflowWithOptions(
+		d.batchWorkflow,
+		workflow.RegisterOptions{Name: batchWorkflowName},
+	)
+
 	d.registerActivities(w)
 
 	if err := w.Start(); err != nil {
@@ -12,6 +12,7 @@ import (
 
 	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
 	"go.autokitteh.dev/autokitteh/internal/backend/types"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
 	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
 	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
@@ -27,43 +28,107 @@ type eventsWorkflowOutput struct {
 	Started, Signaled []sdktypes.SessionID
 }
 
+// Destinations used to be started one after the other. Workflows that were
+// running before that changed must keep doing so on replay.
+const parallelStartSessionsChangeID = "parallel_start_sessions"
+
 func (d *Dispatcher) startSessions(wctx workflow.Context, event sdktypes.Event, sds []sessionData) ([]sdktypes.SessionID, error) {
-	eid := event.ID()
+	sids := make([]sdktypes.SessionID, len(sds))
 
-	sl := d.sl.With("event_id", eid)
+	start := func(wctx workflow.Context, i int, sd sessionData) {
+		if sd.Trigger.Batch().IsValid() {
+			// The session will be started by the batch workflow.
+			if d.batchEvent(wctx, event, sd) {
+				sids[i] = d.waitForBatchSession(wctx, event, sd)
+			}
 
-	var started []sdktypes.SessionID
+			return
+		}
 
-	for _, sd := range sds {
-		sl := sl.With("deployment_id", sd.Deployment.ID(), "trigger_id", sd.Trigger.ID(), "entrypoint", sd.CodeLocation)
+		sids[i] = d.startSession(wctx, event, event.Data(), sd)
+	}
 
-		session, err := newSession(event, event.Data(), sd)
-		if err != nil {
-			sl.With("err", err).Errorf("could not initialize session: %v", err)
-			continue
+	if workflow.GetVersion(wctx, parallelStartSessionsChangeID, workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+		for i, sd := range sds {
+			start(wctx, i, sd)
 		}
 
-		var sid sdktypes.SessionID
+		return kittehs.Filter(sids, sdktypes.SessionID.IsValid), nil
+	}
 
-		if err := workflow.ExecuteActivity(wctx, startSessionActivityName, session).Get(wctx, &sid); err != nil {
-			sl := sl.With("err", err)
+	// Each destination is started independently, so an event waiting in one
+	// trigger's concurrency queue does not hold back the others.
+	wg := workflow.NewWaitGroup(wctx)
 
-			var aerr *temporal.ApplicationError
-			if errors.As(err, &aerr) && aerr.Type() == sdkerrors.ErrorType(sdkerrors.ErrResourceExhausted) {
-				sl.Infof("resources exhausted: %v", err)
-			} else {
-				sl.Errorf("session activity: %v", err)
-			}
+	for i, sd := range sds {
+		wg.Add(1)
 
-			continue
+		workflow.Go(wctx, func(wctx workflow.Context) {
+			defer wg.Done()
+			start(wctx, i, sd)
+		})
+	}
+
+	wg.Wait(wctx)
+
+	return kittehs.Filter(sids, sdktypes.SessionID.IsValid), nil
+}
+
+// startSession returns InvalidSessionID if no session was started. The reason
+// is recorded as a dead letter.
+func (d *Dispatcher) startSession(wctx workflow.Context, event sdktypes.Event, inputs map[string]sdktypes.Value, sd sessionData) sdktypes.SessionID {
+	eid := event.ID()
+
+	sl := d.sl.With("event_id", eid, "deployment_id", sd.Deployment.ID(), "trigger_id", sd.Trigger.ID(), "entrypoint", sd.CodeLocation)
+
+	dl := deadLetterInput{
+		Event:        event,
+		TriggerID:    sd.Trigger.ID(),
+		DeploymentID: sd.Deployment.ID(),
+	}
+
+	session, err := newSession(event, inputs, sd)
+	if err != nil {
+		sl.With("err", err).Errorf("could not initialize session: %v", err)
+
+		dl.Reason, dl.Error = sdktypes.DeadLetterReasonSessionInitFailed, err.Error()
+		d.saveDeadLetter(wctx, dl)
+
+		return sdktypes.InvalidSessionID
+	}
+
+	release, ok := d.acquireConcurrency(wctx, event, sd)
+	if !ok {
+		return sdktypes.InvalidSessionID
+	}
+
+	// The event releases its slot only once its session is in the DB,
+	// where the session takes the slot over.
+	defer release()
+
+	var sid sdktypes.SessionID
+
+	if err := workflow.ExecuteActivity(wctx, startSessionActivityName, session).Get(wctx, &sid); err != nil {
+		sl := sl.With("err", err)
+
+		dl.Reason, dl.Error = sdktypes.DeadLetterReasonSessionStartFailed, err.Error()
+
+		var aerr *temporal.ApplicationError
+		if errors.As(err, &aerr) && aerr.Type() == sdkerrors.ErrorType(sdkerrors.ErrResourceExhausted) {
+			sl.Infof("resources exhausted: %v", err)
+			dl.Reason = sdktypes.DeadLetterReasonResourceExhausted
+		} else {
+			sl.Errorf("session activity: %v", err)
 		}
 
-		sl.With("session_id", sid).Infof("started session %v for %v", sid, eid)
+		d.saveDeadLetter(wctx, dl)
 
-		started = append(started, sid)
+		return sdktypes.InvalidSessionID
 	}
 
-	return started, nil
+	sl.With("session_id", sid).Infof("started session %v for %v", sid, eid)
+
+	return sid
 }
 
 func (d *Dispatcher) eventsWorkflow(wctx workflow.Context, input eventsWorkflowInput) (*eventsWorkflowOutput, error) {
@@ -101,6 +166,10 @@ func (d *Dispatcher) eventsWorkflow(wctx workflow.Context, input eventsWorkflowI
 	if err != nil {
 		sl.With("err", err).Errorf("signalling error: %v", err)
 		errs = append(errs, fmt.Errorf("signal workflows: %w", err))
+	} else if len(sds) == 0 && len(signaled) == 0 {
+		sl.Infof("no destinations for %v", eid)
+
+		d.saveDeadLetter(wctx, deadLetterInput{Event: event, Reason: sdktypes.DeadLetterReasonNoDestinations})
 	}
 
 	return &eventsWorkflowOutput{
@@ -109,6 +178,20 @@ func (d *Dispatcher) eventsWorkflow(wctx workflow.Context, input eventsWorkflowI
 	}, errors.Join(errs...)
 }
 
+// saveDeadLetter records an event that was not delivered. Failing to do so is
+// logged, but does not fail the workflow.
+func (d *Dispatcher) saveDeadLetter(wctx workflow.Context, in deadLetterInput) {
+	sl := d.sl.With("event_id", in.Event.ID(), "reason", in.Reason)
+
+	var dlid sdktypes.DeadLetterID
+	if err := workflow.ExecuteActivity(wctx, saveDeadLetterActivityName, in).Get(wctx, &dlid); err != nil {
+		sl.With("err", err).Errorf("could not save dead letter for %v: %v", in.Event.ID(), err)
+		return
+	}
+
+	sl.With("dead_letter_id", dlid).Infof("saved dead letter %v for %v", dlid, in.Event.ID())
+}
+
 func (d *Dispatcher) signalWorkflows(wctx workflow.Context, event sdktypes.Event) ([]sdktypes.SessionID, error) {
 	eid := event.ID()
 
@@ -253,7 +336,10 @@ func newSession(event sdktypes.Event, inputs map[string]sdktypes.Value, data ses
 	return sdktypes.NewSession(data.Deployment.BuildID(), data.CodeLocation, inputs, memo).
 			WithDeploymentID(data.Deployment.ID()).
 			WithEventID(event.ID()).
+			WithTriggerID(data.Trigger.ID()).
 			WithProjectID(pid).
+			WithTimeout(data.Trigger.Timeout()).
+			WithOnStop(data.Trigger.OnStop()).
 			SetDurable(data.Trigger.IsDurable()),
 		nil
 }
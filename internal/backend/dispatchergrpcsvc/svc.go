@@ -87,3 +87,63 @@ func (s *server) Redispatch(ctx context.Context, req *connect.Request[dispatcher
 		SignaledSessionIds: kittehs.TransformToStrings(resp.SignaledSessionIDs),
 	}), nil
 }
+
+func (s *server) ListDeadLetters(ctx context.Context, req *connect.Request[dispatcher1.ListDeadLettersRequest]) (*connect.Response[dispatcher1.ListDeadLettersResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	filter := sdkservices.ListDeadLettersFilter{
+		IncludeRetried: msg.IncludeRetried,
+		Limit:          int(msg.Limit),
+	}
+
+	var err error
+
+	if filter.OrgID, err = sdktypes.ParseOrgID(msg.OrgId); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	if filter.ProjectID, err = sdktypes.ParseProjectID(msg.ProjectId); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	if filter.EventID, err = sdktypes.ParseEventID(msg.EventId); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	dls, err := s.dispatcher.ListDeadLetters(ctx, filter)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	return connect.NewResponse(&dispatcher1.ListDeadLettersResponse{
+		DeadLetters: kittehs.Transform(dls, sdktypes.ToProto),
+	}), nil
+}
+
+func (s *server) RetryDeadLetter(ctx context.Context, req *connect.Request[dispatcher1.RetryDeadLetterRequest]) (*connect.Response[dispatcher1.RetryDeadLetterResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	id, err := sdktypes.StrictParseDeadLetterID(msg.DeadLetterId)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	resp, err := s.dispatcher.RetryDeadLetter(ctx, id, &sdkservices.DispatchOptions{Wait: msg.Wait})
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	return connect.NewResponse(&dispatcher1.RetryDeadLetterResponse{
+		EventId:            resp.EventID.String(),
+		StartedSessionIds:  kittehs.TransformToStrings(resp.StartedSessionIDs),
+		SignaledSessionIds: kittehs.TransformToStrings(resp.SignaledSessionIDs),
+	}), nil
+}
@@ -31,7 +31,7 @@ func initialManifest() *manifest.Manifest {
 					Name:      "events",
 					EventType: "post",
 					Call:      "program.py:on_event",
-					Webhook:   &struct{}{},
+					Webhook:   &manifest.TriggerWebhook{},
 				},
 			},
 			Vars: []*manifest.Var{
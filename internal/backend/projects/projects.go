@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"go.uber.org/fx"
@@ -15,6 +16,7 @@ import (
 	"go.autokitteh.dev/autokitteh/internal/backend/auth/authcontext"
 	"go.autokitteh.dev/autokitteh/internal/backend/auth/authz"
 	"go.autokitteh.dev/autokitteh/internal/backend/db"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
 	"go.autokitteh.dev/autokitteh/internal/manifest"
 	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
 	"go.autokitteh.dev/autokitteh/sdk/sdkruntimes"
@@ -304,10 +306,56 @@ func (ps *Projects) exportManifest(ctx context.Context, projectID sdktypes.Proje
 		if etype := t.EventType(); etype != "" {
 			mt.EventType = etype
 		}
+		if c := t.Concurrency(); c.IsValid() {
+			mt.Concurrency = &manifest.TriggerConcurrency{Max: c.MaxConcurrent()}
+			if o := c.Overlap(); !o.IsZero() {
+				mt.Concurrency.Overlap = strings.ToLower(o.String())
+			}
+		}
+		if b := t.Batch(); b.IsValid() {
+			mt.Batch = &manifest.TriggerBatch{Key: b.Key(), MaxEvents: b.MaxEvents()}
+			if w := b.Window(); w > 0 {
+				mt.Batch.Window = w.String()
+			}
+		}
+
+		if loc := t.OnStop(); loc.IsValid() {
+			mt.OnStop = loc.CanonicalString()
+		}
+
+		if d := t.Timeout(); d > 0 {
+			mt.Timeout = d.String()
+		}
+
+		if r := t.Retry(); r.IsValid() {
+			pb := r.ToProto()
+
+			mt.Retry = &manifest.TriggerRetry{
+				MaxAttempts:         r.MaxAttempts(),
+				BackoffCoefficient:  pb.BackoffCoefficient,
+				RetryOnProgramError: r.RetryOnProgramError(),
+			}
+
+			if pb.InitialInterval != nil {
+				mt.Retry.InitialInterval = pb.InitialInterval.AsDuration().String()
+			}
+
+			if pb.MaxInterval != nil {
+				mt.Retry.MaxInterval = pb.MaxInterval.AsDuration().String()
+			}
+		}
 
 		switch t.SourceType() {
 		case sdktypes.TriggerSourceTypeWebhook:
-			mt.Webhook = &struct{}{}
+			mt.Webhook = &manifest.TriggerWebhook{Route: t.WebhookRoute().String()}
+			if a := t.WebhookAuth(); a.IsValid() {
+				mt.Webhook.Auth = exportWebhookAuth(a)
+			}
+			if i := t.WebhookIdempotency(); i.IsValid() {
+				mt.Webhook.Idempotency = &manifest.TriggerWebhookIdempotency{Header: i.Header(), Key: i.Key()}
+			}
+		case sdktypes.TriggerSourceTypeStore:
+			mt.Store = &struct{}{}
 		case sdktypes.TriggerSourceTypeSchedule:
 			sched := t.Schedule()
 			mt.Schedule = &sched
@@ -348,6 +396,29 @@ func (ps *Projects) exportManifest(ctx context.Context, projectID sdktypes.Proje
 	return yaml.Marshal(m)
 }
 
+func exportWebhookAuth(a sdktypes.WebhookAuth) *manifest.TriggerWebhookAuth {
+	ma := manifest.TriggerWebhookAuth{
+		AllowedCIDRs: kittehs.TransformToStrings(a.AllowedCIDRs()),
+	}
+
+	if header, secret, format, ok := a.Signature(); ok {
+		ma.Signature = &manifest.TriggerWebhookSignature{Header: header, Secret: secret.String()}
+		if !format.IsZero() {
+			ma.Signature.Format = strings.ToLower(format.String())
+		}
+	}
+
+	if v := a.BearerTokenVar(); v.IsValid() {
+		ma.BearerToken = v.String()
+	}
+
+	if username, password, ok := a.Basic(); ok {
+		ma.Basic = &manifest.TriggerWebhookBasic{Username: username, Password: password.String()}
+	}
+
+	return &ma
+}
+
 func findConnection(id sdktypes.ConnectionID, conns []sdktypes.Connection) (sdktypes.Connection, bool) {
 	for _, c := range conns {
 		if c.ID() == id {
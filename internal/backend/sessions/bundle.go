@@ -0,0 +1,139 @@
+package sessions
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/tar"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+const (
+	bundleVersion = 1
+
+	bundleManifestFilename  = "bundle.json"
+	bundleSessionFilename   = "session.json"
+	bundleEventFilename     = "event.json"
+	bundleBuildFilename     = "build.json"
+	bundleBuildDataFilename = "build.akb"
+	bundleLogFilename       = "log.json"
+)
+
+type bundleManifest struct {
+	Version int `json:"version"`
+}
+
+// bundle is a portable snapshot of a session, used to reproduce it
+// on another instance. It is serialized as a gzipped tar.
+type bundle struct {
+	Session   sdktypes.Session
+	Event     sdktypes.Event // optional.
+	Build     sdktypes.Build
+	BuildData []byte
+
+	// Complete log, including calls, in chronological order.
+	Log []sdktypes.SessionLogRecord
+}
+
+func (b *bundle) encode() ([]byte, error) {
+	ta := tar.NewTarFile()
+
+	add := func(name string, v any) error {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal %s: %w", name, err)
+		}
+
+		ta.Add(name, data)
+		return nil
+	}
+
+	if err := add(bundleManifestFilename, bundleManifest{Version: bundleVersion}); err != nil {
+		return nil, err
+	}
+
+	if err := add(bundleSessionFilename, b.Session); err != nil {
+		return nil, err
+	}
+
+	if b.Event.IsValid() {
+		if err := add(bundleEventFilename, b.Event); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := add(bundleBuildFilename, b.Build); err != nil {
+		return nil, err
+	}
+
+	ta.Add(bundleBuildDataFilename, b.BuildData)
+
+	if err := add(bundleLogFilename, b.Log); err != nil {
+		return nil, err
+	}
+
+	return ta.Bytes(true)
+}
+
+func decodeBundle(data []byte) (*bundle, error) {
+	ta, err := tar.FromBytes(data, true)
+	if err != nil {
+		return nil, sdkerrors.NewInvalidArgumentError("invalid bundle: %v", err)
+	}
+
+	content, err := ta.Content()
+	if err != nil {
+		return nil, err
+	}
+
+	get := func(name string, dst any, optional bool) error {
+		data, ok := content[name]
+		if !ok {
+			if optional {
+				return nil
+			}
+
+			return sdkerrors.NewInvalidArgumentError("invalid bundle: missing %s", name)
+		}
+
+		if err := json.Unmarshal(data, dst); err != nil {
+			return sdkerrors.NewInvalidArgumentError("invalid bundle: %s: %v", name, err)
+		}
+
+		return nil
+	}
+
+	var manifest bundleManifest
+	if err := get(bundleManifestFilename, &manifest, false); err != nil {
+		return nil, err
+	}
+
+	if manifest.Version != bundleVersion {
+		return nil, sdkerrors.NewInvalidArgumentError("unsupported bundle version %d", manifest.Version)
+	}
+
+	var b bundle
+
+	if err := get(bundleSessionFilename, &b.Session, false); err != nil {
+		return nil, err
+	}
+
+	if err := get(bundleEventFilename, &b.Event, true); err != nil {
+		return nil, err
+	}
+
+	if err := get(bundleBuildFilename, &b.Build, false); err != nil {
+		return nil, err
+	}
+
+	if b.BuildData = content[bundleBuildDataFilename]; len(b.BuildData) == 0 {
+		return nil, sdkerrors.NewInvalidArgumentError("invalid bundle: missing %s", bundleBuildDataFilename)
+	}
+
+	if err := get(bundleLogFilename, &b.Log, false); err != nil {
+		return nil, err
+	}
+
+	return &b, nil
+}
@@ -0,0 +1,60 @@
+package sessions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/tar"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func TestBundleRoundTrip(t *testing.T) {
+	ep := kittehs.Must1(sdktypes.ParseCodeLocation("main.py:main"))
+
+	b := bundle{
+		Session: sdktypes.NewSession(sdktypes.NewBuildID(), ep, map[string]sdktypes.Value{"x": sdktypes.NewIntegerValue(1)}, nil).
+			WithNewID().
+			WithState(sdktypes.SessionStateTypeCompleted),
+		Build:     sdktypes.NewBuild().WithNewID(),
+		BuildData: []byte("meow"),
+		Log: []sdktypes.SessionLogRecord{
+			sdktypes.NewStateSessionLogRecord(fixedTime, sdktypes.NewSessionStateCreated()),
+			sdktypes.NewPrintSessionLogRecord(fixedTime, sdktypes.NewStringValue("woof"), 0),
+		},
+	}
+
+	data, err := b.encode()
+	require.NoError(t, err)
+
+	got, err := decodeBundle(data)
+	require.NoError(t, err)
+
+	assert.True(t, got.Session.Equal(b.Session))
+	assert.True(t, got.Build.Equal(b.Build))
+	assert.False(t, got.Event.IsValid())
+	assert.Equal(t, b.BuildData, got.BuildData)
+	require.Len(t, got.Log, 2)
+	assert.True(t, got.Log[1].Equal(b.Log[1]))
+}
+
+func TestDecodeBundleInvalid(t *testing.T) {
+	_, err := decodeBundle([]byte("meow"))
+	assert.ErrorContains(t, err, "invalid bundle")
+
+	ta := tar.NewTarFile()
+	ta.Add(bundleManifestFilename, []byte(`{"version": 42}`))
+	data := kittehs.Must1(ta.Bytes(true))
+
+	_, err = decodeBundle(data)
+	assert.ErrorContains(t, err, "unsupported bundle version 42")
+
+	ta = tar.NewTarFile()
+	ta.Add(bundleManifestFilename, []byte(`{"version": 1}`))
+	data = kittehs.Must1(ta.Bytes(true))
+
+	_, err = decodeBundle(data)
+	assert.ErrorContains(t, err, "missing session.json")
+}
@@ -18,6 +18,11 @@ type Config struct {
 	Workflows     sessionworkflows.Config `koanf:"workflows"`
 	Calls         sessioncalls.Config     `koanf:"calls"`
 	ExternalStart ExternalStartConfig     `koanf:"external_start"`
+
+	// Log watchers are notified of db log changes made by this process,
+	// but must poll for changes made by other workers. Calls are long
+	// polled from the workflow history, so they need no polling.
+	WatchLogPollInterval time.Duration `koanf:"watch_log_poll_interval"`
 }
 
 var defaultConfig = Config{
@@ -25,11 +30,15 @@ var defaultConfig = Config{
 	ExternalStart: ExternalStartConfig{
 		Enabled: false,
 	},
+	WatchLogPollInterval: time.Second,
 	Workflows: sessionworkflows.Config{
 		Worker: temporalclient.WorkerConfig{
 			WorkflowDeadlockTimeout: time.Second * 10, // TODO: bring down to 1s.
 		},
 		NextEventInActivityPollDuration: time.Millisecond * 100,
+		OnStopGracePeriod:               time.Second * 30,
+		WaitSessionsPollInterval:        time.Second,
+		WaitSessionsMaxPollInterval:     time.Minute,
 	},
 	Calls: sessioncalls.Config{
 		ActivityHeartbeatInterval: time.Second * 5,
@@ -0,0 +1,75 @@
+package sessions
+
+import (
+	"context"
+	"errors"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/auth/authz"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+// retentionSubject is the authz subject of a retention policy scope.
+func retentionSubject(scope sdkservices.SessionRetentionScope) sdktypes.ID {
+	if scope.ProjectID.IsValid() {
+		return scope.ProjectID
+	}
+
+	return scope.OrgID
+}
+
+func (s *sessions) SetRetentionPolicy(ctx context.Context, policy sdkservices.SessionRetentionPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	if err := authz.CheckContext(
+		ctx,
+		retentionSubject(policy.SessionRetentionScope),
+		authz.OpSessionRetentionWriteSet,
+		authz.WithData("policy", policy),
+	); err != nil {
+		return err
+	}
+
+	return s.svcs.DB.SetSessionRetentionPolicy(ctx, policy)
+}
+
+func (s *sessions) GetRetentionPolicy(ctx context.Context, scope sdkservices.SessionRetentionScope) (*sdkservices.SessionRetentionPolicy, error) {
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := authz.CheckContext(
+		ctx,
+		retentionSubject(scope),
+		authz.OpSessionRetentionReadGet,
+		authz.WithConvertForbiddenToNotFound,
+	); err != nil {
+		return nil, err
+	}
+
+	policy, err := s.svcs.DB.GetSessionRetentionPolicy(ctx, scope)
+	if errors.Is(err, sdkerrors.ErrNotFound) {
+		return nil, nil
+	}
+
+	return policy, err
+}
+
+func (s *sessions) DeleteRetentionPolicy(ctx context.Context, scope sdkservices.SessionRetentionScope) error {
+	if err := scope.Validate(); err != nil {
+		return err
+	}
+
+	if err := authz.CheckContext(
+		ctx,
+		retentionSubject(scope),
+		authz.OpSessionRetentionDeleteDelete,
+	); err != nil {
+		return err
+	}
+
+	return s.svcs.DB.DeleteSessionRetentionPolicy(ctx, scope)
+}
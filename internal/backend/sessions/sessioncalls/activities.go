@@ -62,6 +62,10 @@ func (cs *calls) sessionCallActivity(ctx context.Context, params *CallActivityIn
 		defer done()
 	}
 
+	// The attempt start and completion are recorded in the workflow history.
+	cs.svcs.Notifier.Notify(params.SessionID)
+	defer cs.svcs.Notifier.Notify(params.SessionID)
+
 	result, err := cs.executeCall(ctx, params.CallSpec, executors)
 	if err != nil {
 		return nil, temporalclient.TranslateError(err, "execute call for %v", params.SessionID)
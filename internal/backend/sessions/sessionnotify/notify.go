@@ -0,0 +1,74 @@
+// Package sessionnotify lets watchers know that something has changed, such as
+// a session log, or the outcomes of the sessions started for an event.
+//
+// Notifications carry no data, they only wake up subscribers, which then
+// fetch the new records. Notifications are in-process only:
+// watchers must not rely on them exclusively and still poll periodically,
+// as the session might be running on a different worker.
+package sessionnotify
+
+import (
+	"sync"
+
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+// Notifier notifies subscribers of keys, such as session IDs.
+type Notifier[K comparable] struct {
+	mu   sync.Mutex
+	subs map[K]map[chan struct{}]struct{}
+}
+
+func New[K comparable]() *Notifier[K] {
+	return &Notifier[K]{subs: make(map[K]map[chan struct{}]struct{})}
+}
+
+// NewSessions returns a notifier of session log changes.
+func NewSessions() *Notifier[sdktypes.SessionID] { return New[sdktypes.SessionID]() }
+
+// Notify wakes up all subscribers of the key. It never blocks.
+// A nil notifier does nothing.
+func (n *Notifier[K]) Notify(key K) {
+	if n == nil {
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for ch := range n.subs[key] {
+		// Pending notifications are coalesced.
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel that receives a value whenever the key is
+// notified, and a function that must be called to unsubscribe.
+func (n *Notifier[K]) Subscribe(key K) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	if n == nil {
+		return ch, func() {}
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.subs[key] == nil {
+		n.subs[key] = make(map[chan struct{}]struct{})
+	}
+
+	n.subs[key][ch] = struct{}{}
+
+	return ch, func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+
+		if delete(n.subs[key], ch); len(n.subs[key]) == 0 {
+			delete(n.subs, key)
+		}
+	}
+}
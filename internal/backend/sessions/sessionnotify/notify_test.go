@@ -0,0 +1,52 @@
+package sessionnotify
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func notified(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestNotifier(t *testing.T) {
+	n := NewSessions()
+
+	sid1, sid2 := sdktypes.NewSessionID(), sdktypes.NewSessionID()
+
+	ch1, unsub1 := n.Subscribe(sid1)
+	ch2, unsub2 := n.Subscribe(sid2)
+	defer unsub2()
+
+	n.Notify(sid1)
+	n.Notify(sid1) // coalesced, must not block.
+
+	assert.True(t, notified(ch1))
+	assert.False(t, notified(ch1))
+	assert.False(t, notified(ch2))
+
+	unsub1()
+	assert.NotContains(t, n.subs, sid1)
+
+	n.Notify(sid1)
+	assert.False(t, notified(ch1))
+}
+
+func TestNilNotifier(t *testing.T) {
+	var n *Notifier[sdktypes.SessionID]
+
+	n.Notify(sdktypes.NewSessionID())
+
+	ch, unsub := n.Subscribe(sdktypes.NewSessionID())
+	defer unsub()
+
+	assert.False(t, notified(ch))
+}
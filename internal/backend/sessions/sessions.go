@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +18,7 @@ import (
 	"go.autokitteh.dev/autokitteh/internal/backend/auth/authz"
 	"go.autokitteh.dev/autokitteh/internal/backend/db"
 	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessioncalls"
+	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessiondata"
 	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessionsvcs"
 	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessionworkflows"
 	"go.autokitteh.dev/autokitteh/internal/kittehs"
@@ -199,6 +202,34 @@ func writeFormattedSessionLog(buf io.StringWriter, record sdktypes.SessionLogRec
 	return nil
 }
 
+func (s *sessions) Search(ctx context.Context, filter sdkservices.SearchSessionsFilter) (*sdkservices.ListSessionResult, error) {
+	for k, v := range filter.Tags {
+		if err := sdktypes.ValidateSessionTag(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	if !filter.AnyIDSpecified() {
+		filter.OrgID = authcontext.GetAuthnInferredOrgID(ctx)
+	}
+
+	if err := authz.CheckContext(
+		ctx,
+		sdktypes.InvalidSessionID,
+		authz.OpSessionReadSearch,
+		authz.WithData("filter", filter),
+		authz.WithAssociationWithID("deployment", filter.DeploymentID),
+		authz.WithAssociationWithID("project", filter.ProjectID),
+		authz.WithAssociationWithID("org", filter.OrgID),
+		authz.WithAssociationWithID("event", filter.EventID),
+		authz.WithAssociationWithID("build", filter.BuildID),
+	); err != nil {
+		return nil, err
+	}
+
+	return s.svcs.DB.SearchSessions(ctx, filter)
+}
+
 func (s *sessions) Get(ctx context.Context, sessionID sdktypes.SessionID) (sdktypes.Session, error) {
 	if err := authz.CheckContext(ctx, sessionID, authz.OpSessionReadGet, authz.WithConvertForbiddenToNotFound); err != nil {
 		return sdktypes.InvalidSession, err
@@ -222,6 +253,12 @@ func (s *sessions) Stop(ctx context.Context, sessionID sdktypes.SessionID, reaso
 }
 
 func (s *sessions) List(ctx context.Context, filter sdkservices.ListSessionsFilter) (*sdkservices.ListSessionResult, error) {
+	for k, v := range filter.Tags {
+		if err := sdktypes.ValidateSessionTag(k, v); err != nil {
+			return nil, err
+		}
+	}
+
 	if !filter.AnyIDSpecified() {
 		filter.OrgID = authcontext.GetAuthnInferredOrgID(ctx)
 	}
@@ -267,6 +304,239 @@ func (s *sessions) Delete(ctx context.Context, sessionID sdktypes.SessionID) err
 	return nil
 }
 
+func (s *sessions) Replay(ctx context.Context, sessionID sdktypes.SessionID, opts sdkservices.ReplaySessionOptions) (*sdkservices.ReplaySessionResult, error) {
+	if err := authz.CheckContext(
+		ctx,
+		sessionID,
+		authz.OpSessionReadReplay,
+		authz.WithData("opts", opts),
+		authz.WithConvertForbiddenToNotFound,
+	); err != nil {
+		return nil, err
+	}
+
+	session, err := s.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.IsDurable() {
+		return nil, sdkerrors.NewInvalidArgumentError("only durable sessions can be replayed")
+	}
+
+	if state := session.State(); !state.IsFinal() {
+		return nil, fmt.Errorf("%w: cannot replay session while in progress: %s, session_id: %v", sdkerrors.ErrFailedPrecondition, state, sessionID)
+	}
+
+	data, err := sessiondata.Get(ctx, s.svcs, session)
+	if err != nil {
+		return nil, fmt.Errorf("get session data: %w", err)
+	}
+
+	return s.workflows.ReplayWorkflow(ctx, data, opts)
+}
+
+func (s *sessions) Export(ctx context.Context, sessionID sdktypes.SessionID) ([]byte, error) {
+	if err := authz.CheckContext(ctx, sessionID, authz.OpSessionReadExport, authz.WithConvertForbiddenToNotFound); err != nil {
+		return nil, err
+	}
+
+	session, err := s.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if state := session.State(); !state.IsFinal() {
+		return nil, fmt.Errorf("%w: cannot export session while in progress: %s, session_id: %v", sdkerrors.ErrFailedPrecondition, state, sessionID)
+	}
+
+	b := bundle{Session: session}
+
+	if eid := session.EventID(); eid.IsValid() {
+		if b.Event, err = s.svcs.Events.Get(ctx, eid); err != nil {
+			return nil, fmt.Errorf("get event: %w", err)
+		}
+	}
+
+	if b.Build, err = s.svcs.Builds.Get(ctx, session.BuildID()); err != nil {
+		return nil, fmt.Errorf("get build: %w", err)
+	}
+
+	r, err := s.svcs.Builds.Download(ctx, session.BuildID())
+	if err != nil {
+		return nil, fmt.Errorf("download build: %w", err)
+	}
+
+	defer r.Close()
+
+	if b.BuildData, err = io.ReadAll(r); err != nil {
+		return nil, fmt.Errorf("read build: %w", err)
+	}
+
+	asc := sdktypes.PaginationRequest{Ascending: true}
+
+	log, err := s.svcs.DB.GetSessionLog(ctx, sdkservices.SessionLogRecordsFilter{SessionID: sessionID, PaginationRequest: asc})
+	if err != nil {
+		return nil, fmt.Errorf("get log: %w", err)
+	}
+
+	b.Log = log.Records
+
+	// Calls are recorded only in the workflow history. If temporal already
+	// lost the workflow, the bundle will contain no calls.
+	calls, err := s.workflows.GetWorkflowLog(ctx, sdkservices.SessionLogRecordsFilter{
+		SessionID:         sessionID,
+		Types:             sdktypes.CallSpecSessionLogRecordType | sdktypes.CallAttemptStartSessionLogRecordType | sdktypes.CallAttemptCompleteSessionLogRecordType,
+		PaginationRequest: asc,
+	})
+	if err != nil && !errors.Is(err, sdkerrors.ErrNotFound) {
+		return nil, fmt.Errorf("get workflow log: %w", err)
+	} else if err == nil {
+		b.Log = append(b.Log, calls.Records...)
+	}
+
+	slices.SortStableFunc(b.Log, func(a, b sdktypes.SessionLogRecord) int { return a.Timestamp().Compare(b.Timestamp()) })
+
+	return b.encode()
+}
+
+func (s *sessions) Import(ctx context.Context, projectID sdktypes.ProjectID, data []byte) (sdktypes.SessionID, error) {
+	if err := authz.CheckContext(
+		ctx,
+		sdktypes.InvalidSessionID,
+		authz.OpSessionCreateImport,
+		authz.WithAssociationWithID("project", projectID),
+	); err != nil {
+		return sdktypes.InvalidSessionID, err
+	}
+
+	b, err := decodeBundle(data)
+	if err != nil {
+		return sdktypes.InvalidSessionID, err
+	}
+
+	if state := b.Session.State(); !state.IsFinal() {
+		return sdktypes.InvalidSessionID, sdkerrors.NewInvalidArgumentError("bundle session is not in a final state: %s", state)
+	}
+
+	bid, err := s.svcs.Builds.Save(ctx, b.Build.WithProjectID(projectID), b.BuildData)
+	if err != nil {
+		return sdktypes.InvalidSessionID, fmt.Errorf("save build: %w", err)
+	}
+
+	memo := maps.Clone(b.Session.Memo())
+	if memo == nil {
+		memo = make(map[string]string)
+	}
+
+	memo["imported_from_session_id"] = b.Session.ID().String()
+
+	// The deployment, event and trigger the session referred to do not exist
+	// in this project. The event data is still available in the inputs.
+	session := b.Session.
+		WithNewID().
+		WithProjectID(projectID).
+		WithBuildID(bid).
+		WithDeploymentID(sdktypes.InvalidDeploymentID).
+		WithEventID(sdktypes.InvalidEventID).
+		WithTriggerID(sdktypes.InvalidTriggerID).
+		WithParentSessionID(sdktypes.InvalidSessionID).
+		WithRetryOfSessionID(sdktypes.InvalidSessionID).
+		WithMemo(memo)
+
+	if err := s.svcs.DB.ImportSession(ctx, session, b.Log); err != nil {
+		return sdktypes.InvalidSessionID, fmt.Errorf("import session: %w", err)
+	}
+
+	s.l.Sugar().With("session_id", session.ID()).Infof("imported %v from %v", session.ID(), b.Session.ID())
+
+	return session.ID(), nil
+}
+
+func (s *sessions) StopMany(ctx context.Context, filter sdkservices.ListSessionsFilter, reason string, force bool) (string, error) {
+	return s.startBulkOperation(ctx, authz.OpSessionWriteStopMany, sessionworkflows.BulkOperationParams{
+		Kind:   sdkservices.BulkSessionsStop,
+		Filter: filter,
+		Reason: reason,
+		Force:  force,
+	})
+}
+
+func (s *sessions) DeleteMany(ctx context.Context, filter sdkservices.ListSessionsFilter) (string, error) {
+	return s.startBulkOperation(ctx, authz.OpSessionDeleteDeleteMany, sessionworkflows.BulkOperationParams{
+		Kind:   sdkservices.BulkSessionsDelete,
+		Filter: filter,
+	})
+}
+
+func (s *sessions) RestartMany(ctx context.Context, filter sdkservices.ListSessionsFilter) (string, error) {
+	return s.startBulkOperation(ctx, authz.OpSessionCreateRestartMany, sessionworkflows.BulkOperationParams{
+		Kind:   sdkservices.BulkSessionsRestart,
+		Filter: filter,
+	})
+}
+
+func (s *sessions) startBulkOperation(ctx context.Context, op string, params sessionworkflows.BulkOperationParams) (string, error) {
+	filter := &params.Filter
+
+	for k, v := range filter.Tags {
+		if err := sdktypes.ValidateSessionTag(k, v); err != nil {
+			return "", err
+		}
+	}
+
+	if !filter.AnyIDSpecified() {
+		filter.OrgID = authcontext.GetAuthnInferredOrgID(ctx)
+	}
+
+	if err := authz.CheckContext(
+		ctx,
+		sdktypes.InvalidSessionID,
+		op,
+		authz.WithData("filter", *filter),
+		authz.WithAssociationWithID("deployment", filter.DeploymentID),
+		authz.WithAssociationWithID("project", filter.ProjectID),
+		authz.WithAssociationWithID("org", filter.OrgID),
+		authz.WithAssociationWithID("event", filter.EventID),
+		authz.WithAssociationWithID("build", filter.BuildID),
+	); err != nil {
+		return "", err
+	}
+
+	// Authorization above guarantees all specified ids belong to a single org.
+	ids := []sdktypes.ID{filter.OrgID, filter.ProjectID, filter.DeploymentID, filter.EventID, filter.BuildID}
+	if i, id := kittehs.FindFirst(ids, func(id sdktypes.ID) bool { return id.IsValid() }); i >= 0 {
+		oid, err := s.svcs.DB.GetOrgIDOf(ctx, id)
+		if err != nil {
+			return "", fmt.Errorf("get org id of %v: %w", id, err)
+		}
+
+		params.OrgID = oid
+	}
+
+	return s.workflows.StartBulkOperation(ctx, params)
+}
+
+func (s *sessions) GetBulkOperation(ctx context.Context, operationID string) (*sdkservices.BulkSessionsOperation, error) {
+	op, oid, err := s.workflows.GetBulkOperation(ctx, operationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authz.CheckContext(
+		ctx,
+		sdktypes.InvalidSessionID,
+		authz.OpSessionReadGetBulkOperation,
+		authz.WithData("operation_id", operationID),
+		authz.WithAssociationWithID("org", oid),
+		authz.WithConvertForbiddenToNotFound,
+	); err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
 func (s *sessions) StartInternal(ctx context.Context, session sdktypes.Session) (sdktypes.SessionID, error) {
 	if err := authz.CheckContext(
 		ctx,
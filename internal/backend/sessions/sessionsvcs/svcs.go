@@ -6,9 +6,11 @@ import (
 	"go.autokitteh.dev/autokitteh/internal/backend/auth/authtokens"
 	"go.autokitteh.dev/autokitteh/internal/backend/db"
 	"go.autokitteh.dev/autokitteh/internal/backend/externalclient"
+	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessionnotify"
 	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
 	"go.autokitteh.dev/autokitteh/internal/backend/workflowexecutor"
 	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
 )
 
 type Svcs struct {
@@ -31,4 +33,7 @@ type Svcs struct {
 
 	WorkflowExecutor workflowexecutor.WorkflowExecutor
 	ExternalClient   externalclient.ExternalClient
+
+	// Notified whenever a session log changes.
+	Notifier *sessionnotify.Notifier[sdktypes.SessionID]
 }
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"go.autokitteh.dev/autokitteh/internal/backend/auth/authcontext"
+	"go.autokitteh.dev/autokitteh/internal/backend/store"
 	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
 	"go.autokitteh.dev/autokitteh/internal/backend/types"
 	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
@@ -20,21 +21,33 @@ import (
 )
 
 const (
+	addSessionCompensationActivityName       = "add_session_compensation"
+	addSessionStopRequestActivityName        = "add_session_stop_request"
+	admitQueuedTriggerEventsActivityName     = "admit_queued_trigger_events"
+	bulkSessionActivityName                  = "bulk_session"
+	countBulkSessionsActivityName            = "count_bulk_sessions"
+	createApprovalActivityName               = "create_approval"
 	createSessionActivityName                = "create_session"
 	deactivateDrainedDeploymentActivityName  = "deactivate_drained_deployment"
+	finishApprovalActivityName               = "finish_approval"
+	getChildSessionFinalStateActivityName    = "get_child_session_final_state"
 	getDeploymentStateActivityName           = "get_deployment_state"
 	getLastEventSequenceActivityName         = "get_last_event_sequence"
 	getProjectIDAndActiveBuildID             = "get_project_id_and_active_build_id"
 	getProjectIDAndActiveBuildIDActivityName = "get_project_id_and_active_build_id"
 	getSessionStopReasonActivityName         = "get_session_stop_reason"
 	getSignalEventActivityName               = "get_signal_event"
+	listBulkSessionsActivityName             = "list_bulk_sessions"
 	listStoreValuesActivityName              = "list_store_values"
 	mutateStoreValueActivityName             = "mutate_store_value"
 	notifyWorkflowEndedActivity              = "notify_workflow_ended"
 	outcomeActivityName                      = "outcome"
 	publishStoreValueActivityName            = "publish_store_value"
 	removeSignalActivityName                 = "remove_signal"
+	retrySessionActivityName                 = "retry_session"
 	saveSignalActivityName                   = "save_signal"
+	scheduleRetrySessionActivityName         = "schedule_retry_session"
+	setSessionTagActivityName                = "set_session_tag"
 	startChildSessionActivityName            = "start_child_session"
 	terminateWorkflowActivityName            = "terminate_workflow"
 	updateSessionStateActivityName           = "update_session_state"
@@ -55,6 +68,26 @@ func (ws *workflows) registerActivities() {
 		activity.RegisterOptions{Name: terminateWorkflowActivityName},
 	)
 
+	ws.utilsWorker.RegisterActivityWithOptions(
+		ws.countBulkSessionsActivity,
+		activity.RegisterOptions{Name: countBulkSessionsActivityName},
+	)
+
+	ws.utilsWorker.RegisterActivityWithOptions(
+		ws.listBulkSessionsActivity,
+		activity.RegisterOptions{Name: listBulkSessionsActivityName},
+	)
+
+	ws.utilsWorker.RegisterActivityWithOptions(
+		ws.bulkSessionActivity,
+		activity.RegisterOptions{Name: bulkSessionActivityName},
+	)
+
+	ws.utilsWorker.RegisterActivityWithOptions(
+		ws.retrySessionActivity,
+		activity.RegisterOptions{Name: retrySessionActivityName},
+	)
+
 	// Session Worker activities
 	ws.sessionsWorker.RegisterActivityWithOptions(
 		ws.updateSessionStateActivity,
@@ -131,10 +164,50 @@ func (ws *workflows) registerActivities() {
 		activity.RegisterOptions{Name: startChildSessionActivityName},
 	)
 
+	ws.sessionsWorker.RegisterActivityWithOptions(
+		ws.addSessionStopRequestActivity,
+		activity.RegisterOptions{Name: addSessionStopRequestActivityName},
+	)
+
+	ws.sessionsWorker.RegisterActivityWithOptions(
+		ws.addSessionCompensationActivity,
+		activity.RegisterOptions{Name: addSessionCompensationActivityName},
+	)
+
+	ws.sessionsWorker.RegisterActivityWithOptions(
+		ws.getChildSessionFinalStateActivity,
+		activity.RegisterOptions{Name: getChildSessionFinalStateActivityName},
+	)
+
+	ws.sessionsWorker.RegisterActivityWithOptions(
+		ws.admitQueuedTriggerEventsActivity,
+		activity.RegisterOptions{Name: admitQueuedTriggerEventsActivityName},
+	)
+
+	ws.sessionsWorker.RegisterActivityWithOptions(
+		ws.scheduleRetrySessionActivity,
+		activity.RegisterOptions{Name: scheduleRetrySessionActivityName},
+	)
+
 	ws.sessionsWorker.RegisterActivityWithOptions(
 		ws.outcomeActivity,
 		activity.RegisterOptions{Name: outcomeActivityName},
 	)
+
+	ws.sessionsWorker.RegisterActivityWithOptions(
+		ws.setSessionTagActivity,
+		activity.RegisterOptions{Name: setSessionTagActivityName},
+	)
+
+	ws.sessionsWorker.RegisterActivityWithOptions(
+		ws.createApprovalActivity,
+		activity.RegisterOptions{Name: createApprovalActivityName},
+	)
+
+	ws.sessionsWorker.RegisterActivityWithOptions(
+		ws.finishApprovalActivity,
+		activity.RegisterOptions{Name: finishApprovalActivityName},
+	)
 }
 
 type getProjectIDAndActiveBuildIDParams struct {
@@ -179,15 +252,32 @@ func (ws *workflows) getProjectIDAndActiveBuildIDActivity(ctx context.Context, p
 }
 
 func (ws *workflows) outcomeActivity(ctx context.Context, sid sdktypes.SessionID, v sdktypes.Value, eid sdktypes.EventID) error {
-	return ws.svcs.DB.AddSessionOutcome(ctx, sid, v, eid)
+	if err := ws.svcs.DB.AddSessionOutcome(ctx, sid, v, eid); err != nil {
+		return err
+	}
+
+	ws.svcs.Notifier.Notify(sid)
+	return nil
+}
+
+func (ws *workflows) setSessionTagActivity(ctx context.Context, sid sdktypes.SessionID, key, value string) error {
+	err := ws.svcs.DB.SetSessionTag(ctx, sid, key, value)
+	return temporalclient.TranslateError(err, "set tag %q on %v", key, sid)
 }
 
 func (ws *workflows) listStoreValuesActivity(ctx context.Context, pid sdktypes.ProjectID) ([]string, error) {
-	return ws.svcs.Store.List(authcontext.SetAuthnSystemUser(ctx), pid)
+	res, err := ws.svcs.Store.List(authcontext.SetAuthnSystemUser(ctx), pid, sdkservices.ListStoreValuesFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Keys, nil
 }
 
-func (ws *workflows) mutateStoreValueActivity(ctx context.Context, pid sdktypes.ProjectID, key, op string, operands []sdktypes.Value) (sdktypes.Value, error) {
-	return ws.svcs.Store.Mutate(authcontext.SetAuthnSystemUser(ctx), pid, key, op, operands...)
+// sid is used as the owner for lock operations.
+func (ws *workflows) mutateStoreValueActivity(ctx context.Context, pid sdktypes.ProjectID, key, op string, operands []sdktypes.Value, sid sdktypes.SessionID, depth int) (sdktypes.Value, error) {
+	ctx = store.WithEventDepth(store.WithSessionID(authcontext.SetAuthnSystemUser(ctx), sid), depth)
+	return ws.svcs.Store.Mutate(ctx, pid, key, op, operands...)
 }
 
 func (ws *workflows) publishStoreValueActivity(ctx context.Context, pid sdktypes.ProjectID, key string) error {
@@ -199,7 +289,12 @@ func (ws *workflows) createSessionActivity(ctx context.Context, session sdktypes
 }
 
 func (ws *workflows) updateSessionStateActivity(ctx context.Context, sid sdktypes.SessionID, state sdktypes.SessionState) error {
-	return temporalclient.TranslateError(ws.svcs.DB.UpdateSessionState(ctx, sid, state), "%v: update session state", sid)
+	if err := ws.svcs.DB.UpdateSessionState(ctx, sid, state); err != nil {
+		return temporalclient.TranslateError(err, "%v: update session state", sid)
+	}
+
+	ws.svcs.Notifier.Notify(sid)
+	return nil
 }
 
 func (ws *workflows) getDeploymentStateActivity(ctx context.Context, did sdktypes.DeploymentID) (sdktypes.DeploymentState, error) {
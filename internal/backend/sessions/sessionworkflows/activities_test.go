@@ -0,0 +1,30 @@
+package sessionworkflows
+
+import (
+	"errors"
+	"testing"
+
+	"go.temporal.io/sdk/temporal"
+	"gotest.tools/v3/assert"
+
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func TestSetSessionTagActivityOverLimit(t *testing.T) {
+	ws, db, _ := setup(t)
+
+	db.On("SetSessionTag", sid, "k", "v").Return(sdkerrors.NewInvalidArgumentError("number of session tags exceeds maximum allowed (%d)", sdktypes.MaxSessionTags)).Once()
+
+	err := ws.setSessionTagActivity(t.Context(), sid, "k", "v")
+
+	var appErr *temporal.ApplicationError
+	assert.Assert(t, errors.As(err, &appErr))
+	assert.Assert(t, appErr.NonRetryable())
+	assert.ErrorContains(t, err, `set tag "k" on `+sid.String())
+
+	var invalid sdkerrors.InvalidArgumentError
+	assert.Assert(t, errors.As(err, &invalid))
+
+	db.AssertExpectations(t)
+}
@@ -0,0 +1,250 @@
+package sessionworkflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/workflow"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/auth/authcontext"
+	"go.autokitteh.dev/autokitteh/internal/backend/fixtures"
+	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+const (
+	bulkSessionsWorkflowName = "bulk_sessions"
+	bulkProgressQueryName    = "progress"
+
+	// Bulk operation ids are their workflow ids, which always have this prefix.
+	bulkOperationIDPrefix = "bulk_sessions_"
+
+	// Sessions are processed concurrently a page at a time, and the workflow
+	// continues as new after each page to keep its history short.
+	bulkPageSize = 50
+
+	maxBulkErrors = 20
+)
+
+type BulkOperationParams struct {
+	Kind   sdkservices.BulkSessionsOperationKind
+	Filter sdkservices.ListSessionsFilter
+
+	// The org the filtered sessions belong to, used to authorize progress queries.
+	OrgID sdktypes.OrgID
+
+	// Stop only.
+	Reason string
+	Force  bool
+}
+
+type bulkWorkflowParams struct {
+	BulkOperationParams
+
+	// Carried over from the previous run.
+	Progress sdkservices.BulkSessionsOperation
+}
+
+type bulkProgress struct {
+	Operation sdkservices.BulkSessionsOperation
+	OrgID     sdktypes.OrgID
+}
+
+type bulkSessionsPage struct {
+	SessionIDs    []sdktypes.SessionID
+	NextPageToken string
+}
+
+type bulkSessionInput struct {
+	Kind      sdkservices.BulkSessionsOperationKind
+	SessionID sdktypes.SessionID
+	Reason    string
+	Force     bool
+}
+
+func (ws *workflows) StartBulkOperation(ctx context.Context, params BulkOperationParams) (string, error) {
+	wid := bulkOperationIDPrefix + uuid.New().String()
+
+	// Pagination is managed by the workflow itself.
+	params.Filter.PaginationRequest = sdktypes.PaginationRequest{}
+	params.Filter.CountOnly = false
+
+	_, err := ws.svcs.Temporal.TemporalClient().ExecuteWorkflow(
+		ctx,
+		ws.cfg.BulkWorkflow.ToStartWorkflowOptions(
+			utilsWorkerQueue,
+			wid,
+			fmt.Sprintf("%s sessions", params.Kind),
+			map[string]string{
+				"process_id": fixtures.ProcessID(),
+				"kind":       string(params.Kind),
+				"org_id":     params.OrgID.String(),
+			},
+		),
+		bulkSessionsWorkflowName,
+		bulkWorkflowParams{BulkOperationParams: params},
+	)
+	if err != nil {
+		return "", fmt.Errorf("execute bulk workflow: %w", err)
+	}
+
+	return wid, nil
+}
+
+// GetBulkOperation returns the progress of a bulk operation, along with the
+// org its sessions belong to.
+func (ws *workflows) GetBulkOperation(ctx context.Context, operationID string) (*sdkservices.BulkSessionsOperation, sdktypes.OrgID, error) {
+	if !strings.HasPrefix(operationID, bulkOperationIDPrefix) {
+		return nil, sdktypes.InvalidOrgID, sdkerrors.ErrNotFound
+	}
+
+	v, err := ws.svcs.Temporal.TemporalClient().QueryWorkflow(ctx, operationID, "", bulkProgressQueryName)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return nil, sdktypes.InvalidOrgID, sdkerrors.ErrNotFound
+		}
+
+		return nil, sdktypes.InvalidOrgID, fmt.Errorf("query bulk workflow: %w", err)
+	}
+
+	var p bulkProgress
+	if err := v.Get(&p); err != nil {
+		return nil, sdktypes.InvalidOrgID, fmt.Errorf("decode bulk progress: %w", err)
+	}
+
+	return &p.Operation, p.OrgID, nil
+}
+
+func (ws *workflows) bulkSessionsWorkflow(wctx workflow.Context, params bulkWorkflowParams) error {
+	op := params.Progress
+	op.ID = workflow.GetInfo(wctx).WorkflowExecution.ID
+	op.Kind = params.Kind
+
+	sl := ws.l.Sugar().With("operation_id", op.ID, "kind", op.Kind)
+
+	if err := workflow.SetQueryHandler(wctx, bulkProgressQueryName, func() (bulkProgress, error) {
+		return bulkProgress{Operation: op, OrgID: params.OrgID}, nil
+	}); err != nil {
+		return fmt.Errorf("set query handler: %w", err)
+	}
+
+	wctx = temporalclient.WithActivityOptions(wctx, utilsWorkerQueue, ws.cfg.Activity)
+
+	filter := params.Filter
+
+	// Only the first run starts without a page token.
+	if filter.PageToken == "" {
+		cfilter := filter
+		cfilter.CountOnly = true
+
+		if err := workflow.ExecuteActivity(wctx, countBulkSessionsActivityName, cfilter).Get(wctx, &op.Total); err != nil {
+			return temporalclient.TranslateError(err, "count sessions")
+		}
+
+		sl.Infof("%s %d sessions", op.Kind, op.Total)
+	}
+
+	filter.PageSize = bulkPageSize
+
+	var page bulkSessionsPage
+	if err := workflow.ExecuteActivity(wctx, listBulkSessionsActivityName, filter).Get(wctx, &page); err != nil {
+		return temporalclient.TranslateError(err, "list sessions")
+	}
+
+	fs := kittehs.Transform(page.SessionIDs, func(sid sdktypes.SessionID) workflow.Future {
+		return workflow.ExecuteActivity(wctx, bulkSessionActivityName, bulkSessionInput{
+			Kind:      params.Kind,
+			SessionID: sid,
+			Reason:    params.Reason,
+			Force:     params.Force,
+		})
+	})
+
+	for i, f := range fs {
+		var msg string
+		if err := f.Get(wctx, &msg); err != nil {
+			msg = err.Error()
+		}
+
+		if msg == "" {
+			op.Succeeded++
+			continue
+		}
+
+		op.Failed++
+
+		if len(op.Errors) < maxBulkErrors {
+			op.Errors = append(op.Errors, fmt.Sprintf("%v: %s", page.SessionIDs[i], msg))
+		}
+	}
+
+	if page.NextPageToken == "" {
+		op.Done = true
+		sl.Infof("%s done: %d succeeded, %d failed", op.Kind, op.Succeeded, op.Failed)
+		return nil
+	}
+
+	params.Filter.PageToken = page.NextPageToken
+	params.Progress = op
+
+	return workflow.NewContinueAsNewError(wctx, bulkSessionsWorkflowName, params)
+}
+
+func (ws *workflows) countBulkSessionsActivity(ctx context.Context, filter sdkservices.ListSessionsFilter) (int64, error) {
+	r, err := ws.svcs.DB.ListSessions(ctx, filter)
+	if err != nil {
+		return 0, temporalclient.TranslateError(err, "count sessions")
+	}
+
+	return r.TotalCount, nil
+}
+
+func (ws *workflows) listBulkSessionsActivity(ctx context.Context, filter sdkservices.ListSessionsFilter) (*bulkSessionsPage, error) {
+	r, err := ws.svcs.DB.ListSessions(ctx, filter)
+	if err != nil {
+		return nil, temporalclient.TranslateError(err, "list sessions")
+	}
+
+	return &bulkSessionsPage{
+		SessionIDs:    kittehs.Transform(r.Sessions, sdktypes.Session.ID),
+		NextPageToken: r.NextPageToken,
+	}, nil
+}
+
+// bulkSessionActivity applies the operation to a single session. Operation
+// failures are returned as a message rather than an error, as they are
+// reported back to the user and should not be retried.
+func (ws *workflows) bulkSessionActivity(ctx context.Context, in bulkSessionInput) (string, error) {
+	// The whole operation was already authorized when it was started.
+	ctx = authcontext.SetAuthnSystemUser(ctx)
+
+	var err error
+
+	switch in.Kind {
+	case sdkservices.BulkSessionsStop:
+		err = ws.sessions.Stop(ctx, in.SessionID, in.Reason, in.Force, 0)
+	case sdkservices.BulkSessionsDelete:
+		err = ws.sessions.Delete(ctx, in.SessionID)
+	case sdkservices.BulkSessionsRestart:
+		var s sdktypes.Session
+		if s, err = ws.sessions.Get(ctx, in.SessionID); err == nil {
+			_, err = ws.sessions.Start(ctx, s.WithNoID())
+		}
+	default:
+		err = sdkerrors.NewInvalidArgumentError("unknown bulk operation %q", in.Kind)
+	}
+
+	if err != nil {
+		return err.Error(), nil
+	}
+
+	return "", nil
+}
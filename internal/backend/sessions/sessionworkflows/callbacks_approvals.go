@@ -0,0 +1,95 @@
+package sessionworkflows
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/workflow"
+
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func approvalSignalName(aid sdktypes.ApprovalID) string { return aid.String() }
+
+func (w *sessionWorkflow) requestApproval(wctx workflow.Context) func(context.Context, sdktypes.RunID, string, []string, time.Duration) (sdktypes.Approval, error) {
+	return func(ctx context.Context, rid sdktypes.RunID, title string, approvers []string, timeout time.Duration) (sdktypes.Approval, error) {
+		if activity.IsActivity(ctx) {
+			return sdktypes.InvalidApproval, errForbiddenInActivity
+		}
+
+		_, span := w.startCallbackSpan(ctx, "request_approval")
+		defer span.End()
+
+		span.SetAttributes(attribute.StringSlice("approvers", approvers), attribute.Int64("timeout", int64(timeout)))
+
+		if title == "" {
+			return sdktypes.InvalidApproval, sdkerrors.NewInvalidArgumentError("missing title")
+		}
+
+		var expiresAt time.Time
+		if timeout != 0 {
+			expiresAt = workflow.Now(wctx).Add(timeout)
+		}
+
+		var approval sdktypes.Approval
+
+		if err := workflow.ExecuteActivity(
+			wctx,
+			createApprovalActivityName,
+			w.data.Session.ID(),
+			w.data.Session.ProjectID(),
+			title,
+			approvers,
+			expiresAt,
+		).Get(wctx, &approval); err != nil {
+			return sdktypes.InvalidApproval, err
+		}
+
+		aid := approval.ID()
+
+		span.SetAttributes(attribute.String("approval_id", aid.String()))
+
+		// A nil signal means the timeout has passed. Either way, the record in the
+		// database is the source of truth: the activity below expires the approval
+		// if it is still pending, or returns the decision that was made.
+		if _, err := w.nextSignal(wctx)(ctx, rid, []string{aid.String()}, timeout); err != nil {
+			return sdktypes.InvalidApproval, err
+		}
+
+		if err := workflow.ExecuteActivity(wctx, finishApprovalActivityName, aid).Get(wctx, &approval); err != nil {
+			return sdktypes.InvalidApproval, err
+		}
+
+		return approval, nil
+	}
+}
+
+func (ws *workflows) createApprovalActivity(ctx context.Context, sid sdktypes.SessionID, pid sdktypes.ProjectID, title string, approvers []string, expiresAt time.Time) (sdktypes.Approval, error) {
+	a := sdktypes.NewApproval(sid, pid, title, approvers)
+
+	if !expiresAt.IsZero() {
+		a = a.WithExpiresAt(expiresAt)
+	}
+
+	if err := ws.svcs.DB.CreateApproval(ctx, a); err != nil {
+		return sdktypes.InvalidApproval, err
+	}
+
+	return a, nil
+}
+
+// finishApprovalActivity expires the approval if it is still pending, and
+// returns its final state.
+func (ws *workflows) finishApprovalActivity(ctx context.Context, aid sdktypes.ApprovalID) (sdktypes.Approval, error) {
+	a, err := ws.svcs.DB.DecideApproval(ctx, aid, sdktypes.ApprovalStateExpired, "", "")
+	if errors.Is(err, sdkerrors.ErrFailedPrecondition) {
+		// Already decided.
+		return ws.svcs.DB.GetApproval(ctx, aid)
+	}
+
+	return a, err
+}
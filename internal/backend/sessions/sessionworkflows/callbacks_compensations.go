@@ -0,0 +1,96 @@
+package sessionworkflows
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+type compensation struct {
+	runID  sdktypes.RunID
+	fn     sdktypes.Value
+	args   []sdktypes.Value
+	kwargs map[string]sdktypes.Value
+}
+
+func (w *sessionWorkflow) registerCompensation(wctx workflow.Context) func(context.Context, sdktypes.RunID, sdktypes.Value, []sdktypes.Value, map[string]sdktypes.Value) error {
+	return func(ctx context.Context, rid sdktypes.RunID, fn sdktypes.Value, args []sdktypes.Value, kwargs map[string]sdktypes.Value) error {
+		if activity.IsActivity(ctx) {
+			return errForbiddenInActivity
+		}
+
+		_, span := w.startCallbackSpan(ctx, "register_compensation")
+		defer span.End()
+
+		if !fn.IsFunction() {
+			return sdkerrors.NewInvalidArgumentError("compensation must be a function")
+		}
+
+		span.SetAttributes(attribute.String("function_name", fn.GetFunction().Name().String()))
+
+		if !w.data.Session.IsDurable() {
+			// Compensations are called as activities, which are not available in non-durable sessions.
+			return sdkerrors.NewInvalidArgumentError("compensations are supported only in durable sessions")
+		}
+
+		w.compensations = append(w.compensations, compensation{runID: rid, fn: fn, args: args, kwargs: kwargs})
+
+		return nil
+	}
+}
+
+// compensate calls all registered compensations in reverse order of registration,
+// each as an activity. A failed compensation is recorded and does not prevent the
+// rest from being called. Each compensation is called at most once.
+//
+// The session might have been stopped, so this works with a disconnected context.
+func (w *sessionWorkflow) compensate(wctx workflow.Context) {
+	if len(w.compensations) == 0 {
+		return
+	}
+
+	wctx, cancel := workflow.NewDisconnectedContext(wctx)
+	defer cancel()
+
+	l := w.l.With(zap.Int("compensations", len(w.compensations)))
+	l.Info("running compensations")
+
+	for len(w.compensations) > 0 {
+		last := len(w.compensations) - 1
+		c := w.compensations[last]
+		w.compensations = w.compensations[:last]
+
+		l := l.With(zap.String("function", c.fn.GetFunction().Name().String()))
+
+		var perr sdktypes.ProgramError
+
+		if _, err := w.call(wctx, c.runID, c.fn, c.args, c.kwargs); err != nil {
+			l.Warn("compensation failed", zap.Error(err))
+
+			var ok bool
+			if perr, ok = sdktypes.FromError(err); !ok {
+				perr = sdktypes.NewProgramError(sdktypes.NewStringValue(err.Error()), nil, nil)
+			}
+		}
+
+		if err := workflow.ExecuteActivity(wctx, addSessionCompensationActivityName, w.data.Session.ID(), c.fn, w.callSeq, perr).Get(wctx, nil); err != nil {
+			l.Error("add compensation record failed", zap.Error(err))
+		}
+	}
+}
+
+func (ws *workflows) addSessionCompensationActivity(ctx context.Context, sid sdktypes.SessionID, fn sdktypes.Value, callSeq uint32, perr sdktypes.ProgramError) error {
+	if err := ws.svcs.DB.AddSessionCompensation(ctx, sid, fn, callSeq, perr); err != nil {
+		return temporalclient.TranslateError(err, "add compensation record for %v", sid)
+	}
+
+	ws.svcs.Notifier.Notify(sid)
+	return nil
+}
@@ -3,6 +3,8 @@ package sessionworkflows
 import (
 	"context"
 	"fmt"
+	"maps"
+	"strconv"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -35,6 +37,18 @@ func (w *sessionWorkflow) start(wctx workflow.Context) func(context.Context, sdk
 		data := w.data
 		parentSessionID := data.Session.ID()
 
+		// Store changes made by the child continue the parent's store event chain.
+		memo = maps.Clone(memo)
+		if depth := w.storeEventDepth(); depth > 0 {
+			if memo == nil {
+				memo = make(map[string]string, 1)
+			}
+
+			memo[storeEventDepthMemoKey] = strconv.Itoa(depth)
+		} else {
+			delete(memo, storeEventDepthMemoKey)
+		}
+
 		data.Session = sdktypes.NewSession(data.Session.BuildID(), loc, inputs, memo).
 			WithParentSessionID(parentSessionID).
 			WithDeploymentID(data.Session.DeploymentID()).
@@ -0,0 +1,204 @@
+package sessionworkflows
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/workflow"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+// waitSessions blocks until all given child sessions reach a final state, or
+// until the timeout passes. The returned states are in the same order as the
+// given session IDs. States of sessions that did not finish in time are invalid.
+//
+// Durable children signal their parent when they are done, so they are checked
+// only when their signal arrives. Non-durable children do not, so their states
+// are polled, with an exponential backoff.
+func (w *sessionWorkflow) waitSessions(wctx workflow.Context) func(context.Context, sdktypes.RunID, []sdktypes.SessionID, time.Duration) ([]sdktypes.SessionState, error) {
+	return func(ctx context.Context, _ sdktypes.RunID, sids []sdktypes.SessionID, timeout time.Duration) ([]sdktypes.SessionState, error) {
+		if activity.IsActivity(ctx) {
+			return nil, errForbiddenInActivity
+		}
+
+		_, span := w.startCallbackSpan(ctx, "wait_sessions")
+		defer span.End()
+
+		span.SetAttributes(
+			attribute.StringSlice("session_ids", kittehs.TransformToStrings(sids)),
+			attribute.Int64("timeout", int64(timeout)),
+		)
+
+		var (
+			states  = make([]sdktypes.SessionState, len(sids))
+			durable = make([]bool, len(sids))
+			pending = len(sids)
+		)
+
+		check := func(i int) (bool, error) {
+			var status childSessionStatus
+			if err := workflow.ExecuteActivity(wctx, getChildSessionFinalStateActivityName, w.data.Session.ID(), sids[i]).Get(wctx, &status); err != nil {
+				return false, err
+			}
+
+			if states[i] = status.State; states[i].IsValid() {
+				pending--
+			}
+
+			return status.Durable, nil
+		}
+
+		for i := range sids {
+			var err error
+			if durable[i], err = check(i); err != nil {
+				return nil, err
+			}
+		}
+
+		var timer workflow.Future
+		if timeout != 0 {
+			timer = workflow.NewTimer(wctx, timeout)
+		}
+
+		var (
+			interval   = w.ws.cfg.WaitSessionsPollInterval
+			pollTimer  workflow.Future
+			cancelPoll = func() {}
+		)
+
+		defer func() { cancelPoll() }()
+
+		for pending > 0 && (timer == nil || !timer.IsReady()) {
+			selector := workflow.NewSelector(wctx)
+
+			if timer != nil {
+				selector.AddFuture(timer, func(workflow.Future) {})
+			}
+
+			var (
+				poll     bool
+				polled   bool
+				signaled = -1
+			)
+
+			for i, sid := range sids {
+				if states[i].IsValid() {
+					continue
+				}
+
+				if !durable[i] {
+					poll = true
+					continue
+				}
+
+				selector.AddReceive(workflow.GetSignalChannel(wctx, sessionSignalName(sid)), func(c workflow.ReceiveChannel, _ bool) {
+					// The payload is ignored - the state is read from the database,
+					// which also covers the errors and stop reasons.
+					var v sdktypes.Value
+					c.ReceiveAsync(&v)
+
+					signaled = i
+				})
+			}
+
+			if poll {
+				// Signals do not delay the next poll.
+				if pollTimer == nil {
+					var pollCtx workflow.Context
+					pollCtx, cancelPoll = workflow.WithCancel(wctx)
+					pollTimer = workflow.NewTimer(pollCtx, interval)
+				}
+
+				selector.AddFuture(pollTimer, func(workflow.Future) { polled, pollTimer = true, nil })
+			}
+
+			// Select doesn't respond to cancellations unless we add a receive on the context done channel.
+			var cancelled bool
+			selector.AddReceive(wctx.Done(), func(c workflow.ReceiveChannel, _ bool) { cancelled = true })
+
+			selector.Select(wctx)
+
+			if cancelled {
+				return nil, wctx.Err()
+			}
+
+			switch {
+			case signaled >= 0:
+				if _, err := check(signaled); err != nil {
+					return nil, err
+				}
+
+				// A child signals only after its final state is saved, so this
+				// is not expected. If it happens anyway, poll the child instead.
+				if !states[signaled].IsValid() {
+					durable[signaled] = false
+				}
+
+			case polled:
+				for i := range sids {
+					if !states[i].IsValid() && !durable[i] {
+						if _, err := check(i); err != nil {
+							return nil, err
+						}
+					}
+				}
+
+				if m := w.ws.cfg.WaitSessionsMaxPollInterval; m > interval {
+					interval = min(interval*2, m)
+				}
+			}
+		}
+
+		return states, nil
+	}
+}
+
+// childSessionStatus is returned by getChildSessionFinalStateActivity.
+type childSessionStatus struct {
+	// Invalid if the child is not done yet.
+	State sdktypes.SessionState
+
+	// Durable children signal their parent when they are done.
+	Durable bool
+}
+
+// getChildSessionFinalStateActivity returns the final state of a child session
+// of the given parent, or an invalid state if the child is not done yet.
+func (ws *workflows) getChildSessionFinalStateActivity(ctx context.Context, parentID, sid sdktypes.SessionID) (childSessionStatus, error) {
+	s, err := ws.svcs.DB.GetSession(ctx, sid)
+	if err != nil {
+		return childSessionStatus{}, temporalclient.TranslateError(err, "get session %v", sid)
+	}
+
+	if s.ParentSessionID() != parentID {
+		return childSessionStatus{}, temporalclient.TranslateError(sdkerrors.NewInvalidArgumentError("session %v is not a child of %v", sid, parentID), "get session %v", sid)
+	}
+
+	status := childSessionStatus{Durable: s.IsDurable()}
+
+	if !s.State().IsFinal() {
+		return status, nil
+	}
+
+	log, err := ws.svcs.DB.GetSessionLog(ctx, sdkservices.SessionLogRecordsFilter{
+		SessionID:         sid,
+		Types:             sdktypes.StateSessionLogRecordType,
+		PaginationRequest: sdktypes.PaginationRequest{PageSize: 1},
+	})
+	if err != nil {
+		return childSessionStatus{}, temporalclient.TranslateError(err, "get session log for %v", sid)
+	}
+
+	if len(log.Records) > 0 {
+		status.State = log.Records[0].GetState()
+	}
+
+	return status, nil
+}
@@ -0,0 +1,146 @@
+package sessionworkflows
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"gotest.tools/v3/assert"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessiondata"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func (m *mockDB) GetSessionLog(_ context.Context, filter sdkservices.SessionLogRecordsFilter) (*sdkservices.GetLogResults, error) {
+	args := m.Called(filter)
+	return args.Get(0).(*sdkservices.GetLogResults), args.Error(1)
+}
+
+var (
+	childID = sdktypes.NewSessionID()
+	child   = session.WithID(childID).WithParentSessionID(sid)
+)
+
+func TestGetChildSessionFinalStateRunning(t *testing.T) {
+	ws, db, _ := setup(t)
+
+	db.On("GetSession", childID).Return(child, nil).Once()
+
+	status, err := ws.getChildSessionFinalStateActivity(t.Context(), sid, childID)
+	assert.NilError(t, err)
+	assert.Assert(t, !status.State.IsValid())
+	assert.Assert(t, !status.Durable)
+
+	mock.AssertExpectationsForObjects(t, db)
+}
+
+func TestGetChildSessionFinalStateCompleted(t *testing.T) {
+	ws, db, _ := setup(t)
+
+	completed := sdktypes.NewSessionStateCompleted(nil, nil, sdktypes.NewIntegerValue(42))
+
+	db.On("GetSession", childID).Return(child.WithState(sdktypes.SessionStateTypeCompleted), nil).Once()
+	db.On("GetSessionLog", sdkservices.SessionLogRecordsFilter{
+		SessionID:         childID,
+		Types:             sdktypes.StateSessionLogRecordType,
+		PaginationRequest: sdktypes.PaginationRequest{PageSize: 1},
+	}).Return(&sdkservices.GetLogResults{
+		Records: []sdktypes.SessionLogRecord{sdktypes.NewStateSessionLogRecord(kittehs.Now(), completed)},
+	}, nil).Once()
+
+	status, err := ws.getChildSessionFinalStateActivity(t.Context(), sid, childID)
+	assert.NilError(t, err)
+	assert.Equal(t, status.State.GetCompleted().ReturnValue().GetInteger().Value(), int64(42))
+
+	mock.AssertExpectationsForObjects(t, db)
+}
+
+func TestGetChildSessionFinalStateNotChild(t *testing.T) {
+	ws, db, _ := setup(t)
+
+	db.On("GetSession", childID).Return(child.WithParentSessionID(sdktypes.NewSessionID()), nil).Once()
+
+	_, err := ws.getChildSessionFinalStateActivity(t.Context(), sid, childID)
+	assert.Assert(t, sdkerrors.IsInvalidArgumentError(err))
+
+	mock.AssertExpectationsForObjects(t, db)
+}
+
+func TestWaitSessionsPollsOnlyNonDurable(t *testing.T) {
+	ws, _, _ := setup(t)
+	ws.cfg.WaitSessionsPollInterval = time.Second
+	ws.cfg.WaitSessionsMaxPollInterval = 4 * time.Second
+
+	w := &sessionWorkflow{ws: ws, data: sessiondata.Data{Session: session}}
+
+	durableID, nonDurableID := sdktypes.NewSessionID(), sdktypes.NewSessionID()
+
+	completed := sdktypes.NewSessionStateCompleted(nil, nil, sdktypes.Nothing)
+
+	var (
+		suite testsuite.WorkflowTestSuite
+		env   = suite.NewTestWorkflowEnvironment()
+
+		t0          time.Time
+		durableDone bool
+		checks      = make(map[sdktypes.SessionID][]time.Duration)
+	)
+
+	env.RegisterActivityWithOptions(
+		func(_ context.Context, _, sid sdktypes.SessionID) (childSessionStatus, error) {
+			elapsed := env.Now().Sub(t0)
+			checks[sid] = append(checks[sid], elapsed)
+
+			if sid == durableID {
+				if durableDone {
+					return childSessionStatus{State: completed, Durable: true}, nil
+				}
+
+				return childSessionStatus{Durable: true}, nil
+			}
+
+			if elapsed >= 10*time.Second {
+				return childSessionStatus{State: completed}, nil
+			}
+
+			return childSessionStatus{}, nil
+		},
+		activity.RegisterOptions{Name: getChildSessionFinalStateActivityName},
+	)
+
+	env.RegisterDelayedCallback(func() {
+		durableDone = true
+		env.SignalWorkflow(sessionSignalName(durableID), sdktypes.Nothing)
+	}, 5*time.Second)
+
+	var states []sdktypes.SessionState
+
+	env.ExecuteWorkflow(func(wctx workflow.Context) error {
+		wctx = workflow.WithActivityOptions(wctx, workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+
+		t0 = workflow.Now(wctx)
+
+		var err error
+		states, err = w.waitSessions(wctx)(t.Context(), sdktypes.InvalidRunID, []sdktypes.SessionID{durableID, nonDurableID}, time.Hour)
+		return err
+	})
+
+	assert.NilError(t, env.GetWorkflowError())
+
+	assert.Equal(t, len(states), 2)
+	assert.Assert(t, states[0].IsValid())
+	assert.Assert(t, states[1].IsValid())
+
+	// The durable child is checked only initially and when it signals.
+	assert.DeepEqual(t, checks[durableID], []time.Duration{0, 5 * time.Second})
+
+	// The non-durable child is polled with an exponential backoff.
+	assert.DeepEqual(t, checks[nonDurableID], []time.Duration{0, time.Second, 3 * time.Second, 7 * time.Second, 11 * time.Second})
+}
@@ -70,6 +70,13 @@ func (w *sessionWorkflow) nextSignal(wctx workflow.Context) func(context.Context
 				}
 
 				names[i] = sessionSignalName(sid)
+			} else if strings.HasPrefix(name, sdktypes.ApprovalIDKind+"_") {
+				aid, err := sdktypes.ParseApprovalID(name)
+				if err != nil {
+					return nil, sdkerrors.NewInvalidArgumentError("invalid approval id %q: %w", name, err)
+				}
+
+				names[i] = approvalSignalName(aid)
 			} else {
 				names[i] = userSignalName(name)
 			}
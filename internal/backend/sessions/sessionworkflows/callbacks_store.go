@@ -2,10 +2,12 @@ package sessionworkflows
 
 import (
 	"context"
+	"strconv"
 
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/workflow"
 
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
 )
 
@@ -27,13 +29,15 @@ func (w *sessionWorkflow) listStoreValues(wctx workflow.Context) func(context.Co
 
 func (w *sessionWorkflow) mutateStoreValue(wctx workflow.Context) func(context.Context, sdktypes.RunID, string, string, ...sdktypes.Value) (sdktypes.Value, error) {
 	return func(ctx context.Context, _ sdktypes.RunID, key, op string, operands ...sdktypes.Value) (sdktypes.Value, error) {
+		depth := w.storeEventDepth()
+
 		if activity.IsActivity(ctx) {
-			return w.ws.mutateStoreValueActivity(ctx, w.data.Session.ProjectID(), key, op, operands)
+			return w.ws.mutateStoreValueActivity(ctx, w.data.Session.ProjectID(), key, op, operands, w.data.Session.ID(), depth)
 		}
 
 		var v sdktypes.Value
 
-		if err := workflow.ExecuteActivity(wctx, mutateStoreValueActivityName, w.data.Session.ProjectID(), key, op, operands).Get(wctx, &v); err != nil {
+		if err := workflow.ExecuteActivity(wctx, mutateStoreValueActivityName, w.data.Session.ProjectID(), key, op, operands, w.data.Session.ID(), depth).Get(wctx, &v); err != nil {
 			return sdktypes.InvalidValue, err
 		}
 
@@ -41,6 +45,44 @@ func (w *sessionWorkflow) mutateStoreValue(wctx workflow.Context) func(context.C
 	}
 }
 
+// Session memo key in which child sessions carry the store event depth of
+// their parent, as they do not have a triggering event of their own.
+const storeEventDepthMemoKey = "store_event_depth"
+
+// storeEventDepth returns the depth of store events the session's store
+// changes emit. It is one more than the depth of the store event that started
+// the session, if it was started by one, the depth of the parent session
+// for child sessions, or zero otherwise.
+func (w *sessionWorkflow) storeEventDepth() int {
+	session := w.data.Session
+
+	if session.ParentSessionID().IsValid() {
+		depth, err := strconv.Atoi(session.Memo()[storeEventDepthMemoKey])
+		if err != nil {
+			return 0
+		}
+
+		return max(depth, 0)
+	}
+
+	tid := session.TriggerID()
+	if !tid.IsValid() {
+		return 0
+	}
+
+	i, t := kittehs.FindFirst(w.data.Triggers, func(t sdktypes.Trigger) bool { return t.ID() == tid })
+	if i < 0 || t.SourceType() != sdktypes.TriggerSourceTypeStore {
+		return 0
+	}
+
+	depth, ok := session.Inputs()["depth"]
+	if !ok || !depth.IsInteger() {
+		return 1
+	}
+
+	return int(depth.GetInteger().Value()) + 1
+}
+
 func (w *sessionWorkflow) publishStoreValue(wctx workflow.Context) func(context.Context, sdktypes.RunID, string) error {
 	return func(ctx context.Context, _ sdktypes.RunID, key string) error {
 		if activity.IsActivity(ctx) {
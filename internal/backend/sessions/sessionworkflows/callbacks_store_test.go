@@ -0,0 +1,107 @@
+package sessionworkflows
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessiondata"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func TestStoreEventDepth(t *testing.T) {
+	store := sdktypes.NewTrigger(sdktypes.NewSymbol("store")).WithNewID().WithSourceType(sdktypes.TriggerSourceTypeStore)
+	webhook := sdktypes.NewTrigger(sdktypes.NewSymbol("webhook")).WithNewID().WithSourceType(sdktypes.TriggerSourceTypeWebhook)
+
+	depth := func(s sdktypes.Session) int {
+		w := sessionWorkflow{data: sessiondata.Data{Session: s, Triggers: []sdktypes.Trigger{store, webhook}}}
+		return w.storeEventDepth()
+	}
+
+	inputs := map[string]sdktypes.Value{"depth": sdktypes.NewIntegerValue(2)}
+
+	assert.Equal(t, depth(session), 0)
+	assert.Equal(t, depth(session.WithTriggerID(webhook.ID()).WithInputs(inputs)), 0)
+	assert.Equal(t, depth(session.WithTriggerID(store.ID())), 1)
+	assert.Equal(t, depth(session.WithTriggerID(store.ID()).WithInputs(inputs)), 3)
+}
+
+func TestStoreEventDepthInChildSessions(t *testing.T) {
+	store := sdktypes.NewTrigger(sdktypes.NewSymbol("store")).WithNewID().WithSourceType(sdktypes.TriggerSourceTypeStore)
+
+	var (
+		suite testsuite.WorkflowTestSuite
+		env   = suite.NewTestWorkflowEnvironment()
+
+		children []sdktypes.Session
+		depths   []int
+	)
+
+	env.RegisterActivityWithOptions(
+		func(_ context.Context, s sdktypes.Session) (sdktypes.SessionID, error) {
+			children = append(children, s)
+			return sdktypes.NewSessionID(), nil
+		},
+		activity.RegisterOptions{Name: startChildSessionActivityName},
+	)
+
+	env.RegisterActivityWithOptions(
+		func(_ context.Context, _ sdktypes.ProjectID, _, _ string, _ []sdktypes.Value, _ sdktypes.SessionID, depth int) (sdktypes.Value, error) {
+			depths = append(depths, depth)
+			return sdktypes.Nothing, nil
+		},
+		activity.RegisterOptions{Name: mutateStoreValueActivityName},
+	)
+
+	newWorkflow := func(s sdktypes.Session) *sessionWorkflow {
+		return &sessionWorkflow{l: zap.NewNop(), data: sessiondata.Data{Session: s, Triggers: []sdktypes.Trigger{store}}}
+	}
+
+	loc := kittehs.Must1(sdktypes.ParseCodeLocation("child:1"))
+
+	env.ExecuteWorkflow(func(wctx workflow.Context) error {
+		wctx = workflow.WithActivityOptions(wctx, workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+
+		ctx := context.Background()
+
+		// A session started by a store event of depth 2 starts a child, which starts a grandchild.
+		parent := newWorkflow(session.WithTriggerID(store.ID()).WithInputs(map[string]sdktypes.Value{"depth": sdktypes.NewIntegerValue(2)}))
+
+		// A child cannot reset its depth through the memo.
+		memo := map[string]string{storeEventDepthMemoKey: "0"}
+
+		if _, err := parent.start(wctx)(ctx, sdktypes.InvalidRunID, sdktypes.InvalidSymbol, loc, nil, memo); err != nil {
+			return err
+		}
+
+		child := newWorkflow(children[0].WithNewID())
+
+		if _, err := child.start(wctx)(ctx, sdktypes.InvalidRunID, sdktypes.InvalidSymbol, loc, nil, nil); err != nil {
+			return err
+		}
+
+		grandchild := newWorkflow(children[1].WithNewID())
+
+		for _, w := range []*sessionWorkflow{parent, child, grandchild} {
+			if _, err := w.mutateStoreValue(wctx)(ctx, sdktypes.InvalidRunID, "k", "set", sdktypes.NewIntegerValue(1)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	assert.NilError(t, env.GetWorkflowError())
+
+	assert.DeepEqual(t, depths, []int{3, 3, 3})
+
+	// Sessions not started by store events do not pass a depth to their children.
+	assert.Equal(t, newWorkflow(session.WithParentSessionID(sid)).storeEventDepth(), 0)
+}
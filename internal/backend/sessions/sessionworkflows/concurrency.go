@@ -0,0 +1,43 @@
+package sessionworkflows
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessiondata"
+	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
+	"go.autokitteh.dev/autokitteh/internal/backend/triggerqueue"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func (ws *workflows) admitQueuedTriggerEventsActivity(ctx context.Context, tid sdktypes.TriggerID) error {
+	t, err := ws.svcs.DB.GetTriggerByID(ctx, tid)
+	if err != nil {
+		return temporalclient.TranslateError(err, "get trigger %v", tid)
+	}
+
+	err = triggerqueue.AdmitQueuedEvents(ctx, ws.svcs.DB, ws.svcs.Temporal.TemporalClient(), t)
+	return temporalclient.TranslateError(err, "admit queued events for %v", tid)
+}
+
+// admitQueuedTriggerEvents lets the next events queued by the session's trigger
+// start, now that the session has ended and freed its slot. If this is skipped,
+// ie the workflow was terminated, the queued events eventually find out on their own.
+func (ws *workflows) admitQueuedTriggerEvents(wctx workflow.Context, l *zap.Logger, data *sessiondata.Data) {
+	tid := data.Session.TriggerID()
+	if !tid.IsValid() {
+		return
+	}
+
+	i, trigger := kittehs.FindFirst(data.Triggers, func(t sdktypes.Trigger) bool { return t.ID() == tid })
+	if i < 0 || trigger.Concurrency().Overlap() != sdktypes.ConcurrencyOverlapQueue {
+		return
+	}
+
+	if err := workflow.ExecuteActivity(wctx, admitQueuedTriggerEventsActivityName, tid).Get(wctx, nil); err != nil {
+		l.Error("admit queued trigger events failed", zap.Error(err))
+	}
+}
@@ -9,6 +9,8 @@ import (
 type Config struct {
 	// SessionWorkflow     temporalclient.WorkflowConfig `koanf:"session_workflow"`
 	TerminationWorkflow temporalclient.WorkflowConfig `koanf:"termination_workflow"`
+	BulkWorkflow        temporalclient.WorkflowConfig `koanf:"bulk_workflow"`
+	RetryWorkflow       temporalclient.WorkflowConfig `koanf:"retry_workflow"`
 
 	Activity temporalclient.ActivityConfig `koanf:"activity"`
 
@@ -21,4 +23,14 @@ type Config struct {
 
 	// NextEvent
 	NextEventInActivityPollDuration time.Duration `koanf:"next_event_in_activity_poll_duration"`
+
+	// How long the session's on_stop handler may run for before it is canceled.
+	OnStopGracePeriod time.Duration `koanf:"on_stop_grace_period"`
+
+	// How often the states of non-durable child sessions are first polled
+	// while waiting for them. The interval doubles after each poll, up to
+	// the max interval. Durable children signal their parent when done,
+	// so they are not polled.
+	WaitSessionsPollInterval    time.Duration `koanf:"wait_sessions_poll_interval"`
+	WaitSessionsMaxPollInterval time.Duration `koanf:"wait_sessions_max_poll_interval"`
 }
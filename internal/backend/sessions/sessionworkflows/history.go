@@ -166,6 +166,61 @@ func (ws *workflows) GetWorkflowLog(ctx context.Context, filter sdkservices.Sess
 	}, nil
 }
 
+// WatchWorkflowLog calls f with each record of the types in the workflow
+// history of the session, in order and as it is appended. The history is
+// long polled, following its next page token, so new records are seen without
+// re-reading the history and regardless of which worker runs the session.
+// It returns once the workflow is closed and all of its records were passed
+// to f, or when either ctx or f fail.
+func (ws *workflows) WatchWorkflowLog(ctx context.Context, sid sdktypes.SessionID, types sdktypes.SessionLogRecordType, f func(sdktypes.SessionLogRecord) error) error {
+	l := ws.l.With(zap.String("session_id", sid.String()))
+
+	iter := ws.svcs.Temporal.TemporalClient().GetWorkflowHistory(
+		ctx,
+		workflowID(sid),
+		"",
+		true,
+		enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT,
+	)
+
+	// See comment on `events` in GetWorkflowLog.
+	events := make(map[int64]*historypb.HistoryEvent)
+
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			var notFound *serviceerror.NotFound
+			if errors.As(err, &notFound) {
+				return sdkerrors.ErrNotFound
+			}
+
+			return temporalclient.TranslateError(err, "watch workflow history")
+		}
+
+		if event == nil {
+			l.Error("nil event from temporal")
+			continue
+		}
+
+		events[event.EventId] = event
+
+		r, err := parseTemporalHistoryEvent(l, event.GetEventTime().AsTime(), event, events, types, ws.svcs.Temporal.DataConverter())
+		if err != nil {
+			return fmt.Errorf("event %d: %w", event.GetEventId(), err)
+		}
+
+		if !r.IsValid() {
+			continue
+		}
+
+		if err := f(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func parseTemporalHistoryEvent(l *zap.Logger, t time.Time, event *historypb.HistoryEvent, events map[int64]*historypb.HistoryEvent, types sdktypes.SessionLogRecordType, dc converter.DataConverter) (sdktypes.SessionLogRecord, error) {
 	switch a := event.Attributes.(type) {
 	case *historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes:
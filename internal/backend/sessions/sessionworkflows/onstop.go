@@ -0,0 +1,75 @@
+package sessionworkflows
+
+import (
+	"maps"
+
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+// onStopSession returns the session to run the on_stop handler of the given
+// session in, or false if it has none. The handler gets the same inputs as the
+// session, with the stop reason added as "stop_reason".
+func onStopSession(session sdktypes.Session, reason string) (sdktypes.Session, bool) {
+	loc := session.OnStop()
+	if !loc.IsValid() {
+		return sdktypes.InvalidSession, false
+	}
+
+	inputs := maps.Clone(session.Inputs())
+	if inputs == nil {
+		inputs = make(map[string]sdktypes.Value, 1)
+	}
+
+	inputs["stop_reason"] = sdktypes.NewStringValue(reason)
+
+	return session.WithEndpoint(loc).WithInputs(inputs), true
+}
+
+// onStop runs the session's on_stop handler, if it has one, after the session
+// was stopped or timed out. It is canceled if it does not complete within the
+// configured grace period.
+//
+// The handler does not run when the session is forcibly terminated, as the
+// session workflow is terminated without getting a chance to run it.
+//
+// The session is already stopped, so this assumes the given wctx is a
+// disconnected context.
+func (ws *workflows) onStop(wctx workflow.Context, w *sessionWorkflow, reason string) {
+	session, ok := onStopSession(w.data.Session, reason)
+	if !ok {
+		return
+	}
+
+	l := w.l.With(zap.String("on_stop", session.OnStop().CanonicalString()))
+
+	data := w.data
+	data.Session = session
+
+	sw := newSessionWorkflow(wctx, l, ws, data)
+	sw.isOnStop = true
+	sw.callSeq = w.callSeq // continue the session's call sequence.
+
+	ctx, cancel := workflow.WithCancel(wctx)
+	defer cancel()
+
+	if grace := ws.cfg.OnStopGracePeriod; grace > 0 {
+		workflow.Go(ctx, func(ctx workflow.Context) {
+			if err := workflow.Sleep(ctx, grace); err == nil {
+				l.Warn("on_stop grace period passed, canceling", zap.Duration("grace_period", grace))
+				cancel()
+			}
+		})
+	}
+
+	l.Info("running on_stop handler")
+
+	if _, _, err := sw.runWorkflow(ctx); err != nil {
+		l.Warn("on_stop handler failed", zap.Error(err))
+		return
+	}
+
+	l.Info("on_stop handler completed")
+}
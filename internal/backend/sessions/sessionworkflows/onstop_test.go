@@ -0,0 +1,36 @@
+package sessionworkflows
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func TestOnStopSession(t *testing.T) {
+	_, ok := onStopSession(session, "meow")
+	assert.Assert(t, !ok)
+
+	loc := kittehs.Must1(sdktypes.ParseCodeLocation("main.py:cleanup"))
+
+	s := session.
+		WithInputs(map[string]sdktypes.Value{"data": sdktypes.NewStringValue("woof")}).
+		WithOnStop(loc)
+
+	stop, ok := onStopSession(s, "meow")
+	assert.Assert(t, ok)
+
+	assert.Equal(t, stop.EntryPoint().CanonicalString(), loc.CanonicalString())
+	assert.Equal(t, stop.ID(), sid)
+
+	inputs := stop.Inputs()
+	assert.Equal(t, len(inputs), 2)
+	assert.Equal(t, inputs["data"].GetString().Value(), "woof")
+	assert.Equal(t, inputs["stop_reason"].GetString().Value(), "meow")
+
+	// The stopped session is left as is.
+	_, ok = s.Inputs()["stop_reason"]
+	assert.Assert(t, !ok)
+}
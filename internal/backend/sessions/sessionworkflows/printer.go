@@ -6,6 +6,7 @@ import (
 	"go.uber.org/zap"
 
 	"go.autokitteh.dev/autokitteh/internal/backend/db"
+	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessionnotify"
 	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
 )
@@ -18,9 +19,10 @@ const maxPrints = 1024
 // in order to consistently get all written data the `Finalize` method
 // must be called.
 type printer struct {
-	l   *zap.Logger
-	db  db.DB
-	sid sdktypes.SessionID
+	l        *zap.Logger
+	db       db.DB
+	notifier *sessionnotify.Notifier[sdktypes.SessionID]
+	sid      sdktypes.SessionID
 
 	all      []sdkservices.SessionPrint // do not get prints from here, use Finalize() instead.
 	overflow bool                       // true if exceeded max prints.
@@ -62,7 +64,7 @@ func (p *printer) Print(sp sdkservices.SessionPrint, activityCallSeq uint32, isR
 }
 
 func (w *sessionWorkflow) newPrinter() *printer {
-	pr := &printer{ch: make(chan *print, 32), done: make(chan struct{}), l: w.l, db: w.ws.svcs.DB, sid: w.data.Session.ID()}
+	pr := &printer{ch: make(chan *print, 32), done: make(chan struct{}), l: w.l, db: w.ws.svcs.DB, notifier: w.ws.svcs.Notifier, sid: w.data.Session.ID()}
 
 	return pr
 }
@@ -74,7 +76,10 @@ func (pr *printer) Start() {
 		for p := range pr.ch {
 			if err := pr.db.AddSessionPrint(ctx, pr.sid, p.Value, p.activityCallSeq); err != nil {
 				pr.l.Error("failed to add session print", zap.Error(err))
+				continue
 			}
+
+			pr.notifier.Notify(pr.sid)
 		}
 
 		close(pr.done)
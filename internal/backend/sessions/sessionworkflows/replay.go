@@ -0,0 +1,412 @@
+package sessionworkflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessiondata"
+	"go.autokitteh.dev/autokitteh/internal/backend/types"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkruntimes"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+var errReplayStopped = errors.New("replay stopped")
+
+func errNotReplayable(what string) error {
+	return fmt.Errorf("%s is not supported during replay", what)
+}
+
+// ReplayWorkflow re-runs a finished durable session outside of temporal.
+// Calls are never executed: each call is fed back the result recorded for
+// its sequence number in the workflow history, so the replay has no side
+// effects. Callbacks that go through temporal, such as the store, event
+// subscriptions, signals, child sessions and sleeps, are fed back their
+// recorded results as well, and the session clock follows the recorded
+// history. Callbacks that wait on other sessions or on signals fail the
+// replay.
+func (ws *workflows) ReplayWorkflow(ctx context.Context, data *sessiondata.Data, opts sdkservices.ReplaySessionOptions) (*sdkservices.ReplaySessionResult, error) {
+	hist, err := ws.getReplayHistory(ctx, data.Session.ID())
+	if errors.Is(err, sdkerrors.ErrNotFound) {
+		// Imported sessions have no workflow history, their calls are in the db.
+		var log *sdkservices.GetLogResults
+
+		log, err = ws.svcs.DB.GetSessionLog(ctx, sdkservices.SessionLogRecordsFilter{
+			SessionID:         data.Session.ID(),
+			Types:             sdktypes.CallSpecSessionLogRecordType | sdktypes.CallAttemptCompleteSessionLogRecordType,
+			PaginationRequest: sdktypes.PaginationRequest{Ascending: true},
+		})
+		if err == nil {
+			hist = newLogReplayHistory(data.Session.CreatedAt(), log.Records)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ws.replay(ctx, data, hist, opts)
+}
+
+// recordedCalls pairs each call spec in the log with the result of its
+// last completed attempt, and the time it completed at.
+func recordedCalls(rs []sdktypes.SessionLogRecord) (map[uint32]sdkservices.ReplayedSessionCall, map[uint32]time.Time) {
+	calls := make(map[uint32]sdkservices.ReplayedSessionCall)
+	times := make(map[uint32]time.Time)
+
+	var seq uint32
+
+	for _, r := range rs {
+		if spec := r.GetCallSpec(); spec.IsValid() {
+			seq = spec.Seq()
+			calls[seq] = sdkservices.ReplayedSessionCall{Spec: spec}
+		} else if complete := r.GetCallAttemptComplete(); complete.IsValid() {
+			if call, ok := calls[seq]; ok {
+				call.Result = complete.Result()
+				calls[seq] = call
+				times[seq] = r.Timestamp()
+			}
+		}
+	}
+
+	return calls, times
+}
+
+func (ws *workflows) replay(ctx context.Context, data *sessiondata.Data, hist *replayHistory, opts sdkservices.ReplaySessionOptions) (*sdkservices.ReplaySessionResult, error) {
+	session := data.Session
+
+	l := ws.l.With(zap.String("session_id", session.ID().String()), zap.Bool("replay", true))
+
+	w := &sessionWorkflow{l: l, ws: ws, data: *data}
+
+	cinfos, err := w.initConnections(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.initEnvModule(cinfos); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		result sdkservices.ReplaySessionResult
+		run    sdkservices.Run
+
+		// The session clock moves to the recorded completion time of
+		// everything the session waits for.
+		now     = hist.startedAt
+		advance = func(t time.Time) {
+			if t.After(now) {
+				now = t
+			}
+		}
+	)
+
+	popActivity := func(name string) (*replayCallback, error) {
+		cb, err := hist.popActivity(name)
+		if err != nil {
+			return nil, err
+		}
+
+		advance(cb.doneAt)
+
+		return cb, cb.err
+	}
+
+	nextEvent := func() (sdktypes.Event, error) {
+		cb, err := popActivity(getSignalEventActivityName)
+		if err != nil {
+			return sdktypes.InvalidEvent, err
+		}
+
+		var event sdktypes.Event
+		if err := hist.decode(cb.result, &event); err != nil {
+			return sdktypes.InvalidEvent, fmt.Errorf("decode recorded event: %w", err)
+		}
+
+		return event, nil
+	}
+
+	cbs := sdkservices.RunCallbacks{
+		NewRunID: func() (sdktypes.RunID, error) { return sdktypes.NewRunID(), nil },
+		Load:     w.load,
+		Call: func(_ context.Context, _ sdktypes.RunID, v sdktypes.Value, args []sdktypes.Value, kwargs map[string]sdktypes.Value) (sdktypes.Value, error) {
+			if f := v.GetFunction(); f.HasFlag(sdktypes.ConstFunctionFlag) {
+				return f.ConstValue()
+			}
+
+			if result.Stopped {
+				return sdktypes.InvalidValue, errReplayStopped
+			}
+
+			if run == nil {
+				return sdktypes.InvalidValue, errors.New("cannot call before the run is initialized")
+			}
+
+			seq := uint32(len(result.Calls)) + 1
+
+			rec, ok := hist.calls[seq]
+			if !ok || !rec.Result.IsValid() {
+				return sdktypes.InvalidValue, fmt.Errorf("call %d has no recorded result", seq)
+			}
+
+			advance(hist.callTimes[seq])
+
+			if name, recName := v.GetFunction().Name(), rec.Spec.Function().GetFunction().Name(); name.String() != recName.String() {
+				return sdktypes.InvalidValue, fmt.Errorf("call %d diverged from the session history: expected %q, got %q", seq, recName, name)
+			}
+
+			result.Calls = append(result.Calls, sdkservices.ReplayedSessionCall{
+				Spec:   sdktypes.NewSessionCallSpec(v, args, kwargs, seq),
+				Result: rec.Result,
+			})
+
+			if seq == opts.StopAtSeq {
+				result.Stopped = true
+				cancel()
+				return sdktypes.InvalidValue, errReplayStopped
+			}
+
+			return rec.Result.ToPair()
+		},
+		Print: func(_ context.Context, _ sdktypes.RunID, text string) error {
+			result.Prints = append(result.Prints, strings.TrimSuffix(text, "\n"))
+			return nil
+		},
+		Now: func(context.Context, sdktypes.RunID) (time.Time, error) { return now.UTC(), nil },
+		Sleep: func(_ context.Context, _ sdktypes.RunID, d time.Duration) error {
+			timer, err := hist.pop(replayTimer, "sleep", func(cb *replayCallback) bool { return cb.duration == d })
+			if err != nil {
+				return err
+			}
+
+			if !timer.fired {
+				return workflow.ErrCanceled
+			}
+
+			advance(timer.doneAt)
+
+			return nil
+		},
+		// Outcomes were already delivered by the original session.
+		Outcome: func(context.Context, sdktypes.RunID, sdktypes.Value, sdktypes.EventID) error { return nil },
+		// Tags were already set by the original session.
+		SetSessionTag: func(context.Context, sdktypes.RunID, string, string) error { return nil },
+		// Compensations are never called during replay, so registering them is harmless.
+		RegisterCompensation: func(context.Context, sdktypes.RunID, sdktypes.Value, []sdktypes.Value, map[string]sdktypes.Value) error {
+			return nil
+		},
+		Start: func(_ context.Context, _ sdktypes.RunID, project sdktypes.Symbol, _ sdktypes.CodeLocation, _ map[string]sdktypes.Value, _ map[string]string) (sdktypes.SessionID, error) {
+			if project.IsValid() {
+				if _, err := popActivity(getProjectIDAndActiveBuildIDActivityName); err != nil {
+					return sdktypes.InvalidSessionID, fmt.Errorf("could not get active build ID for project %s: %w", project, err)
+				}
+			}
+
+			cb, err := popActivity(startChildSessionActivityName)
+			if err != nil {
+				return sdktypes.InvalidSessionID, err
+			}
+
+			var sid sdktypes.SessionID
+			if err := hist.decode(cb.result, &sid); err != nil {
+				return sdktypes.InvalidSessionID, fmt.Errorf("decode recorded session id: %w", err)
+			}
+
+			return sid, nil
+		},
+		WaitSessions: func(context.Context, sdktypes.RunID, []sdktypes.SessionID, time.Duration) ([]sdktypes.SessionState, error) {
+			return nil, errNotReplayable("waiting for sessions")
+		},
+		Subscribe: func(context.Context, sdktypes.RunID, string, string) (string, error) {
+			cb, err := popActivity(saveSignalActivityName)
+			if err != nil {
+				return "", err
+			}
+
+			var signal types.Signal
+			if err := hist.decode(cb.input, &signal); err != nil {
+				return "", fmt.Errorf("decode recorded subscription: %w", err)
+			}
+
+			return signal.ID.String(), nil
+		},
+		// Subscriptions were already removed by the original session.
+		Unsubscribe: func(context.Context, sdktypes.RunID, string) error { return nil },
+		NextEvent: func(_ context.Context, _ sdktypes.RunID, signals []string, timeout time.Duration) (sdktypes.Event, error) {
+			if len(signals) == 0 {
+				return sdktypes.InvalidEvent, nil
+			}
+
+			// Mirrors the original session, which first checked every
+			// subscription for a pending event, and then waited for the
+			// first signal or the timeout.
+			for range signals {
+				if event, err := nextEvent(); err != nil || event.IsValid() {
+					return event, err
+				}
+			}
+
+			var timer *replayCallback
+
+			if timeout != 0 {
+				var err error
+				if timer, err = hist.pop(replayTimer, "next_event timeout", func(cb *replayCallback) bool { return cb.duration == timeout }); err != nil {
+					return sdktypes.InvalidEvent, err
+				}
+			}
+
+			for {
+				if timer != nil && timer.fired {
+					next := hist.next(replayActivity, func(cb *replayCallback) bool { return cb.name == getSignalEventActivityName })
+					if next == nil || timer.doneEventID < next.scheduledEventID {
+						advance(timer.doneAt)
+						return sdktypes.InvalidEvent, nil
+					}
+				}
+
+				if event, err := nextEvent(); err != nil || event.IsValid() {
+					return event, err
+				}
+			}
+		},
+		RequestApproval: func(context.Context, sdktypes.RunID, string, []string, time.Duration) (sdktypes.Approval, error) {
+			return sdktypes.InvalidApproval, errNotReplayable("requesting approvals")
+		},
+		IsDeploymentActive: func(context.Context) (bool, error) {
+			return false, errNotReplayable("checking deployment state")
+		},
+		Signal: func(_ context.Context, _ sdktypes.RunID, sid sdktypes.SessionID, name string, _ sdktypes.Value) error {
+			cb, err := hist.pop(replaySignal, "signal "+name, func(cb *replayCallback) bool {
+				return cb.target == sid.String() && cb.name == userSignalName(name)
+			})
+			if err != nil {
+				return err
+			}
+
+			if !cb.done {
+				return fmt.Errorf("signal %s has no recorded result", name)
+			}
+
+			advance(cb.doneAt)
+
+			return cb.err
+		},
+		NextSignal: func(context.Context, sdktypes.RunID, []string, time.Duration) (*sdkservices.RunSignal, error) {
+			return nil, errNotReplayable("waiting for signals")
+		},
+		ListStoreValues: func(context.Context, sdktypes.RunID) ([]string, error) {
+			cb, err := popActivity(listStoreValuesActivityName)
+			if err != nil {
+				return nil, err
+			}
+
+			var keys []string
+			if err := hist.decode(cb.result, &keys); err != nil {
+				return nil, fmt.Errorf("decode recorded store keys: %w", err)
+			}
+
+			return keys, nil
+		},
+		MutateStoreValue: func(_ context.Context, _ sdktypes.RunID, key, op string, _ ...sdktypes.Value) (sdktypes.Value, error) {
+			cb, err := hist.popActivity(mutateStoreValueActivityName)
+			if err != nil {
+				return sdktypes.InvalidValue, err
+			}
+
+			var (
+				pid           sdktypes.ProjectID
+				recKey, recOp string
+			)
+
+			if err := hist.decode(cb.input, &pid, &recKey, &recOp); err != nil {
+				return sdktypes.InvalidValue, fmt.Errorf("decode recorded store mutation: %w", err)
+			}
+
+			if recKey != key || recOp != op {
+				return sdktypes.InvalidValue, fmt.Errorf("store mutation diverged from the session history: expected %q on %q, got %q on %q", recOp, recKey, op, key)
+			}
+
+			advance(cb.doneAt)
+
+			if cb.err != nil {
+				return sdktypes.InvalidValue, cb.err
+			}
+
+			var v sdktypes.Value
+			if err := hist.decode(cb.result, &v); err != nil {
+				return sdktypes.InvalidValue, fmt.Errorf("decode recorded store value: %w", err)
+			}
+
+			return v, nil
+		},
+		PublishStoreValue: func(context.Context, sdktypes.RunID, string) error {
+			_, err := popActivity(publishStoreValueActivityName)
+			return err
+		},
+		UnpublishStoreValue: func(context.Context, sdktypes.RunID, string) error {
+			return errNotReplayable("accessing the store")
+		},
+	}
+
+	entryPoint := session.EntryPoint()
+
+	run, err = sdkruntimes.Run(
+		ctx,
+		sdkruntimes.RunParams{
+			Runtimes:             ws.svcs.Runtimes,
+			BuildFile:            data.BuildFile,
+			RunID:                sdktypes.NewRunID(),
+			FallthroughCallbacks: cbs,
+			EntryPointPath:       entryPoint.Path(),
+			SessionID:            session.ID(),
+			IsDurable:            true,
+		},
+	)
+	if err != nil {
+		return replayResult(&result, err)
+	}
+
+	defer run.Close()
+
+	result.Values = run.Values()
+
+	if epName := entryPoint.Name(); epName != "" {
+		callValue, ok := result.Values[epName]
+		if !ok || !callValue.IsFunction() {
+			return replayResult(&result, sdktypes.WrapError(fmt.Errorf("entry point %q is not a function", epName)).ToError())
+		}
+
+		result.ReturnValue, err = run.Call(ctx, callValue, nil, w.entryPointInputs())
+	}
+
+	return replayResult(&result, err)
+}
+
+// replayResult reports program errors as part of the result, since the
+// original session might have failed the same way. A replay that was
+// stopped on purpose is not an error.
+func replayResult(result *sdkservices.ReplaySessionResult, err error) (*sdkservices.ReplaySessionResult, error) {
+	if err == nil || result.Stopped {
+		return result, nil
+	}
+
+	perr, ok := sdktypes.FromError(err)
+	if !ok {
+		return nil, err
+	}
+
+	result.Error = perr
+
+	return result, nil
+}
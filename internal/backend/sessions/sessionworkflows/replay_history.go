@@ -0,0 +1,269 @@
+package sessionworkflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+type replayCallbackKind int
+
+const (
+	replayActivity replayCallbackKind = iota
+	replayTimer
+	replaySignal
+)
+
+// Activities made by session callbacks whose results are fed back to the
+// program during replay.
+var replayedActivities = map[string]bool{
+	getProjectIDAndActiveBuildIDActivityName: true,
+	getSignalEventActivityName:               true,
+	listStoreValuesActivityName:              true,
+	mutateStoreValueActivityName:             true,
+	publishStoreValueActivityName:            true,
+	saveSignalActivityName:                   true,
+	startChildSessionActivityName:            true,
+}
+
+// replayCallback is something a session did through temporal other than a
+// call: an activity made by a callback, a timer or a signal sent to another
+// workflow, along with its recorded outcome.
+type replayCallback struct {
+	kind     replayCallbackKind
+	name     string        // activity or signal name.
+	target   string        // signaled workflow id.
+	duration time.Duration // timers only.
+	input    *commonpb.Payloads
+	result   *commonpb.Payloads
+	err      error
+
+	scheduledEventID int64
+	doneEventID      int64
+	doneAt           time.Time
+	done             bool
+	fired            bool // timers only, false if the timer was cancelled.
+	used             bool
+}
+
+// replayHistory is everything a replay feeds back to the session program.
+type replayHistory struct {
+	dc converter.DataConverter
+
+	// The clock of the replayed session starts here.
+	startedAt time.Time
+
+	calls     map[uint32]sdkservices.ReplayedSessionCall
+	callTimes map[uint32]time.Time
+
+	callbacks []*replayCallback
+}
+
+// newLogReplayHistory builds a replay history from session log records.
+// Logs contain only calls, so no callbacks can be replayed from it.
+func newLogReplayHistory(startedAt time.Time, rs []sdktypes.SessionLogRecord) *replayHistory {
+	h := &replayHistory{startedAt: startedAt}
+	h.calls, h.callTimes = recordedCalls(rs)
+	return h
+}
+
+// getReplayHistory reads the recorded calls and callbacks of a session from
+// its workflow history.
+func (ws *workflows) getReplayHistory(ctx context.Context, sid sdktypes.SessionID) (*replayHistory, error) {
+	l := ws.l.With(zap.String("session_id", sid.String()))
+
+	dc := ws.svcs.Temporal.DataConverter()
+
+	iter := ws.svcs.Temporal.TemporalClient().GetWorkflowHistory(
+		ctx,
+		workflowID(sid),
+		"",
+		false,
+		enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT,
+	)
+
+	var (
+		h       = &replayHistory{dc: dc}
+		rs      []sdktypes.SessionLogRecord
+		events  = make(map[int64]*historypb.HistoryEvent)
+		pending = make(map[int64]*replayCallback)
+	)
+
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			var notFound *serviceerror.NotFound
+			if errors.As(err, &notFound) {
+				return nil, sdkerrors.ErrNotFound
+			}
+
+			return nil, temporalclient.TranslateError(err, "get workflow history")
+		}
+
+		if event == nil {
+			l.Error("nil event from temporal")
+			continue
+		}
+
+		t := event.GetEventTime().AsTime()
+
+		events[event.EventId] = event
+
+		r, err := parseTemporalHistoryEvent(l, t, event, events, sdktypes.CallSpecSessionLogRecordType|sdktypes.CallAttemptCompleteSessionLogRecordType, dc)
+		if err != nil {
+			return nil, fmt.Errorf("event %d: %w", event.GetEventId(), err)
+		}
+
+		if r.IsValid() {
+			rs = append(rs, r)
+		}
+
+		h.add(event, t, pending)
+	}
+
+	h.calls, h.callTimes = recordedCalls(rs)
+
+	return h, nil
+}
+
+// add records the callback, or the callback outcome, in the history event.
+func (h *replayHistory) add(event *historypb.HistoryEvent, t time.Time, pending map[int64]*replayCallback) {
+	complete := func(id int64) *replayCallback {
+		cb, ok := pending[id]
+		if !ok {
+			return nil
+		}
+
+		delete(pending, id)
+
+		cb.done, cb.doneEventID, cb.doneAt = true, event.EventId, t
+
+		return cb
+	}
+
+	schedule := func(cb *replayCallback) {
+		cb.scheduledEventID = event.EventId
+		pending[event.EventId] = cb
+		h.callbacks = append(h.callbacks, cb)
+	}
+
+	switch a := event.Attributes.(type) {
+	case *historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes:
+		h.startedAt = t
+
+	case *historypb.HistoryEvent_ActivityTaskScheduledEventAttributes:
+		attrs := a.ActivityTaskScheduledEventAttributes
+		if name := attrs.GetActivityType().GetName(); replayedActivities[name] {
+			schedule(&replayCallback{kind: replayActivity, name: name, input: attrs.GetInput()})
+		}
+
+	case *historypb.HistoryEvent_ActivityTaskCompletedEventAttributes:
+		if cb := complete(a.ActivityTaskCompletedEventAttributes.GetScheduledEventId()); cb != nil {
+			cb.result = a.ActivityTaskCompletedEventAttributes.GetResult()
+		}
+
+	case *historypb.HistoryEvent_ActivityTaskFailedEventAttributes:
+		if cb := complete(a.ActivityTaskFailedEventAttributes.GetScheduledEventId()); cb != nil {
+			cb.err = errors.New(a.ActivityTaskFailedEventAttributes.GetFailure().GetMessage())
+		}
+
+	case *historypb.HistoryEvent_ActivityTaskTimedOutEventAttributes:
+		if cb := complete(a.ActivityTaskTimedOutEventAttributes.GetScheduledEventId()); cb != nil {
+			cb.err = errors.New(a.ActivityTaskTimedOutEventAttributes.GetFailure().GetMessage())
+		}
+
+	case *historypb.HistoryEvent_ActivityTaskCanceledEventAttributes:
+		if cb := complete(a.ActivityTaskCanceledEventAttributes.GetScheduledEventId()); cb != nil {
+			cb.err = workflow.ErrCanceled
+		}
+
+	case *historypb.HistoryEvent_TimerStartedEventAttributes:
+		schedule(&replayCallback{kind: replayTimer, duration: a.TimerStartedEventAttributes.GetStartToFireTimeout().AsDuration()})
+
+	case *historypb.HistoryEvent_TimerFiredEventAttributes:
+		if cb := complete(a.TimerFiredEventAttributes.GetStartedEventId()); cb != nil {
+			cb.fired = true
+		}
+
+	case *historypb.HistoryEvent_TimerCanceledEventAttributes:
+		complete(a.TimerCanceledEventAttributes.GetStartedEventId())
+
+	case *historypb.HistoryEvent_SignalExternalWorkflowExecutionInitiatedEventAttributes:
+		attrs := a.SignalExternalWorkflowExecutionInitiatedEventAttributes
+		schedule(&replayCallback{
+			kind:   replaySignal,
+			name:   attrs.GetSignalName(),
+			target: attrs.GetWorkflowExecution().GetWorkflowId(),
+			input:  attrs.GetInput(),
+		})
+
+	case *historypb.HistoryEvent_ExternalWorkflowExecutionSignaledEventAttributes:
+		complete(a.ExternalWorkflowExecutionSignaledEventAttributes.GetInitiatedEventId())
+
+	case *historypb.HistoryEvent_SignalExternalWorkflowExecutionFailedEventAttributes:
+		attrs := a.SignalExternalWorkflowExecutionFailedEventAttributes
+		if cb := complete(attrs.GetInitiatedEventId()); cb != nil {
+			cb.err = fmt.Errorf("signal workflow %q: %v", cb.target, attrs.GetCause())
+		}
+	}
+}
+
+// next returns the first unused callback of the kind that matches, without
+// using it.
+func (h *replayHistory) next(kind replayCallbackKind, match func(*replayCallback) bool) *replayCallback {
+	for _, cb := range h.callbacks {
+		if !cb.used && cb.kind == kind && (match == nil || match(cb)) {
+			return cb
+		}
+	}
+
+	return nil
+}
+
+// pop uses the first unused callback of the kind that matches.
+func (h *replayHistory) pop(kind replayCallbackKind, what string, match func(*replayCallback) bool) (*replayCallback, error) {
+	cb := h.next(kind, match)
+	if cb == nil {
+		return nil, fmt.Errorf("%s has no recorded result", what)
+	}
+
+	cb.used = true
+
+	return cb, nil
+}
+
+// popActivity uses the next completed activity with the name.
+func (h *replayHistory) popActivity(name string) (*replayCallback, error) {
+	cb, err := h.pop(replayActivity, name, func(cb *replayCallback) bool { return cb.name == name })
+	if err != nil {
+		return nil, err
+	}
+
+	if !cb.done {
+		return nil, fmt.Errorf("%s has no recorded result", name)
+	}
+
+	return cb, nil
+}
+
+func (h *replayHistory) decode(ps *commonpb.Payloads, dsts ...any) error {
+	if h.dc == nil {
+		return errors.New("no data converter")
+	}
+
+	return h.dc.FromPayloads(ps, dsts...)
+}
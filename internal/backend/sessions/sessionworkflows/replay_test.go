@@ -0,0 +1,188 @@
+package sessionworkflows
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"go.temporal.io/sdk/converter"
+	"gotest.tools/v3/assert"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessiondata"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/runtimes/starlarkrt"
+	"go.autokitteh.dev/autokitteh/sdk/sdkruntimes"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+const replayProgram = `
+def double(x):
+    return x * 2
+
+def main(data, session_id, event_id, event_type):
+    a = run_activity(double, 1)
+    print(a)
+    b = run_activity(double, a)
+    print(b)
+    return b
+`
+
+const replayStoreProgram = `
+def main(data, session_id, event_id, event_type):
+    print(time.now().unix)
+    print(ak.store.mutate("cat", "set", ["meow"]))
+    print(ak.store.get("cat"))
+    print(ak.store.list_keys())
+    print(time.now().unix)
+`
+
+func setupReplay(t *testing.T, program string) (*workflows, *sessiondata.Data) {
+	ws, _, _ := setup(t)
+
+	rts := kittehs.Must1(sdkruntimes.New([]*sdkruntimes.Runtime{starlarkrt.New()}))
+	ws.svcs.Runtimes = rts
+
+	fs := kittehs.Must1(kittehs.MapToMemFS(map[string][]byte{"main.star": []byte(program)}))
+
+	bf, err := rts.Build(t.Context(), fs, nil, nil)
+	assert.NilError(t, err)
+
+	ep := kittehs.Must1(sdktypes.ParseCodeLocation("main.star:main"))
+
+	return ws, &sessiondata.Data{
+		Session:   sdktypes.NewSession(sdktypes.NewBuildID(), ep, nil, nil).WithID(sid).SetDurable(true),
+		BuildFile: bf,
+	}
+}
+
+func recordedLog(t *testing.T, name string, results ...sdktypes.Value) []sdktypes.SessionLogRecord {
+	now := time.Now()
+
+	f, err := sdktypes.NewFunctionValue(sdktypes.NewExecutorID(sdktypes.NewRunID()), name, nil, nil, sdktypes.InvalidModuleFunction)
+	assert.NilError(t, err)
+
+	var rs []sdktypes.SessionLogRecord
+
+	for i, v := range results {
+		rs = append(
+			rs,
+			sdktypes.NewCallSpecSessionLogRecord(now, sdktypes.NewSessionCallSpec(f, nil, nil, uint32(i+1))),
+			// A failed attempt, which is superseded by the next one.
+			sdktypes.NewCallAttemptCompleteSessionLogRecord(now, sdktypes.NewSessionCallAttemptComplete(now, false, sdktypes.NewSessionCallAttemptResult(sdktypes.Nothing, nil))),
+			sdktypes.NewCallAttemptCompleteSessionLogRecord(now, sdktypes.NewSessionCallAttemptComplete(now, true, sdktypes.NewSessionCallAttemptResult(v, nil))),
+		)
+	}
+
+	return rs
+}
+
+func TestReplay(t *testing.T) {
+	ws, data := setupReplay(t, replayProgram)
+
+	// Recorded results differ from what the program would compute, to
+	// verify they are fed back instead of executing the calls.
+	recorded := newLogReplayHistory(time.Now(), recordedLog(t, "double", sdktypes.NewIntegerValue(10), sdktypes.NewIntegerValue(42)))
+
+	r, err := ws.replay(t.Context(), data, recorded, sdkservices.ReplaySessionOptions{})
+	assert.NilError(t, err)
+
+	assert.Assert(t, !r.Stopped)
+	assert.Assert(t, !r.Error.IsValid(), r.Error)
+	assert.DeepEqual(t, r.Prints, []string{"10", "42"})
+	assert.Assert(t, r.ReturnValue.Equal(sdktypes.NewIntegerValue(42)))
+	assert.Equal(t, len(r.Calls), 2)
+
+	_, args, _ := r.Calls[1].Spec.Data()
+	assert.Equal(t, r.Calls[1].Spec.Seq(), uint32(2))
+	assert.Assert(t, args[0].Equal(sdktypes.NewIntegerValue(10)))
+}
+
+func TestReplayStop(t *testing.T) {
+	ws, data := setupReplay(t, replayProgram)
+
+	recorded := newLogReplayHistory(time.Now(), recordedLog(t, "double", sdktypes.NewIntegerValue(10), sdktypes.NewIntegerValue(42)))
+
+	r, err := ws.replay(t.Context(), data, recorded, sdkservices.ReplaySessionOptions{StopAtSeq: 2})
+	assert.NilError(t, err)
+
+	assert.Assert(t, r.Stopped)
+	assert.DeepEqual(t, r.Prints, []string{"10"})
+	assert.Equal(t, len(r.Calls), 2)
+	assert.Assert(t, r.Calls[1].Result.GetValue().Equal(sdktypes.NewIntegerValue(42)))
+	assert.Assert(t, !r.ReturnValue.IsValid())
+}
+
+func TestReplayDiverged(t *testing.T) {
+	ws, data := setupReplay(t, replayProgram)
+
+	recorded := newLogReplayHistory(time.Now(), recordedLog(t, "triple", sdktypes.NewIntegerValue(10)))
+
+	r, err := ws.replay(t.Context(), data, recorded, sdkservices.ReplaySessionOptions{})
+	assert.NilError(t, err)
+
+	assert.Assert(t, r.Error.IsValid())
+	assert.ErrorContains(t, r.Error.ToError(), `call 1 diverged from the session history: expected "triple", got "double"`)
+	assert.Equal(t, len(r.Calls), 0)
+}
+
+func recordedActivity(name string, doneAt time.Time, result any, inputs ...any) *replayCallback {
+	dc := converter.GetDefaultDataConverter()
+
+	return &replayCallback{
+		kind:   replayActivity,
+		name:   name,
+		input:  kittehs.Must1(dc.ToPayloads(inputs...)),
+		result: kittehs.Must1(dc.ToPayloads(result)),
+		done:   true,
+		doneAt: doneAt,
+	}
+}
+
+func TestReplayStoreAndNow(t *testing.T) {
+	ws, data := setupReplay(t, replayStoreProgram)
+
+	t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	pid := sdktypes.NewProjectID()
+
+	// Recorded results differ from what the store would return, to verify
+	// they are fed back instead of accessing the store.
+	hist := &replayHistory{
+		dc:        converter.GetDefaultDataConverter(),
+		startedAt: t0,
+		callbacks: []*replayCallback{
+			recordedActivity(mutateStoreValueActivityName, t0.Add(time.Minute), sdktypes.Nothing, pid, "cat", "set"),
+			recordedActivity(mutateStoreValueActivityName, t0.Add(2*time.Minute), sdktypes.NewStringValue("woof"), pid, "cat", "get"),
+			recordedActivity(listStoreValuesActivityName, t0.Add(3*time.Minute), []string{"dog"}, pid),
+		},
+	}
+
+	r, err := ws.replay(t.Context(), data, hist, sdkservices.ReplaySessionOptions{})
+	assert.NilError(t, err)
+
+	assert.Assert(t, !r.Error.IsValid(), r.Error)
+	assert.DeepEqual(t, r.Prints, []string{
+		strconv.FormatInt(t0.Unix(), 10),
+		"None",
+		"woof",
+		`["dog"]`,
+		strconv.FormatInt(t0.Add(3*time.Minute).Unix(), 10),
+	})
+}
+
+func TestReplayStoreDiverged(t *testing.T) {
+	ws, data := setupReplay(t, replayStoreProgram)
+
+	hist := &replayHistory{
+		dc: converter.GetDefaultDataConverter(),
+		callbacks: []*replayCallback{
+			recordedActivity(mutateStoreValueActivityName, time.Now(), sdktypes.Nothing, sdktypes.NewProjectID(), "dog", "set"),
+		},
+	}
+
+	r, err := ws.replay(t.Context(), data, hist, sdkservices.ReplaySessionOptions{})
+	assert.NilError(t, err)
+
+	assert.Assert(t, r.Error.IsValid())
+	assert.ErrorContains(t, r.Error.ToError(), `store mutation diverged from the session history: expected "set" on "dog", got "set" on "cat"`)
+}
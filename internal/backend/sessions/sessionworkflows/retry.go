@@ -0,0 +1,120 @@
+package sessionworkflows
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/fixtures"
+	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessiondata"
+	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+type scheduleRetryParams struct {
+	Session sdktypes.Session
+	Delay   time.Duration
+}
+
+func retryWorkflowID(orig sdktypes.SessionID, attempt int) string {
+	return fmt.Sprintf("retry_%v_%d", orig, attempt)
+}
+
+// scheduleRetrySessionActivity starts a retry workflow that starts the retry
+// session once the delay passes. The workflow id is unique per attempt, so
+// scheduling the same attempt again does not start another one.
+func (ws *workflows) scheduleRetrySessionActivity(ctx context.Context, params scheduleRetryParams) error {
+	session := params.Session
+	wid := retryWorkflowID(session.RetryOfSessionID(), session.Attempt())
+
+	opts := ws.cfg.RetryWorkflow.ToStartWorkflowOptions(
+		utilsWorkerQueue,
+		wid,
+		fmt.Sprintf("retry %v", session.RetryOfSessionID()),
+		map[string]string{
+			"process_id":          fixtures.ProcessID(),
+			"retry_of_session_id": session.RetryOfSessionID().String(),
+			"attempt":             strconv.Itoa(session.Attempt()),
+		},
+	)
+
+	opts.StartDelay = params.Delay
+
+	_, err := ws.svcs.Temporal.TemporalClient().ExecuteWorkflow(ctx, opts, retrySessionWorkflowName, session)
+	return temporalclient.TranslateError(err, "schedule retry %v", wid)
+}
+
+func (ws *workflows) retrySessionActivity(ctx context.Context, session sdktypes.Session) (sdktypes.SessionID, error) {
+	sid, err := ws.sessions.Start(ctx, session)
+	return sid, temporalclient.TranslateError(err, "retry session %v", session.RetryOfSessionID())
+}
+
+// retrySessionWorkflow starts a retry session. It is started with the retry
+// delay, so the ended session's workflow does not wait for it.
+func (ws *workflows) retrySessionWorkflow(wctx workflow.Context, session sdktypes.Session) (sdktypes.SessionID, error) {
+	l := ws.l.With(zap.String("retry_of_session_id", session.RetryOfSessionID().String()), zap.Int("retry_attempt", session.Attempt()))
+
+	wctx = temporalclient.WithActivityOptions(wctx, utilsWorkerQueue, ws.cfg.Activity)
+
+	var sid sdktypes.SessionID
+	if err := workflow.ExecuteActivity(wctx, retrySessionActivityName, session).Get(wctx, &sid); err != nil {
+		l.Error("retry session failed", zap.Error(err))
+		return sdktypes.InvalidSessionID, err
+	}
+
+	l.Info("session retried", zap.String("retry_session_id", sid.String()))
+
+	return sid, nil
+}
+
+// retry schedules a new attempt of a session that ended in an error, if its
+// trigger's retry policy calls for it. Each attempt refers back to the
+// original session. The attempt is started by a separate workflow once the
+// retry delay passes.
+func (ws *workflows) retry(wctx workflow.Context, l *zap.Logger, data *sessiondata.Data, err error) {
+	session := data.Session
+
+	tid := session.TriggerID()
+	if !tid.IsValid() {
+		return
+	}
+
+	i, trigger := kittehs.FindFirst(data.Triggers, func(t sdktypes.Trigger) bool { return t.ID() == tid })
+	if i < 0 {
+		return
+	}
+
+	policy := trigger.Retry()
+
+	_, isProgramErr := sdktypes.FromError(err)
+
+	// The first attempt is not marked as such.
+	attempt := max(session.Attempt(), 1)
+
+	if !policy.ShouldRetry(attempt, isProgramErr) {
+		return
+	}
+
+	delay := policy.Delay(attempt)
+
+	l = l.With(zap.Int("retry_attempt", attempt+1), zap.Duration("retry_delay", delay))
+
+	orig := session.RetryOfSessionID()
+	if !orig.IsValid() {
+		orig = session.ID()
+	}
+
+	next := session.WithNoID().WithRetryOfSessionID(orig).WithAttempt(attempt + 1)
+
+	if err := workflow.ExecuteActivity(wctx, scheduleRetrySessionActivityName, scheduleRetryParams{Session: next, Delay: delay}).Get(wctx, nil); err != nil {
+		l.Error("schedule session retry failed", zap.Error(err))
+		return
+	}
+
+	l.Info("session retry scheduled")
+}
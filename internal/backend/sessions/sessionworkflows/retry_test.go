@@ -0,0 +1,88 @@
+package sessionworkflows
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"gotest.tools/v3/assert"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessiondata"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func TestRetry(t *testing.T) {
+	ws, _, _ := setup(t)
+
+	policy := kittehs.Must1(sdktypes.NewRetryPolicy(3, time.Hour, 0, 0, false))
+	trigger := sdktypes.NewTrigger(sdktypes.NewSymbol("t")).WithNewID().WithRetry(policy)
+
+	data := sessiondata.Data{
+		Session:  session.WithTriggerID(trigger.ID()),
+		Triggers: []sdktypes.Trigger{trigger},
+	}
+
+	var (
+		scheduled []scheduleRetryParams
+		suite     testsuite.WorkflowTestSuite
+	)
+
+	env := suite.NewTestWorkflowEnvironment()
+
+	env.RegisterActivityWithOptions(
+		func(_ context.Context, params scheduleRetryParams) error {
+			scheduled = append(scheduled, params)
+			return nil
+		},
+		activity.RegisterOptions{Name: scheduleRetrySessionActivityName},
+	)
+
+	var elapsed time.Duration
+
+	env.ExecuteWorkflow(func(wctx workflow.Context) error {
+		wctx = workflow.WithActivityOptions(wctx, workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+
+		t0 := workflow.Now(wctx)
+		ws.retry(wctx, ws.l, &data, errors.New("meow"))
+		elapsed = workflow.Now(wctx).Sub(t0)
+
+		return nil
+	})
+
+	assert.NilError(t, env.GetWorkflowError())
+
+	// The session workflow does not wait for the retry delay.
+	assert.Assert(t, elapsed < time.Hour)
+
+	assert.Equal(t, len(scheduled), 1)
+	assert.Equal(t, scheduled[0].Delay, time.Hour)
+	assert.Equal(t, scheduled[0].Session.Attempt(), 2)
+	assert.Equal(t, scheduled[0].Session.RetryOfSessionID(), sid)
+	assert.Assert(t, !scheduled[0].Session.ID().IsValid())
+}
+
+func TestScheduleRetrySessionActivity(t *testing.T) {
+	ws, _, tc := setup(t)
+
+	next := session.WithNoID().WithRetryOfSessionID(sid).WithAttempt(2)
+
+	tc.On(
+		"ExecuteWorkflow",
+		mock.MatchedBy(func(opts client.StartWorkflowOptions) bool {
+			return opts.ID == retryWorkflowID(sid, 2) && opts.TaskQueue == utilsWorkerQueue && opts.StartDelay == time.Minute
+		}),
+		retrySessionWorkflowName,
+		[]any{next},
+	).Return(&mockTemporalWorkflowRun{}, nil).Once()
+
+	assert.NilError(t, ws.scheduleRetrySessionActivity(t.Context(), scheduleRetryParams{Session: next, Delay: time.Minute}))
+
+	mock.AssertExpectationsForObjects(t, tc)
+}
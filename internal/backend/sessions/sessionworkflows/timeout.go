@@ -0,0 +1,72 @@
+package sessionworkflows
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/temporalclient"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func timeoutReason(d time.Duration) string { return fmt.Sprintf("timed out after %v", d) }
+
+func (ws *workflows) addSessionStopRequestActivity(ctx context.Context, sid sdktypes.SessionID, reason string) error {
+	if err := ws.svcs.DB.AddSessionStopRequest(ctx, sid, reason); err != nil {
+		return temporalclient.TranslateError(err, "add stop request for %v", sid)
+	}
+
+	ws.svcs.Notifier.Notify(sid)
+	return nil
+}
+
+// withTimeout returns a context that is canceled once the session's timeout
+// passes, just like StopWorkflow cancels the workflow. The returned function
+// stops the timer and tells if the timeout was reached. It must be called once
+// the session is done running.
+func (ws *workflows) withTimeout(wctx workflow.Context, l *zap.Logger, session sdktypes.Session) (workflow.Context, func() bool) {
+	d := session.Timeout()
+	if d <= 0 {
+		return wctx, func() bool { return false }
+	}
+
+	rctx, cancelRun := workflow.WithCancel(wctx)
+	tctx, cancelTimer := workflow.WithCancel(wctx)
+
+	timedOut := false
+
+	workflow.Go(tctx, func(ctx workflow.Context) {
+		if err := workflow.NewTimer(ctx, d).Get(ctx, nil); err != nil {
+			// Session ended before the timeout, or was stopped.
+			return
+		}
+
+		l.Info("session timed out", zap.Duration("timeout", d))
+
+		// Logged the same way StopWorkflow does.
+		if err := workflow.ExecuteActivity(ctx, addSessionStopRequestActivityName, session.ID(), timeoutReason(d)).Get(ctx, nil); err != nil {
+			l.Error("add stop request failed", zap.Error(err))
+		}
+
+		if ctx.Err() != nil {
+			// Session ended meanwhile.
+			return
+		}
+
+		timedOut = true
+		cancelRun()
+	})
+
+	return rctx, func() bool {
+		cancelTimer()
+		return timedOut
+	}
+}
+
+// workflow is stopped, so this assumes the given wctx is a disconnected context.
+func (ws *workflows) timedOut(wctx workflow.Context, sessionID sdktypes.SessionID, d time.Duration) {
+	_ = ws.updateSessionState(wctx, sessionID, sdktypes.NewSessionStateTimedOut(timeoutReason(d)))
+}
@@ -0,0 +1,58 @@
+package sessionworkflows
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"gotest.tools/v3/assert"
+)
+
+func runWithTimeout(t *testing.T, timeout, duration time.Duration) (timedOut bool, err error) {
+	ws, db, _ := setup(t)
+
+	if timeout > 0 && duration > timeout {
+		db.On("AddSessionStopRequest", sid, timeoutReason(timeout)).Return(nil).Once()
+	}
+
+	var suite testsuite.WorkflowTestSuite
+
+	env := suite.NewTestWorkflowEnvironment()
+
+	env.RegisterActivityWithOptions(ws.addSessionStopRequestActivity, activity.RegisterOptions{Name: addSessionStopRequestActivityName})
+
+	env.ExecuteWorkflow(func(wctx workflow.Context) error {
+		wctx = workflow.WithActivityOptions(wctx, workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+
+		rctx, done := ws.withTimeout(wctx, ws.l, session.WithTimeout(timeout))
+
+		err = workflow.Sleep(rctx, duration)
+		timedOut = done()
+
+		return nil
+	})
+
+	assert.NilError(t, env.GetWorkflowError())
+
+	mock.AssertExpectationsForObjects(t, db)
+
+	return
+}
+
+func TestWithTimeout(t *testing.T) {
+	timedOut, err := runWithTimeout(t, 0, time.Hour)
+	assert.NilError(t, err)
+	assert.Assert(t, !timedOut)
+
+	timedOut, err = runWithTimeout(t, time.Hour, time.Minute)
+	assert.NilError(t, err)
+	assert.Assert(t, !timedOut)
+
+	timedOut, err = runWithTimeout(t, time.Minute, time.Hour)
+	assert.Assert(t, temporal.IsCanceledError(err))
+	assert.Assert(t, timedOut)
+}
@@ -55,6 +55,12 @@ type sessionWorkflow struct {
 	callSeq uint32
 
 	lastReadEventSeqForSignal map[uuid.UUID]uint64 // map signals to last read event seq num.
+
+	// Set when running the session's on_stop handler, after the session itself was stopped.
+	isOnStop bool
+
+	// Registered by the session as it runs. Called in reverse order if the session fails or is stopped.
+	compensations []compensation
 }
 
 type connInfo struct {
@@ -62,23 +68,20 @@ type connInfo struct {
 	IntegrationName string            `json:"integration_name"`
 }
 
-func runWorkflow(
-	wctx workflow.Context,
-	l *zap.Logger,
-	ws *workflows,
-	params sessionWorkflowParams,
-) (prints []sdkservices.SessionPrint, rv sdktypes.Value, err error) {
-	w := &sessionWorkflow{
+func newSessionWorkflow(wctx workflow.Context, l *zap.Logger, ws *workflows, data sessiondata.Data) *sessionWorkflow {
+	return &sessionWorkflow{
 		l:                         l,
-		data:                      params.Data,
+		data:                      data,
 		ws:                        ws,
 		lastReadEventSeqForSignal: make(map[uuid.UUID]uint64),
 		workflowExecutionID:       workflow.GetInfo(wctx).WorkflowExecution.ID,
 	}
+}
 
+func (w *sessionWorkflow) runWorkflow(wctx workflow.Context) (prints []sdkservices.SessionPrint, rv sdktypes.Value, err error) {
 	var cinfos map[string]connInfo
 
-	if cinfos, err = w.initConnections(wctx); err != nil {
+	if cinfos, err = w.initConnections(temporalclient.NewWorkflowContextAsGOContext(wctx)); err != nil {
 		return
 	}
 
@@ -90,7 +93,7 @@ func runWorkflow(
 		return
 	}
 
-	prints, rv, err = w.run(wctx, l)
+	prints, rv, err = w.run(wctx, w.l)
 
 	// context might have been canceled, create a disconnected one.
 	wctx, cancel := workflow.NewDisconnectedContext(wctx)
@@ -112,6 +115,11 @@ func (w *sessionWorkflow) cleanupSignals(ctx workflow.Context) {
 }
 
 func (w *sessionWorkflow) updateState(wctx workflow.Context, state sdktypes.SessionState) error {
+	if w.isOnStop {
+		// The session state is determined by the stop itself.
+		return nil
+	}
+
 	return w.ws.updateSessionState(wctx, w.data.Session.ID(), state)
 }
 
@@ -170,6 +178,23 @@ func (w *sessionWorkflow) outcome(wctx workflow.Context) func(ctx context.Contex
 	}
 }
 
+func (w *sessionWorkflow) setSessionTag(wctx workflow.Context) func(ctx context.Context, runID sdktypes.RunID, key, value string) error {
+	return func(ctx context.Context, runID sdktypes.RunID, key, value string) error {
+		ctx, span := w.startCallbackSpan(ctx, "set_session_tag")
+		defer span.End()
+
+		if err := sdktypes.ValidateSessionTag(key, value); err != nil {
+			return err
+		}
+
+		if activity.IsActivity(ctx) {
+			return w.ws.setSessionTagActivity(ctx, w.data.Session.ID(), key, value)
+		}
+
+		return workflow.ExecuteActivity(wctx, setSessionTagActivityName, w.data.Session.ID(), key, value).Get(wctx, nil)
+	}
+}
+
 func (w *sessionWorkflow) call(ctx workflow.Context, _ sdktypes.RunID, v sdktypes.Value, args []sdktypes.Value, kwargs map[string]sdktypes.Value) (sdktypes.Value, error) {
 	w.callSeq++
 
@@ -233,15 +258,13 @@ func (w *sessionWorkflow) initEnvModule(cinfos map[string]connInfo) error {
 
 func integrationModulePrefix(name string) string { return fmt.Sprintf("__%v__", name) }
 
-func (w *sessionWorkflow) initConnections(wctx workflow.Context) (map[string]connInfo, error) {
+func (w *sessionWorkflow) initConnections(goCtx context.Context) (map[string]connInfo, error) {
 	// In theory, all this code is reaching external systems for integrations, but since
 	// all the integrations are currently bundled with the AK binary, the operations
 	// are instantaneous. No need to use activities and such right now.
 
 	cinfos := make(map[string]connInfo, len(w.data.Connections))
 
-	goCtx := temporalclient.NewWorkflowContextAsGOContext(wctx)
-
 	for _, conn := range w.data.Connections {
 		name, cid, iid := conn.Name().String(), conn.ID(), conn.IntegrationID()
 
@@ -315,6 +338,24 @@ func (w *sessionWorkflow) initGlobalModules(wctx workflow.Context) (map[string]s
 	return map[string]sdktypes.Value{name: tt}, nil
 }
 
+func (w *sessionWorkflow) entryPointInputs() map[string]sdktypes.Value {
+	session := w.data.Session
+
+	inputs := map[string]sdktypes.Value{
+		"data":       sdktypes.NewDictValueFromStringMap(session.Inputs()),
+		"session_id": sdktypes.NewStringValue(session.ID().String()),
+		"event_id":   sdktypes.Nothing,
+		"event_type": sdktypes.Nothing,
+	}
+
+	if eid := session.EventID(); eid.IsValid() {
+		inputs["event_id"] = sdktypes.NewStringValue(eid.String())
+		inputs["event_type"] = sdktypes.NewStringValue(w.data.Event.Type())
+	}
+
+	return inputs
+}
+
 func (w *sessionWorkflow) run(wctx workflow.Context, l *zap.Logger) (_ []sdkservices.SessionPrint, retVal sdktypes.Value, _ error) {
 	ctx := temporalclient.NewWorkflowContextAsGOContext(wctx)
 
@@ -436,6 +477,7 @@ func (w *sessionWorkflow) run(wctx workflow.Context, l *zap.Logger) (_ []sdkserv
 			return workflow.Sleep(wctx, d)
 		},
 		Start:              w.start(wctx),
+		WaitSessions:       w.waitSessions(wctx),
 		Subscribe:          w.subscribe(wctx),
 		Unsubscribe:        w.unsubscribe(wctx),
 		NextEvent:          w.nextEvent(wctx),
@@ -445,6 +487,10 @@ func (w *sessionWorkflow) run(wctx workflow.Context, l *zap.Logger) (_ []sdkserv
 		ListStoreValues:    w.listStoreValues(wctx),
 		MutateStoreValue:   w.mutateStoreValue(wctx),
 		PublishStoreValue:  w.publishStoreValue(wctx),
+		SetSessionTag:      w.setSessionTag(wctx),
+		RequestApproval:    w.requestApproval(wctx),
+
+		RegisterCompensation: w.registerCompensation(wctx),
 	}
 
 	runID, err := newRunID()
@@ -489,6 +535,10 @@ func (w *sessionWorkflow) run(wctx workflow.Context, l *zap.Logger) (_ []sdkserv
 
 	kittehs.Must0(w.executors.AddExecutor(fmt.Sprintf("run_%s", run.ID().Value()), run))
 
+	// Some runtimes keep resources alive after the entrypoint call, as long as
+	// they might still be called for compensations.
+	defer run.Close()
+
 	// Run call only if the entrypoint includes a name.
 	if epName := entryPoint.Name(); epName != "" {
 		callValue, ok := run.Values()[epName]
@@ -512,17 +562,7 @@ func (w *sessionWorkflow) run(wctx workflow.Context, l *zap.Logger) (_ []sdkserv
 			return printer.Finalize(), sdktypes.InvalidValue, err
 		}
 
-		inputs := map[string]sdktypes.Value{
-			"data":       sdktypes.NewDictValueFromStringMap(session.Inputs()),
-			"session_id": sdktypes.NewStringValue(session.ID().String()),
-			"event_id":   sdktypes.Nothing,
-			"event_type": sdktypes.Nothing,
-		}
-
-		if eid := session.EventID(); eid.IsValid() {
-			inputs["event_id"] = sdktypes.NewStringValue(eid.String())
-			inputs["event_type"] = sdktypes.NewStringValue(w.data.Event.Type())
-		}
+		inputs := w.entryPointInputs()
 
 		callCtx, callSpan := startTrace(ctx, "session.call")
 		callSpan.SetAttributes(attribute.String("function_name", callValue.GetFunction().Name().String()))
@@ -537,6 +577,8 @@ func (w *sessionWorkflow) run(wctx workflow.Context, l *zap.Logger) (_ []sdkserv
 		}
 
 		if err != nil {
+			w.compensate(wctx)
+
 			return printer.Finalize(), sdktypes.InvalidValue, err
 		}
 
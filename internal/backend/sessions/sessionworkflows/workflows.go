@@ -28,6 +28,7 @@ import (
 const (
 	terminateSessionWorkflowName        = "terminate_session"
 	delayedTerminateSessionWorkflowName = "delayed_terminate_session"
+	retrySessionWorkflowName            = "session_retry"
 	utilsWorkerQueue                    = "utils"
 )
 
@@ -38,7 +39,11 @@ type Workflows interface {
 	StartWorkflow(ctx context.Context, session sdktypes.Session) error
 	StartChildWorkflow(wctx workflow.Context, session sdktypes.Session) (sdktypes.SessionID, error)
 	GetWorkflowLog(ctx context.Context, filter sdkservices.SessionLogRecordsFilter) (*sdkservices.GetLogResults, error)
+	WatchWorkflowLog(ctx context.Context, sid sdktypes.SessionID, types sdktypes.SessionLogRecordType, f func(sdktypes.SessionLogRecord) error) error
 	StopWorkflow(ctx context.Context, sessionID sdktypes.SessionID, reason string, force bool, cancelTimeout time.Duration) error
+	ReplayWorkflow(ctx context.Context, data *sessiondata.Data, opts sdkservices.ReplaySessionOptions) (*sdkservices.ReplaySessionResult, error)
+	StartBulkOperation(ctx context.Context, params BulkOperationParams) (string, error)
+	GetBulkOperation(ctx context.Context, operationID string) (*sdkservices.BulkSessionsOperation, sdktypes.OrgID, error)
 }
 
 type sessionWorkflowParams struct {
@@ -92,6 +97,16 @@ func (ws *workflows) StartWorkers(ctx context.Context) error {
 		workflow.RegisterOptions{Name: delayedTerminateSessionWorkflowName},
 	)
 
+	ws.utilsWorker.RegisterWorkflowWithOptions(
+		ws.bulkSessionsWorkflow,
+		workflow.RegisterOptions{Name: bulkSessionsWorkflowName},
+	)
+
+	ws.utilsWorker.RegisterWorkflowWithOptions(
+		ws.retrySessionWorkflow,
+		workflow.RegisterOptions{Name: retrySessionWorkflowName},
+	)
+
 	ws.registerActivities()
 
 	if err := ws.sessionsWorker.Start(); err != nil {
@@ -243,10 +258,16 @@ func (ws *workflows) sessionWorkflow(wctx workflow.Context, params sessionWorkfl
 		metric.WithAttributes(attribute.Bool("replay", isReplaying)),
 	)
 
+	rctx, timedOut := ws.withTimeout(wctx, l, session)
+
 	startTime := time.Now() // we want actual start time for metrics.
-	prints, retVal, err := runWorkflow(wctx, l, ws, params)
+	w := newSessionWorkflow(wctx, l, ws, params.Data)
+
+	prints, retVal, err := w.runWorkflow(rctx)
 	duration := time.Since(startTime)
 
+	didTimeOut := timedOut()
+
 	// from this point on we should not be doing anything really that should be cancelled.
 	// the original wctx might have been cancelled, so we work with a disconnected context
 	// to avoid any belated non-timely cancellations.
@@ -267,21 +288,37 @@ func (ws *workflows) sessionWorkflow(wctx workflow.Context, params sessionWorkfl
 
 	workflowErr := err
 
+	var retryErr error // set if the session should be considered for a retry.
+
 	dwctx, done := workflow.NewDisconnectedContext(wctx)
 	defer done()
 
 	if err != nil {
 		l := l.With(zap.Error(err))
 
-		if wctxErr := wctx.Err(); errors.Is(err, workflow.ErrCanceled) || errors.Is(wctxErr, workflow.ErrCanceled) {
+		if didTimeOut {
+			sessionsStoppedCounter.Add(metricsCtx, 1)
+
+			l.Info("session workflow timed out")
+
+			ws.onStop(dwctx, w, timeoutReason(session.Timeout()))
+
+			ws.timedOut(dwctx, sid, session.Timeout())
+		} else if wctxErr := wctx.Err(); errors.Is(err, workflow.ErrCanceled) || errors.Is(wctxErr, workflow.ErrCanceled) {
 			sessionsStoppedCounter.Add(metricsCtx, 1)
 
 			l.With(zap.Any("ctx_err", wctxErr)).Info("session workflow canceled")
 
-			ws.stopped(dwctx, sid)
+			reason := ws.stopReason(dwctx, sid)
+
+			ws.onStop(dwctx, w, reason)
+
+			ws.stopped(dwctx, sid, reason)
 		} else {
 			ws.errored(dwctx, sid, err, kittehs.Transform(prints, func(p sdkservices.SessionPrint) string { return p.Value.GetString().Value() }))
 
+			retryErr = err
+
 			if _, ok := sdktypes.FromError(err); ok {
 				// User level error (convertible to ProgramError).
 				l.Info("session workflow program error")
@@ -331,13 +368,18 @@ func (ws *workflows) sessionWorkflow(wctx workflow.Context, params sessionWorkfl
 		ws.l.Info("notify workflow ended activity failed", zap.Error(err))
 	}
 	didNotifyDone = true
+
+	ws.admitQueuedTriggerEvents(dwctx, l, &params.Data)
+
+	if retryErr != nil {
+		ws.retry(wctx, l, &params.Data, retryErr)
+	}
+
 	return workflowErr
 }
 
 // workflow is stopped, so this assumes the given wctx is a disconnected context.
-func (ws *workflows) stopped(wctx workflow.Context, sessionID sdktypes.SessionID) {
-	var reason string
-
+func (ws *workflows) stopReason(wctx workflow.Context, sessionID sdktypes.SessionID) (reason string) {
 	if err := workflow.ExecuteActivity(wctx, getSessionStopReasonActivityName, sessionID).Get(wctx, &reason); err != nil {
 		// error here is always a temporal error since the local activity above would never return an error.
 		// it is just nice like that.
@@ -346,6 +388,11 @@ func (ws *workflows) stopped(wctx workflow.Context, sessionID sdktypes.SessionID
 		reason = "<unknown>"
 	}
 
+	return
+}
+
+// workflow is stopped, so this assumes the given wctx is a disconnected context.
+func (ws *workflows) stopped(wctx workflow.Context, sessionID sdktypes.SessionID, reason string) {
 	_ = ws.updateSessionState(wctx, sessionID, sdktypes.NewSessionStateStopped(reason))
 }
 
@@ -373,6 +420,8 @@ func (ws *workflows) StopWorkflow(ctx context.Context, sessionID sdktypes.Sessio
 		return err
 	}
 
+	ws.svcs.Notifier.Notify(sessionID)
+
 	wid := workflowID(sessionID)
 
 	// Always first try to terminate politely.
@@ -402,6 +451,8 @@ func (ws *workflows) StopWorkflow(ctx context.Context, sessionID sdktypes.Sessio
 				return fmt.Errorf("update session state: %w", err)
 			}
 
+			ws.svcs.Notifier.Notify(sessionID)
+
 			return nil
 		}
 
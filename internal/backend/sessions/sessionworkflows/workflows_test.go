@@ -42,6 +42,11 @@ func (m *mockDB) UpdateSessionState(ctx context.Context, sessionID sdktypes.Sess
 	return args.Error(0)
 }
 
+func (m *mockDB) SetSessionTag(_ context.Context, sid sdktypes.SessionID, key, value string) error {
+	args := m.Called(sid, key, value)
+	return args.Error(0)
+}
+
 type fakeTemporalClient struct {
 	temporalclient.Client
 	t client.Client
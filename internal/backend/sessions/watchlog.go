@@ -0,0 +1,228 @@
+package sessions
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/auth/authz"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+const (
+	// Records written to the db.
+	dbLogRecordTypes = sdktypes.PrintSessionLogRecordType |
+		sdktypes.StateSessionLogRecordType |
+		sdktypes.StopRequestSessionLogRecordType |
+		sdktypes.OutcomeSessionLogRecordType |
+		sdktypes.CompensationSessionLogRecordType
+
+	// Records kept in the workflow history.
+	callLogRecordTypes = sdktypes.CallSpecSessionLogRecordType |
+		sdktypes.CallAttemptStartSessionLogRecordType |
+		sdktypes.CallAttemptCompleteSessionLogRecordType
+)
+
+func (s *sessions) WatchLog(ctx context.Context, filter sdkservices.SessionLogRecordsFilter, f func(sdktypes.SessionLogRecord) error) error {
+	if err := authz.CheckContext(
+		ctx,
+		filter.SessionID,
+		authz.OpSessionReadWatchLog,
+		authz.WithData("filter", filter),
+		authz.WithConvertForbiddenToNotFound,
+	); err != nil {
+		return err
+	}
+
+	types := filter.Types
+	if types == 0 {
+		types = dbLogRecordTypes | callLogRecordTypes
+	}
+
+	notified, unsubscribe := s.svcs.Notifier.Subscribe(filter.SessionID)
+	defer unsubscribe()
+
+	ticker := time.NewTicker(s.config.WatchLogPollInterval)
+	defer ticker.Stop()
+
+	// Stops watching the workflow history on return.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w := newLogWatcher(s, filter.SessionID, types, f)
+
+	for {
+		// Get the state before flushing, so once the session is seen as final
+		// the flush is guaranteed to include all of its records.
+		session, err := s.svcs.DB.GetSession(ctx, filter.SessionID)
+		if err != nil {
+			return err
+		}
+
+		final := session.State().IsFinal()
+
+		w.watchCalls(ctx)
+
+		// The workflow history of a final session is complete once its
+		// workflow is closed.
+		if err := w.checkCalls(ctx, final); err != nil {
+			return err
+		}
+
+		if err := w.flush(ctx); err != nil {
+			return err
+		}
+
+		if final {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-notified:
+		case <-w.callsReady:
+		case <-ticker.C:
+		}
+	}
+}
+
+// logWatcher merges the db log with the calls from the workflow history.
+// The db log is polled, keeping track of how many records were already passed
+// on from it, as it is append only. Notifications of db log changes are
+// in-process only, so changes made by other workers are seen on the next poll.
+// The workflow history is long polled in the background, so new calls are
+// seen as they are made regardless of which worker runs the session.
+type logWatcher struct {
+	sessions *sessions
+	sid      sdktypes.SessionID
+	types    sdktypes.SessionLogRecordType
+	f        func(sdktypes.SessionLogRecord) error
+
+	dbSeen int32
+
+	mu         sync.Mutex
+	calls      []sdktypes.SessionLogRecord // not flushed yet.
+	callsReady chan struct{}
+
+	callsDone   chan error // nil if the history is not watched.
+	callsClosed bool       // the whole history was read.
+	callsInDB   bool       // the session has no workflow history, its calls are in the db.
+}
+
+func newLogWatcher(s *sessions, sid sdktypes.SessionID, types sdktypes.SessionLogRecordType, f func(sdktypes.SessionLogRecord) error) *logWatcher {
+	return &logWatcher{sessions: s, sid: sid, types: types, f: f, callsReady: make(chan struct{}, 1)}
+}
+
+// watchCalls starts watching the workflow history, unless it is already
+// watched, was read completely or there is none.
+func (w *logWatcher) watchCalls(ctx context.Context) {
+	callTypes := w.types & callLogRecordTypes
+
+	if callTypes == 0 || w.callsDone != nil || w.callsClosed || w.callsInDB {
+		return
+	}
+
+	done := make(chan error, 1)
+	w.callsDone = done
+
+	go func() {
+		done <- w.sessions.workflows.WatchWorkflowLog(ctx, w.sid, callTypes, func(r sdktypes.SessionLogRecord) error {
+			w.mu.Lock()
+			w.calls = append(w.calls, r)
+			w.mu.Unlock()
+
+			select {
+			case w.callsReady <- struct{}{}:
+			default:
+			}
+
+			return nil
+		})
+	}()
+}
+
+// checkCalls checks if watching the workflow history is done. For final
+// sessions, it waits for it.
+func (w *logWatcher) checkCalls(ctx context.Context, final bool) error {
+	if w.callsDone == nil {
+		return nil
+	}
+
+	var err error
+
+	if final {
+		select {
+		case err = <-w.callsDone:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	} else {
+		select {
+		case err = <-w.callsDone:
+		default:
+			return nil
+		}
+	}
+
+	w.callsDone = nil
+
+	switch {
+	case err == nil:
+		w.callsClosed = true
+	case errors.Is(err, sdkerrors.ErrNotFound):
+		// Not started yet, lost or imported. Imported sessions have their
+		// calls in the db, for all others this makes no difference. A session
+		// that is not final might not be started yet, so its history is
+		// watched again on the next poll.
+		w.callsInDB = final
+	default:
+		return err
+	}
+
+	return nil
+}
+
+func (w *logWatcher) flush(ctx context.Context) error {
+	w.mu.Lock()
+	rs := w.calls
+	w.calls = nil
+	w.mu.Unlock()
+
+	dbTypes := w.types & dbLogRecordTypes
+	if w.callsInDB {
+		dbTypes = w.types
+	}
+
+	if dbTypes != 0 {
+		log, err := w.sessions.svcs.DB.GetSessionLog(ctx, w.filter(dbTypes, w.dbSeen))
+		if err != nil {
+			return err
+		}
+
+		rs = append(rs, log.Records...)
+		w.dbSeen += int32(len(log.Records))
+	}
+
+	slices.SortStableFunc(rs, func(a, b sdktypes.SessionLogRecord) int { return a.Timestamp().Compare(b.Timestamp()) })
+
+	for _, r := range rs {
+		if err := w.f(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *logWatcher) filter(types sdktypes.SessionLogRecordType, skip int32) sdkservices.SessionLogRecordsFilter {
+	return sdkservices.SessionLogRecordsFilter{
+		SessionID:         w.sid,
+		Types:             types,
+		PaginationRequest: sdktypes.PaginationRequest{Skip: skip, Ascending: true},
+	}
+}
@@ -0,0 +1,121 @@
+package sessions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/db"
+	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessionsvcs"
+	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessionworkflows"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+type fakeLogDB struct {
+	db.DB
+	rs      []sdktypes.SessionLogRecord
+	filters []sdkservices.SessionLogRecordsFilter
+}
+
+func (f *fakeLogDB) GetSessionLog(_ context.Context, filter sdkservices.SessionLogRecordsFilter) (*sdkservices.GetLogResults, error) {
+	f.filters = append(f.filters, filter)
+
+	var rs []sdktypes.SessionLogRecord
+	for _, r := range f.rs {
+		if filter.Types&r.Type() != 0 {
+			rs = append(rs, r)
+		}
+	}
+
+	return &sdkservices.GetLogResults{Records: rs[min(int(filter.Skip), len(rs)):]}, nil
+}
+
+type fakeLogWorkflows struct {
+	sessionworkflows.Workflows
+	calls   []sdktypes.SessionLogRecord
+	err     error
+	watched int
+}
+
+func (f *fakeLogWorkflows) WatchWorkflowLog(_ context.Context, _ sdktypes.SessionID, _ sdktypes.SessionLogRecordType, cb func(sdktypes.SessionLogRecord) error) error {
+	f.watched++
+
+	for _, r := range f.calls {
+		if err := cb(r); err != nil {
+			return err
+		}
+	}
+
+	return f.err
+}
+
+func newTestLogWatcher(fdb *fakeLogDB, fwfs *fakeLogWorkflows) (*logWatcher, *[]sdktypes.SessionLogRecord) {
+	s := &sessions{svcs: &sessionsvcs.Svcs{DB: fdb}, workflows: fwfs}
+
+	var got []sdktypes.SessionLogRecord
+
+	return newLogWatcher(s, sdktypes.NewSessionID(), dbLogRecordTypes|callLogRecordTypes, func(r sdktypes.SessionLogRecord) error {
+		got = append(got, r)
+		return nil
+	}), &got
+}
+
+func TestLogWatcherMerge(t *testing.T) {
+	t0 := time.Now()
+
+	call := sdktypes.NewCallAttemptStartSessionLogRecord(t0.Add(time.Second), sdktypes.NewSessionCallAttemptStart(t0, 1))
+	print1 := sdktypes.NewPrintSessionLogRecord(t0, sdktypes.NewStringValue("1"), 0)
+	print2 := sdktypes.NewPrintSessionLogRecord(t0.Add(2*time.Second), sdktypes.NewStringValue("2"), 0)
+
+	fdb := &fakeLogDB{rs: []sdktypes.SessionLogRecord{print1, print2}}
+	fwfs := &fakeLogWorkflows{calls: []sdktypes.SessionLogRecord{call}}
+
+	w, got := newTestLogWatcher(fdb, fwfs)
+
+	w.watchCalls(t.Context())
+	require.NoError(t, w.checkCalls(t.Context(), true))
+	require.NoError(t, w.flush(t.Context()))
+
+	assert.Equal(t, []sdktypes.SessionLogRecord{print1, call, print2}, *got)
+	assert.True(t, w.callsClosed)
+
+	// The history is read once, and the db log is paged from the last seen record.
+	w.watchCalls(t.Context())
+	require.NoError(t, w.checkCalls(t.Context(), true))
+	require.NoError(t, w.flush(t.Context()))
+
+	assert.Equal(t, 1, fwfs.watched)
+	assert.Len(t, *got, 3)
+	assert.Equal(t, int32(2), fdb.filters[1].Skip)
+}
+
+func TestLogWatcherNoHistory(t *testing.T) {
+	call := sdktypes.NewCallAttemptStartSessionLogRecord(time.Now(), sdktypes.NewSessionCallAttemptStart(time.Now(), 1))
+
+	fdb := &fakeLogDB{rs: []sdktypes.SessionLogRecord{call}}
+	fwfs := &fakeLogWorkflows{err: sdkerrors.ErrNotFound}
+
+	w, got := newTestLogWatcher(fdb, fwfs)
+
+	// A session that is not final might not be started yet.
+	w.watchCalls(t.Context())
+	require.Eventually(t, func() bool {
+		require.NoError(t, w.checkCalls(t.Context(), false))
+		return w.callsDone == nil
+	}, time.Second, time.Millisecond)
+	assert.False(t, w.callsInDB)
+
+	// A final session without history has its calls in the db.
+	w.watchCalls(t.Context())
+	require.NoError(t, w.checkCalls(t.Context(), true))
+	assert.Equal(t, 2, fwfs.watched)
+	assert.True(t, w.callsInDB)
+	require.NoError(t, w.flush(t.Context()))
+
+	assert.Equal(t, []sdktypes.SessionLogRecord{call}, *got)
+}
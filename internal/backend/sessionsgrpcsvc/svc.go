@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.autokitteh.dev/autokitteh/internal/backend/muxes"
@@ -220,6 +221,29 @@ func (s *server) GetLog(ctx context.Context, req *connect.Request[sessionsv1.Get
 	return connect.NewResponse(&sessionsv1.GetLogResponse{Records: pbrs, Count: hist.TotalCount, NextPageToken: hist.NextPageToken}), nil
 }
 
+func (s *server) WatchLog(ctx context.Context, req *connect.Request[sessionsv1.WatchLogRequest], stream *connect.ServerStream[sessionsv1.WatchLogResponse]) error {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return sdkerrors.AsConnectError(err)
+	}
+
+	sid, err := sdktypes.StrictParseSessionID(msg.SessionId)
+	if err != nil {
+		return sdkerrors.AsConnectError(err)
+	}
+
+	filter := sdkservices.SessionLogRecordsFilter{SessionID: sid, Types: msg.Types}
+
+	if err := s.sessions.WatchLog(ctx, filter, func(r sdktypes.SessionLogRecord) error {
+		return stream.Send(&sessionsv1.WatchLogResponse{Record: r.ToProto()})
+	}); err != nil {
+		return sdkerrors.AsConnectError(err)
+	}
+
+	return nil
+}
+
 func (s *server) GetPrints(ctx context.Context, req *connect.Request[sessionsv1.GetPrintsRequest]) (*connect.Response[sessionsv1.GetPrintsResponse], error) {
 	msg := req.Msg
 
@@ -286,20 +310,55 @@ func (s *server) DownloadLogs(ctx context.Context, req *connect.Request[sessions
 	return connect.NewResponse(&sessionsv1.DownloadLogsResponse{Data: data}), nil
 }
 
-func (s *server) List(ctx context.Context, req *connect.Request[sessionsv1.ListRequest]) (*connect.Response[sessionsv1.ListResponse], error) {
+func (s *server) Export(ctx context.Context, req *connect.Request[sessionsv1.ExportRequest]) (*connect.Response[sessionsv1.ExportResponse], error) {
 	msg := req.Msg
 
 	if err := proto.Validate(msg); err != nil {
 		return nil, sdkerrors.AsConnectError(err)
 	}
 
+	sid, err := sdktypes.StrictParseSessionID(msg.SessionId)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	bundle, err := s.sessions.Export(ctx, sid)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	return connect.NewResponse(&sessionsv1.ExportResponse{Bundle: bundle}), nil
+}
+
+func (s *server) Import(ctx context.Context, req *connect.Request[sessionsv1.ImportRequest]) (*connect.Response[sessionsv1.ImportResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	pid, err := sdktypes.StrictParseProjectID(msg.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	sid, err := s.sessions.Import(ctx, pid, msg.Bundle)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	return connect.NewResponse(&sessionsv1.ImportResponse{SessionId: sid.String()}), nil
+}
+
+func listFilterFromProto(msg *sessionsv1.ListRequest) (filter sdkservices.ListSessionsFilter, err error) {
 	stateType, err := sdktypes.SessionStateTypeFromProto(msg.StateType)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("state_type: %w", err))
+		return filter, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("state_type: %w", err))
 	}
 
-	filter := sdkservices.ListSessionsFilter{
+	filter = sdkservices.ListSessionsFilter{
 		StateType: stateType,
+		Tags:      msg.Tags,
 		CountOnly: msg.CountOnly,
 		PaginationRequest: sdktypes.PaginationRequest{
 			Skip:      msg.Skip,
@@ -316,22 +375,45 @@ func (s *server) List(ctx context.Context, req *connect.Request[sessionsv1.ListR
 		filter.PageSize = 10
 	}
 
-	if filter.DeploymentID, err = sdktypes.ParseDeploymentID(req.Msg.DeploymentId); err != nil {
-		return nil, sdkerrors.AsConnectError(err)
+	if filter.DeploymentID, err = sdktypes.ParseDeploymentID(msg.DeploymentId); err != nil {
+		return filter, sdkerrors.AsConnectError(err)
 	}
 
-	if filter.EventID, err = sdktypes.ParseEventID(req.Msg.EventId); err != nil {
-		return nil, sdkerrors.AsConnectError(err)
+	if filter.EventID, err = sdktypes.ParseEventID(msg.EventId); err != nil {
+		return filter, sdkerrors.AsConnectError(err)
 	}
 
-	if filter.ProjectID, err = sdktypes.ParseProjectID(req.Msg.ProjectId); err != nil {
-		return nil, sdkerrors.AsConnectError(err)
+	if filter.ProjectID, err = sdktypes.ParseProjectID(msg.ProjectId); err != nil {
+		return filter, sdkerrors.AsConnectError(err)
+	}
+
+	if filter.OrgID, err = sdktypes.ParseOrgID(msg.OrgId); err != nil {
+		return filter, sdkerrors.AsConnectError(err)
+	}
+
+	if msg.CreatedAfter != nil {
+		filter.CreatedAfter = msg.CreatedAfter.AsTime()
+	}
+
+	if msg.CreatedBefore != nil {
+		filter.CreatedBefore = msg.CreatedBefore.AsTime()
 	}
 
-	if filter.OrgID, err = sdktypes.ParseOrgID(req.Msg.OrgId); err != nil {
+	return filter, nil
+}
+
+func (s *server) List(ctx context.Context, req *connect.Request[sessionsv1.ListRequest]) (*connect.Response[sessionsv1.ListResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
 		return nil, sdkerrors.AsConnectError(err)
 	}
 
+	filter, err := listFilterFromProto(msg)
+	if err != nil {
+		return nil, err
+	}
+
 	result, err := s.sessions.List(ctx, filter)
 	if err != nil {
 		return nil, sdkerrors.AsConnectError(err)
@@ -342,6 +424,36 @@ func (s *server) List(ctx context.Context, req *connect.Request[sessionsv1.ListR
 	return connect.NewResponse(&sessionsv1.ListResponse{Sessions: pbsessions, Count: result.TotalCount, NextPageToken: result.NextPageToken}), nil
 }
 
+func (s *server) Search(ctx context.Context, req *connect.Request[sessionsv1.SearchRequest]) (*connect.Response[sessionsv1.SearchResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	lfilter, err := listFilterFromProto(msg.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := sdkservices.SearchSessionsFilter{
+		ListSessionsFilter: lfilter,
+		PrintsContain:      msg.PrintsContain,
+		ErrorContains:      msg.ErrorContains,
+		TriggerName:        msg.TriggerName,
+		EntryPoint:         msg.EntryPoint,
+	}
+
+	result, err := s.sessions.Search(ctx, filter)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	pbsessions := kittehs.Transform(result.Sessions, sdktypes.ToProto)
+
+	return connect.NewResponse(&sessionsv1.SearchResponse{Sessions: pbsessions, Count: result.TotalCount, NextPageToken: result.NextPageToken}), nil
+}
+
 func (s *server) Delete(ctx context.Context, req *connect.Request[sessionsv1.DeleteRequest]) (*connect.Response[sessionsv1.DeleteResponse], error) {
 	msg := req.Msg
 
@@ -359,3 +471,214 @@ func (s *server) Delete(ctx context.Context, req *connect.Request[sessionsv1.Del
 	}
 	return connect.NewResponse(&sessionsv1.DeleteResponse{}), nil
 }
+
+func (s *server) StopMany(ctx context.Context, req *connect.Request[sessionsv1.StopManyRequest]) (*connect.Response[sessionsv1.StopManyResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	filter, err := listFilterFromProto(msg.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.sessions.StopMany(ctx, filter, msg.Reason, msg.Terminate)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	return connect.NewResponse(&sessionsv1.StopManyResponse{OperationId: id}), nil
+}
+
+func (s *server) DeleteMany(ctx context.Context, req *connect.Request[sessionsv1.DeleteManyRequest]) (*connect.Response[sessionsv1.DeleteManyResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	filter, err := listFilterFromProto(msg.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.sessions.DeleteMany(ctx, filter)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	return connect.NewResponse(&sessionsv1.DeleteManyResponse{OperationId: id}), nil
+}
+
+func (s *server) RestartMany(ctx context.Context, req *connect.Request[sessionsv1.RestartManyRequest]) (*connect.Response[sessionsv1.RestartManyResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	filter, err := listFilterFromProto(msg.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.sessions.RestartMany(ctx, filter)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	return connect.NewResponse(&sessionsv1.RestartManyResponse{OperationId: id}), nil
+}
+
+func (s *server) GetBulkOperation(ctx context.Context, req *connect.Request[sessionsv1.GetBulkOperationRequest]) (*connect.Response[sessionsv1.GetBulkOperationResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	op, err := s.sessions.GetBulkOperation(ctx, msg.OperationId)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	return connect.NewResponse(&sessionsv1.GetBulkOperationResponse{
+		Operation: &sessionsv1.BulkOperation{
+			OperationId: op.ID,
+			Kind:        string(op.Kind),
+			Done:        op.Done,
+			Total:       op.Total,
+			Succeeded:   op.Succeeded,
+			Failed:      op.Failed,
+			Errors:      op.Errors,
+		},
+	}), nil
+}
+
+func parseRetentionScope(oid, pid string) (scope sdkservices.SessionRetentionScope, err error) {
+	if scope.OrgID, err = sdktypes.ParseOrgID(oid); err != nil {
+		return
+	}
+
+	scope.ProjectID, err = sdktypes.ParseProjectID(pid)
+	return
+}
+
+func (s *server) SetRetentionPolicy(ctx context.Context, req *connect.Request[sessionsv1.SetRetentionPolicyRequest]) (*connect.Response[sessionsv1.SetRetentionPolicyResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	scope, err := parseRetentionScope(msg.Policy.OrgId, msg.Policy.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	states, err := kittehs.TransformError(msg.Policy.States, sdktypes.SessionStateTypeFromProto)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	policy := sdkservices.SessionRetentionPolicy{
+		SessionRetentionScope: scope,
+		MaxAge:                msg.Policy.MaxAge.AsDuration(),
+		MaxCount:              int(msg.Policy.MaxCount),
+		States:                states,
+	}
+
+	if err := s.sessions.SetRetentionPolicy(ctx, policy); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	return connect.NewResponse(&sessionsv1.SetRetentionPolicyResponse{}), nil
+}
+
+func (s *server) GetRetentionPolicy(ctx context.Context, req *connect.Request[sessionsv1.GetRetentionPolicyRequest]) (*connect.Response[sessionsv1.GetRetentionPolicyResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	scope, err := parseRetentionScope(msg.OrgId, msg.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	policy, err := s.sessions.GetRetentionPolicy(ctx, scope)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	if policy == nil {
+		return connect.NewResponse(&sessionsv1.GetRetentionPolicyResponse{}), nil
+	}
+
+	pb := &sessionsv1.RetentionPolicy{
+		OrgId:     msg.OrgId,
+		ProjectId: msg.ProjectId,
+		MaxCount:  int32(policy.MaxCount),
+		States:    kittehs.Transform(policy.States, sdktypes.SessionStateType.ToProto),
+	}
+
+	if policy.MaxAge != 0 {
+		pb.MaxAge = durationpb.New(policy.MaxAge)
+	}
+
+	return connect.NewResponse(&sessionsv1.GetRetentionPolicyResponse{Policy: pb}), nil
+}
+
+func (s *server) DeleteRetentionPolicy(ctx context.Context, req *connect.Request[sessionsv1.DeleteRetentionPolicyRequest]) (*connect.Response[sessionsv1.DeleteRetentionPolicyResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	scope, err := parseRetentionScope(msg.OrgId, msg.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	if err := s.sessions.DeleteRetentionPolicy(ctx, scope); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	return connect.NewResponse(&sessionsv1.DeleteRetentionPolicyResponse{}), nil
+}
+
+func (s *server) Replay(ctx context.Context, req *connect.Request[sessionsv1.ReplayRequest]) (*connect.Response[sessionsv1.ReplayResponse], error) {
+	msg := req.Msg
+
+	if err := proto.Validate(msg); err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	sid, err := sdktypes.StrictParseSessionID(msg.SessionId)
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	result, err := s.sessions.Replay(ctx, sid, sdkservices.ReplaySessionOptions{StopAtSeq: msg.StopAtSeq})
+	if err != nil {
+		return nil, sdkerrors.AsConnectError(err)
+	}
+
+	return connect.NewResponse(&sessionsv1.ReplayResponse{
+		Calls: kittehs.Transform(result.Calls, func(c sdkservices.ReplayedSessionCall) *sessionsv1.ReplayResponse_ReplayedCall {
+			return &sessionsv1.ReplayResponse_ReplayedCall{
+				Spec:   c.Spec.ToProto(),
+				Result: c.Result.ToProto(),
+			}
+		}),
+		Prints:      result.Prints,
+		Stopped:     result.Stopped,
+		Values:      kittehs.TransformMapValues(result.Values, sdktypes.ToProto),
+		ReturnValue: result.ReturnValue.ToProto(),
+		Error:       result.Error.ToProto(),
+	}), nil
+}
@@ -0,0 +1,50 @@
+package store
+
+import (
+	"maps"
+	"sync"
+	"time"
+
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+type triggersCacheEntry struct {
+	triggers  []sdktypes.Trigger
+	expiresAt time.Time
+}
+
+// triggersCache holds the store triggers of projects for a short while, so
+// that not every write needs to look them up.
+type triggersCache struct {
+	mu      sync.Mutex
+	entries map[sdktypes.ProjectID]triggersCacheEntry
+}
+
+func (c *triggersCache) get(pid sdktypes.ProjectID, ttl time.Duration) ([]sdktypes.Trigger, bool) {
+	if ttl <= 0 {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[pid]
+	if !ok || kittehs.Now().After(e.expiresAt) {
+		return nil, false
+	}
+
+	return e.triggers, true
+}
+
+func (c *triggersCache) put(pid sdktypes.ProjectID, ts []sdktypes.Trigger, ttl time.Duration) {
+	now := kittehs.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Expired entries are dropped as new ones come in.
+	maps.DeleteFunc(c.entries, func(_ sdktypes.ProjectID, e triggersCacheEntry) bool { return now.After(e.expiresAt) })
+
+	c.entries[pid] = triggersCacheEntry{triggers: ts, expiresAt: now.Add(ttl)}
+}
@@ -0,0 +1,34 @@
+package store
+
+import (
+	"context"
+
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+type sessionIDCtxKey struct{}
+
+// WithSessionID marks the mutation as done on behalf of a session.
+// Lock operations require it, as locks are owned by sessions.
+func WithSessionID(ctx context.Context, sid sdktypes.SessionID) context.Context {
+	return context.WithValue(ctx, sessionIDCtxKey{}, sid)
+}
+
+func sessionIDFromContext(ctx context.Context) sdktypes.SessionID {
+	sid, _ := ctx.Value(sessionIDCtxKey{}).(sdktypes.SessionID)
+	return sid
+}
+
+type eventDepthCtxKey struct{}
+
+// WithEventDepth marks the mutation as done by a session that was started,
+// directly or not, by depth store events. Events emitted by the mutation
+// carry it in their data as "depth".
+func WithEventDepth(ctx context.Context, depth int) context.Context {
+	return context.WithValue(ctx, eventDepthCtxKey{}, depth)
+}
+
+func eventDepthFromContext(ctx context.Context) int {
+	depth, _ := ctx.Value(eventDepthCtxKey{}).(int)
+	return depth
+}
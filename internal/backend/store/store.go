@@ -3,14 +3,19 @@ package store
 import (
 	"context"
 	"errors"
+	"fmt"
 	"maps"
 	"slices"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
+	"go.autokitteh.dev/autokitteh/internal/backend/auth/authcontext"
 	"go.autokitteh.dev/autokitteh/internal/backend/auth/authz"
 	"go.autokitteh.dev/autokitteh/internal/backend/configset"
 	"go.autokitteh.dev/autokitteh/internal/backend/db"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
 	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
 	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
@@ -19,39 +24,107 @@ import (
 type Config struct {
 	MaxValueSizeBytes   int `koanf:"max_value_size_bytes"`
 	MaxValuesPerProject int `koanf:"max_values_per_project"`
+
+	// Maximum number of values per key prefix in each project, in addition
+	// to MaxValuesPerProject. A key is subject to all prefixes it starts with.
+	MaxValuesPerPrefix map[string]int `koanf:"max_values_per_prefix"`
+
+	// How long the store triggers of a project are cached for. Changes to
+	// store triggers might take this long to take effect. Zero disables caching.
+	TriggersCacheTTL time.Duration `koanf:"triggers_cache_ttl"`
+
+	// Changes made by sessions that were started by store events emit events
+	// of their own. This limits how deep such chains can go, to prevent a
+	// session from triggering itself endlessly. Zero means no limit.
+	MaxEventDepth int `koanf:"max_event_depth"`
 }
 
 var Configs = configset.Set[Config]{
 	Default: &Config{
 		MaxValueSizeBytes:   64 * 1024, // 64 KiB
 		MaxValuesPerProject: 64,
+		TriggersCacheTTL:    10 * time.Second,
+		MaxEventDepth:       8,
 	},
 }
 
-type store struct {
-	cfg *Config
-	db  db.DB
-	l   *zap.Logger
+type Store struct {
+	cfg        *Config
+	db         db.DB
+	l          *zap.Logger
+	dispatcher sdkservices.Dispatcher
+	triggers   triggersCache
 }
 
-func New(db db.DB, l *zap.Logger, cfg *Config) sdkservices.Store {
-	return &store{db: db, l: l, cfg: cfg}
+var _ sdkservices.Store = (*Store)(nil)
+
+func New(db db.DB, l *zap.Logger, cfg *Config) *Store {
+	return &Store{db: db, l: l, cfg: cfg, triggers: triggersCache{entries: make(map[sdktypes.ProjectID]triggersCacheEntry)}}
 }
 
-func (s *store) Mutate(ctx context.Context, pid sdktypes.ProjectID, key, op string, operands ...sdktypes.Value) (sdktypes.Value, error) {
-	ret := sdktypes.Nothing
+// SetDispatcher enables dispatching store change events to the project's
+// store triggers. Without a dispatcher, no events are emitted.
+func (s *Store) SetDispatcher(d sdkservices.Dispatcher) { s.dispatcher = d }
 
-	if err := s.db.Transaction(ctx, func(tx db.DB) error {
-		r, ok := ops[op]
-		if !ok {
-			return sdkerrors.NewInvalidArgumentError("unknown operation")
+func (s *Store) Mutate(ctx context.Context, pid sdktypes.ProjectID, key, op string, operands ...sdktypes.Value) (sdktypes.Value, error) {
+	r, ok := ops[op]
+	if !ok {
+		return sdktypes.InvalidValue, sdkerrors.NewInvalidArgumentError("unknown operation")
+	}
+
+	var triggers []sdktypes.Trigger
+
+	if r.write && s.dispatcher != nil {
+		var err error
+		if triggers, err = s.storeTriggers(ctx, pid); err != nil {
+			return sdktypes.InvalidValue, err
 		}
+	}
+
+	var (
+		ret     = sdktypes.Nothing
+		changed bool
+		before  sdktypes.Value // only set if changed.
+		after   sdktypes.Value // only set if changed.
+	)
 
+	if err := s.db.Transaction(ctx, func(tx db.DB) error {
 		var (
 			curr, next sdktypes.Value
 			err        error
+			expiresAt  *time.Time // nil means keep current expiry.
 		)
 
+		if r.ttl > 0 {
+			if expiresAt, operands, err = extractExpiry(operands, r.ttl); err != nil {
+				return err
+			}
+		}
+
+		var owner sdktypes.SessionID
+
+		if r.lock {
+			if owner = sessionIDFromContext(ctx); !owner.IsValid() {
+				return sdkerrors.NewInvalidArgumentError("lock operations are only allowed from within a session")
+			}
+
+			if r.lease {
+				if len(operands) == 0 {
+					return sdkerrors.NewInvalidArgumentError("missing lease duration")
+				} else if len(operands) > 1 {
+					return errTooManyOperands
+				}
+
+				if expiresAt, err = expiryFromTTL(operands[0], "lease"); err != nil {
+					return err
+				}
+			} else if len(operands) > 0 {
+				return errTooManyOperands
+			}
+
+			operands = []sdktypes.Value{sdktypes.NewStringValue(owner.String())}
+		}
+
 		if r.read {
 			if err := authz.CheckContext(
 				ctx,
@@ -76,7 +149,10 @@ func (s *store) Mutate(ctx context.Context, pid sdktypes.ProjectID, key, op stri
 		}
 
 		if r.read && curr.Equal(next) {
-			return nil
+			// A successful check_and_set with a ttl still needs to refresh the expiry.
+			if expiresAt == nil || expiresAt.IsZero() || ret.Equal(sdktypes.FalseValue) {
+				return nil
+			}
 		}
 
 		if r.write {
@@ -85,7 +161,7 @@ func (s *store) Mutate(ctx context.Context, pid sdktypes.ProjectID, key, op stri
 			}
 
 			if (!curr.IsValid() || curr.IsNothing()) && next.IsValid() {
-				count, err := tx.CountStoreValues(ctx, pid)
+				count, err := tx.CountStoreValues(ctx, pid, "")
 				if err != nil {
 					return err
 				}
@@ -93,6 +169,10 @@ func (s *store) Mutate(ctx context.Context, pid sdktypes.ProjectID, key, op stri
 				if int(count)+1 > s.cfg.MaxValuesPerProject {
 					return sdkerrors.NewInvalidArgumentError("number of stored values (%d) exceeds maximum allowed (%d)", count+1, s.cfg.MaxValuesPerProject)
 				}
+
+				if err := s.checkPrefixQuotas(ctx, tx, pid, key); err != nil {
+					return err
+				}
 			}
 
 			if err := authz.CheckContext(
@@ -106,9 +186,34 @@ func (s *store) Mutate(ctx context.Context, pid sdktypes.ProjectID, key, op stri
 				return err
 			}
 
-			if err := tx.SetStoreValue(ctx, pid, key, next); err != nil {
+			if !r.lock {
+				// Locks are only changed by lock operations.
+				locker, err := tx.GetStoreLockOwner(ctx, pid, key)
+				if err != nil {
+					return err
+				}
+
+				if locker.IsValid() {
+					return fmt.Errorf("%w: key %q is locked by session %v", sdkerrors.ErrFailedPrecondition, key, locker)
+				}
+			}
+
+			prev := curr
+			if len(triggers) > 0 && !r.read {
+				// The operation does not need the current value, but the event does.
+				if prev, err = tx.GetStoreValue(ctx, pid, key); err != nil && !errors.Is(err, sdkerrors.ErrNotFound) {
+					return err
+				}
+			}
+
+			if err := tx.SetStoreValue(ctx, pid, key, next, expiresAt, owner); err != nil {
 				return err
 			}
+
+			if len(triggers) > 0 {
+				before, after = orNothing(prev), orNothing(next)
+				changed = !before.Equal(after)
+			}
 		}
 
 		return nil
@@ -116,10 +221,130 @@ func (s *store) Mutate(ctx context.Context, pid sdktypes.ProjectID, key, op stri
 		return sdktypes.InvalidValue, err
 	}
 
+	if changed {
+		s.notify(ctx, triggers, key, op, before, after)
+	}
+
 	return ret, nil
 }
 
-func (s *store) Get(ctx context.Context, pid sdktypes.ProjectID, keys []string) (map[string]sdktypes.Value, error) {
+// storeTriggers returns the store triggers of the project, possibly from cache.
+func (s *Store) storeTriggers(ctx context.Context, pid sdktypes.ProjectID) ([]sdktypes.Trigger, error) {
+	ttl := s.cfg.TriggersCacheTTL
+
+	if ts, ok := s.triggers.get(pid, ttl); ok {
+		return ts, nil
+	}
+
+	ts, err := s.db.ListTriggers(ctx, sdkservices.ListTriggersFilter{
+		ProjectID:  pid,
+		SourceType: sdktypes.TriggerSourceTypeStore,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if ttl > 0 {
+		s.triggers.put(pid, ts, ttl)
+	}
+
+	return ts, nil
+}
+
+// notify dispatches a store change event to each of the given triggers.
+// The event type is "set" if the key holds a value after the change, or
+// "del" if it was removed. Dispatch errors do not fail the mutation.
+func (s *Store) notify(ctx context.Context, triggers []sdktypes.Trigger, key, op string, before, after sdktypes.Value) {
+	typ := "set"
+	if after.IsNothing() {
+		typ = "del"
+	}
+
+	depth := eventDepthFromContext(ctx)
+	if limit := s.cfg.MaxEventDepth; limit > 0 && depth >= limit {
+		s.l.Warn("store event depth limit reached, not dispatching", zap.String("key", key), zap.Int("depth", depth))
+		return
+	}
+
+	data := map[string]sdktypes.Value{
+		"key":       sdktypes.NewStringValue(key),
+		"op":        sdktypes.NewStringValue(op),
+		"old_value": before,
+		"new_value": after,
+		"depth":     sdktypes.NewIntegerValue(depth),
+	}
+
+	ctx = authcontext.SetAuthnSystemUser(ctx)
+
+	for _, t := range triggers {
+		event := sdktypes.NewEvent(t.ID()).WithType(typ).WithData(data)
+
+		if _, err := s.dispatcher.Dispatch(ctx, event, nil); err != nil {
+			s.l.Error("dispatch store event", zap.String("trigger_id", t.ID().String()), zap.String("key", key), zap.Error(err))
+		}
+	}
+}
+
+func orNothing(v sdktypes.Value) sdktypes.Value {
+	if !v.IsValid() {
+		return sdktypes.Nothing
+	}
+
+	return v
+}
+
+func (s *Store) checkPrefixQuotas(ctx context.Context, tx db.DB, pid sdktypes.ProjectID, key string) error {
+	for _, prefix := range slices.Sorted(maps.Keys(s.cfg.MaxValuesPerPrefix)) {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		limit := s.cfg.MaxValuesPerPrefix[prefix]
+
+		count, err := tx.CountStoreValues(ctx, pid, prefix)
+		if err != nil {
+			return err
+		}
+
+		if int(count)+1 > limit {
+			return sdkerrors.NewInvalidArgumentError("number of stored values with prefix %q (%d) exceeds maximum allowed (%d)", prefix, count+1, limit)
+		}
+	}
+
+	return nil
+}
+
+// extractExpiry removes the optional ttl operand at index i, if present, and
+// returns the expiry time it implies. A zero time means no expiry.
+func extractExpiry(operands []sdktypes.Value, i int) (*time.Time, []sdktypes.Value, error) {
+	if len(operands) != i+1 || !operands[i].IsDuration() {
+		return &time.Time{}, operands, nil
+	}
+
+	expiresAt, err := expiryFromTTL(operands[i], "ttl")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return expiresAt, operands[:i], nil
+}
+
+func expiryFromTTL(v sdktypes.Value, what string) (*time.Time, error) {
+	if !v.IsDuration() {
+		return nil, sdkerrors.NewInvalidArgumentError("%s must be a duration", what)
+	}
+
+	ttl := v.GetDuration().Value()
+	if ttl <= 0 {
+		return nil, sdkerrors.NewInvalidArgumentError("%s must be positive", what)
+	}
+
+	expiresAt := kittehs.Now().Add(ttl)
+
+	return &expiresAt, nil
+}
+
+func (s *Store) Get(ctx context.Context, pid sdktypes.ProjectID, keys []string) (map[string]sdktypes.Value, error) {
 	if err := authz.CheckContext(
 		ctx,
 		pid,
@@ -133,20 +358,15 @@ func (s *store) Get(ctx context.Context, pid sdktypes.ProjectID, keys []string)
 	return s.db.ListStoreValues(ctx, pid, keys, true)
 }
 
-func (s *store) List(ctx context.Context, pid sdktypes.ProjectID) ([]string, error) {
-	if err := authz.CheckContext(ctx, pid, authz.OpStoreReadList); err != nil {
-		return nil, err
-	}
-
-	vs, err := s.db.ListStoreValues(ctx, pid, nil, false)
-	if err != nil {
+func (s *Store) List(ctx context.Context, pid sdktypes.ProjectID, f sdkservices.ListStoreValuesFilter) (*sdkservices.ListStoreValuesResult, error) {
+	if err := authz.CheckContext(ctx, pid, authz.OpStoreReadList, authz.WithData("prefix", f.Prefix)); err != nil {
 		return nil, err
 	}
 
-	return slices.Sorted(maps.Keys(vs)), nil
+	return s.db.ListStoreKeys(ctx, pid, f)
 }
 
-func (s *store) Publish(ctx context.Context, pid sdktypes.ProjectID, key string) error {
+func (s *Store) Publish(ctx context.Context, pid sdktypes.ProjectID, key string) error {
 	if err := authz.CheckContext(
 		ctx,
 		pid,
@@ -159,7 +379,7 @@ func (s *store) Publish(ctx context.Context, pid sdktypes.ProjectID, key string)
 	return s.db.PublishStoreValue(ctx, pid, key)
 }
 
-func (s *store) Unpublish(ctx context.Context, pid sdktypes.ProjectID, key string) error {
+func (s *Store) Unpublish(ctx context.Context, pid sdktypes.ProjectID, key string) error {
 	if err := authz.CheckContext(
 		ctx,
 		pid,
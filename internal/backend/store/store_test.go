@@ -1,9 +1,11 @@
 package store
 
 import (
+	"context"
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
@@ -11,6 +13,8 @@ import (
 	"go.autokitteh.dev/autokitteh/internal/backend/auth/authz"
 	"go.autokitteh.dev/autokitteh/internal/backend/db/dbtest"
 	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
 )
 
@@ -266,3 +270,308 @@ func TestMutate(t *testing.T) {
 		})
 	}
 }
+
+func TestMutateTTL(t *testing.T) {
+	db := dbtest.NewTestDB(t, o, ps[0])
+
+	store := New(db, zap.NewNop(), &Config{
+		MaxValueSizeBytes:   64 * 1024, // 64 KiB
+		MaxValuesPerProject: 4,
+	})
+
+	ctx := t.Context()
+
+	mutate := func(key, op string, operands ...sdktypes.Value) sdktypes.Value {
+		v, err := store.Mutate(ctx, pids[0], key, op, operands...)
+		if assert.NoError(t, err) {
+			return v
+		}
+		return sdktypes.InvalidValue
+	}
+
+	short, long := sdktypes.NewDurationValue(time.Millisecond), sdktypes.NewDurationValue(time.Hour)
+
+	_, err := store.Mutate(ctx, pids[0], "k", "set", ivs[0], sdktypes.NewDurationValue(-time.Second))
+	assert.EqualError(t, err, "ttl must be positive")
+
+	mutate("k1", "set", ivs[1], long)
+	mutate("k2", "set", ivs[2], short)
+	mutate("k5", "set", ivs[0], short)
+	assert.True(t, mutate("k3", "check_and_set", ivs[3], sdktypes.Nothing, short).Equal(sdktypes.TrueValue))
+
+	time.Sleep(10 * time.Millisecond)
+
+	assert.True(t, mutate("k1", "get").Equal(ivs[1]))
+	assert.True(t, mutate("k2", "get").Equal(sdktypes.Nothing))
+	assert.True(t, mutate("k3", "get").Equal(sdktypes.Nothing))
+
+	// Expired values do not count against the quota.
+	mutate("k4", "set", ivs[0])
+
+	// An expired value that was not swept yet is treated as absent.
+	assert.True(t, mutate("k5", "add", ivs[1]).Equal(ivs[1]))
+	assert.True(t, mutate("k5", "get").Equal(ivs[1]))
+	mutate("k5", "del")
+
+	// An expired value can be claimed again.
+	assert.True(t, mutate("k3", "check_and_set", ivs[0], sdktypes.Nothing, long).Equal(sdktypes.TrueValue))
+	assert.True(t, mutate("k3", "get").Equal(ivs[0]))
+
+	// Setting without a ttl clears the expiry.
+	assert.True(t, mutate("k1", "check_and_set", ivs[2], ivs[1]).Equal(sdktypes.TrueValue))
+
+	n, err := db.DeleteExpiredStoreValues(ctx)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(1), n)
+	}
+}
+
+func TestLocks(t *testing.T) {
+	db := dbtest.NewTestDB(t, o, ps[0])
+
+	store := New(db, zap.NewNop(), &Config{
+		MaxValueSizeBytes:   64 * 1024, // 64 KiB
+		MaxValuesPerProject: 3,
+	})
+
+	sids := []sdktypes.SessionID{sdktypes.NewSessionID(), sdktypes.NewSessionID()}
+
+	mutate := func(sid sdktypes.SessionID, key, op string, operands ...sdktypes.Value) sdktypes.Value {
+		v, err := store.Mutate(WithSessionID(t.Context(), sid), pids[0], key, op, operands...)
+		if assert.NoError(t, err) {
+			return v
+		}
+		return sdktypes.InvalidValue
+	}
+
+	short, long := sdktypes.NewDurationValue(time.Millisecond), sdktypes.NewDurationValue(time.Hour)
+
+	_, err := store.Mutate(t.Context(), pids[0], "l", "acquire_lock", long)
+	assert.EqualError(t, err, "lock operations are only allowed from within a session")
+
+	_, err = store.Mutate(WithSessionID(t.Context(), sids[0]), pids[0], "l", "acquire_lock")
+	assert.EqualError(t, err, "missing lease duration")
+
+	_, err = store.Mutate(WithSessionID(t.Context(), sids[0]), pids[0], "l", "acquire_lock", ivs[0])
+	assert.EqualError(t, err, "lease must be a duration")
+
+	_, err = store.Mutate(WithSessionID(t.Context(), sids[0]), pids[0], "l", "release_lock", long)
+	assert.EqualError(t, err, "too many operands")
+
+	assert.True(t, mutate(sids[0], "l", "acquire_lock", long).Equal(sdktypes.TrueValue))
+	assert.True(t, mutate(sids[0], "l", "acquire_lock", long).Equal(sdktypes.TrueValue), "reentrant")
+	assert.True(t, mutate(sids[1], "l", "acquire_lock", long).Equal(sdktypes.FalseValue))
+	assert.True(t, mutate(sids[1], "l", "renew_lock", long).Equal(sdktypes.FalseValue))
+	assert.True(t, mutate(sids[1], "l", "release_lock").Equal(sdktypes.FalseValue))
+	assert.True(t, mutate(sids[1], "l", "get").Equal(sdktypes.NewStringValue(sids[0].String())))
+
+	// Only lock operations may change a held lock, even by its owner.
+	for _, sid := range []sdktypes.SessionID{sids[0], sids[1], sdktypes.InvalidSessionID} {
+		ctx := WithSessionID(t.Context(), sid)
+
+		_, err = store.Mutate(ctx, pids[0], "l", "set", ivs[0])
+		assert.ErrorIs(t, err, sdkerrors.ErrFailedPrecondition)
+
+		_, err = store.Mutate(ctx, pids[0], "l", "del")
+		assert.ErrorIs(t, err, sdkerrors.ErrFailedPrecondition)
+
+		_, err = store.Mutate(ctx, pids[0], "l", "check_and_set", ivs[0], sdktypes.NewStringValue(sids[0].String()))
+		assert.ErrorIs(t, err, sdkerrors.ErrFailedPrecondition)
+	}
+
+	assert.True(t, mutate(sids[1], "l", "get").Equal(sdktypes.NewStringValue(sids[0].String())))
+	assert.True(t, mutate(sids[1], "l", "acquire_lock", long).Equal(sdktypes.FalseValue))
+
+	assert.True(t, mutate(sids[0], "l", "release_lock").Equal(sdktypes.TrueValue))
+	assert.True(t, mutate(sids[0], "l", "get").Equal(sdktypes.Nothing))
+
+	assert.True(t, mutate(sids[1], "l", "acquire_lock", long).Equal(sdktypes.TrueValue))
+	assert.True(t, mutate(sids[1], "l", "renew_lock", short).Equal(sdktypes.TrueValue))
+
+	time.Sleep(10 * time.Millisecond)
+
+	// Lease expired.
+	assert.True(t, mutate(sids[1], "l", "renew_lock", long).Equal(sdktypes.FalseValue))
+	assert.True(t, mutate(sids[0], "l", "acquire_lock", long).Equal(sdktypes.TrueValue))
+
+	assert.True(t, mutate(sids[0], "l", "renew_lock", short).Equal(sdktypes.TrueValue))
+
+	time.Sleep(10 * time.Millisecond)
+
+	// An expired lock is not a lock anymore.
+	mutate(sids[1], "l", "set", ivs[1])
+	assert.True(t, mutate(sids[1], "l", "get").Equal(ivs[1]))
+
+	owner, err := db.GetStoreLockOwner(t.Context(), pids[0], "l")
+	if assert.NoError(t, err) {
+		assert.False(t, owner.IsValid())
+	}
+}
+
+func TestPrefixQuotas(t *testing.T) {
+	db := dbtest.NewTestDB(t, o, ps[0])
+
+	store := New(db, zap.NewNop(), &Config{
+		MaxValueSizeBytes:   64 * 1024, // 64 KiB
+		MaxValuesPerProject: 4,
+		MaxValuesPerPrefix:  map[string]int{"cache/": 2, "cache/big/": 1},
+	})
+
+	set := func(key string) error {
+		_, err := store.Mutate(t.Context(), pids[0], key, "set", ivs[0])
+		return err
+	}
+
+	assert.NoError(t, set("cache/big/1"))
+	assert.EqualError(t, set("cache/big/2"), `number of stored values with prefix "cache/big/" (2) exceeds maximum allowed (1)`)
+	assert.NoError(t, set("cache/1"))
+	assert.EqualError(t, set("cache/2"), `number of stored values with prefix "cache/" (3) exceeds maximum allowed (2)`)
+	assert.NoError(t, set("users/1"))
+	assert.NoError(t, set("users/2"))
+
+	res, err := store.List(t.Context(), pids[0], sdkservices.ListStoreValuesFilter{Prefix: "cache/"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"cache/1", "cache/big/1"}, res.Keys)
+	}
+}
+
+type fakeDispatcher struct {
+	sdkservices.Dispatcher
+
+	events []sdktypes.Event
+}
+
+func (d *fakeDispatcher) Dispatch(_ context.Context, event sdktypes.Event, _ *sdkservices.DispatchOptions) (*sdkservices.DispatchResponse, error) {
+	d.events = append(d.events, event)
+	return &sdkservices.DispatchResponse{}, nil
+}
+
+func TestEvents(t *testing.T) {
+	trigger := sdktypes.NewTrigger(sdktypes.NewSymbol("t")).
+		WithNewID().
+		WithProjectID(pids[0]).
+		WithSourceType(sdktypes.TriggerSourceTypeStore)
+
+	db := dbtest.NewTestDB(t, o, ps[0], ps[1], trigger)
+
+	store := New(db, zap.NewNop(), &Config{
+		MaxValueSizeBytes:   64 * 1024, // 64 KiB
+		MaxValuesPerProject: 3,
+	})
+
+	d := &fakeDispatcher{}
+	store.SetDispatcher(d)
+
+	ctx := t.Context()
+
+	mutate := func(pid sdktypes.ProjectID, key, op string, operands ...sdktypes.Value) {
+		_, err := store.Mutate(ctx, pid, key, op, operands...)
+		assert.NoError(t, err)
+	}
+
+	mutate(pids[0], "k", "set", ivs[1])
+	mutate(pids[0], "k", "set", ivs[1]) // unchanged.
+	mutate(pids[0], "k", "get")
+	mutate(pids[0], "k", "add", ivs[1])
+	mutate(pids[0], "k", "check_and_set", ivs[0], ivs[3]) // failed check.
+	mutate(pids[0], "k", "del")
+	mutate(pids[0], "k", "del") // already deleted.
+	mutate(pids[1], "k", "set", ivs[1])
+
+	type change struct {
+		typ, op  string
+		old, new sdktypes.Value
+	}
+
+	expected := []change{
+		{"set", "set", sdktypes.Nothing, ivs[1]},
+		{"set", "add", ivs[1], ivs[2]},
+		{"del", "del", ivs[2], sdktypes.Nothing},
+	}
+
+	if assert.Len(t, d.events, len(expected)) {
+		for i, e := range expected {
+			event := d.events[i]
+			data := event.Data()
+
+			assert.Equal(t, trigger.ID(), event.DestinationID().ToTriggerID())
+			assert.Equal(t, e.typ, event.Type())
+			assert.Equal(t, "k", data["key"].GetString().Value())
+			assert.Equal(t, e.op, data["op"].GetString().Value())
+			assert.True(t, e.old.Equal(data["old_value"]), "old_value: expected %v, got %v", e.old, data["old_value"])
+			assert.True(t, e.new.Equal(data["new_value"]), "new_value: expected %v, got %v", e.new, data["new_value"])
+			assert.True(t, data["depth"].Equal(sdktypes.NewIntegerValue(0)))
+		}
+
+		// Trigger filters are evaluated against the event data.
+		match, err := d.events[1].Matches(`data.key == "k" && data.new_value > data.old_value`)
+		if assert.NoError(t, err) {
+			assert.True(t, match)
+		}
+	}
+}
+
+func TestEventDepth(t *testing.T) {
+	trigger := sdktypes.NewTrigger(sdktypes.NewSymbol("t")).
+		WithNewID().
+		WithProjectID(pids[0]).
+		WithSourceType(sdktypes.TriggerSourceTypeStore)
+
+	db := dbtest.NewTestDB(t, o, ps[0], trigger)
+
+	store := New(db, zap.NewNop(), &Config{
+		MaxValueSizeBytes:   64 * 1024, // 64 KiB
+		MaxValuesPerProject: 3,
+		MaxEventDepth:       2,
+	})
+
+	d := &fakeDispatcher{}
+	store.SetDispatcher(d)
+
+	for i := range 3 {
+		_, err := store.Mutate(WithEventDepth(t.Context(), i), pids[0], "k", "set", ivs[i])
+		assert.NoError(t, err)
+	}
+
+	// A session started by a store event that changes the store again can
+	// only do so up to the depth limit.
+	if assert.Len(t, d.events, 2) {
+		for i, e := range d.events {
+			assert.True(t, e.Data()["depth"].Equal(sdktypes.NewIntegerValue(i)))
+		}
+	}
+}
+
+func TestTriggersCache(t *testing.T) {
+	trigger := sdktypes.NewTrigger(sdktypes.NewSymbol("t")).
+		WithNewID().
+		WithProjectID(pids[0]).
+		WithSourceType(sdktypes.TriggerSourceTypeStore)
+
+	for _, ttl := range []time.Duration{0, time.Hour} {
+		db := dbtest.NewTestDB(t, o, ps[0], trigger)
+
+		store := New(db, zap.NewNop(), &Config{
+			MaxValueSizeBytes:   64 * 1024, // 64 KiB
+			MaxValuesPerProject: 3,
+			TriggersCacheTTL:    ttl,
+		})
+
+		d := &fakeDispatcher{}
+		store.SetDispatcher(d)
+
+		_, err := store.Mutate(t.Context(), pids[0], "k", "set", ivs[0])
+		assert.NoError(t, err)
+
+		assert.NoError(t, db.DeleteTrigger(t.Context(), trigger.ID()))
+
+		_, err = store.Mutate(t.Context(), pids[0], "k", "set", ivs[1])
+		assert.NoError(t, err)
+
+		if ttl == 0 {
+			assert.Len(t, d.events, 1)
+		} else {
+			assert.Len(t, d.events, 2, "deleted trigger is still cached")
+		}
+	}
+}
@@ -13,6 +13,15 @@ type (
 		fn    opFn
 		read  bool // needs current value from db.
 		write bool // should write next value to db.
+
+		// if non-zero, operands[ttl], if present and a duration, is the ttl for the written value.
+		ttl int
+
+		// lock operations receive the owner session id as their only operand,
+		// and the written value is owned by that session. if lease is true,
+		// the caller must supply a lease duration as the only operand.
+		lock  bool
+		lease bool
 	}
 )
 
@@ -36,6 +45,7 @@ var ops = map[string]op{
 			return vs[0], sdktypes.Nothing, nil
 		},
 		write: true,
+		ttl:   1,
 	},
 	"check_and_set": {
 		fn: func(curr sdktypes.Value, vs []sdktypes.Value) (sdktypes.Value, sdktypes.Value, error) {
@@ -77,6 +87,7 @@ var ops = map[string]op{
 		},
 		read:  true,
 		write: true,
+		ttl:   2,
 	},
 	"add": {
 		fn: func(curr sdktypes.Value, vs []sdktypes.Value) (sdktypes.Value, sdktypes.Value, error) {
@@ -105,4 +116,46 @@ var ops = map[string]op{
 		// no fn -> next is invalid -> delete on write.
 		write: true,
 	},
+	"acquire_lock": {
+		// Acquiring an already held lock by the same owner extends its lease.
+		fn: func(curr sdktypes.Value, vs []sdktypes.Value) (sdktypes.Value, sdktypes.Value, error) {
+			owner := vs[0]
+
+			if curr.IsValid() && !curr.IsNothing() && !curr.Equal(owner) {
+				return curr, sdktypes.FalseValue, nil
+			}
+
+			return owner, sdktypes.TrueValue, nil
+		},
+		read:  true,
+		write: true,
+		lock:  true,
+		lease: true,
+	},
+	"renew_lock": {
+		fn: func(curr sdktypes.Value, vs []sdktypes.Value) (sdktypes.Value, sdktypes.Value, error) {
+			if !curr.Equal(vs[0]) {
+				return curr, sdktypes.FalseValue, nil
+			}
+
+			return curr, sdktypes.TrueValue, nil
+		},
+		read:  true,
+		write: true,
+		lock:  true,
+		lease: true,
+	},
+	"release_lock": {
+		fn: func(curr sdktypes.Value, vs []sdktypes.Value) (sdktypes.Value, sdktypes.Value, error) {
+			if !curr.Equal(vs[0]) {
+				return curr, sdktypes.FalseValue, nil
+			}
+
+			// invalid next -> delete on write.
+			return sdktypes.InvalidValue, sdktypes.TrueValue, nil
+		},
+		read:  true,
+		write: true,
+		lock:  true,
+	},
 }
@@ -59,12 +59,24 @@ func (s *server) List(ctx context.Context, req *connect.Request[storev1.ListRequ
 		return nil, sdkerrors.AsConnectError(err)
 	}
 
-	keys, err := s.store.List(ctx, pid)
+	res, err := s.store.List(ctx, pid, sdkservices.ListStoreValuesFilter{
+		Prefix:        msg.Prefix,
+		PublishedOnly: msg.PublishedOnly,
+		PaginationRequest: sdktypes.PaginationRequest{
+			PageSize:  msg.PageSize,
+			Skip:      msg.Skip,
+			PageToken: msg.PageToken,
+		},
+	})
 	if err != nil {
 		return nil, sdkerrors.AsConnectError(err)
 	}
 
-	return connect.NewResponse(&storev1.ListResponse{Keys: keys}), nil
+	return connect.NewResponse(&storev1.ListResponse{
+		Keys:          res.Keys,
+		Count:         res.TotalCount,
+		NextPageToken: res.NextPageToken,
+	}), nil
 }
 
 func (s *server) Mutate(ctx context.Context, req *connect.Request[storev1.MutateRequest]) (*connect.Response[storev1.MutateResponse], error) {
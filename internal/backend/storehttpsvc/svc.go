@@ -4,12 +4,15 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"go.uber.org/zap"
 
 	"go.autokitteh.dev/autokitteh/internal/backend/db"
 	"go.autokitteh.dev/autokitteh/internal/backend/muxes"
 	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
 )
 
@@ -18,7 +21,10 @@ var unwrapper = sdktypes.ValueWrapper{
 	SafeForJSON: true,
 }
 
-const storePathPrefix = "store/"
+const (
+	storePathPrefix = "store/"
+	maxListPageSize = 100
+)
 
 type svc struct {
 	l  *zap.Logger
@@ -27,7 +33,8 @@ type svc struct {
 
 func Init(muxes *muxes.Muxes, db db.DB, l *zap.Logger) {
 	s := &svc{db: db, l: l}
-	muxes.NoAuth.Handle("/"+storePathPrefix+"{pid}/{key}", s)
+	// Keys may be namespaced with slashes (e.g. "users/123").
+	muxes.NoAuth.Handle("/"+storePathPrefix+"{pid}/{key...}", s)
 }
 
 func (s *svc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -44,6 +51,12 @@ func (s *svc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		zap.String("key", key),
 	)
 
+	// A path that ends with a slash lists the published keys under it.
+	if key == "" || strings.HasSuffix(key, "/") {
+		s.list(w, r, l, pid, key)
+		return
+	}
+
 	published, err := s.db.IsStoreValuePublished(r.Context(), pid, key)
 	if errors.Is(err, sdkerrors.ErrNotFound) || !published {
 		http.Error(w, "not found", http.StatusNotFound)
@@ -76,3 +89,49 @@ func (s *svc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+type listResponse struct {
+	Keys          []string `json:"keys"`
+	NextPageToken string   `json:"next_page_token,omitempty"`
+}
+
+func (s *svc) list(w http.ResponseWriter, r *http.Request, l *zap.Logger, pid sdktypes.ProjectID, prefix string) {
+	f := sdkservices.ListStoreValuesFilter{
+		Prefix:        prefix,
+		PublishedOnly: true,
+		PaginationRequest: sdktypes.PaginationRequest{
+			PageSize:  maxListPageSize,
+			PageToken: r.URL.Query().Get("page_token"),
+		},
+	}
+
+	if v := r.URL.Query().Get("page_size"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || n <= 0 {
+			http.Error(w, "invalid page size", http.StatusBadRequest)
+			return
+		}
+
+		f.PageSize = int32(min(n, maxListPageSize))
+	}
+
+	res, err := s.db.ListStoreKeys(r.Context(), pid, f)
+	if err != nil {
+		l.Error("failed to list store keys", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	keys := res.Keys
+	if keys == nil {
+		keys = []string{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(listResponse{Keys: keys, NextPageToken: res.NextPageToken}); err != nil {
+		l.Error("failed to encode store keys", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+}
@@ -19,6 +19,9 @@ import (
 
 	"go.autokitteh.dev/autokitteh/integrations/oauth"
 	"go.autokitteh.dev/autokitteh/internal/backend/applygrpcsvc"
+	"go.autokitteh.dev/autokitteh/internal/backend/approvals"
+	"go.autokitteh.dev/autokitteh/internal/backend/approvalsgrpcsvc"
+	"go.autokitteh.dev/autokitteh/internal/backend/approvalshttpsvc"
 	"go.autokitteh.dev/autokitteh/internal/backend/auth/authgrpcsvc"
 	"go.autokitteh.dev/autokitteh/internal/backend/auth/authhttpmiddleware"
 	"go.autokitteh.dev/autokitteh/internal/backend/auth/authloginhttpsvc"
@@ -58,6 +61,7 @@ import (
 	"go.autokitteh.dev/autokitteh/internal/backend/scheduler"
 	"go.autokitteh.dev/autokitteh/internal/backend/secrets"
 	"go.autokitteh.dev/autokitteh/internal/backend/sessions"
+	"go.autokitteh.dev/autokitteh/internal/backend/sessions/sessionnotify"
 	"go.autokitteh.dev/autokitteh/internal/backend/sessionsgrpcsvc"
 	"go.autokitteh.dev/autokitteh/internal/backend/store"
 	"go.autokitteh.dev/autokitteh/internal/backend/storegrpcsvc"
@@ -232,6 +236,7 @@ func makeFxOpts(cfg *Config, opts RunOptions) []fx.Option {
 		Component(
 			"sessions",
 			sessions.Configs,
+			fx.Provide(sessionnotify.NewSessions),
 			fx.Provide(sessions.New),
 			fx.Provide(func(s sessions.Sessions) sdkservices.Sessions { return s }),
 			fx.Invoke(func(lc fx.Lifecycle, s sessions.Sessions) { HookOnStart(lc, s.StartWorkers) }),
@@ -249,7 +254,19 @@ func makeFxOpts(cfg *Config, opts RunOptions) []fx.Option {
 				})
 			}),
 		),
-		Component("store", store.Configs, fx.Provide(store.New)),
+		Component(
+			"store",
+			store.Configs,
+			fx.Provide(store.New),
+			fx.Provide(func(s *store.Store) sdkservices.Store { return s }),
+			fx.Invoke(func(s *store.Store, d sdkservices.Dispatcher) { s.SetDispatcher(d) }),
+		),
+		Component(
+			"approvals",
+			configset.Empty,
+			fx.Provide(approvals.New),
+			fx.Provide(func(a *approvals.Approvals) sdkservices.Approvals { return a }),
+		),
 		Component("projects", configset.Empty, fx.Provide(projects.New)),
 		Component("projectsgrpcsvc", projectsgrpcsvc.Configs, fx.Provide(projectsgrpcsvc.New)),
 		Component(
@@ -321,6 +338,8 @@ func makeFxOpts(cfg *Config, opts RunOptions) []fx.Option {
 		fx.Provide(func(s sdkservices.ServicesStruct) sdkservices.Services { return &s }),
 		fx.Invoke(authgrpcsvc.Init),
 		fx.Invoke(applygrpcsvc.Init),
+		fx.Invoke(approvalsgrpcsvc.Init),
+		fx.Invoke(approvalshttpsvc.Init),
 		fx.Invoke(buildsgrpcsvc.Init),
 		fx.Invoke(connectionsgrpcsvc.Init),
 		fx.Invoke(deploymentsgrpcsvc.Init),
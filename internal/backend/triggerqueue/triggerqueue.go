@@ -0,0 +1,63 @@
+// Package triggerqueue admits events that wait in the queues of triggers whose
+// concurrency policy queues overlapping events. It is used both by the
+// dispatcher, which queues the events, and by the session workflows, which
+// free slots when they end.
+package triggerqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/db"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+// SignalName is signaled to the workflow waiting for an event to be admitted by
+// the trigger's queue.
+func SignalName(seq uint64) string { return fmt.Sprintf("trigger_queue_%d", seq) }
+
+// AdmitQueuedEvents admits events that wait for free slots of the trigger's
+// concurrency limit, and wakes up the workflows waiting for them. It should be called
+// whenever a slot might have been freed, ie a session of the trigger ended.
+func AdmitQueuedEvents(ctx context.Context, db db.DB, tc client.Client, t sdktypes.Trigger) error {
+	var errs []error
+
+	for {
+		es, err := db.AdmitQueuedTriggerEvents(ctx, t)
+		if err != nil {
+			return fmt.Errorf("admit queued events for %v: %w", t.ID(), err)
+		}
+
+		again := false
+
+		for _, e := range es {
+			err := tc.SignalWorkflow(ctx, e.WorkflowID, "", SignalName(e.Seq), nil)
+			if err == nil {
+				continue
+			}
+
+			var nf *serviceerror.NotFound
+			if !errors.As(err, &nf) {
+				errs = append(errs, fmt.Errorf("signal %v: %w", e.EventID, err))
+				continue
+			}
+
+			// The workflow is gone, so it will never release the slot.
+			if err := db.DequeueTriggerEvent(ctx, e.Seq); err != nil && !errors.Is(err, sdkerrors.ErrNotFound) {
+				errs = append(errs, fmt.Errorf("dequeue %v: %w", e.EventID, err))
+				continue
+			}
+
+			again = true
+		}
+
+		if !again {
+			return errors.Join(errs...)
+		}
+	}
+}
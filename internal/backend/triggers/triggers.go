@@ -56,7 +56,10 @@ func (m *triggers) Create(ctx context.Context, trigger sdktypes.Trigger) (sdktyp
 	sl := m.sl.With("trigger_id", trigger.ID())
 
 	if trigger.SourceType() == sdktypes.TriggerSourceTypeWebhook {
-		trigger = webhookssvc.InitTrigger(trigger)
+		var err error
+		if trigger, err = m.initWebhookSlug(ctx, trigger, sdktypes.InvalidTrigger); err != nil {
+			return sdktypes.InvalidTriggerID, err
+		}
 	}
 
 	if err := m.db.CreateTrigger(ctx, trigger); err != nil {
@@ -74,6 +77,8 @@ func (m *triggers) Create(ctx context.Context, trigger sdktypes.Trigger) (sdktyp
 		sl.With("schedule", trigger.Schedule()).Infof("created schedule trigger with spec %q", trigger.Schedule())
 	case sdktypes.TriggerSourceTypeConnection:
 		sl.With("connection", trigger.ConnectionID()).Infof("created connection trigger with connection %q", trigger.ConnectionID())
+	case sdktypes.TriggerSourceTypeStore:
+		sl.Infof("created store trigger")
 	default:
 		return sdktypes.InvalidTriggerID, sdkerrors.NewInvalidArgumentError("unsupported source type")
 	}
@@ -81,6 +86,69 @@ func (m *triggers) Create(ctx context.Context, trigger sdktypes.Trigger) (sdktyp
 	return trigger.ID(), nil
 }
 
+// initWebhookSlug sets the webhook slug of the trigger, given its current state
+// if it is being updated. Webhook triggers with routes in the same project share
+// a single slug, which lets a project serve multiple routes from a single webhook
+// URL. Triggers without a route get a slug of their own.
+func (m *triggers) initWebhookSlug(ctx context.Context, trigger, curr sdktypes.Trigger) (sdktypes.Trigger, error) {
+	route := trigger.WebhookRoute()
+
+	if !route.IsValid() && !curr.WebhookRoute().IsValid() {
+		// Neither joins nor leaves the shared slug.
+		if slug := curr.WebhookSlug(); slug != "" {
+			return trigger.WithWebhookSlug(slug), nil
+		}
+
+		return webhookssvc.InitTrigger(trigger), nil
+	}
+
+	pid := trigger.ProjectID()
+	if curr.IsValid() {
+		pid = curr.ProjectID()
+	}
+
+	ts, err := m.db.ListTriggers(ctx, sdkservices.ListTriggersFilter{
+		ProjectID:  pid,
+		SourceType: sdktypes.TriggerSourceTypeWebhook,
+	})
+	if err != nil {
+		return sdktypes.InvalidTrigger, fmt.Errorf("list project webhook triggers: %w", err)
+	}
+
+	var shared string
+
+	for _, t := range ts {
+		if t.ID() == trigger.ID() || !t.WebhookRoute().IsValid() {
+			continue
+		}
+
+		if route.IsValid() && route.Equivalent(t.WebhookRoute()) {
+			return sdktypes.InvalidTrigger, fmt.Errorf("%w: webhook route %q is already used by trigger %q", sdkerrors.ErrAlreadyExists, route, t.Name())
+		}
+
+		if t.WebhookSlug() != "" {
+			shared = t.WebhookSlug()
+		}
+	}
+
+	slug := curr.WebhookSlug()
+
+	switch {
+	case route.IsValid() && shared != "":
+		// Joins the other routed triggers.
+		slug = shared
+	case !route.IsValid() && slug == shared:
+		// Leaves the other routed triggers, so it needs a slug of its own.
+		slug = ""
+	}
+
+	if slug == "" {
+		return webhookssvc.InitTrigger(trigger), nil
+	}
+
+	return trigger.WithWebhookSlug(slug), nil
+}
+
 func (m *triggers) Update(ctx context.Context, trigger sdktypes.Trigger) error {
 	if err := authz.CheckContext(
 		ctx,
@@ -113,6 +181,12 @@ func (m *triggers) Update(ctx context.Context, trigger sdktypes.Trigger) error {
 		return sdkerrors.NewInvalidArgumentError("cannot update source type")
 	}
 
+	if trigger.SourceType() == sdktypes.TriggerSourceTypeWebhook {
+		if trigger, err = m.initWebhookSlug(ctx, trigger, curr); err != nil {
+			return err
+		}
+	}
+
 	if err := m.db.UpdateTrigger(ctx, trigger); err != nil {
 		return err
 	}
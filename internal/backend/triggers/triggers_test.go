@@ -0,0 +1,158 @@
+package triggers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/auth/authz"
+	"go.autokitteh.dev/autokitteh/internal/backend/db"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func TestMain(m *testing.M) {
+	authz.DisableCheckForTesting()
+	m.Run()
+}
+
+type testDB struct {
+	db.DB
+	triggers map[sdktypes.TriggerID]sdktypes.Trigger
+}
+
+func (db *testDB) CreateTrigger(_ context.Context, t sdktypes.Trigger) error {
+	db.triggers[t.ID()] = t
+	return nil
+}
+
+func (db *testDB) UpdateTrigger(_ context.Context, t sdktypes.Trigger) error {
+	curr, ok := db.triggers[t.ID()]
+	if !ok {
+		return sdkerrors.ErrNotFound
+	}
+
+	if t.WebhookSlug() == "" {
+		t = t.WithWebhookSlug(curr.WebhookSlug())
+	}
+
+	db.triggers[t.ID()] = t
+	return nil
+}
+
+func (db *testDB) GetTriggerByID(_ context.Context, id sdktypes.TriggerID) (sdktypes.Trigger, error) {
+	t, ok := db.triggers[id]
+	if !ok {
+		return sdktypes.InvalidTrigger, sdkerrors.ErrNotFound
+	}
+
+	return t, nil
+}
+
+func (db *testDB) ListTriggers(_ context.Context, filter sdkservices.ListTriggersFilter) (ts []sdktypes.Trigger, _ error) {
+	for _, t := range db.triggers {
+		if t.ProjectID() == filter.ProjectID && t.SourceType() == filter.SourceType {
+			ts = append(ts, t)
+		}
+	}
+
+	return
+}
+
+var pid = sdktypes.NewProjectID()
+
+func newWebhookTrigger(name, route string) sdktypes.Trigger {
+	t := sdktypes.NewTrigger(sdktypes.NewSymbol(name)).
+		WithProjectID(pid).
+		WithSourceType(sdktypes.TriggerSourceTypeWebhook)
+
+	if route != "" {
+		t = t.WithWebhookRoute(kittehs.Must1(sdktypes.ParseWebhookRoute(route)))
+	}
+
+	return t
+}
+
+func TestWebhookSlugs(t *testing.T) {
+	ctx := t.Context()
+	m := New(zap.NewNop(), &testDB{triggers: make(map[sdktypes.TriggerID]sdktypes.Trigger)}, nil)
+
+	create := func(name, route string) sdktypes.Trigger {
+		id, err := m.Create(ctx, newWebhookTrigger(name, route))
+		require.NoError(t, err)
+		return kittehs.Must1(m.Get(ctx, id))
+	}
+
+	update := func(tr sdktypes.Trigger, route string) sdktypes.Trigger {
+		if route == "" {
+			tr = tr.WithWebhookRoute(sdktypes.InvalidWebhookRoute)
+		} else {
+			tr = tr.WithWebhookRoute(kittehs.Must1(sdktypes.ParseWebhookRoute(route)))
+		}
+
+		require.NoError(t, m.Update(ctx, tr))
+		return kittehs.Must1(m.Get(ctx, tr.ID()))
+	}
+
+	plain := create("plain", "")
+	orders := create("orders", "GET /orders")
+	order := create("order", "GET /orders/{id}")
+
+	shared := orders.WebhookSlug()
+	assert.Equal(t, shared, order.WebhookSlug())
+	assert.NotEqual(t, shared, plain.WebhookSlug())
+
+	// Adding a route joins the shared slug.
+	plain = update(plain, "POST /orders")
+	assert.Equal(t, shared, plain.WebhookSlug())
+
+	// Changing a route keeps it.
+	plain = update(plain, "PUT /orders")
+	assert.Equal(t, shared, plain.WebhookSlug())
+
+	// Removing a route leaves the shared slug.
+	order = update(order, "")
+	assert.NotEqual(t, shared, order.WebhookSlug())
+	assert.Equal(t, shared, kittehs.Must1(m.Get(ctx, orders.ID())).WebhookSlug())
+
+	// Removing the route of the last routed trigger keeps its slug.
+	plain = update(plain, "")
+	assert.NotEqual(t, shared, plain.WebhookSlug())
+	orders = update(orders, "")
+	assert.Equal(t, shared, orders.WebhookSlug())
+
+	// Other updates keep the slug.
+	orders = update(orders.WithFilter("true"), "")
+	assert.Equal(t, shared, orders.WebhookSlug())
+}
+
+func TestDuplicateWebhookRoutes(t *testing.T) {
+	ctx := t.Context()
+	m := New(zap.NewNop(), &testDB{triggers: make(map[sdktypes.TriggerID]sdktypes.Trigger)}, nil)
+
+	id, err := m.Create(ctx, newWebhookTrigger("order", "POST /orders/{id}"))
+	require.NoError(t, err)
+
+	_, err = m.Create(ctx, newWebhookTrigger("other", "POST /orders/{oid}"))
+	assert.ErrorIs(t, err, sdkerrors.ErrAlreadyExists)
+
+	// Different method.
+	_, err = m.Create(ctx, newWebhookTrigger("get", "GET /orders/{id}"))
+	require.NoError(t, err)
+
+	plainID, err := m.Create(ctx, newWebhookTrigger("plain", ""))
+	require.NoError(t, err)
+
+	plain := kittehs.Must1(m.Get(ctx, plainID))
+	err = m.Update(ctx, plain.WithWebhookRoute(kittehs.Must1(sdktypes.ParseWebhookRoute("POST /orders/{x}"))))
+	assert.ErrorIs(t, err, sdkerrors.ErrAlreadyExists)
+
+	// A trigger does not conflict with itself.
+	order := kittehs.Must1(m.Get(ctx, id))
+	assert.NoError(t, m.Update(ctx, order.WithFilter("true")))
+}
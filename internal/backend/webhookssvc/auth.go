@@ -0,0 +1,219 @@
+package webhookssvc
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.autokitteh.dev/autokitteh/internal/backend/auth/authcontext"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+// Stripe signatures include the time they were made at. Older ones are
+// rejected to prevent replays.
+const stripeSignatureTolerance = 5 * time.Minute
+
+var errUnauthenticated = errors.New("unauthenticated")
+
+// getVarFunc returns the value of a project variable, revealing secrets.
+type getVarFunc func(sdktypes.Symbol) (string, error)
+
+// authenticate verifies the request against the trigger's webhook authentication,
+// if any. The request body is restored after it is read, so it can be read again.
+func (s *Service) authenticate(ctx context.Context, t sdktypes.Trigger, r *http.Request) error {
+	auth := t.WebhookAuth()
+	if !auth.IsValid() {
+		return nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return fmt.Errorf("body read: %w", err)
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	sid := sdktypes.NewVarScopeID(t.ProjectID())
+
+	getVar := func(name sdktypes.Symbol) (string, error) {
+		vs, err := s.vars.Get(authcontext.SetAuthnSystemUser(ctx), sid, name)
+		if err != nil {
+			return "", fmt.Errorf("get var %q: %w", name, err)
+		}
+
+		v := vs.GetValue(name)
+		if v == "" {
+			return "", fmt.Errorf("var %q is not set", name)
+		}
+
+		return v, nil
+	}
+
+	return verifyWebhookAuth(auth, r, body, getVar, kittehs.Now())
+}
+
+// verifyWebhookAuth returns an error wrapping errUnauthenticated if the request
+// fails any of the configured checks. Other errors mean that the checks could
+// not be made, for example when a referenced variable is not set.
+func verifyWebhookAuth(auth sdktypes.WebhookAuth, r *http.Request, body []byte, getVar getVarFunc, now time.Time) error {
+	if cidrs := auth.AllowedCIDRs(); len(cidrs) != 0 {
+		if err := verifyRemoteAddr(r.RemoteAddr, cidrs); err != nil {
+			return err
+		}
+	}
+
+	if name := auth.BearerTokenVar(); name.IsValid() {
+		expected, err := getVar(name)
+		if err != nil {
+			return err
+		}
+
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || !secureEqual(token, expected) {
+			return fmt.Errorf("%w: bearer token mismatch", errUnauthenticated)
+		}
+	}
+
+	if username, passwordVar, ok := auth.Basic(); ok {
+		expected, err := getVar(passwordVar)
+		if err != nil {
+			return err
+		}
+
+		u, p, ok := r.BasicAuth()
+		if !ok {
+			return fmt.Errorf("%w: missing basic auth", errUnauthenticated)
+		}
+
+		// Evaluate both to avoid leaking which one is wrong via timing.
+		if uok, pok := secureEqual(u, username), secureEqual(p, expected); !uok || !pok {
+			return fmt.Errorf("%w: basic auth mismatch", errUnauthenticated)
+		}
+	}
+
+	if header, secretVar, format, ok := auth.Signature(); ok {
+		secret, err := getVar(secretVar)
+		if err != nil {
+			return err
+		}
+
+		sig := r.Header.Get(header)
+		if sig == "" {
+			return fmt.Errorf("%w: missing signature header %q", errUnauthenticated, header)
+		}
+
+		if err := verifySignature(format, sig, []byte(secret), body, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func verifyRemoteAddr(remoteAddr string, cidrs []netip.Prefix) error {
+	var addr netip.Addr
+
+	if ap, err := netip.ParseAddrPort(remoteAddr); err == nil {
+		addr = ap.Addr()
+	} else if addr, err = netip.ParseAddr(remoteAddr); err != nil {
+		return fmt.Errorf("%w: invalid remote address %q", errUnauthenticated, remoteAddr)
+	}
+
+	addr = addr.Unmap()
+
+	for _, cidr := range cidrs {
+		if cidr.Contains(addr) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: remote address %v is not allowed", errUnauthenticated, addr)
+}
+
+func verifySignature(format sdktypes.WebhookSignatureFormat, sig string, secret, body []byte, now time.Time) error {
+	switch format {
+	case sdktypes.WebhookSignatureFormatStripe:
+		return verifyStripeSignature(sig, secret, body, now)
+
+	case sdktypes.WebhookSignatureFormatBase64:
+		got, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(sig, "sha256="))
+		if err != nil || !hmac.Equal(got, hmacSHA256(secret, body)) {
+			return fmt.Errorf("%w: signature mismatch", errUnauthenticated)
+		}
+
+		return nil
+
+	default:
+		got, err := hex.DecodeString(strings.TrimPrefix(sig, "sha256="))
+		if err != nil || !hmac.Equal(got, hmacSHA256(secret, body)) {
+			return fmt.Errorf("%w: signature mismatch", errUnauthenticated)
+		}
+
+		return nil
+	}
+}
+
+// verifyStripeSignature verifies headers of the form "t=<unix time>,v1=<hex>[,v1=<hex>...]",
+// where each v1 is a signature of "<unix time>.<body>". Multiple v1 values are
+// sent while secrets are rolled.
+func verifyStripeSignature(sig string, secret, body []byte, now time.Time) error {
+	var (
+		ts   string
+		sigs [][]byte
+	)
+
+	for _, part := range strings.Split(sig, ",") {
+		k, v, _ := strings.Cut(strings.TrimSpace(part), "=")
+
+		switch k {
+		case "t":
+			ts = v
+		case "v1":
+			if b, err := hex.DecodeString(v); err == nil {
+				sigs = append(sigs, b)
+			}
+		}
+	}
+
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || len(sigs) == 0 {
+		return fmt.Errorf("%w: malformed signature", errUnauthenticated)
+	}
+
+	if d := now.Sub(time.Unix(unix, 0)); d > stripeSignatureTolerance || d < -stripeSignatureTolerance {
+		return fmt.Errorf("%w: signature timestamp outside of tolerance", errUnauthenticated)
+	}
+
+	expected := hmacSHA256(secret, append([]byte(ts+"."), body...))
+
+	for _, s := range sigs {
+		if hmac.Equal(s, expected) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: signature mismatch", errUnauthenticated)
+}
+
+func hmacSHA256(secret, data []byte) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+func secureEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
@@ -0,0 +1,185 @@
+package webhookssvc
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func TestVerifyWebhookAuth(t *testing.T) {
+	var (
+		body   = []byte(`{"hello":"world"}`)
+		now    = time.Unix(1700000000, 0)
+		secret = []byte("s3cr3t")
+		sig    = hmacSHA256(secret, body)
+		ts     = strconv.FormatInt(now.Unix(), 10)
+		stripe = hex.EncodeToString(hmacSHA256(secret, append([]byte(ts+"."), body...)))
+	)
+
+	vars := map[string]string{"SECRET": string(secret), "TOKEN": "tok", "PASSWORD": "pass"}
+
+	getVar := func(name sdktypes.Symbol) (string, error) {
+		if v, ok := vars[name.String()]; ok {
+			return v, nil
+		}
+
+		return "", errors.New("not set")
+	}
+
+	withSig := func(format sdktypes.WebhookSignatureFormat) sdktypes.WebhookAuth {
+		return sdktypes.NewWebhookAuth().WithSignature("X-Signature", sdktypes.NewSymbol("SECRET"), format)
+	}
+
+	tests := []struct {
+		name       string
+		auth       sdktypes.WebhookAuth
+		remoteAddr string
+		headers    map[string]string
+		basic      []string
+		now        time.Time
+		err        bool // not an authentication error.
+		unauth     bool
+	}{
+		{
+			name: "hex",
+			auth: withSig(sdktypes.WebhookSignatureFormatUnspecified),
+			headers: map[string]string{
+				"X-Signature": "sha256=" + hex.EncodeToString(sig),
+			},
+		},
+		{
+			name: "hex without prefix",
+			auth: withSig(sdktypes.WebhookSignatureFormatHex),
+			headers: map[string]string{
+				"X-Signature": hex.EncodeToString(sig),
+			},
+		},
+		{
+			name: "hex mismatch",
+			auth: withSig(sdktypes.WebhookSignatureFormatHex),
+			headers: map[string]string{
+				"X-Signature": hex.EncodeToString(hmacSHA256([]byte("other"), body)),
+			},
+			unauth: true,
+		},
+		{
+			name:   "missing signature",
+			auth:   withSig(sdktypes.WebhookSignatureFormatHex),
+			unauth: true,
+		},
+		{
+			name: "base64",
+			auth: withSig(sdktypes.WebhookSignatureFormatBase64),
+			headers: map[string]string{
+				"X-Signature": base64.StdEncoding.EncodeToString(sig),
+			},
+		},
+		{
+			name: "stripe",
+			auth: withSig(sdktypes.WebhookSignatureFormatStripe),
+			headers: map[string]string{
+				"X-Signature": "t=" + ts + ",v1=deadbeef,v1=" + stripe,
+			},
+		},
+		{
+			name: "stripe too old",
+			auth: withSig(sdktypes.WebhookSignatureFormatStripe),
+			headers: map[string]string{
+				"X-Signature": "t=" + ts + ",v1=" + stripe,
+			},
+			now:    now.Add(time.Hour),
+			unauth: true,
+		},
+		{
+			name: "stripe malformed",
+			auth: withSig(sdktypes.WebhookSignatureFormatStripe),
+			headers: map[string]string{
+				"X-Signature": "v1=" + stripe,
+			},
+			unauth: true,
+		},
+		{
+			name:    "bearer",
+			auth:    sdktypes.NewWebhookAuth().WithBearerTokenVar(sdktypes.NewSymbol("TOKEN")),
+			headers: map[string]string{"Authorization": "Bearer tok"},
+		},
+		{
+			name:    "bearer mismatch",
+			auth:    sdktypes.NewWebhookAuth().WithBearerTokenVar(sdktypes.NewSymbol("TOKEN")),
+			headers: map[string]string{"Authorization": "Bearer meow"},
+			unauth:  true,
+		},
+		{
+			name:  "basic",
+			auth:  sdktypes.NewWebhookAuth().WithBasic("garfield", sdktypes.NewSymbol("PASSWORD")),
+			basic: []string{"garfield", "pass"},
+		},
+		{
+			name:   "basic mismatch",
+			auth:   sdktypes.NewWebhookAuth().WithBasic("garfield", sdktypes.NewSymbol("PASSWORD")),
+			basic:  []string{"odie", "pass"},
+			unauth: true,
+		},
+		{
+			name:       "cidr",
+			auth:       sdktypes.NewWebhookAuth().WithAllowedCIDRs([]netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}),
+			remoteAddr: "10.1.2.3:1234",
+		},
+		{
+			name:       "cidr mismatch",
+			auth:       sdktypes.NewWebhookAuth().WithAllowedCIDRs([]netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}),
+			remoteAddr: "192.168.1.1:1234",
+			unauth:     true,
+		},
+		{
+			name: "missing var",
+			auth: sdktypes.NewWebhookAuth().WithBearerTokenVar(sdktypes.NewSymbol("MISSING")),
+			err:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/webhooks/slug", nil)
+
+			if test.remoteAddr != "" {
+				r.RemoteAddr = test.remoteAddr
+			}
+
+			for k, v := range test.headers {
+				r.Header.Set(k, v)
+			}
+
+			if test.basic != nil {
+				r.SetBasicAuth(test.basic[0], test.basic[1])
+			}
+
+			tnow := test.now
+			if tnow.IsZero() {
+				tnow = now
+			}
+
+			err := verifyWebhookAuth(test.auth, r, body, getVar, tnow)
+
+			switch {
+			case test.unauth:
+				assert.ErrorIs(t, err, errUnauthenticated)
+			case test.err:
+				if assert.Error(t, err) {
+					assert.NotErrorIs(t, err, errUnauthenticated)
+				}
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
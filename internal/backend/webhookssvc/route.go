@@ -0,0 +1,70 @@
+package webhookssvc
+
+import (
+	"errors"
+	"slices"
+
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+var (
+	errRouteNotFound         = errors.New("no matching route")
+	errRouteMethodNotAllowed = errors.New("method not allowed")
+)
+
+// matchTrigger returns the trigger whose route best matches the request method
+// and path, which is relative to the webhook URL, along with the values of the
+// route parameters. A trigger without a route matches any request, but only if
+// no trigger with a route matches it.
+//
+// If there is no match, the returned error is errRouteMethodNotAllowed if some
+// route matches the path but not the method, in which case the methods that
+// are allowed for the path are returned. Otherwise it is errRouteNotFound.
+func matchTrigger(ts []sdktypes.Trigger, method, path string) (sdktypes.Trigger, map[string]string, []string, error) {
+	var (
+		best, fallback sdktypes.Trigger
+		bestRoute      sdktypes.WebhookRoute
+		bestParams     map[string]string
+		allowed        []string
+	)
+
+	for _, t := range ts {
+		route := t.WebhookRoute()
+		if !route.IsValid() {
+			if !fallback.IsValid() {
+				fallback = t
+			}
+
+			continue
+		}
+
+		params, ok := route.MatchPath(path)
+		if !ok {
+			continue
+		}
+
+		if !route.MatchMethod(method) {
+			if m := route.Method(); !slices.Contains(allowed, m) {
+				allowed = append(allowed, m)
+			}
+
+			continue
+		}
+
+		if !best.IsValid() || route.MoreSpecificThan(bestRoute) {
+			best, bestRoute, bestParams = t, route, params
+		}
+	}
+
+	switch {
+	case best.IsValid():
+		return best, bestParams, nil, nil
+	case fallback.IsValid():
+		return fallback, nil, nil, nil
+	case len(allowed) != 0:
+		slices.Sort(allowed)
+		return sdktypes.InvalidTrigger, nil, allowed, errRouteMethodNotAllowed
+	default:
+		return sdktypes.InvalidTrigger, nil, nil, errRouteNotFound
+	}
+}
@@ -0,0 +1,70 @@
+package webhookssvc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func TestMatchTrigger(t *testing.T) {
+	newTrigger := func(route string) sdktypes.Trigger {
+		tr := sdktypes.NewTrigger(sdktypes.NewSymbol("t")).WithNewID()
+		if route != "" {
+			tr = tr.WithWebhookRoute(kittehs.Must1(sdktypes.ParseWebhookRoute(route)))
+		}
+
+		return tr
+	}
+
+	var (
+		list     = newTrigger("GET /orders")
+		get      = newTrigger("GET /orders/{id}")
+		create   = newTrigger("POST /orders")
+		newest   = newTrigger("GET /orders/newest")
+		files    = newTrigger("/files/{path...}")
+		catchAll = newTrigger("")
+	)
+
+	routed := []sdktypes.Trigger{list, get, create, newest, files}
+
+	tests := []struct {
+		name    string
+		ts      []sdktypes.Trigger
+		method  string
+		path    string
+		trigger sdktypes.Trigger
+		params  map[string]string
+		allowed []string
+		err     error
+	}{
+		{name: "list", ts: routed, method: "GET", path: "/orders", trigger: list, params: map[string]string{}},
+		{name: "create", ts: routed, method: "POST", path: "/orders/", trigger: create, params: map[string]string{}},
+		{name: "get", ts: routed, method: "GET", path: "/orders/42", trigger: get, params: map[string]string{"id": "42"}},
+		{name: "literal preferred", ts: routed, method: "GET", path: "/orders/newest", trigger: newest, params: map[string]string{}},
+		{name: "rest", ts: routed, method: "DELETE", path: "/files/a/b", trigger: files, params: map[string]string{"path": "a/b"}},
+		{name: "method not allowed", ts: routed, method: "DELETE", path: "/orders", allowed: []string{"GET", "POST"}, err: errRouteMethodNotAllowed},
+		{name: "not found", ts: routed, method: "GET", path: "/customers", err: errRouteNotFound},
+		{name: "fallback", ts: append(routed, catchAll), method: "GET", path: "/customers", trigger: catchAll},
+		{name: "unrouted", ts: []sdktypes.Trigger{catchAll}, method: "PATCH", path: "/whatever", trigger: catchAll},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tr, params, allowed, err := matchTrigger(test.ts, test.method, test.path)
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+				assert.Equal(t, test.allowed, allowed)
+				return
+			}
+
+			if assert.NoError(t, err) {
+				assert.Equal(t, test.trigger.ID(), tr.ID())
+				assert.Equal(t, test.params, params)
+			}
+		})
+	}
+}
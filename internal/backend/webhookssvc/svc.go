@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -35,16 +36,21 @@ var unwrapper = sdktypes.ValueWrapper{
 const WebhooksPathPrefix = "webhooks/"
 
 type Config struct {
-	SessionOutcomePollInterval time.Duration `koanf:"session_outcome_poll_interval"`
-	WebhookResponseTimeout     time.Duration `koanf:"webhook_response_timeout"`
-	MaxBodySize                uint64        `koanf:"max_body_size"`
+	// Outcomes are read when the database notifies about them, and polled for
+	// in case a notification is missed. While the database relays notifications
+	// from all processes, polling is only a fallback and can be less frequent.
+	SessionOutcomePollInterval        time.Duration `koanf:"session_outcome_poll_interval"`
+	SessionOutcomeRelayedPollInterval time.Duration `koanf:"session_outcome_relayed_poll_interval"`
+	WebhookResponseTimeout            time.Duration `koanf:"webhook_response_timeout"`
+	MaxBodySize                       uint64        `koanf:"max_body_size"`
 }
 
 var Configs = configset.Set[Config]{
 	Default: &Config{
-		WebhookResponseTimeout:     30 * time.Second,
-		SessionOutcomePollInterval: 100 * time.Millisecond,
-		MaxBodySize:                512 * 1024, // 512KB
+		WebhookResponseTimeout:            30 * time.Second,
+		SessionOutcomePollInterval:        100 * time.Millisecond,
+		SessionOutcomeRelayedPollInterval: 5 * time.Second,
+		MaxBodySize:                       512 * 1024, // 512KB
 	},
 }
 
@@ -52,11 +58,12 @@ type Service struct {
 	logger   *zap.Logger
 	dispatch sdkservices.DispatchFunc
 	db       db.DB
+	vars     sdkservices.Vars
 	cfg      *Config
 }
 
-func New(l *zap.Logger, cfg *Config, db db.DB, dispatch sdkservices.DispatchFunc) *Service {
-	return &Service{logger: l, db: db, dispatch: dispatch, cfg: cfg}
+func New(l *zap.Logger, cfg *Config, db db.DB, vars sdkservices.Vars, dispatch sdkservices.DispatchFunc) *Service {
+	return &Service{logger: l, db: db, vars: vars, dispatch: dispatch, cfg: cfg}
 }
 
 func (s *Service) Start(muxes *muxes.Muxes) {
@@ -97,39 +104,71 @@ func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	t, err := s.db.GetTriggerWithActiveDeploymentByWebhookSlug(ctx, slug)
+	ts, err := s.db.ListTriggersWithActiveDeploymentByWebhookSlug(ctx, slug)
 	if err != nil {
-		if errors.Is(err, sdkerrors.ErrNotFound) {
-			sl.Infof("could not find an active deployment for trigger by slug %q", slug)
+		sl.Errorw("list triggers by slug failed", "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	if len(ts) == 0 {
+		sl.Infof("could not find an active deployment for trigger by slug %q", slug)
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+
+	t, params, allowed, err := matchTrigger(ts, r.Method, "/"+getPathSuffix(r.URL, slug))
+	if err != nil {
+		sl.Infow("no matching webhook route", "err", err)
+
+		if errors.Is(err, errRouteMethodNotAllowed) {
+			w.Header().Set("Allow", strings.Join(allowed, ", "))
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		} else {
 			http.Error(w, "Not Found", http.StatusNotFound)
-			return
 		}
 
-		sl.Errorw("get trigger by slug failed", "err", err)
-		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 		return
 	}
 
 	sl.With("trigger", t).Infof("webhook request: method=%s, trigger_event_type=%s", r.Method, t.EventType())
 
-	data, err := requestToData(r, slug)
+	if err := s.authenticate(ctx, t, r); err != nil {
+		if errors.Is(err, errUnauthenticated) {
+			sl.Warnw("webhook request authentication failed", "err", err)
+		} else {
+			sl.Errorw("webhook request authentication could not be verified", "err", err)
+		}
+
+		// Deliberately not distinguishing between the two cases in the response.
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	data, err := requestToData(r, slug, params)
 	if err != nil {
 		sl.Errorw("failed to convert request to data", "err", err)
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 		return
 	}
 
+	memo := map[string]string{
+		"method":       r.Method,
+		"webhook_slug": slug,
+		"remote_addr":  r.RemoteAddr,
+		"trigger_id":   t.ID().String(),
+		"trigger_uuid": t.ID().UUIDValue().String(),
+	}
+
+	if route := t.WebhookRoute(); route.IsValid() {
+		memo["webhook_route"] = route.String()
+	}
+
 	event, err := sdktypes.EventFromProto(&sdktypes.EventPB{
 		EventType:     strings.ToLower(r.Method),
 		Data:          kittehs.TransformMapValues(data, sdktypes.ToProto),
 		DestinationId: t.ID().String(),
-		Memo: map[string]string{
-			"method":       r.Method,
-			"webhook_slug": slug,
-			"remote_addr":  r.RemoteAddr,
-			"trigger_id":   t.ID().String(),
-			"trigger_uuid": t.ID().UUIDValue().String(),
-		},
+		Memo:          memo,
 	})
 	if err != nil {
 		sl.Errorw("failed to convert protocol buffer to event", "event_type", r.Method, "data", data, "err", err)
@@ -137,6 +176,14 @@ func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if idem := t.WebhookIdempotency(); idem.IsValid() {
+		if key, err := idem.Eval(event, r.Header.Get); err != nil {
+			sl.Warnw("failed to evaluate idempotency key, dispatching without it", "err", err)
+		} else {
+			event = event.WithIdempotencyKey(key)
+		}
+	}
+
 	isSync := t.IsSync()
 
 	sl = sl.With("sync", isSync, "event_id", event.ID())
@@ -160,6 +207,11 @@ func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("AutoKitteh-Event-ID", eventID.String())
 	w.Header().Set("Cache-Control", "no-cache")
 
+	if resp.Duplicate {
+		sl.Infow("duplicate webhook request", "original_event_id", eventID, "idempotency_key", event.IdempotencyKey())
+		w.Header().Set("AutoKitteh-Duplicate", "true")
+	}
+
 	if !isSync {
 		w.WriteHeader(http.StatusAccepted)
 		return
@@ -176,35 +228,66 @@ func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	s.handleSyncResponse(ctx, sl, w, resp.EventID)
 }
 
+func (s *Service) sessionOutcomePollInterval() time.Duration {
+	if s.cfg.SessionOutcomeRelayedPollInterval != 0 && s.db.SessionOutcomeNotificationsRelayed() {
+		return s.cfg.SessionOutcomeRelayedPollInterval
+	}
+
+	return s.cfg.SessionOutcomePollInterval
+}
+
 func (s *Service) handleSyncResponse(ctx context.Context, sl *zap.SugaredLogger, w http.ResponseWriter, eventID sdktypes.EventID) {
+	// The timeout applies to the wait for each outcome, so streams are not cut
+	// off as long as they keep producing.
+	var idle *time.Timer
 	if tmo := s.cfg.WebhookResponseTimeout; tmo != 0 {
-		var done context.CancelFunc
-		ctx, done = context.WithTimeout(ctx, tmo)
-		defer done()
+		idle = time.NewTimer(tmo)
+		defer idle.Stop()
 	}
 
+	// Subscribe before the first read, so no outcome added in between is missed.
+	notify, unsubscribe := s.db.SubscribeSessionOutcomes(eventID)
+	defer unsubscribe()
+
 	var (
-		firstOutcomeHandled bool
-		lastSeq             uint64
+		headersWritten bool
+		lastSeq        uint64
+		wait           bool
+		stream         string // determined by the first outcome.
 	)
 
+	// Once the headers are written, the status can no longer be changed.
+	fail := func(code int, msg string) {
+		if !headersWritten {
+			http.Error(w, msg, code)
+			return
+		}
+
+		writeStreamError(w, stream, msg)
+	}
+
 	for {
 		sl := sl.With("last_seq", lastSeq, "event_id", eventID)
 
-		if lastSeq > 0 {
-			// delay poll only after first iteration.
+		if wait {
+			var idleC <-chan time.Time
+			if idle != nil {
+				idleC = idle.C
+			}
 
 			select {
-			case <-time.After(s.cfg.SessionOutcomePollInterval):
+			case <-notify:
 				// nop
+			case <-time.After(s.sessionOutcomePollInterval()):
+				// nop
+			case <-idleC:
+				sl.Debugw("timed out waiting for session outcome", "headers_written", headersWritten)
+				fail(http.StatusGatewayTimeout, "Gateway Timeout")
+				return
 			case <-ctx.Done():
-				if ctx.Err() == context.DeadlineExceeded {
-					// timeout
-					sl.Debugw("timed out waiting for session to complete")
-					w.WriteHeader(http.StatusGatewayTimeout)
-				} else {
-					// cancelled
-					sl.Debugw("request context cancelled", "err", ctx.Err())
+				// The client is gone, so there is no one to respond to.
+				sl.Debugw("request context cancelled", "err", ctx.Err())
+				if !headersWritten {
 					w.WriteHeader(http.StatusRequestTimeout)
 				}
 				return
@@ -219,11 +302,12 @@ func (s *Service) handleSyncResponse(ctx context.Context, sl *zap.SugaredLogger,
 
 		if v, sid, lastSeq, err = s.db.GetNextSessionOutcomeForEvent(ctx, eventID, lastSeq); err != nil {
 			sl.Errorw("failed to get next session outcome for event", "last_seq", lastSeq, "err", err)
-			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			fail(http.StatusInternalServerError, "Internal Server Error")
 			return
 		}
 
-		if !v.IsValid() {
+		// Wait only if there is nothing new, otherwise read the next outcome right away.
+		if wait = !v.IsValid(); wait {
 			continue
 		}
 
@@ -232,14 +316,26 @@ func (s *Service) handleSyncResponse(ctx context.Context, sl *zap.SugaredLogger,
 		outcome, err := parseOutcomeValue(v)
 		if err != nil {
 			sl.Errorw("failed to parse outcome value", "err", err)
-			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			fail(http.StatusInternalServerError, "Internal Server Error")
 			return
 		}
 
-		more, err := s.writeOutcome(w, outcome, firstOutcomeHandled)
+		if !headersWritten {
+			if stream = outcome.Stream; !slices.Contains(outcomeStreams, stream) {
+				sl.Errorw("invalid outcome stream", "stream", stream)
+				fail(http.StatusInternalServerError, "Internal Server Error")
+				return
+			}
+		}
+
+		more, err := s.writeOutcome(w, outcome, stream, headersWritten)
+
+		// writeOutcome writes the headers first, even if the body fails.
+		headersWritten = true
+
 		if err != nil {
 			sl.Errorw("failed to handle outcome", "err", err)
-			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			fail(http.StatusInternalServerError, "Internal Server Error")
 			return
 		}
 
@@ -248,12 +344,31 @@ func (s *Service) handleSyncResponse(ctx context.Context, sl *zap.SugaredLogger,
 			return
 		}
 
-		firstOutcomeHandled = true
+		if idle != nil {
+			idle.Reset(s.cfg.WebhookResponseTimeout)
+		}
 	}
 }
 
-func (s *Service) writeOutcome(w http.ResponseWriter, outcome httpOutcome, firstOutcomeHandled bool) (more bool, err error) {
-	if !firstOutcomeHandled {
+// writeStreamError ends a response whose headers were already written. SSE
+// clients get a final "error" event. Other responses are just ended, which
+// terminates the chunked body.
+func writeStreamError(w http.ResponseWriter, stream, msg string) {
+	if stream != sseOutcomeStream {
+		return
+	}
+
+	if err := (httpOutcome{Body: sdktypes.NewStringValue(msg), SseEvent: "error"}).WriteSSE(w); err != nil {
+		return
+	}
+
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (s *Service) writeOutcome(w http.ResponseWriter, outcome httpOutcome, stream string, headersWritten bool) (more bool, err error) {
+	if !headersWritten {
 		var hadContentType bool
 
 		for k, v := range outcome.Headers {
@@ -264,8 +379,17 @@ func (s *Service) writeOutcome(w http.ResponseWriter, outcome httpOutcome, first
 			}
 		}
 
-		if !hadContentType && outcome.Json.IsValid() {
-			w.Header().Set("Content-Type", "application/json")
+		if !hadContentType {
+			if stream == sseOutcomeStream {
+				w.Header().Set("Content-Type", "text/event-stream")
+			} else if outcome.Json.IsValid() {
+				w.Header().Set("Content-Type", "application/json")
+			}
+		}
+
+		if stream != "" {
+			// Prevent reverse proxies from buffering the stream.
+			w.Header().Set("X-Accel-Buffering", "no")
 		}
 
 		code := outcome.StatusCode
@@ -276,7 +400,13 @@ func (s *Service) writeOutcome(w http.ResponseWriter, outcome httpOutcome, first
 		w.WriteHeader(code)
 	}
 
-	if err := outcome.WriteBody(w); err != nil {
+	if stream == sseOutcomeStream {
+		err = outcome.WriteSSE(w)
+	} else {
+		err = outcome.WriteBody(w)
+	}
+
+	if err != nil {
 		return false, fmt.Errorf("write outcome body: %w", err)
 	}
 
@@ -287,12 +417,25 @@ func (s *Service) writeOutcome(w http.ResponseWriter, outcome httpOutcome, first
 	return outcome.More, nil
 }
 
+const (
+	chunkedOutcomeStream = "chunked"
+	sseOutcomeStream     = "sse"
+)
+
+var outcomeStreams = []string{"", chunkedOutcomeStream, sseOutcomeStream}
+
 type httpOutcome struct {
 	StatusCode int
 	Body       sdktypes.Value
 	Json       sdktypes.Value // due to the way unwrapping work, this must be "Json" and not "JSON".
 	Headers    map[string]string
 	More       bool
+
+	// Set in the first outcome of a response to stream it: "chunked" or "sse"
+	// (Server-Sent Events). Each outcome is written as a chunk or as an event
+	// as soon as it arrives, until an outcome without More.
+	Stream   string
+	SseEvent string // event name, only for "sse". Also must not be "SSEEvent" due to unwrapping.
 }
 
 func parseOutcomeValue(v sdktypes.Value) (outcome httpOutcome, err error) {
@@ -353,7 +496,39 @@ func (o httpOutcome) WriteBody(w io.Writer) error {
 	return nil
 }
 
-func requestToData(r *http.Request, slug string) (map[string]sdktypes.Value, error) {
+// WriteSSE writes the body as a single Server-Sent Event. Each line of the body
+// is sent as a separate data field, which clients join back with newlines.
+func (o httpOutcome) WriteSSE(w io.Writer) error {
+	var buf bytes.Buffer
+	if err := o.WriteBody(&buf); err != nil {
+		return err
+	}
+
+	if buf.Len() == 0 && o.SseEvent == "" {
+		return nil
+	}
+
+	var ev strings.Builder
+
+	if o.SseEvent != "" {
+		fmt.Fprintf(&ev, "event: %s\n", o.SseEvent)
+	}
+
+	// JSON bodies are encoded with a trailing newline, which is not part of the data.
+	for line := range strings.SplitSeq(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		fmt.Fprintf(&ev, "data: %s\n", line)
+	}
+
+	ev.WriteString("\n")
+
+	if _, err := io.WriteString(w, ev.String()); err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+
+	return nil
+}
+
+func requestToData(r *http.Request, slug string, params map[string]string) (map[string]sdktypes.Value, error) {
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
 		return nil, fmt.Errorf("body read: %w", err)
@@ -375,7 +550,7 @@ func requestToData(r *http.Request, slug string) (map[string]sdktypes.Value, err
 			})),
 		"method":  sdktypes.NewStringValue(r.Method),
 		"raw_url": sdktypes.NewStringValue(r.RequestURI),
-		"url":     urlData(r.URL, slug),
+		"url":     urlData(r.URL, slug, params),
 	}, nil
 }
 
@@ -426,10 +601,12 @@ func jsonData(body []byte) sdktypes.Value {
 	}
 }
 
-func urlData(u *url.URL, slug string) sdktypes.Value {
+// params are the values of the parameters of the matched route, if any.
+func urlData(u *url.URL, slug string, params map[string]string) sdktypes.Value {
 	return sdktypes.NewDictValueFromStringMap(
 		map[string]sdktypes.Value{
-			"path": sdktypes.NewStringValue(u.Path),
+			"path":   sdktypes.NewStringValue(u.Path),
+			"params": sdktypes.NewDictValueFromStringMap(kittehs.TransformMapValues(params, sdktypes.NewStringValue)),
 			"query": sdktypes.NewDictValueFromStringMap(
 				kittehs.TransformMapValues(u.Query(), func(vs []string) sdktypes.Value {
 					return sdktypes.NewStringValue(strings.Join(vs, ", "))
@@ -2,12 +2,19 @@ package webhookssvc
 
 import (
 	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
+	"go.autokitteh.dev/autokitteh/internal/backend/db"
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
 )
 
@@ -66,6 +73,22 @@ func TestParseOutcomeValue(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "streamed outcome",
+			input: map[string]any{
+				"body":      "token",
+				"more":      true,
+				"stream":    "sse",
+				"sse_event": "delta",
+			},
+			expected: httpOutcome{
+				Body:     sdktypes.NewStringValue("token"),
+				More:     true,
+				Stream:   "sse",
+				SseEvent: "delta",
+			},
+			expectError: false,
+		},
 		{
 			name: "outcome with zero status",
 			input: map[string]any{
@@ -96,6 +119,8 @@ func TestParseOutcomeValue(t *testing.T) {
 			assert.Equal(t, tt.expected.StatusCode, result.StatusCode)
 			assert.Equal(t, tt.expected.Headers, result.Headers)
 			assert.Equal(t, tt.expected.More, result.More)
+			assert.Equal(t, tt.expected.Stream, result.Stream)
+			assert.Equal(t, tt.expected.SseEvent, result.SseEvent)
 
 			if tt.expected.Body.IsValid() {
 				assert.True(t, result.Body.IsValid())
@@ -319,6 +344,165 @@ func TestHTTPOutcome_BodyBytes_Integration(t *testing.T) {
 	assert.False(t, outcome.More)
 }
 
+func TestHTTPOutcome_WriteSSE(t *testing.T) {
+	tests := []struct {
+		name     string
+		outcome  httpOutcome
+		expected string
+	}{
+		{
+			name:     "empty",
+			outcome:  httpOutcome{},
+			expected: "",
+		},
+		{
+			name:     "string",
+			outcome:  httpOutcome{Body: sdktypes.NewStringValue("meow")},
+			expected: "data: meow\n\n",
+		},
+		{
+			name:     "multiline with event",
+			outcome:  httpOutcome{Body: sdktypes.NewStringValue("meow\nwoof"), SseEvent: "token"},
+			expected: "event: token\ndata: meow\ndata: woof\n\n",
+		},
+		{
+			name:     "json",
+			outcome:  httpOutcome{Json: kittehs.Must1(sdktypes.WrapValue(map[string]any{"n": 1}))},
+			expected: "data: {\"n\":1}\n\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, tt.outcome.WriteSSE(&buf))
+			assert.Equal(t, tt.expected, buf.String())
+		})
+	}
+}
+
+func TestWriteOutcomeStream(t *testing.T) {
+	var s Service
+
+	w := httptest.NewRecorder()
+
+	outcomes := []httpOutcome{
+		{StatusCode: 201, Stream: sseOutcomeStream, More: true},
+		{Body: sdktypes.NewStringValue("hello"), More: true},
+		{Body: sdktypes.NewStringValue("world"), SseEvent: "token", More: true},
+		{},
+	}
+
+	for i, o := range outcomes {
+		more, err := s.writeOutcome(w, o, sseOutcomeStream, i > 0)
+		require.NoError(t, err)
+		assert.Equal(t, o.More, more)
+	}
+
+	assert.Equal(t, 201, w.Code)
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	assert.Equal(t, "no", w.Header().Get("X-Accel-Buffering"))
+	assert.Equal(t, "data: hello\n\nevent: token\ndata: world\n\n", w.Body.String())
+	assert.True(t, w.Flushed)
+}
+
+// outcomesDB returns each of the outcomes once, after its delay since the previous one.
+type outcomesDB struct {
+	db.DB
+
+	outcomes []map[string]any
+	delays   []time.Duration
+	last     time.Time
+}
+
+func (d *outcomesDB) SubscribeSessionOutcomes(sdktypes.EventID) (<-chan struct{}, func()) {
+	return nil, func() {}
+}
+
+func (d *outcomesDB) SessionOutcomeNotificationsRelayed() bool { return false }
+
+func (d *outcomesDB) GetNextSessionOutcomeForEvent(_ context.Context, _ sdktypes.EventID, lastSeq uint64) (sdktypes.Value, sdktypes.SessionID, uint64, error) {
+	if lastSeq >= uint64(len(d.outcomes)) || time.Since(d.last) < d.delays[lastSeq] {
+		return sdktypes.InvalidValue, sdktypes.InvalidSessionID, lastSeq, nil
+	}
+
+	d.last = time.Now()
+
+	v, err := sdktypes.WrapValue(d.outcomes[lastSeq])
+	if err != nil {
+		return sdktypes.InvalidValue, sdktypes.InvalidSessionID, lastSeq, err
+	}
+
+	return v, sdktypes.NewSessionID(), lastSeq + 1, nil
+}
+
+func TestHandleSyncResponseTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		outcomes []map[string]any
+		delays   []time.Duration
+		code     int
+		body     string
+	}{
+		{
+			name: "no outcome",
+			code: http.StatusGatewayTimeout,
+			body: "Gateway Timeout\n",
+		},
+		{
+			name: "timeout applies per chunk",
+			outcomes: []map[string]any{
+				{"stream": sseOutcomeStream, "more": true},
+				{"body": "meow", "more": true},
+				{"body": "woof", "more": true},
+				{},
+			},
+			delays: []time.Duration{0, 60 * time.Millisecond, 60 * time.Millisecond, 60 * time.Millisecond},
+			code:   http.StatusOK,
+			body:   "data: meow\n\ndata: woof\n\n",
+		},
+		{
+			name: "sse timeout after headers",
+			outcomes: []map[string]any{
+				{"status_code": http.StatusCreated, "stream": sseOutcomeStream, "more": true},
+				{"body": "meow", "more": true},
+			},
+			delays: []time.Duration{0, 0},
+			code:   http.StatusCreated,
+			body:   "data: meow\n\nevent: error\ndata: Gateway Timeout\n\n",
+		},
+		{
+			name: "chunked timeout after headers",
+			outcomes: []map[string]any{
+				{"status_code": http.StatusCreated, "stream": chunkedOutcomeStream, "more": true},
+				{"body": "meow", "more": true},
+			},
+			delays: []time.Duration{0, 0},
+			code:   http.StatusCreated,
+			body:   "meow",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Service{
+				db: &outcomesDB{outcomes: tt.outcomes, delays: tt.delays, last: time.Now()},
+				cfg: &Config{
+					SessionOutcomePollInterval: 5 * time.Millisecond,
+					WebhookResponseTimeout:     100 * time.Millisecond,
+				},
+			}
+
+			w := httptest.NewRecorder()
+
+			s.handleSyncResponse(t.Context(), zap.NewNop().Sugar(), w, sdktypes.NewEventID())
+
+			assert.Equal(t, tt.code, w.Code)
+			assert.Equal(t, tt.body, w.Body.String())
+		})
+	}
+}
+
 func TestPathSuffix(t *testing.T) {
 	tests := []struct {
 		name     string
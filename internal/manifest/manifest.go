@@ -69,12 +69,67 @@ type Trigger struct {
 	IsDurable *bool  `yaml:"is_durable,omitempty" json:"is_durable,omitempty" jsonschema_description:"Is handling done as a durable session? Default: true for manifest v1, false for all others."`
 	IsSync    bool   `yaml:"is_sync,omitempty" json:"is_sync,omitempty"`
 
-	Type          string    `yaml:"type,omitempty" json:"type,omitempty" jsonschema:"enum=schedule,enum=webhook,enum=connection"`
-	Schedule      *string   `yaml:"schedule,omitempty" json:"schedule,omitempty"`
-	Webhook       *struct{} `yaml:"webhook,omitempty" json:"webhook,omitempty"`
-	ConnectionKey *string   `yaml:"connection,omitempty" json:"connection,omitempty"`
+	Concurrency *TriggerConcurrency `yaml:"concurrency,omitempty" json:"concurrency,omitempty"`
+	Batch       *TriggerBatch       `yaml:"batch,omitempty" json:"batch,omitempty"`
+	Retry       *TriggerRetry       `yaml:"retry,omitempty" json:"retry,omitempty"`
+	Timeout     string              `yaml:"timeout,omitempty" json:"timeout,omitempty" jsonschema_description:"Stop sessions still running after this long, e.g. 10m. Default: no timeout."`
+
+	Type          string          `yaml:"type,omitempty" json:"type,omitempty" jsonschema:"enum=schedule,enum=webhook,enum=connection,enum=store"`
+	Schedule      *string         `yaml:"schedule,omitempty" json:"schedule,omitempty"`
+	Webhook       *TriggerWebhook `yaml:"webhook,omitempty" json:"webhook,omitempty"`
+	ConnectionKey *string         `yaml:"connection,omitempty" json:"connection,omitempty"`
+	Store         *struct{}       `yaml:"store,omitempty" json:"store,omitempty" jsonschema_description:"Trigger on changes to values in the project's store."`
+
+	Call   string `yaml:"call,omitempty" json:"call,omitempty"`
+	OnStop string `yaml:"on_stop,omitempty" json:"on_stop,omitempty" jsonschema_description:"Function to call when a session started by this trigger is stopped, to let it clean up."`
+}
+
+type TriggerConcurrency struct {
+	Max     int    `yaml:"max,omitempty" json:"max,omitempty" jsonschema_description:"Maximum number of concurrent sessions. Default: unlimited, or 1 if overlap is specified."`
+	Overlap string `yaml:"overlap,omitempty" json:"overlap,omitempty" jsonschema:"enum=queue,enum=skip,enum=cancel_previous" jsonschema_description:"What to do with an event when max sessions are already running. Default: queue."`
+}
+
+type TriggerBatch struct {
+	Key       string `yaml:"key,omitempty" json:"key,omitempty" jsonschema_description:"CEL expression over the event. Events with the same value are batched together. Default: a single batch."`
+	Window    string `yaml:"window,omitempty" json:"window,omitempty" jsonschema_description:"How long to collect events for, starting from the first event, e.g. 30s."`
+	MaxEvents int    `yaml:"max_events,omitempty" json:"max_events,omitempty" jsonschema_description:"Start the session once this many events were collected."`
+}
+
+type TriggerRetry struct {
+	MaxAttempts         int     `yaml:"max_attempts" json:"max_attempts" jsonschema_description:"Total number of attempts, including the first one."`
+	InitialInterval     string  `yaml:"initial_interval,omitempty" json:"initial_interval,omitempty" jsonschema_description:"Delay before the first retry, e.g. 10s. Default: 1s."`
+	BackoffCoefficient  float64 `yaml:"backoff_coefficient,omitempty" json:"backoff_coefficient,omitempty" jsonschema_description:"Each retry is delayed by the previous delay times this. Default: 2."`
+	MaxInterval         string  `yaml:"max_interval,omitempty" json:"max_interval,omitempty" jsonschema_description:"Maximum delay between retries. Default: 100 times initial_interval."`
+	RetryOnProgramError bool    `yaml:"retry_on_program_error,omitempty" json:"retry_on_program_error,omitempty" jsonschema_description:"Also retry errors raised by the program. Default: only infrastructure errors are retried."`
+}
+
+type TriggerWebhook struct {
+	Route       string                     `yaml:"route,omitempty" json:"route,omitempty" jsonschema_description:"Handle only requests that match this method and path relative to the webhook URL, e.g. POST /orders/{id}. Triggers with routes in the same project share a webhook URL."`
+	Auth        *TriggerWebhookAuth        `yaml:"auth,omitempty" json:"auth,omitempty" jsonschema_description:"Reject requests that fail any of the specified checks, before they start sessions."`
+	Idempotency *TriggerWebhookIdempotency `yaml:"idempotency,omitempty" json:"idempotency,omitempty" jsonschema_description:"Do not start sessions again for retried requests with the same idempotency key."`
+}
+
+type TriggerWebhookIdempotency struct {
+	Header string `yaml:"header,omitempty" json:"header,omitempty" jsonschema_description:"Request header holding the idempotency key, e.g. Idempotency-Key."`
+	Key    string `yaml:"key,omitempty" json:"key,omitempty" jsonschema_description:"Expression evaluated over the event to compute the idempotency key, e.g. data.body.json.id."`
+}
+
+type TriggerWebhookAuth struct {
+	Signature    *TriggerWebhookSignature `yaml:"signature,omitempty" json:"signature,omitempty"`
+	BearerToken  string                   `yaml:"bearer_token,omitempty" json:"bearer_token,omitempty" jsonschema_description:"Name of the project variable holding the expected bearer token."`
+	Basic        *TriggerWebhookBasic     `yaml:"basic,omitempty" json:"basic,omitempty"`
+	AllowedCIDRs []string                 `yaml:"allowed_cidrs,omitempty" json:"allowed_cidrs,omitempty" jsonschema_description:"Accept requests only from these address ranges, e.g. 192.30.252.0/22."`
+}
+
+type TriggerWebhookSignature struct {
+	Header string `yaml:"header" json:"header" jsonschema:"required" jsonschema_description:"Request header containing the HMAC-SHA256 signature of the body, e.g. X-Hub-Signature-256."`
+	Secret string `yaml:"secret" json:"secret" jsonschema:"required" jsonschema_description:"Name of the project variable holding the signing secret."`
+	Format string `yaml:"format,omitempty" json:"format,omitempty" jsonschema:"enum=hex,enum=base64,enum=stripe" jsonschema_description:"Encoding of the signature. Default: hex, optionally prefixed by sha256=."`
+}
 
-	Call string `yaml:"call,omitempty" json:"call,omitempty"`
+type TriggerWebhookBasic struct {
+	Username string `yaml:"username" json:"username" jsonschema:"required"`
+	Password string `yaml:"password" json:"password" jsonschema:"required" jsonschema_description:"Name of the project variable holding the expected password."`
 }
 
 func (t Trigger) GetKey() string {
@@ -93,6 +148,8 @@ func (t Trigger) GetKey() string {
 		what = "webhook"
 	case t.ConnectionKey != nil:
 		what = "connection:" + *t.ConnectionKey
+	case t.Store != nil:
+		what = "store"
 	}
 
 	return id + what + "/" + t.Name
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/netip"
+	"time"
 
 	"go.autokitteh.dev/autokitteh/internal/kittehs"
 	"go.autokitteh.dev/autokitteh/internal/manifest/internal/actions"
@@ -417,11 +419,17 @@ func planTriggers(ctx context.Context, mtriggers []*Trigger, client sdkservices.
 			return nil, fmt.Errorf("trigger %q: invalid entrypoint: %w", mtrigger.GetKey(), err)
 		}
 
+		onStop, err := sdktypes.ParseCodeLocation(mtrigger.OnStop)
+		if err != nil {
+			return nil, fmt.Errorf("trigger %q: invalid on_stop: %w", mtrigger.GetKey(), err)
+		}
+
 		desired, err := sdktypes.TriggerFromProto(&sdktypes.TriggerPB{
 			Filter:       mtrigger.Filter,
 			IsDurable:    isDurable,
 			EventType:    mtrigger.EventType,
 			CodeLocation: loc.ToProto(),
+			OnStop:       onStop.ToProto(),
 			Name:         mtrigger.Name,
 			ProjectId:    pid.String(),
 			IsSync:       mtrigger.IsSync,
@@ -430,12 +438,112 @@ func planTriggers(ctx context.Context, mtriggers []*Trigger, client sdkservices.
 			return nil, fmt.Errorf("trigger %q: invalid: %w", mtrigger.GetKey(), err)
 		}
 
+		if c := mtrigger.Concurrency; c != nil {
+			overlap, err := sdktypes.ParseConcurrencyOverlap(c.Overlap)
+			if err != nil {
+				return nil, fmt.Errorf("trigger %q: invalid concurrency overlap: %w", mtrigger.GetKey(), err)
+			}
+
+			if c.Max < 0 {
+				return nil, fmt.Errorf("trigger %q: concurrency max must not be negative", mtrigger.GetKey())
+			}
+
+			desired = desired.WithConcurrency(sdktypes.NewConcurrencyPolicy(c.Max, overlap))
+		}
+
+		if b := mtrigger.Batch; b != nil {
+			var window time.Duration
+			if b.Window != "" {
+				if window, err = time.ParseDuration(b.Window); err != nil {
+					return nil, fmt.Errorf("trigger %q: invalid batch window: %w", mtrigger.GetKey(), err)
+				}
+			}
+
+			if b.MaxEvents < 0 {
+				return nil, fmt.Errorf("trigger %q: batch max_events must not be negative", mtrigger.GetKey())
+			}
+
+			batch, err := sdktypes.NewBatchPolicy(b.Key, window, b.MaxEvents)
+			if err != nil {
+				return nil, fmt.Errorf("trigger %q: invalid batch: %w", mtrigger.GetKey(), err)
+			}
+
+			desired = desired.WithBatch(batch)
+		}
+
+		if mtrigger.Timeout != "" {
+			timeout, err := time.ParseDuration(mtrigger.Timeout)
+			if err != nil {
+				return nil, fmt.Errorf("trigger %q: invalid timeout: %w", mtrigger.GetKey(), err)
+			}
+
+			if timeout <= 0 {
+				return nil, fmt.Errorf("trigger %q: timeout must be positive", mtrigger.GetKey())
+			}
+
+			desired = desired.WithTimeout(timeout)
+		}
+
+		if r := mtrigger.Retry; r != nil {
+			var initial, maxInterval time.Duration
+
+			if r.InitialInterval != "" {
+				if initial, err = time.ParseDuration(r.InitialInterval); err != nil {
+					return nil, fmt.Errorf("trigger %q: invalid retry initial_interval: %w", mtrigger.GetKey(), err)
+				}
+			}
+
+			if r.MaxInterval != "" {
+				if maxInterval, err = time.ParseDuration(r.MaxInterval); err != nil {
+					return nil, fmt.Errorf("trigger %q: invalid retry max_interval: %w", mtrigger.GetKey(), err)
+				}
+			}
+
+			if r.MaxAttempts <= 0 {
+				return nil, fmt.Errorf("trigger %q: retry max_attempts must be positive", mtrigger.GetKey())
+			}
+
+			retry, err := sdktypes.NewRetryPolicy(r.MaxAttempts, initial, r.BackoffCoefficient, maxInterval, r.RetryOnProgramError)
+			if err != nil {
+				return nil, fmt.Errorf("trigger %q: invalid retry: %w", mtrigger.GetKey(), err)
+			}
+
+			desired = desired.WithRetry(retry)
+		}
+
 		if wh := mtrigger.Webhook; wh != nil || mtrigger.Type == "webhook" {
 			if mtrigger.Type != "" && mtrigger.Type != "webhook" {
 				return nil, fmt.Errorf("trigger %q: type %q is not supported for webhook", mtrigger.GetKey(), mtrigger.Type)
 			}
 
 			desired = desired.WithSourceType(sdktypes.TriggerSourceTypeWebhook)
+
+			if wh != nil && wh.Auth != nil {
+				auth, err := parseWebhookAuth(wh.Auth)
+				if err != nil {
+					return nil, fmt.Errorf("trigger %q: invalid webhook auth: %w", mtrigger.GetKey(), err)
+				}
+
+				desired = desired.WithWebhookAuth(auth)
+			}
+
+			if wh != nil && wh.Route != "" {
+				route, err := sdktypes.ParseWebhookRoute(wh.Route)
+				if err != nil {
+					return nil, fmt.Errorf("trigger %q: invalid webhook route: %w", mtrigger.GetKey(), err)
+				}
+
+				desired = desired.WithWebhookRoute(route)
+			}
+
+			if wh != nil && wh.Idempotency != nil {
+				idem, err := sdktypes.NewWebhookIdempotency(wh.Idempotency.Header, wh.Idempotency.Key)
+				if err != nil {
+					return nil, fmt.Errorf("trigger %q: invalid webhook idempotency: %w", mtrigger.GetKey(), err)
+				}
+
+				desired = desired.WithWebhookIdempotency(idem)
+			}
 		}
 
 		if mtrigger.ConnectionKey != nil || mtrigger.Type == "connection" {
@@ -454,6 +562,14 @@ func planTriggers(ctx context.Context, mtriggers []*Trigger, client sdkservices.
 			desired = desired.WithSchedule(*mtrigger.Schedule)
 		}
 
+		if mtrigger.Store != nil || mtrigger.Type == "store" {
+			if mtrigger.Type != "" && mtrigger.Type != "store" {
+				return nil, fmt.Errorf("trigger %q: type %q is not supported for store", mtrigger.GetKey(), mtrigger.Type)
+			}
+
+			desired = desired.WithSourceType(sdktypes.TriggerSourceTypeStore)
+		}
+
 		if desired.SourceType() == sdktypes.TriggerSourceTypeUnspecified {
 			return nil, fmt.Errorf("trigger %q: concrete type not specified", mtrigger.GetKey())
 		}
@@ -505,3 +621,61 @@ func planTriggers(ctx context.Context, mtriggers []*Trigger, client sdkservices.
 
 	return acc, nil
 }
+
+func parseWebhookAuth(ma *TriggerWebhookAuth) (sdktypes.WebhookAuth, error) {
+	auth := sdktypes.NewWebhookAuth()
+
+	if s := ma.Signature; s != nil {
+		if s.Header == "" {
+			return sdktypes.InvalidWebhookAuth, errors.New("signature header is required")
+		}
+
+		secret, err := sdktypes.StrictParseSymbol(s.Secret)
+		if err != nil {
+			return sdktypes.InvalidWebhookAuth, fmt.Errorf("signature secret: %w", err)
+		}
+
+		format, err := sdktypes.ParseWebhookSignatureFormat(s.Format)
+		if err != nil {
+			return sdktypes.InvalidWebhookAuth, fmt.Errorf("signature format: %w", err)
+		}
+
+		auth = auth.WithSignature(s.Header, secret, format)
+	}
+
+	if ma.BearerToken != "" {
+		token, err := sdktypes.StrictParseSymbol(ma.BearerToken)
+		if err != nil {
+			return sdktypes.InvalidWebhookAuth, fmt.Errorf("bearer_token: %w", err)
+		}
+
+		auth = auth.WithBearerTokenVar(token)
+	}
+
+	if b := ma.Basic; b != nil {
+		if b.Username == "" {
+			return sdktypes.InvalidWebhookAuth, errors.New("basic username is required")
+		}
+
+		password, err := sdktypes.StrictParseSymbol(b.Password)
+		if err != nil {
+			return sdktypes.InvalidWebhookAuth, fmt.Errorf("basic password: %w", err)
+		}
+
+		auth = auth.WithBasic(b.Username, password)
+	}
+
+	if len(ma.AllowedCIDRs) != 0 {
+		cidrs := make([]netip.Prefix, len(ma.AllowedCIDRs))
+		for i, raw := range ma.AllowedCIDRs {
+			var err error
+			if cidrs[i], err = netip.ParsePrefix(raw); err != nil {
+				return sdktypes.InvalidWebhookAuth, fmt.Errorf("allowed_cidrs: %w", err)
+			}
+		}
+
+		auth = auth.WithAllowedCIDRs(cidrs)
+	}
+
+	return auth, nil
+}
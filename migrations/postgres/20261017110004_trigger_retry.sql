@@ -0,0 +1,15 @@
+-- +goose Up
+-- modify "triggers" table
+ALTER TABLE "triggers" ADD COLUMN "retry" jsonb NULL;
+-- modify "sessions" table
+ALTER TABLE "sessions" ADD COLUMN "retry_of_session_id" uuid NULL, ADD COLUMN "attempt" bigint NOT NULL DEFAULT 0;
+-- create index "idx_sessions_retry_of_session_id" to table: "sessions"
+CREATE INDEX "idx_sessions_retry_of_session_id" ON "sessions" ("retry_of_session_id");
+
+-- +goose Down
+-- reverse: create index "idx_sessions_retry_of_session_id" to table: "sessions"
+DROP INDEX "idx_sessions_retry_of_session_id";
+-- reverse: modify "sessions" table
+ALTER TABLE "sessions" DROP COLUMN "attempt", DROP COLUMN "retry_of_session_id";
+-- reverse: modify "triggers" table
+ALTER TABLE "triggers" DROP COLUMN "retry";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "triggers" table
+ALTER TABLE "triggers" ADD COLUMN "timeout" bigint NULL;
+-- modify "sessions" table
+ALTER TABLE "sessions" ADD COLUMN "timeout" bigint NOT NULL DEFAULT 0;
+
+-- +goose Down
+-- reverse: modify "sessions" table
+ALTER TABLE "sessions" DROP COLUMN "timeout";
+-- reverse: modify "triggers" table
+ALTER TABLE "triggers" DROP COLUMN "timeout";
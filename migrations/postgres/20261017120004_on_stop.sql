@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "triggers" table
+ALTER TABLE "triggers" ADD COLUMN "on_stop" text NULL;
+-- modify "sessions" table
+ALTER TABLE "sessions" ADD COLUMN "on_stop" text NULL;
+
+-- +goose Down
+-- reverse: modify "sessions" table
+ALTER TABLE "sessions" DROP COLUMN "on_stop";
+-- reverse: modify "triggers" table
+ALTER TABLE "triggers" DROP COLUMN "on_stop";
@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "triggers" table
+ALTER TABLE "triggers" ADD COLUMN "webhook_auth" jsonb NULL;
+
+-- +goose Down
+-- reverse: modify "triggers" table
+ALTER TABLE "triggers" DROP COLUMN "webhook_auth";
@@ -0,0 +1,13 @@
+-- +goose Up
+-- keep the idempotency key only on the earliest event of each destination
+UPDATE "events" SET "idempotency_key" = NULL WHERE "idempotency_key" IS NOT NULL AND EXISTS (SELECT 1 FROM "events" AS "e" WHERE "e"."destination_id" = "events"."destination_id" AND "e"."idempotency_key" = "events"."idempotency_key" AND "e"."seq" < "events"."seq");
+-- drop index "idx_event_idempotency_key" from table: "events"
+DROP INDEX "idx_event_idempotency_key";
+-- create index "idx_event_idempotency_key" to table: "events"
+CREATE UNIQUE INDEX "idx_event_idempotency_key" ON "events" ("destination_id", "idempotency_key") WHERE (idempotency_key IS NOT NULL);
+
+-- +goose Down
+-- reverse: create index "idx_event_idempotency_key" to table: "events"
+DROP INDEX "idx_event_idempotency_key";
+-- reverse: drop index "idx_event_idempotency_key" from table: "events"
+CREATE INDEX "idx_event_idempotency_key" ON "events" ("destination_id", "idempotency_key");
@@ -0,0 +1,35 @@
+-- +goose Up
+-- modify "triggers" table
+ALTER TABLE "triggers" ADD COLUMN "concurrency" jsonb NULL;
+-- modify "sessions" table
+ALTER TABLE "sessions" ADD COLUMN "trigger_id" uuid NULL;
+-- create index "idx_sessions_trigger_id" to table: "sessions"
+CREATE INDEX "idx_sessions_trigger_id" ON "sessions" ("trigger_id");
+-- create "trigger_queue_entries" table
+CREATE TABLE "trigger_queue_entries" (
+  "seq" bigserial NOT NULL,
+  "trigger_id" uuid NOT NULL,
+  "project_id" uuid NOT NULL,
+  "event_id" uuid NOT NULL,
+  "deployment_id" uuid NULL,
+  "created_at" timestamptz NULL,
+  PRIMARY KEY ("seq")
+);
+-- create index "idx_trigger_queue_entries_project_id" to table: "trigger_queue_entries"
+CREATE INDEX "idx_trigger_queue_entries_project_id" ON "trigger_queue_entries" ("project_id");
+-- create index "idx_trigger_queue_entries_trigger_id" to table: "trigger_queue_entries"
+CREATE INDEX "idx_trigger_queue_entries_trigger_id" ON "trigger_queue_entries" ("trigger_id");
+
+-- +goose Down
+-- reverse: create index "idx_trigger_queue_entries_trigger_id" to table: "trigger_queue_entries"
+DROP INDEX "idx_trigger_queue_entries_trigger_id";
+-- reverse: create index "idx_trigger_queue_entries_project_id" to table: "trigger_queue_entries"
+DROP INDEX "idx_trigger_queue_entries_project_id";
+-- reverse: create "trigger_queue_entries" table
+DROP TABLE "trigger_queue_entries";
+-- reverse: create index "idx_sessions_trigger_id" to table: "sessions"
+DROP INDEX "idx_sessions_trigger_id";
+-- reverse: modify "sessions" table
+ALTER TABLE "sessions" DROP COLUMN "trigger_id";
+-- reverse: modify "triggers" table
+ALTER TABLE "triggers" DROP COLUMN "concurrency";
@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "triggers" table
+ALTER TABLE "triggers" ADD COLUMN "batch" jsonb NULL;
+
+-- +goose Down
+-- reverse: modify "triggers" table
+ALTER TABLE "triggers" DROP COLUMN "batch";
@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "sessions" table
+ALTER TABLE "sessions" ADD COLUMN "tags" jsonb NULL;
+
+-- +goose Down
+-- reverse: modify "sessions" table
+ALTER TABLE "sessions" DROP COLUMN "tags";
@@ -0,0 +1,15 @@
+-- +goose Up
+-- modify "triggers" table
+ALTER TABLE "triggers" ADD COLUMN "webhook_idempotency" jsonb NULL;
+-- modify "events" table
+ALTER TABLE "events" ADD COLUMN "idempotency_key" text NULL;
+-- create index "idx_event_idempotency_key" to table: "events"
+CREATE INDEX "idx_event_idempotency_key" ON "events" ("destination_id", "idempotency_key");
+
+-- +goose Down
+-- reverse: create index "idx_event_idempotency_key" to table: "events"
+DROP INDEX "idx_event_idempotency_key";
+-- reverse: modify "events" table
+ALTER TABLE "events" DROP COLUMN "idempotency_key";
+-- reverse: modify "triggers" table
+ALTER TABLE "triggers" DROP COLUMN "webhook_idempotency";
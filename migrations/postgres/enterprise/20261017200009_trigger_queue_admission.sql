@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "trigger_queue_entries" table
+ALTER TABLE "trigger_queue_entries" ADD COLUMN "workflow_id" text NULL, ADD COLUMN "admitted_at" timestamptz NULL;
+
+-- +goose Down
+-- reverse: modify "trigger_queue_entries" table
+ALTER TABLE "trigger_queue_entries" DROP COLUMN "admitted_at", DROP COLUMN "workflow_id";
@@ -0,0 +1,36 @@
+-- +goose Up
+-- create "dead_letters" table
+CREATE TABLE `dead_letters` (
+  `dead_letter_id` uuid NOT NULL,
+  `project_id` uuid NOT NULL,
+  `org_id` uuid NULL,
+  `event_id` uuid NOT NULL,
+  `trigger_id` uuid NULL,
+  `deployment_id` uuid NULL,
+  `reason` integer NULL,
+  `error` text NULL,
+  `created_at` datetime NULL,
+  `retry_event_id` uuid NULL,
+  `retried_at` datetime NULL,
+  PRIMARY KEY (`dead_letter_id`)
+);
+-- create index "idx_dead_letters_created_at" to table: "dead_letters"
+CREATE INDEX `idx_dead_letters_created_at` ON `dead_letters` (`created_at`);
+-- create index "idx_dead_letters_event_id" to table: "dead_letters"
+CREATE INDEX `idx_dead_letters_event_id` ON `dead_letters` (`event_id`);
+-- create index "idx_dead_letters_org_id" to table: "dead_letters"
+CREATE INDEX `idx_dead_letters_org_id` ON `dead_letters` (`org_id`);
+-- create index "idx_dead_letters_project_id" to table: "dead_letters"
+CREATE INDEX `idx_dead_letters_project_id` ON `dead_letters` (`project_id`);
+
+-- +goose Down
+-- reverse: create index "idx_dead_letters_project_id" to table: "dead_letters"
+DROP INDEX `idx_dead_letters_project_id`;
+-- reverse: create index "idx_dead_letters_org_id" to table: "dead_letters"
+DROP INDEX `idx_dead_letters_org_id`;
+-- reverse: create index "idx_dead_letters_event_id" to table: "dead_letters"
+DROP INDEX `idx_dead_letters_event_id`;
+-- reverse: create index "idx_dead_letters_created_at" to table: "dead_letters"
+DROP INDEX `idx_dead_letters_created_at`;
+-- reverse: create "dead_letters" table
+DROP TABLE `dead_letters`;
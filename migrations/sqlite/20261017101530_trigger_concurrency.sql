@@ -0,0 +1,34 @@
+-- +goose Up
+-- add column "concurrency" to table: "triggers"
+ALTER TABLE `triggers` ADD COLUMN `concurrency` json NULL;
+-- add column "trigger_id" to table: "sessions"
+ALTER TABLE `sessions` ADD COLUMN `trigger_id` uuid NULL;
+-- create index "idx_sessions_trigger_id" to table: "sessions"
+CREATE INDEX `idx_sessions_trigger_id` ON `sessions` (`trigger_id`);
+-- create "trigger_queue_entries" table
+CREATE TABLE `trigger_queue_entries` (
+  `seq` integer NULL PRIMARY KEY AUTOINCREMENT,
+  `trigger_id` uuid NOT NULL,
+  `project_id` uuid NOT NULL,
+  `event_id` uuid NOT NULL,
+  `deployment_id` uuid NULL,
+  `created_at` datetime NULL
+);
+-- create index "idx_trigger_queue_entries_project_id" to table: "trigger_queue_entries"
+CREATE INDEX `idx_trigger_queue_entries_project_id` ON `trigger_queue_entries` (`project_id`);
+-- create index "idx_trigger_queue_entries_trigger_id" to table: "trigger_queue_entries"
+CREATE INDEX `idx_trigger_queue_entries_trigger_id` ON `trigger_queue_entries` (`trigger_id`);
+
+-- +goose Down
+-- reverse: create index "idx_trigger_queue_entries_trigger_id" to table: "trigger_queue_entries"
+DROP INDEX `idx_trigger_queue_entries_trigger_id`;
+-- reverse: create index "idx_trigger_queue_entries_project_id" to table: "trigger_queue_entries"
+DROP INDEX `idx_trigger_queue_entries_project_id`;
+-- reverse: create "trigger_queue_entries" table
+DROP TABLE `trigger_queue_entries`;
+-- reverse: create index "idx_sessions_trigger_id" to table: "sessions"
+DROP INDEX `idx_sessions_trigger_id`;
+-- reverse: add column "trigger_id" to table: "sessions"
+ALTER TABLE `sessions` DROP COLUMN `trigger_id`;
+-- reverse: add column "concurrency" to table: "triggers"
+ALTER TABLE `triggers` DROP COLUMN `concurrency`;
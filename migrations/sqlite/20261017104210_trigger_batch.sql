@@ -0,0 +1,7 @@
+-- +goose Up
+-- add column "batch" to table: "triggers"
+ALTER TABLE `triggers` ADD COLUMN `batch` json NULL;
+
+-- +goose Down
+-- reverse: add column "batch" to table: "triggers"
+ALTER TABLE `triggers` DROP COLUMN `batch`;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- add column "retry" to table: "triggers"
+ALTER TABLE `triggers` ADD COLUMN `retry` json NULL;
+-- add column "retry_of_session_id" to table: "sessions"
+ALTER TABLE `sessions` ADD COLUMN `retry_of_session_id` uuid NULL;
+-- add column "attempt" to table: "sessions"
+ALTER TABLE `sessions` ADD COLUMN `attempt` integer NOT NULL DEFAULT 0;
+-- create index "idx_sessions_retry_of_session_id" to table: "sessions"
+CREATE INDEX `idx_sessions_retry_of_session_id` ON `sessions` (`retry_of_session_id`);
+
+-- +goose Down
+-- reverse: create index "idx_sessions_retry_of_session_id" to table: "sessions"
+DROP INDEX `idx_sessions_retry_of_session_id`;
+-- reverse: add column "attempt" to table: "sessions"
+ALTER TABLE `sessions` DROP COLUMN `attempt`;
+-- reverse: add column "retry_of_session_id" to table: "sessions"
+ALTER TABLE `sessions` DROP COLUMN `retry_of_session_id`;
+-- reverse: add column "retry" to table: "triggers"
+ALTER TABLE `triggers` DROP COLUMN `retry`;
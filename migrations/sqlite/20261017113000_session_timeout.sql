@@ -0,0 +1,11 @@
+-- +goose Up
+-- add column "timeout" to table: "triggers"
+ALTER TABLE `triggers` ADD COLUMN `timeout` integer NULL;
+-- add column "timeout" to table: "sessions"
+ALTER TABLE `sessions` ADD COLUMN `timeout` integer NOT NULL DEFAULT 0;
+
+-- +goose Down
+-- reverse: add column "timeout" to table: "sessions"
+ALTER TABLE `sessions` DROP COLUMN `timeout`;
+-- reverse: add column "timeout" to table: "triggers"
+ALTER TABLE `triggers` DROP COLUMN `timeout`;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- add column "on_stop" to table: "triggers"
+ALTER TABLE `triggers` ADD COLUMN `on_stop` text NULL;
+-- add column "on_stop" to table: "sessions"
+ALTER TABLE `sessions` ADD COLUMN `on_stop` text NULL;
+
+-- +goose Down
+-- reverse: add column "on_stop" to table: "sessions"
+ALTER TABLE `sessions` DROP COLUMN `on_stop`;
+-- reverse: add column "on_stop" to table: "triggers"
+ALTER TABLE `triggers` DROP COLUMN `on_stop`;
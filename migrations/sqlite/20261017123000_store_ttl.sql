@@ -0,0 +1,11 @@
+-- +goose Up
+-- add column "expires_at" to table: "store_values"
+ALTER TABLE `store_values` ADD COLUMN `expires_at` datetime NULL;
+-- create index "idx_store_values_expires_at" to table: "store_values"
+CREATE INDEX `idx_store_values_expires_at` ON `store_values` (`expires_at`);
+
+-- +goose Down
+-- reverse: create index "idx_store_values_expires_at" to table: "store_values"
+DROP INDEX `idx_store_values_expires_at`;
+-- reverse: add column "expires_at" to table: "store_values"
+ALTER TABLE `store_values` DROP COLUMN `expires_at`;
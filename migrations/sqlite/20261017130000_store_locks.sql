@@ -0,0 +1,11 @@
+-- +goose Up
+-- add column "locked_by" to table: "store_values"
+ALTER TABLE `store_values` ADD COLUMN `locked_by` uuid NULL;
+-- create index "idx_store_values_locked_by" to table: "store_values"
+CREATE INDEX `idx_store_values_locked_by` ON `store_values` (`locked_by`);
+
+-- +goose Down
+-- reverse: create index "idx_store_values_locked_by" to table: "store_values"
+DROP INDEX `idx_store_values_locked_by`;
+-- reverse: add column "locked_by" to table: "store_values"
+ALTER TABLE `store_values` DROP COLUMN `locked_by`;
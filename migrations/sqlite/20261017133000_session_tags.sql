@@ -0,0 +1,7 @@
+-- +goose Up
+-- add column "tags" to table: "sessions"
+ALTER TABLE `sessions` ADD COLUMN `tags` json NULL;
+
+-- +goose Down
+-- reverse: add column "tags" to table: "sessions"
+ALTER TABLE `sessions` DROP COLUMN `tags`;
@@ -0,0 +1,41 @@
+-- +goose Up
+-- create "approvals" table
+CREATE TABLE `approvals` (
+  `approval_id` uuid NOT NULL,
+  `session_id` uuid NOT NULL,
+  `project_id` uuid NOT NULL,
+  `org_id` uuid NULL,
+  `title` text NULL,
+  `approvers` json NULL,
+  `state` integer NULL,
+  `decided_by` text NULL,
+  `comment` text NULL,
+  `created_at` datetime NULL,
+  `expires_at` datetime NULL,
+  `decided_at` datetime NULL,
+  PRIMARY KEY (`approval_id`)
+);
+-- create index "idx_approvals_created_at" to table: "approvals"
+CREATE INDEX `idx_approvals_created_at` ON `approvals` (`created_at`);
+-- create index "idx_approvals_org_id" to table: "approvals"
+CREATE INDEX `idx_approvals_org_id` ON `approvals` (`org_id`);
+-- create index "idx_approvals_project_id" to table: "approvals"
+CREATE INDEX `idx_approvals_project_id` ON `approvals` (`project_id`);
+-- create index "idx_approvals_session_id" to table: "approvals"
+CREATE INDEX `idx_approvals_session_id` ON `approvals` (`session_id`);
+-- create index "idx_approvals_state" to table: "approvals"
+CREATE INDEX `idx_approvals_state` ON `approvals` (`state`);
+
+-- +goose Down
+-- reverse: create index "idx_approvals_state" to table: "approvals"
+DROP INDEX `idx_approvals_state`;
+-- reverse: create index "idx_approvals_session_id" to table: "approvals"
+DROP INDEX `idx_approvals_session_id`;
+-- reverse: create index "idx_approvals_project_id" to table: "approvals"
+DROP INDEX `idx_approvals_project_id`;
+-- reverse: create index "idx_approvals_org_id" to table: "approvals"
+DROP INDEX `idx_approvals_org_id`;
+-- reverse: create index "idx_approvals_created_at" to table: "approvals"
+DROP INDEX `idx_approvals_created_at`;
+-- reverse: create "approvals" table
+DROP TABLE `approvals`;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- add column "webhook_auth" to table: "triggers"
+ALTER TABLE `triggers` ADD COLUMN `webhook_auth` json NULL;
+
+-- +goose Down
+-- reverse: add column "webhook_auth" to table: "triggers"
+ALTER TABLE `triggers` DROP COLUMN `webhook_auth`;
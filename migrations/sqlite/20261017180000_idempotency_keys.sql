@@ -0,0 +1,15 @@
+-- +goose Up
+-- add column "webhook_idempotency" to table: "triggers"
+ALTER TABLE `triggers` ADD COLUMN `webhook_idempotency` json NULL;
+-- add column "idempotency_key" to table: "events"
+ALTER TABLE `events` ADD COLUMN `idempotency_key` text NULL;
+-- create index "idx_event_idempotency_key" to table: "events"
+CREATE INDEX `idx_event_idempotency_key` ON `events` (`destination_id`, `idempotency_key`);
+
+-- +goose Down
+-- reverse: create index "idx_event_idempotency_key" to table: "events"
+DROP INDEX `idx_event_idempotency_key`;
+-- reverse: add column "idempotency_key" to table: "events"
+ALTER TABLE `events` DROP COLUMN `idempotency_key`;
+-- reverse: add column "webhook_idempotency" to table: "triggers"
+ALTER TABLE `triggers` DROP COLUMN `webhook_idempotency`;
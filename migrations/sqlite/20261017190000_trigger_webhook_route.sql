@@ -0,0 +1,7 @@
+-- +goose Up
+-- add column "webhook_route" to table: "triggers"
+ALTER TABLE `triggers` ADD COLUMN `webhook_route` text NULL;
+
+-- +goose Down
+-- reverse: add column "webhook_route" to table: "triggers"
+ALTER TABLE `triggers` DROP COLUMN `webhook_route`;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- add column "workflow_id" to table: "trigger_queue_entries"
+ALTER TABLE `trigger_queue_entries` ADD COLUMN `workflow_id` text NULL;
+-- add column "admitted_at" to table: "trigger_queue_entries"
+ALTER TABLE `trigger_queue_entries` ADD COLUMN `admitted_at` datetime NULL;
+
+-- +goose Down
+-- reverse: add column "admitted_at" to table: "trigger_queue_entries"
+ALTER TABLE `trigger_queue_entries` DROP COLUMN `admitted_at`;
+-- reverse: add column "workflow_id" to table: "trigger_queue_entries"
+ALTER TABLE `trigger_queue_entries` DROP COLUMN `workflow_id`;
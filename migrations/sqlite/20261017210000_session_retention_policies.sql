@@ -0,0 +1,19 @@
+-- +goose Up
+-- create "session_retention_policies" table
+CREATE TABLE `session_retention_policies` (
+  `created_by` uuid NULL,
+  `created_at` datetime NULL,
+  `scope_id` uuid NOT NULL,
+  `org_id` uuid NULL,
+  `project_id` uuid NULL,
+  `max_age` integer NULL,
+  `max_count` integer NULL,
+  `states` json NULL,
+  `updated_by` uuid NULL,
+  `updated_at` datetime NULL,
+  PRIMARY KEY (`scope_id`)
+);
+
+-- +goose Down
+-- reverse: create "session_retention_policies" table
+DROP TABLE `session_retention_policies`;
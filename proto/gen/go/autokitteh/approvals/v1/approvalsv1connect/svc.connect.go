@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: autokitteh/approvals/v1/svc.proto
+
+package approvalsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/approvals/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion0_1_0
+
+const (
+	// ApprovalsServiceName is the fully-qualified name of the ApprovalsService service.
+	ApprovalsServiceName = "autokitteh.approvals.v1.ApprovalsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ApprovalsServiceGetProcedure is the fully-qualified name of the ApprovalsService's Get RPC.
+	ApprovalsServiceGetProcedure = "/autokitteh.approvals.v1.ApprovalsService/Get"
+	// ApprovalsServiceListProcedure is the fully-qualified name of the ApprovalsService's List RPC.
+	ApprovalsServiceListProcedure = "/autokitteh.approvals.v1.ApprovalsService/List"
+	// ApprovalsServiceDecideProcedure is the fully-qualified name of the ApprovalsService's Decide RPC.
+	ApprovalsServiceDecideProcedure = "/autokitteh.approvals.v1.ApprovalsService/Decide"
+)
+
+// ApprovalsServiceClient is a client for the autokitteh.approvals.v1.ApprovalsService service.
+type ApprovalsServiceClient interface {
+	Get(context.Context, *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error)
+	// Lists approvals, most recent first.
+	List(context.Context, *connect.Request[v1.ListRequest]) (*connect.Response[v1.ListResponse], error)
+	// Approves or rejects a pending approval, and signals the
+	// waiting session with the decision.
+	Decide(context.Context, *connect.Request[v1.DecideRequest]) (*connect.Response[v1.DecideResponse], error)
+}
+
+// NewApprovalsServiceClient constructs a client for the autokitteh.approvals.v1.ApprovalsService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewApprovalsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ApprovalsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &approvalsServiceClient{
+		get: connect.NewClient[v1.GetRequest, v1.GetResponse](
+			httpClient,
+			baseURL+ApprovalsServiceGetProcedure,
+			opts...,
+		),
+		list: connect.NewClient[v1.ListRequest, v1.ListResponse](
+			httpClient,
+			baseURL+ApprovalsServiceListProcedure,
+			opts...,
+		),
+		decide: connect.NewClient[v1.DecideRequest, v1.DecideResponse](
+			httpClient,
+			baseURL+ApprovalsServiceDecideProcedure,
+			opts...,
+		),
+	}
+}
+
+// approvalsServiceClient implements ApprovalsServiceClient.
+type approvalsServiceClient struct {
+	get    *connect.Client[v1.GetRequest, v1.GetResponse]
+	list   *connect.Client[v1.ListRequest, v1.ListResponse]
+	decide *connect.Client[v1.DecideRequest, v1.DecideResponse]
+}
+
+// Get calls autokitteh.approvals.v1.ApprovalsService.Get.
+func (c *approvalsServiceClient) Get(ctx context.Context, req *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error) {
+	return c.get.CallUnary(ctx, req)
+}
+
+// List calls autokitteh.approvals.v1.ApprovalsService.List.
+func (c *approvalsServiceClient) List(ctx context.Context, req *connect.Request[v1.ListRequest]) (*connect.Response[v1.ListResponse], error) {
+	return c.list.CallUnary(ctx, req)
+}
+
+// Decide calls autokitteh.approvals.v1.ApprovalsService.Decide.
+func (c *approvalsServiceClient) Decide(ctx context.Context, req *connect.Request[v1.DecideRequest]) (*connect.Response[v1.DecideResponse], error) {
+	return c.decide.CallUnary(ctx, req)
+}
+
+// ApprovalsServiceHandler is an implementation of the autokitteh.approvals.v1.ApprovalsService
+// service.
+type ApprovalsServiceHandler interface {
+	Get(context.Context, *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error)
+	// Lists approvals, most recent first.
+	List(context.Context, *connect.Request[v1.ListRequest]) (*connect.Response[v1.ListResponse], error)
+	// Approves or rejects a pending approval, and signals the
+	// waiting session with the decision.
+	Decide(context.Context, *connect.Request[v1.DecideRequest]) (*connect.Response[v1.DecideResponse], error)
+}
+
+// NewApprovalsServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewApprovalsServiceHandler(svc ApprovalsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	approvalsServiceGetHandler := connect.NewUnaryHandler(
+		ApprovalsServiceGetProcedure,
+		svc.Get,
+		opts...,
+	)
+	approvalsServiceListHandler := connect.NewUnaryHandler(
+		ApprovalsServiceListProcedure,
+		svc.List,
+		opts...,
+	)
+	approvalsServiceDecideHandler := connect.NewUnaryHandler(
+		ApprovalsServiceDecideProcedure,
+		svc.Decide,
+		opts...,
+	)
+	return "/autokitteh.approvals.v1.ApprovalsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ApprovalsServiceGetProcedure:
+			approvalsServiceGetHandler.ServeHTTP(w, r)
+		case ApprovalsServiceListProcedure:
+			approvalsServiceListHandler.ServeHTTP(w, r)
+		case ApprovalsServiceDecideProcedure:
+			approvalsServiceDecideHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedApprovalsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedApprovalsServiceHandler struct{}
+
+func (UnimplementedApprovalsServiceHandler) Get(context.Context, *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.approvals.v1.ApprovalsService.Get is not implemented"))
+}
+
+func (UnimplementedApprovalsServiceHandler) List(context.Context, *connect.Request[v1.ListRequest]) (*connect.Response[v1.ListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.approvals.v1.ApprovalsService.List is not implemented"))
+}
+
+func (UnimplementedApprovalsServiceHandler) Decide(context.Context, *connect.Request[v1.DecideRequest]) (*connect.Response[v1.DecideResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.approvals.v1.ApprovalsService.Decide is not implemented"))
+}
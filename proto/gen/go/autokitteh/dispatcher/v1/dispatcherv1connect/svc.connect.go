@@ -39,6 +39,12 @@ const (
 	// DispatcherServiceRedispatchProcedure is the fully-qualified name of the DispatcherService's
 	// Redispatch RPC.
 	DispatcherServiceRedispatchProcedure = "/autokitteh.dispatcher.v1.DispatcherService/Redispatch"
+	// DispatcherServiceListDeadLettersProcedure is the fully-qualified name of the DispatcherService's
+	// ListDeadLetters RPC.
+	DispatcherServiceListDeadLettersProcedure = "/autokitteh.dispatcher.v1.DispatcherService/ListDeadLetters"
+	// DispatcherServiceRetryDeadLetterProcedure is the fully-qualified name of the DispatcherService's
+	// RetryDeadLetter RPC.
+	DispatcherServiceRetryDeadLetterProcedure = "/autokitteh.dispatcher.v1.DispatcherService/RetryDeadLetter"
 )
 
 // DispatcherServiceClient is a client for the autokitteh.dispatcher.v1.DispatcherService service.
@@ -48,6 +54,11 @@ type DispatcherServiceClient interface {
 	// This method also duplicates the event, and generates a new
 	// event ID for it. The new event ID is returned in the response.
 	Redispatch(context.Context, *connect.Request[v1.RedispatchRequest]) (*connect.Response[v1.RedispatchResponse], error)
+	// Lists events that were dropped during dispatch, most recent first.
+	ListDeadLetters(context.Context, *connect.Request[v1.ListDeadLettersRequest]) (*connect.Response[v1.ListDeadLettersResponse], error)
+	// Redispatches the event of a dead letter to the trigger and deployment
+	// it originally failed for, and marks the dead letter as retried.
+	RetryDeadLetter(context.Context, *connect.Request[v1.RetryDeadLetterRequest]) (*connect.Response[v1.RetryDeadLetterResponse], error)
 }
 
 // NewDispatcherServiceClient constructs a client for the autokitteh.dispatcher.v1.DispatcherService
@@ -70,13 +81,25 @@ func NewDispatcherServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			baseURL+DispatcherServiceRedispatchProcedure,
 			opts...,
 		),
+		listDeadLetters: connect.NewClient[v1.ListDeadLettersRequest, v1.ListDeadLettersResponse](
+			httpClient,
+			baseURL+DispatcherServiceListDeadLettersProcedure,
+			opts...,
+		),
+		retryDeadLetter: connect.NewClient[v1.RetryDeadLetterRequest, v1.RetryDeadLetterResponse](
+			httpClient,
+			baseURL+DispatcherServiceRetryDeadLetterProcedure,
+			opts...,
+		),
 	}
 }
 
 // dispatcherServiceClient implements DispatcherServiceClient.
 type dispatcherServiceClient struct {
-	dispatch   *connect.Client[v1.DispatchRequest, v1.DispatchResponse]
-	redispatch *connect.Client[v1.RedispatchRequest, v1.RedispatchResponse]
+	dispatch        *connect.Client[v1.DispatchRequest, v1.DispatchResponse]
+	redispatch      *connect.Client[v1.RedispatchRequest, v1.RedispatchResponse]
+	listDeadLetters *connect.Client[v1.ListDeadLettersRequest, v1.ListDeadLettersResponse]
+	retryDeadLetter *connect.Client[v1.RetryDeadLetterRequest, v1.RetryDeadLetterResponse]
 }
 
 // Dispatch calls autokitteh.dispatcher.v1.DispatcherService.Dispatch.
@@ -89,6 +112,16 @@ func (c *dispatcherServiceClient) Redispatch(ctx context.Context, req *connect.R
 	return c.redispatch.CallUnary(ctx, req)
 }
 
+// ListDeadLetters calls autokitteh.dispatcher.v1.DispatcherService.ListDeadLetters.
+func (c *dispatcherServiceClient) ListDeadLetters(ctx context.Context, req *connect.Request[v1.ListDeadLettersRequest]) (*connect.Response[v1.ListDeadLettersResponse], error) {
+	return c.listDeadLetters.CallUnary(ctx, req)
+}
+
+// RetryDeadLetter calls autokitteh.dispatcher.v1.DispatcherService.RetryDeadLetter.
+func (c *dispatcherServiceClient) RetryDeadLetter(ctx context.Context, req *connect.Request[v1.RetryDeadLetterRequest]) (*connect.Response[v1.RetryDeadLetterResponse], error) {
+	return c.retryDeadLetter.CallUnary(ctx, req)
+}
+
 // DispatcherServiceHandler is an implementation of the autokitteh.dispatcher.v1.DispatcherService
 // service.
 type DispatcherServiceHandler interface {
@@ -97,6 +130,11 @@ type DispatcherServiceHandler interface {
 	// This method also duplicates the event, and generates a new
 	// event ID for it. The new event ID is returned in the response.
 	Redispatch(context.Context, *connect.Request[v1.RedispatchRequest]) (*connect.Response[v1.RedispatchResponse], error)
+	// Lists events that were dropped during dispatch, most recent first.
+	ListDeadLetters(context.Context, *connect.Request[v1.ListDeadLettersRequest]) (*connect.Response[v1.ListDeadLettersResponse], error)
+	// Redispatches the event of a dead letter to the trigger and deployment
+	// it originally failed for, and marks the dead letter as retried.
+	RetryDeadLetter(context.Context, *connect.Request[v1.RetryDeadLetterRequest]) (*connect.Response[v1.RetryDeadLetterResponse], error)
 }
 
 // NewDispatcherServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -115,12 +153,26 @@ func NewDispatcherServiceHandler(svc DispatcherServiceHandler, opts ...connect.H
 		svc.Redispatch,
 		opts...,
 	)
+	dispatcherServiceListDeadLettersHandler := connect.NewUnaryHandler(
+		DispatcherServiceListDeadLettersProcedure,
+		svc.ListDeadLetters,
+		opts...,
+	)
+	dispatcherServiceRetryDeadLetterHandler := connect.NewUnaryHandler(
+		DispatcherServiceRetryDeadLetterProcedure,
+		svc.RetryDeadLetter,
+		opts...,
+	)
 	return "/autokitteh.dispatcher.v1.DispatcherService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DispatcherServiceDispatchProcedure:
 			dispatcherServiceDispatchHandler.ServeHTTP(w, r)
 		case DispatcherServiceRedispatchProcedure:
 			dispatcherServiceRedispatchHandler.ServeHTTP(w, r)
+		case DispatcherServiceListDeadLettersProcedure:
+			dispatcherServiceListDeadLettersHandler.ServeHTTP(w, r)
+		case DispatcherServiceRetryDeadLetterProcedure:
+			dispatcherServiceRetryDeadLetterHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -137,3 +189,11 @@ func (UnimplementedDispatcherServiceHandler) Dispatch(context.Context, *connect.
 func (UnimplementedDispatcherServiceHandler) Redispatch(context.Context, *connect.Request[v1.RedispatchRequest]) (*connect.Response[v1.RedispatchResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.dispatcher.v1.DispatcherService.Redispatch is not implemented"))
 }
+
+func (UnimplementedDispatcherServiceHandler) ListDeadLetters(context.Context, *connect.Request[v1.ListDeadLettersRequest]) (*connect.Response[v1.ListDeadLettersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.dispatcher.v1.DispatcherService.ListDeadLetters is not implemented"))
+}
+
+func (UnimplementedDispatcherServiceHandler) RetryDeadLetter(context.Context, *connect.Request[v1.RetryDeadLetterRequest]) (*connect.Response[v1.RetryDeadLetterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.dispatcher.v1.DispatcherService.RetryDeadLetter is not implemented"))
+}
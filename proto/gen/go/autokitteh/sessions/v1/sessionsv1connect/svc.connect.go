@@ -39,10 +39,15 @@ const (
 	SessionsServiceStopProcedure = "/autokitteh.sessions.v1.SessionsService/Stop"
 	// SessionsServiceListProcedure is the fully-qualified name of the SessionsService's List RPC.
 	SessionsServiceListProcedure = "/autokitteh.sessions.v1.SessionsService/List"
+	// SessionsServiceSearchProcedure is the fully-qualified name of the SessionsService's Search RPC.
+	SessionsServiceSearchProcedure = "/autokitteh.sessions.v1.SessionsService/Search"
 	// SessionsServiceGetProcedure is the fully-qualified name of the SessionsService's Get RPC.
 	SessionsServiceGetProcedure = "/autokitteh.sessions.v1.SessionsService/Get"
 	// SessionsServiceGetLogProcedure is the fully-qualified name of the SessionsService's GetLog RPC.
 	SessionsServiceGetLogProcedure = "/autokitteh.sessions.v1.SessionsService/GetLog"
+	// SessionsServiceWatchLogProcedure is the fully-qualified name of the SessionsService's WatchLog
+	// RPC.
+	SessionsServiceWatchLogProcedure = "/autokitteh.sessions.v1.SessionsService/WatchLog"
 	// SessionsServiceDownloadLogsProcedure is the fully-qualified name of the SessionsService's
 	// DownloadLogs RPC.
 	SessionsServiceDownloadLogsProcedure = "/autokitteh.sessions.v1.SessionsService/DownloadLogs"
@@ -51,6 +56,33 @@ const (
 	SessionsServiceGetPrintsProcedure = "/autokitteh.sessions.v1.SessionsService/GetPrints"
 	// SessionsServiceDeleteProcedure is the fully-qualified name of the SessionsService's Delete RPC.
 	SessionsServiceDeleteProcedure = "/autokitteh.sessions.v1.SessionsService/Delete"
+	// SessionsServiceReplayProcedure is the fully-qualified name of the SessionsService's Replay RPC.
+	SessionsServiceReplayProcedure = "/autokitteh.sessions.v1.SessionsService/Replay"
+	// SessionsServiceExportProcedure is the fully-qualified name of the SessionsService's Export RPC.
+	SessionsServiceExportProcedure = "/autokitteh.sessions.v1.SessionsService/Export"
+	// SessionsServiceImportProcedure is the fully-qualified name of the SessionsService's Import RPC.
+	SessionsServiceImportProcedure = "/autokitteh.sessions.v1.SessionsService/Import"
+	// SessionsServiceStopManyProcedure is the fully-qualified name of the SessionsService's StopMany
+	// RPC.
+	SessionsServiceStopManyProcedure = "/autokitteh.sessions.v1.SessionsService/StopMany"
+	// SessionsServiceDeleteManyProcedure is the fully-qualified name of the SessionsService's
+	// DeleteMany RPC.
+	SessionsServiceDeleteManyProcedure = "/autokitteh.sessions.v1.SessionsService/DeleteMany"
+	// SessionsServiceRestartManyProcedure is the fully-qualified name of the SessionsService's
+	// RestartMany RPC.
+	SessionsServiceRestartManyProcedure = "/autokitteh.sessions.v1.SessionsService/RestartMany"
+	// SessionsServiceGetBulkOperationProcedure is the fully-qualified name of the SessionsService's
+	// GetBulkOperation RPC.
+	SessionsServiceGetBulkOperationProcedure = "/autokitteh.sessions.v1.SessionsService/GetBulkOperation"
+	// SessionsServiceSetRetentionPolicyProcedure is the fully-qualified name of the SessionsService's
+	// SetRetentionPolicy RPC.
+	SessionsServiceSetRetentionPolicyProcedure = "/autokitteh.sessions.v1.SessionsService/SetRetentionPolicy"
+	// SessionsServiceGetRetentionPolicyProcedure is the fully-qualified name of the SessionsService's
+	// GetRetentionPolicy RPC.
+	SessionsServiceGetRetentionPolicyProcedure = "/autokitteh.sessions.v1.SessionsService/GetRetentionPolicy"
+	// SessionsServiceDeleteRetentionPolicyProcedure is the fully-qualified name of the
+	// SessionsService's DeleteRetentionPolicy RPC.
+	SessionsServiceDeleteRetentionPolicyProcedure = "/autokitteh.sessions.v1.SessionsService/DeleteRetentionPolicy"
 )
 
 // SessionsServiceClient is a client for the autokitteh.sessions.v1.SessionsService service.
@@ -61,11 +93,36 @@ type SessionsServiceClient interface {
 	Stop(context.Context, *connect.Request[v1.StopRequest]) (*connect.Response[v1.StopResponse], error)
 	// List returns events without their data.
 	List(context.Context, *connect.Request[v1.ListRequest]) (*connect.Response[v1.ListResponse], error)
+	// Search is like List, but can also match on prints, errors and
+	// additional session attributes.
+	Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error)
 	Get(context.Context, *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error)
 	GetLog(context.Context, *connect.Request[v1.GetLogRequest]) (*connect.Response[v1.GetLogResponse], error)
+	// WatchLog streams the session log from its beginning, including prints,
+	// as it is written. The stream ends once the session reaches a final state.
+	WatchLog(context.Context, *connect.Request[v1.WatchLogRequest]) (*connect.ServerStreamForClient[v1.WatchLogResponse], error)
 	DownloadLogs(context.Context, *connect.Request[v1.DownloadLogsRequest]) (*connect.Response[v1.DownloadLogsResponse], error)
 	GetPrints(context.Context, *connect.Request[v1.GetPrintsRequest]) (*connect.Response[v1.GetPrintsResponse], error)
 	Delete(context.Context, *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error)
+	// Replay re-runs a finished durable session, feeding each call its
+	// recorded result instead of executing it.
+	Replay(context.Context, *connect.Request[v1.ReplayRequest]) (*connect.Response[v1.ReplayResponse], error)
+	// Export returns a portable bundle of a finished session, which can
+	// be imported into another autokitteh instance for reproduction.
+	Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error)
+	Import(context.Context, *connect.Request[v1.ImportRequest]) (*connect.Response[v1.ImportResponse], error)
+	// StopMany, DeleteMany and RestartMany apply the respective operation
+	// to all sessions matching a filter, in the background. Their progress
+	// can be tracked using GetBulkOperation.
+	StopMany(context.Context, *connect.Request[v1.StopManyRequest]) (*connect.Response[v1.StopManyResponse], error)
+	DeleteMany(context.Context, *connect.Request[v1.DeleteManyRequest]) (*connect.Response[v1.DeleteManyResponse], error)
+	RestartMany(context.Context, *connect.Request[v1.RestartManyRequest]) (*connect.Response[v1.RestartManyResponse], error)
+	GetBulkOperation(context.Context, *connect.Request[v1.GetBulkOperationRequest]) (*connect.Response[v1.GetBulkOperationResponse], error)
+	// SetRetentionPolicy sets the session retention policy of an org or a
+	// project, which is enforced periodically by the server.
+	SetRetentionPolicy(context.Context, *connect.Request[v1.SetRetentionPolicyRequest]) (*connect.Response[v1.SetRetentionPolicyResponse], error)
+	GetRetentionPolicy(context.Context, *connect.Request[v1.GetRetentionPolicyRequest]) (*connect.Response[v1.GetRetentionPolicyResponse], error)
+	DeleteRetentionPolicy(context.Context, *connect.Request[v1.DeleteRetentionPolicyRequest]) (*connect.Response[v1.DeleteRetentionPolicyResponse], error)
 }
 
 // NewSessionsServiceClient constructs a client for the autokitteh.sessions.v1.SessionsService
@@ -93,6 +150,11 @@ func NewSessionsServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			baseURL+SessionsServiceListProcedure,
 			opts...,
 		),
+		search: connect.NewClient[v1.SearchRequest, v1.SearchResponse](
+			httpClient,
+			baseURL+SessionsServiceSearchProcedure,
+			opts...,
+		),
 		get: connect.NewClient[v1.GetRequest, v1.GetResponse](
 			httpClient,
 			baseURL+SessionsServiceGetProcedure,
@@ -103,6 +165,11 @@ func NewSessionsServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			baseURL+SessionsServiceGetLogProcedure,
 			opts...,
 		),
+		watchLog: connect.NewClient[v1.WatchLogRequest, v1.WatchLogResponse](
+			httpClient,
+			baseURL+SessionsServiceWatchLogProcedure,
+			opts...,
+		),
 		downloadLogs: connect.NewClient[v1.DownloadLogsRequest, v1.DownloadLogsResponse](
 			httpClient,
 			baseURL+SessionsServiceDownloadLogsProcedure,
@@ -118,19 +185,81 @@ func NewSessionsServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			baseURL+SessionsServiceDeleteProcedure,
 			opts...,
 		),
+		replay: connect.NewClient[v1.ReplayRequest, v1.ReplayResponse](
+			httpClient,
+			baseURL+SessionsServiceReplayProcedure,
+			opts...,
+		),
+		export: connect.NewClient[v1.ExportRequest, v1.ExportResponse](
+			httpClient,
+			baseURL+SessionsServiceExportProcedure,
+			opts...,
+		),
+		_import: connect.NewClient[v1.ImportRequest, v1.ImportResponse](
+			httpClient,
+			baseURL+SessionsServiceImportProcedure,
+			opts...,
+		),
+		stopMany: connect.NewClient[v1.StopManyRequest, v1.StopManyResponse](
+			httpClient,
+			baseURL+SessionsServiceStopManyProcedure,
+			opts...,
+		),
+		deleteMany: connect.NewClient[v1.DeleteManyRequest, v1.DeleteManyResponse](
+			httpClient,
+			baseURL+SessionsServiceDeleteManyProcedure,
+			opts...,
+		),
+		restartMany: connect.NewClient[v1.RestartManyRequest, v1.RestartManyResponse](
+			httpClient,
+			baseURL+SessionsServiceRestartManyProcedure,
+			opts...,
+		),
+		getBulkOperation: connect.NewClient[v1.GetBulkOperationRequest, v1.GetBulkOperationResponse](
+			httpClient,
+			baseURL+SessionsServiceGetBulkOperationProcedure,
+			opts...,
+		),
+		setRetentionPolicy: connect.NewClient[v1.SetRetentionPolicyRequest, v1.SetRetentionPolicyResponse](
+			httpClient,
+			baseURL+SessionsServiceSetRetentionPolicyProcedure,
+			opts...,
+		),
+		getRetentionPolicy: connect.NewClient[v1.GetRetentionPolicyRequest, v1.GetRetentionPolicyResponse](
+			httpClient,
+			baseURL+SessionsServiceGetRetentionPolicyProcedure,
+			opts...,
+		),
+		deleteRetentionPolicy: connect.NewClient[v1.DeleteRetentionPolicyRequest, v1.DeleteRetentionPolicyResponse](
+			httpClient,
+			baseURL+SessionsServiceDeleteRetentionPolicyProcedure,
+			opts...,
+		),
 	}
 }
 
 // sessionsServiceClient implements SessionsServiceClient.
 type sessionsServiceClient struct {
-	start        *connect.Client[v1.StartRequest, v1.StartResponse]
-	stop         *connect.Client[v1.StopRequest, v1.StopResponse]
-	list         *connect.Client[v1.ListRequest, v1.ListResponse]
-	get          *connect.Client[v1.GetRequest, v1.GetResponse]
-	getLog       *connect.Client[v1.GetLogRequest, v1.GetLogResponse]
-	downloadLogs *connect.Client[v1.DownloadLogsRequest, v1.DownloadLogsResponse]
-	getPrints    *connect.Client[v1.GetPrintsRequest, v1.GetPrintsResponse]
-	delete       *connect.Client[v1.DeleteRequest, v1.DeleteResponse]
+	start                 *connect.Client[v1.StartRequest, v1.StartResponse]
+	stop                  *connect.Client[v1.StopRequest, v1.StopResponse]
+	list                  *connect.Client[v1.ListRequest, v1.ListResponse]
+	search                *connect.Client[v1.SearchRequest, v1.SearchResponse]
+	get                   *connect.Client[v1.GetRequest, v1.GetResponse]
+	getLog                *connect.Client[v1.GetLogRequest, v1.GetLogResponse]
+	watchLog              *connect.Client[v1.WatchLogRequest, v1.WatchLogResponse]
+	downloadLogs          *connect.Client[v1.DownloadLogsRequest, v1.DownloadLogsResponse]
+	getPrints             *connect.Client[v1.GetPrintsRequest, v1.GetPrintsResponse]
+	delete                *connect.Client[v1.DeleteRequest, v1.DeleteResponse]
+	replay                *connect.Client[v1.ReplayRequest, v1.ReplayResponse]
+	export                *connect.Client[v1.ExportRequest, v1.ExportResponse]
+	_import               *connect.Client[v1.ImportRequest, v1.ImportResponse]
+	stopMany              *connect.Client[v1.StopManyRequest, v1.StopManyResponse]
+	deleteMany            *connect.Client[v1.DeleteManyRequest, v1.DeleteManyResponse]
+	restartMany           *connect.Client[v1.RestartManyRequest, v1.RestartManyResponse]
+	getBulkOperation      *connect.Client[v1.GetBulkOperationRequest, v1.GetBulkOperationResponse]
+	setRetentionPolicy    *connect.Client[v1.SetRetentionPolicyRequest, v1.SetRetentionPolicyResponse]
+	getRetentionPolicy    *connect.Client[v1.GetRetentionPolicyRequest, v1.GetRetentionPolicyResponse]
+	deleteRetentionPolicy *connect.Client[v1.DeleteRetentionPolicyRequest, v1.DeleteRetentionPolicyResponse]
 }
 
 // Start calls autokitteh.sessions.v1.SessionsService.Start.
@@ -148,6 +277,11 @@ func (c *sessionsServiceClient) List(ctx context.Context, req *connect.Request[v
 	return c.list.CallUnary(ctx, req)
 }
 
+// Search calls autokitteh.sessions.v1.SessionsService.Search.
+func (c *sessionsServiceClient) Search(ctx context.Context, req *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+	return c.search.CallUnary(ctx, req)
+}
+
 // Get calls autokitteh.sessions.v1.SessionsService.Get.
 func (c *sessionsServiceClient) Get(ctx context.Context, req *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
@@ -158,6 +292,11 @@ func (c *sessionsServiceClient) GetLog(ctx context.Context, req *connect.Request
 	return c.getLog.CallUnary(ctx, req)
 }
 
+// WatchLog calls autokitteh.sessions.v1.SessionsService.WatchLog.
+func (c *sessionsServiceClient) WatchLog(ctx context.Context, req *connect.Request[v1.WatchLogRequest]) (*connect.ServerStreamForClient[v1.WatchLogResponse], error) {
+	return c.watchLog.CallServerStream(ctx, req)
+}
+
 // DownloadLogs calls autokitteh.sessions.v1.SessionsService.DownloadLogs.
 func (c *sessionsServiceClient) DownloadLogs(ctx context.Context, req *connect.Request[v1.DownloadLogsRequest]) (*connect.Response[v1.DownloadLogsResponse], error) {
 	return c.downloadLogs.CallUnary(ctx, req)
@@ -173,6 +312,56 @@ func (c *sessionsServiceClient) Delete(ctx context.Context, req *connect.Request
 	return c.delete.CallUnary(ctx, req)
 }
 
+// Replay calls autokitteh.sessions.v1.SessionsService.Replay.
+func (c *sessionsServiceClient) Replay(ctx context.Context, req *connect.Request[v1.ReplayRequest]) (*connect.Response[v1.ReplayResponse], error) {
+	return c.replay.CallUnary(ctx, req)
+}
+
+// Export calls autokitteh.sessions.v1.SessionsService.Export.
+func (c *sessionsServiceClient) Export(ctx context.Context, req *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error) {
+	return c.export.CallUnary(ctx, req)
+}
+
+// Import calls autokitteh.sessions.v1.SessionsService.Import.
+func (c *sessionsServiceClient) Import(ctx context.Context, req *connect.Request[v1.ImportRequest]) (*connect.Response[v1.ImportResponse], error) {
+	return c._import.CallUnary(ctx, req)
+}
+
+// StopMany calls autokitteh.sessions.v1.SessionsService.StopMany.
+func (c *sessionsServiceClient) StopMany(ctx context.Context, req *connect.Request[v1.StopManyRequest]) (*connect.Response[v1.StopManyResponse], error) {
+	return c.stopMany.CallUnary(ctx, req)
+}
+
+// DeleteMany calls autokitteh.sessions.v1.SessionsService.DeleteMany.
+func (c *sessionsServiceClient) DeleteMany(ctx context.Context, req *connect.Request[v1.DeleteManyRequest]) (*connect.Response[v1.DeleteManyResponse], error) {
+	return c.deleteMany.CallUnary(ctx, req)
+}
+
+// RestartMany calls autokitteh.sessions.v1.SessionsService.RestartMany.
+func (c *sessionsServiceClient) RestartMany(ctx context.Context, req *connect.Request[v1.RestartManyRequest]) (*connect.Response[v1.RestartManyResponse], error) {
+	return c.restartMany.CallUnary(ctx, req)
+}
+
+// GetBulkOperation calls autokitteh.sessions.v1.SessionsService.GetBulkOperation.
+func (c *sessionsServiceClient) GetBulkOperation(ctx context.Context, req *connect.Request[v1.GetBulkOperationRequest]) (*connect.Response[v1.GetBulkOperationResponse], error) {
+	return c.getBulkOperation.CallUnary(ctx, req)
+}
+
+// SetRetentionPolicy calls autokitteh.sessions.v1.SessionsService.SetRetentionPolicy.
+func (c *sessionsServiceClient) SetRetentionPolicy(ctx context.Context, req *connect.Request[v1.SetRetentionPolicyRequest]) (*connect.Response[v1.SetRetentionPolicyResponse], error) {
+	return c.setRetentionPolicy.CallUnary(ctx, req)
+}
+
+// GetRetentionPolicy calls autokitteh.sessions.v1.SessionsService.GetRetentionPolicy.
+func (c *sessionsServiceClient) GetRetentionPolicy(ctx context.Context, req *connect.Request[v1.GetRetentionPolicyRequest]) (*connect.Response[v1.GetRetentionPolicyResponse], error) {
+	return c.getRetentionPolicy.CallUnary(ctx, req)
+}
+
+// DeleteRetentionPolicy calls autokitteh.sessions.v1.SessionsService.DeleteRetentionPolicy.
+func (c *sessionsServiceClient) DeleteRetentionPolicy(ctx context.Context, req *connect.Request[v1.DeleteRetentionPolicyRequest]) (*connect.Response[v1.DeleteRetentionPolicyResponse], error) {
+	return c.deleteRetentionPolicy.CallUnary(ctx, req)
+}
+
 // SessionsServiceHandler is an implementation of the autokitteh.sessions.v1.SessionsService
 // service.
 type SessionsServiceHandler interface {
@@ -182,11 +371,36 @@ type SessionsServiceHandler interface {
 	Stop(context.Context, *connect.Request[v1.StopRequest]) (*connect.Response[v1.StopResponse], error)
 	// List returns events without their data.
 	List(context.Context, *connect.Request[v1.ListRequest]) (*connect.Response[v1.ListResponse], error)
+	// Search is like List, but can also match on prints, errors and
+	// additional session attributes.
+	Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error)
 	Get(context.Context, *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error)
 	GetLog(context.Context, *connect.Request[v1.GetLogRequest]) (*connect.Response[v1.GetLogResponse], error)
+	// WatchLog streams the session log from its beginning, including prints,
+	// as it is written. The stream ends once the session reaches a final state.
+	WatchLog(context.Context, *connect.Request[v1.WatchLogRequest], *connect.ServerStream[v1.WatchLogResponse]) error
 	DownloadLogs(context.Context, *connect.Request[v1.DownloadLogsRequest]) (*connect.Response[v1.DownloadLogsResponse], error)
 	GetPrints(context.Context, *connect.Request[v1.GetPrintsRequest]) (*connect.Response[v1.GetPrintsResponse], error)
 	Delete(context.Context, *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error)
+	// Replay re-runs a finished durable session, feeding each call its
+	// recorded result instead of executing it.
+	Replay(context.Context, *connect.Request[v1.ReplayRequest]) (*connect.Response[v1.ReplayResponse], error)
+	// Export returns a portable bundle of a finished session, which can
+	// be imported into another autokitteh instance for reproduction.
+	Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error)
+	Import(context.Context, *connect.Request[v1.ImportRequest]) (*connect.Response[v1.ImportResponse], error)
+	// StopMany, DeleteMany and RestartMany apply the respective operation
+	// to all sessions matching a filter, in the background. Their progress
+	// can be tracked using GetBulkOperation.
+	StopMany(context.Context, *connect.Request[v1.StopManyRequest]) (*connect.Response[v1.StopManyResponse], error)
+	DeleteMany(context.Context, *connect.Request[v1.DeleteManyRequest]) (*connect.Response[v1.DeleteManyResponse], error)
+	RestartMany(context.Context, *connect.Request[v1.RestartManyRequest]) (*connect.Response[v1.RestartManyResponse], error)
+	GetBulkOperation(context.Context, *connect.Request[v1.GetBulkOperationRequest]) (*connect.Response[v1.GetBulkOperationResponse], error)
+	// SetRetentionPolicy sets the session retention policy of an org or a
+	// project, which is enforced periodically by the server.
+	SetRetentionPolicy(context.Context, *connect.Request[v1.SetRetentionPolicyRequest]) (*connect.Response[v1.SetRetentionPolicyResponse], error)
+	GetRetentionPolicy(context.Context, *connect.Request[v1.GetRetentionPolicyRequest]) (*connect.Response[v1.GetRetentionPolicyResponse], error)
+	DeleteRetentionPolicy(context.Context, *connect.Request[v1.DeleteRetentionPolicyRequest]) (*connect.Response[v1.DeleteRetentionPolicyResponse], error)
 }
 
 // NewSessionsServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -210,6 +424,11 @@ func NewSessionsServiceHandler(svc SessionsServiceHandler, opts ...connect.Handl
 		svc.List,
 		opts...,
 	)
+	sessionsServiceSearchHandler := connect.NewUnaryHandler(
+		SessionsServiceSearchProcedure,
+		svc.Search,
+		opts...,
+	)
 	sessionsServiceGetHandler := connect.NewUnaryHandler(
 		SessionsServiceGetProcedure,
 		svc.Get,
@@ -220,6 +439,11 @@ func NewSessionsServiceHandler(svc SessionsServiceHandler, opts ...connect.Handl
 		svc.GetLog,
 		opts...,
 	)
+	sessionsServiceWatchLogHandler := connect.NewServerStreamHandler(
+		SessionsServiceWatchLogProcedure,
+		svc.WatchLog,
+		opts...,
+	)
 	sessionsServiceDownloadLogsHandler := connect.NewUnaryHandler(
 		SessionsServiceDownloadLogsProcedure,
 		svc.DownloadLogs,
@@ -235,6 +459,56 @@ func NewSessionsServiceHandler(svc SessionsServiceHandler, opts ...connect.Handl
 		svc.Delete,
 		opts...,
 	)
+	sessionsServiceReplayHandler := connect.NewUnaryHandler(
+		SessionsServiceReplayProcedure,
+		svc.Replay,
+		opts...,
+	)
+	sessionsServiceExportHandler := connect.NewUnaryHandler(
+		SessionsServiceExportProcedure,
+		svc.Export,
+		opts...,
+	)
+	sessionsServiceImportHandler := connect.NewUnaryHandler(
+		SessionsServiceImportProcedure,
+		svc.Import,
+		opts...,
+	)
+	sessionsServiceStopManyHandler := connect.NewUnaryHandler(
+		SessionsServiceStopManyProcedure,
+		svc.StopMany,
+		opts...,
+	)
+	sessionsServiceDeleteManyHandler := connect.NewUnaryHandler(
+		SessionsServiceDeleteManyProcedure,
+		svc.DeleteMany,
+		opts...,
+	)
+	sessionsServiceRestartManyHandler := connect.NewUnaryHandler(
+		SessionsServiceRestartManyProcedure,
+		svc.RestartMany,
+		opts...,
+	)
+	sessionsServiceGetBulkOperationHandler := connect.NewUnaryHandler(
+		SessionsServiceGetBulkOperationProcedure,
+		svc.GetBulkOperation,
+		opts...,
+	)
+	sessionsServiceSetRetentionPolicyHandler := connect.NewUnaryHandler(
+		SessionsServiceSetRetentionPolicyProcedure,
+		svc.SetRetentionPolicy,
+		opts...,
+	)
+	sessionsServiceGetRetentionPolicyHandler := connect.NewUnaryHandler(
+		SessionsServiceGetRetentionPolicyProcedure,
+		svc.GetRetentionPolicy,
+		opts...,
+	)
+	sessionsServiceDeleteRetentionPolicyHandler := connect.NewUnaryHandler(
+		SessionsServiceDeleteRetentionPolicyProcedure,
+		svc.DeleteRetentionPolicy,
+		opts...,
+	)
 	return "/autokitteh.sessions.v1.SessionsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SessionsServiceStartProcedure:
@@ -243,16 +517,40 @@ func NewSessionsServiceHandler(svc SessionsServiceHandler, opts ...connect.Handl
 			sessionsServiceStopHandler.ServeHTTP(w, r)
 		case SessionsServiceListProcedure:
 			sessionsServiceListHandler.ServeHTTP(w, r)
+		case SessionsServiceSearchProcedure:
+			sessionsServiceSearchHandler.ServeHTTP(w, r)
 		case SessionsServiceGetProcedure:
 			sessionsServiceGetHandler.ServeHTTP(w, r)
 		case SessionsServiceGetLogProcedure:
 			sessionsServiceGetLogHandler.ServeHTTP(w, r)
+		case SessionsServiceWatchLogProcedure:
+			sessionsServiceWatchLogHandler.ServeHTTP(w, r)
 		case SessionsServiceDownloadLogsProcedure:
 			sessionsServiceDownloadLogsHandler.ServeHTTP(w, r)
 		case SessionsServiceGetPrintsProcedure:
 			sessionsServiceGetPrintsHandler.ServeHTTP(w, r)
 		case SessionsServiceDeleteProcedure:
 			sessionsServiceDeleteHandler.ServeHTTP(w, r)
+		case SessionsServiceReplayProcedure:
+			sessionsServiceReplayHandler.ServeHTTP(w, r)
+		case SessionsServiceExportProcedure:
+			sessionsServiceExportHandler.ServeHTTP(w, r)
+		case SessionsServiceImportProcedure:
+			sessionsServiceImportHandler.ServeHTTP(w, r)
+		case SessionsServiceStopManyProcedure:
+			sessionsServiceStopManyHandler.ServeHTTP(w, r)
+		case SessionsServiceDeleteManyProcedure:
+			sessionsServiceDeleteManyHandler.ServeHTTP(w, r)
+		case SessionsServiceRestartManyProcedure:
+			sessionsServiceRestartManyHandler.ServeHTTP(w, r)
+		case SessionsServiceGetBulkOperationProcedure:
+			sessionsServiceGetBulkOperationHandler.ServeHTTP(w, r)
+		case SessionsServiceSetRetentionPolicyProcedure:
+			sessionsServiceSetRetentionPolicyHandler.ServeHTTP(w, r)
+		case SessionsServiceGetRetentionPolicyProcedure:
+			sessionsServiceGetRetentionPolicyHandler.ServeHTTP(w, r)
+		case SessionsServiceDeleteRetentionPolicyProcedure:
+			sessionsServiceDeleteRetentionPolicyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -274,6 +572,10 @@ func (UnimplementedSessionsServiceHandler) List(context.Context, *connect.Reques
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.List is not implemented"))
 }
 
+func (UnimplementedSessionsServiceHandler) Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.Search is not implemented"))
+}
+
 func (UnimplementedSessionsServiceHandler) Get(context.Context, *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.Get is not implemented"))
 }
@@ -282,6 +584,10 @@ func (UnimplementedSessionsServiceHandler) GetLog(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.GetLog is not implemented"))
 }
 
+func (UnimplementedSessionsServiceHandler) WatchLog(context.Context, *connect.Request[v1.WatchLogRequest], *connect.ServerStream[v1.WatchLogResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.WatchLog is not implemented"))
+}
+
 func (UnimplementedSessionsServiceHandler) DownloadLogs(context.Context, *connect.Request[v1.DownloadLogsRequest]) (*connect.Response[v1.DownloadLogsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.DownloadLogs is not implemented"))
 }
@@ -293,3 +599,43 @@ func (UnimplementedSessionsServiceHandler) GetPrints(context.Context, *connect.R
 func (UnimplementedSessionsServiceHandler) Delete(context.Context, *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.Delete is not implemented"))
 }
+
+func (UnimplementedSessionsServiceHandler) Replay(context.Context, *connect.Request[v1.ReplayRequest]) (*connect.Response[v1.ReplayResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.Replay is not implemented"))
+}
+
+func (UnimplementedSessionsServiceHandler) Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.Export is not implemented"))
+}
+
+func (UnimplementedSessionsServiceHandler) Import(context.Context, *connect.Request[v1.ImportRequest]) (*connect.Response[v1.ImportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.Import is not implemented"))
+}
+
+func (UnimplementedSessionsServiceHandler) StopMany(context.Context, *connect.Request[v1.StopManyRequest]) (*connect.Response[v1.StopManyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.StopMany is not implemented"))
+}
+
+func (UnimplementedSessionsServiceHandler) DeleteMany(context.Context, *connect.Request[v1.DeleteManyRequest]) (*connect.Response[v1.DeleteManyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.DeleteMany is not implemented"))
+}
+
+func (UnimplementedSessionsServiceHandler) RestartMany(context.Context, *connect.Request[v1.RestartManyRequest]) (*connect.Response[v1.RestartManyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.RestartMany is not implemented"))
+}
+
+func (UnimplementedSessionsServiceHandler) GetBulkOperation(context.Context, *connect.Request[v1.GetBulkOperationRequest]) (*connect.Response[v1.GetBulkOperationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.GetBulkOperation is not implemented"))
+}
+
+func (UnimplementedSessionsServiceHandler) SetRetentionPolicy(context.Context, *connect.Request[v1.SetRetentionPolicyRequest]) (*connect.Response[v1.SetRetentionPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.SetRetentionPolicy is not implemented"))
+}
+
+func (UnimplementedSessionsServiceHandler) GetRetentionPolicy(context.Context, *connect.Request[v1.GetRetentionPolicyRequest]) (*connect.Response[v1.GetRetentionPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.GetRetentionPolicy is not implemented"))
+}
+
+func (UnimplementedSessionsServiceHandler) DeleteRetentionPolicy(context.Context, *connect.Request[v1.DeleteRetentionPolicyRequest]) (*connect.Response[v1.DeleteRetentionPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autokitteh.sessions.v1.SessionsService.DeleteRetentionPolicy is not implemented"))
+}
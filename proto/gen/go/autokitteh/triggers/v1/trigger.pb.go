@@ -10,6 +10,7 @@ import (
 	v1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/program/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,6 +22,114 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// What to do with an event when max_concurrent sessions are already running.
+type ConcurrencyPolicy_Overlap int32
+
+const (
+	ConcurrencyPolicy_OVERLAP_UNSPECIFIED     ConcurrencyPolicy_Overlap = 0 // same as QUEUE.
+	ConcurrencyPolicy_OVERLAP_QUEUE           ConcurrencyPolicy_Overlap = 1 // wait until a session finishes, in order of arrival.
+	ConcurrencyPolicy_OVERLAP_SKIP            ConcurrencyPolicy_Overlap = 2 // drop the event.
+	ConcurrencyPolicy_OVERLAP_CANCEL_PREVIOUS ConcurrencyPolicy_Overlap = 3 // stop the oldest running session.
+)
+
+// Enum value maps for ConcurrencyPolicy_Overlap.
+var (
+	ConcurrencyPolicy_Overlap_name = map[int32]string{
+		0: "OVERLAP_UNSPECIFIED",
+		1: "OVERLAP_QUEUE",
+		2: "OVERLAP_SKIP",
+		3: "OVERLAP_CANCEL_PREVIOUS",
+	}
+	ConcurrencyPolicy_Overlap_value = map[string]int32{
+		"OVERLAP_UNSPECIFIED":     0,
+		"OVERLAP_QUEUE":           1,
+		"OVERLAP_SKIP":            2,
+		"OVERLAP_CANCEL_PREVIOUS": 3,
+	}
+)
+
+func (x ConcurrencyPolicy_Overlap) Enum() *ConcurrencyPolicy_Overlap {
+	p := new(ConcurrencyPolicy_Overlap)
+	*p = x
+	return p
+}
+
+func (x ConcurrencyPolicy_Overlap) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConcurrencyPolicy_Overlap) Descriptor() protoreflect.EnumDescriptor {
+	return file_autokitteh_triggers_v1_trigger_proto_enumTypes[0].Descriptor()
+}
+
+func (ConcurrencyPolicy_Overlap) Type() protoreflect.EnumType {
+	return &file_autokitteh_triggers_v1_trigger_proto_enumTypes[0]
+}
+
+func (x ConcurrencyPolicy_Overlap) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConcurrencyPolicy_Overlap.Descriptor instead.
+func (ConcurrencyPolicy_Overlap) EnumDescriptor() ([]byte, []int) {
+	return file_autokitteh_triggers_v1_trigger_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type WebhookAuth_SignatureFormat int32
+
+const (
+	WebhookAuth_SIGNATURE_FORMAT_UNSPECIFIED WebhookAuth_SignatureFormat = 0 // same as HEX.
+	// Hex digest, optionally prefixed with "sha256=", e.g. GitHub's X-Hub-Signature-256.
+	WebhookAuth_SIGNATURE_FORMAT_HEX WebhookAuth_SignatureFormat = 1
+	// Base64 digest, e.g. Shopify's X-Shopify-Hmac-Sha256.
+	WebhookAuth_SIGNATURE_FORMAT_BASE64 WebhookAuth_SignatureFormat = 2
+	// "t=<timestamp>,v1=<hex digest>" signed over "<timestamp>.<body>", e.g. Stripe-Signature.
+	WebhookAuth_SIGNATURE_FORMAT_STRIPE WebhookAuth_SignatureFormat = 3
+)
+
+// Enum value maps for WebhookAuth_SignatureFormat.
+var (
+	WebhookAuth_SignatureFormat_name = map[int32]string{
+		0: "SIGNATURE_FORMAT_UNSPECIFIED",
+		1: "SIGNATURE_FORMAT_HEX",
+		2: "SIGNATURE_FORMAT_BASE64",
+		3: "SIGNATURE_FORMAT_STRIPE",
+	}
+	WebhookAuth_SignatureFormat_value = map[string]int32{
+		"SIGNATURE_FORMAT_UNSPECIFIED": 0,
+		"SIGNATURE_FORMAT_HEX":         1,
+		"SIGNATURE_FORMAT_BASE64":      2,
+		"SIGNATURE_FORMAT_STRIPE":      3,
+	}
+)
+
+func (x WebhookAuth_SignatureFormat) Enum() *WebhookAuth_SignatureFormat {
+	p := new(WebhookAuth_SignatureFormat)
+	*p = x
+	return p
+}
+
+func (x WebhookAuth_SignatureFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookAuth_SignatureFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_autokitteh_triggers_v1_trigger_proto_enumTypes[1].Descriptor()
+}
+
+func (WebhookAuth_SignatureFormat) Type() protoreflect.EnumType {
+	return &file_autokitteh_triggers_v1_trigger_proto_enumTypes[1]
+}
+
+func (x WebhookAuth_SignatureFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookAuth_SignatureFormat.Descriptor instead.
+func (WebhookAuth_SignatureFormat) EnumDescriptor() ([]byte, []int) {
+	return file_autokitteh_triggers_v1_trigger_proto_rawDescGZIP(), []int{3, 0}
+}
+
 type Trigger_SourceType int32
 
 const (
@@ -28,6 +137,7 @@ const (
 	Trigger_SOURCE_TYPE_CONNECTION  Trigger_SourceType = 1
 	Trigger_SOURCE_TYPE_WEBHOOK     Trigger_SourceType = 2
 	Trigger_SOURCE_TYPE_SCHEDULE    Trigger_SourceType = 3
+	Trigger_SOURCE_TYPE_STORE       Trigger_SourceType = 4
 )
 
 // Enum value maps for Trigger_SourceType.
@@ -37,12 +147,14 @@ var (
 		1: "SOURCE_TYPE_CONNECTION",
 		2: "SOURCE_TYPE_WEBHOOK",
 		3: "SOURCE_TYPE_SCHEDULE",
+		4: "SOURCE_TYPE_STORE",
 	}
 	Trigger_SourceType_value = map[string]int32{
 		"SOURCE_TYPE_UNSPECIFIED": 0,
 		"SOURCE_TYPE_CONNECTION":  1,
 		"SOURCE_TYPE_WEBHOOK":     2,
 		"SOURCE_TYPE_SCHEDULE":    3,
+		"SOURCE_TYPE_STORE":       4,
 	}
 )
 
@@ -57,11 +169,11 @@ func (x Trigger_SourceType) String() string {
 }
 
 func (Trigger_SourceType) Descriptor() protoreflect.EnumDescriptor {
-	return file_autokitteh_triggers_v1_trigger_proto_enumTypes[0].Descriptor()
+	return file_autokitteh_triggers_v1_trigger_proto_enumTypes[2].Descriptor()
 }
 
 func (Trigger_SourceType) Type() protoreflect.EnumType {
-	return &file_autokitteh_triggers_v1_trigger_proto_enumTypes[0]
+	return &file_autokitteh_triggers_v1_trigger_proto_enumTypes[2]
 }
 
 func (x Trigger_SourceType) Number() protoreflect.EnumNumber {
@@ -70,7 +182,350 @@ func (x Trigger_SourceType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Trigger_SourceType.Descriptor instead.
 func (Trigger_SourceType) EnumDescriptor() ([]byte, []int) {
-	return file_autokitteh_triggers_v1_trigger_proto_rawDescGZIP(), []int{0, 0}
+	return file_autokitteh_triggers_v1_trigger_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type ConcurrencyPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// zero means unlimited, unless overlap is specified, in which case it is 1.
+	MaxConcurrent uint32                    `protobuf:"varint,1,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
+	Overlap       ConcurrencyPolicy_Overlap `protobuf:"varint,2,opt,name=overlap,proto3,enum=autokitteh.triggers.v1.ConcurrencyPolicy_Overlap" json:"overlap,omitempty"`
+}
+
+func (x *ConcurrencyPolicy) Reset() {
+	*x = ConcurrencyPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConcurrencyPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConcurrencyPolicy) ProtoMessage() {}
+
+func (x *ConcurrencyPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConcurrencyPolicy.ProtoReflect.Descriptor instead.
+func (*ConcurrencyPolicy) Descriptor() ([]byte, []int) {
+	return file_autokitteh_triggers_v1_trigger_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConcurrencyPolicy) GetMaxConcurrent() uint32 {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return 0
+}
+
+func (x *ConcurrencyPolicy) GetOverlap() ConcurrencyPolicy_Overlap {
+	if x != nil {
+		return x.Overlap
+	}
+	return ConcurrencyPolicy_OVERLAP_UNSPECIFIED
+}
+
+type BatchPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CEL expression evaluated like the filter. Events that evaluate to the same
+	// value are batched together. Empty means all events go into a single batch.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// How long to collect events, starting from the first event in the batch.
+	Window *durationpb.Duration `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	// Start the session once this many events were collected, even if the window
+	// did not pass yet. At least one of window or max_events must be specified.
+	MaxEvents uint32 `protobuf:"varint,3,opt,name=max_events,json=maxEvents,proto3" json:"max_events,omitempty"`
+}
+
+func (x *BatchPolicy) Reset() {
+	*x = BatchPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPolicy) ProtoMessage() {}
+
+func (x *BatchPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPolicy.ProtoReflect.Descriptor instead.
+func (*BatchPolicy) Descriptor() ([]byte, []int) {
+	return file_autokitteh_triggers_v1_trigger_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BatchPolicy) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BatchPolicy) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *BatchPolicy) GetMaxEvents() uint32 {
+	if x != nil {
+		return x.MaxEvents
+	}
+	return 0
+}
+
+// Retries sessions that ended in an error. Defaults follow Temporal's.
+type RetryPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Total number of attempts, including the first one.
+	MaxAttempts uint32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// Delay before the first retry. Default: 1s.
+	InitialInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=initial_interval,json=initialInterval,proto3" json:"initial_interval,omitempty"`
+	// Each retry is delayed by the previous delay times this. Default: 2.
+	BackoffCoefficient float64 `protobuf:"fixed64,3,opt,name=backoff_coefficient,json=backoffCoefficient,proto3" json:"backoff_coefficient,omitempty"`
+	// Maximum delay between retries. Default: 100 times initial_interval.
+	MaxInterval *durationpb.Duration `protobuf:"bytes,4,opt,name=max_interval,json=maxInterval,proto3" json:"max_interval,omitempty"`
+	// By default only infrastructure errors are retried, not errors raised by
+	// the program itself.
+	RetryOnProgramError bool `protobuf:"varint,5,opt,name=retry_on_program_error,json=retryOnProgramError,proto3" json:"retry_on_program_error,omitempty"`
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_autokitteh_triggers_v1_trigger_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetInitialInterval() *durationpb.Duration {
+	if x != nil {
+		return x.InitialInterval
+	}
+	return nil
+}
+
+func (x *RetryPolicy) GetBackoffCoefficient() float64 {
+	if x != nil {
+		return x.BackoffCoefficient
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxInterval() *durationpb.Duration {
+	if x != nil {
+		return x.MaxInterval
+	}
+	return nil
+}
+
+func (x *RetryPolicy) GetRetryOnProgramError() bool {
+	if x != nil {
+		return x.RetryOnProgramError
+	}
+	return false
+}
+
+// Verifies requests to a webhook trigger before they are dispatched. A request
+// must pass all of the configured checks, otherwise it is rejected with 401.
+// Secrets are referenced by the names of project variables holding them.
+type WebhookAuth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signature      *WebhookAuth_Signature `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	BearerTokenVar string                 `protobuf:"bytes,2,opt,name=bearer_token_var,json=bearerTokenVar,proto3" json:"bearer_token_var,omitempty"`
+	Basic          *WebhookAuth_Basic     `protobuf:"bytes,3,opt,name=basic,proto3" json:"basic,omitempty"`
+	// Allowed remote addresses, e.g. "192.30.252.0/22". Empty allows all.
+	AllowedCidrs []string `protobuf:"bytes,4,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
+}
+
+func (x *WebhookAuth) Reset() {
+	*x = WebhookAuth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookAuth) ProtoMessage() {}
+
+func (x *WebhookAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookAuth.ProtoReflect.Descriptor instead.
+func (*WebhookAuth) Descriptor() ([]byte, []int) {
+	return file_autokitteh_triggers_v1_trigger_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WebhookAuth) GetSignature() *WebhookAuth_Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *WebhookAuth) GetBearerTokenVar() string {
+	if x != nil {
+		return x.BearerTokenVar
+	}
+	return ""
+}
+
+func (x *WebhookAuth) GetBasic() *WebhookAuth_Basic {
+	if x != nil {
+		return x.Basic
+	}
+	return nil
+}
+
+func (x *WebhookAuth) GetAllowedCidrs() []string {
+	if x != nil {
+		return x.AllowedCidrs
+	}
+	return nil
+}
+
+// Derives an idempotency key from webhook requests. Repeated requests with the
+// same key are not dispatched again, but get the response of the first one.
+// Exactly one of header or key must be set.
+type WebhookIdempotency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header string `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"` // request header that contains the key.
+	Key    string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`       // expression over the event, in the same language as filters.
+}
+
+func (x *WebhookIdempotency) Reset() {
+	*x = WebhookIdempotency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookIdempotency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookIdempotency) ProtoMessage() {}
+
+func (x *WebhookIdempotency) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookIdempotency.ProtoReflect.Descriptor instead.
+func (*WebhookIdempotency) Descriptor() ([]byte, []int) {
+	return file_autokitteh_triggers_v1_trigger_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WebhookIdempotency) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *WebhookIdempotency) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
 }
 
 type Trigger struct {
@@ -87,9 +542,22 @@ type Trigger struct {
 	Filter       string             `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
 	IsDurable    bool               `protobuf:"varint,8,opt,name=is_durable,json=isDurable,proto3" json:"is_durable,omitempty"`
 	IsSync       bool               `protobuf:"varint,9,opt,name=is_sync,json=isSync,proto3" json:"is_sync,omitempty"`
-	ConnectionId string             `protobuf:"bytes,50,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"` // if source_type == CONNECTION.
-	Schedule     string             `protobuf:"bytes,51,opt,name=schedule,proto3" json:"schedule,omitempty"`                             // if source_type == SCHEDULE.
-	Timezone     string             `protobuf:"bytes,52,opt,name=timezone,proto3" json:"timezone,omitempty"`                             // if source_type == SCHEDULE.
+	Concurrency  *ConcurrencyPolicy `protobuf:"bytes,10,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Batch        *BatchPolicy       `protobuf:"bytes,11,opt,name=batch,proto3" json:"batch,omitempty"`
+	Retry        *RetryPolicy       `protobuf:"bytes,12,opt,name=retry,proto3" json:"retry,omitempty"`
+	// Execution timeout for sessions started by this trigger.
+	Timeout *durationpb.Duration `protobuf:"bytes,13,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Called when a session started by this trigger is stopped.
+	OnStop             *v1.CodeLocation    `protobuf:"bytes,14,opt,name=on_stop,json=onStop,proto3" json:"on_stop,omitempty"`
+	ConnectionId       string              `protobuf:"bytes,50,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`                   // if source_type == CONNECTION.
+	Schedule           string              `protobuf:"bytes,51,opt,name=schedule,proto3" json:"schedule,omitempty"`                                               // if source_type == SCHEDULE.
+	Timezone           string              `protobuf:"bytes,52,opt,name=timezone,proto3" json:"timezone,omitempty"`                                               // if source_type == SCHEDULE.
+	WebhookAuth        *WebhookAuth        `protobuf:"bytes,53,opt,name=webhook_auth,json=webhookAuth,proto3" json:"webhook_auth,omitempty"`                      // if source_type == WEBHOOK.
+	WebhookIdempotency *WebhookIdempotency `protobuf:"bytes,54,opt,name=webhook_idempotency,json=webhookIdempotency,proto3" json:"webhook_idempotency,omitempty"` // if source_type == WEBHOOK.
+	// if source_type == WEBHOOK. Method and path template relative to the webhook
+	// URL, e.g. "POST /orders/{id}". Webhook triggers created with routes in the
+	// same project share a slug, and each request goes to the best matching route.
+	WebhookRoute string `protobuf:"bytes,55,opt,name=webhook_route,json=webhookRoute,proto3" json:"webhook_route,omitempty"`
 	// read only.
 	WebhookSlug string `protobuf:"bytes,100,opt,name=webhook_slug,json=webhookSlug,proto3" json:"webhook_slug,omitempty"` // if source_type == WEBHOOK, after creation.
 }
@@ -97,7 +565,7 @@ type Trigger struct {
 func (x *Trigger) Reset() {
 	*x = Trigger{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[0]
+		mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -110,7 +578,7 @@ func (x *Trigger) String() string {
 func (*Trigger) ProtoMessage() {}
 
 func (x *Trigger) ProtoReflect() protoreflect.Message {
-	mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[0]
+	mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -123,7 +591,7 @@ func (x *Trigger) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trigger.ProtoReflect.Descriptor instead.
 func (*Trigger) Descriptor() ([]byte, []int) {
-	return file_autokitteh_triggers_v1_trigger_proto_rawDescGZIP(), []int{0}
+	return file_autokitteh_triggers_v1_trigger_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Trigger) GetTriggerId() string {
@@ -189,6 +657,41 @@ func (x *Trigger) GetIsSync() bool {
 	return false
 }
 
+func (x *Trigger) GetConcurrency() *ConcurrencyPolicy {
+	if x != nil {
+		return x.Concurrency
+	}
+	return nil
+}
+
+func (x *Trigger) GetBatch() *BatchPolicy {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *Trigger) GetRetry() *RetryPolicy {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *Trigger) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Trigger) GetOnStop() *v1.CodeLocation {
+	if x != nil {
+		return x.OnStop
+	}
+	return nil
+}
+
 func (x *Trigger) GetConnectionId() string {
 	if x != nil {
 		return x.ConnectionId
@@ -210,6 +713,27 @@ func (x *Trigger) GetTimezone() string {
 	return ""
 }
 
+func (x *Trigger) GetWebhookAuth() *WebhookAuth {
+	if x != nil {
+		return x.WebhookAuth
+	}
+	return nil
+}
+
+func (x *Trigger) GetWebhookIdempotency() *WebhookIdempotency {
+	if x != nil {
+		return x.WebhookIdempotency
+	}
+	return nil
+}
+
+func (x *Trigger) GetWebhookRoute() string {
+	if x != nil {
+		return x.WebhookRoute
+	}
+	return ""
+}
+
 func (x *Trigger) GetWebhookSlug() string {
 	if x != nil {
 		return x.WebhookSlug
@@ -217,6 +741,125 @@ func (x *Trigger) GetWebhookSlug() string {
 	return ""
 }
 
+// HMAC-SHA256 signature of the request body.
+type WebhookAuth_Signature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    string                      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	SecretVar string                      `protobuf:"bytes,2,opt,name=secret_var,json=secretVar,proto3" json:"secret_var,omitempty"`
+	Format    WebhookAuth_SignatureFormat `protobuf:"varint,3,opt,name=format,proto3,enum=autokitteh.triggers.v1.WebhookAuth_SignatureFormat" json:"format,omitempty"`
+}
+
+func (x *WebhookAuth_Signature) Reset() {
+	*x = WebhookAuth_Signature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookAuth_Signature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookAuth_Signature) ProtoMessage() {}
+
+func (x *WebhookAuth_Signature) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookAuth_Signature.ProtoReflect.Descriptor instead.
+func (*WebhookAuth_Signature) Descriptor() ([]byte, []int) {
+	return file_autokitteh_triggers_v1_trigger_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *WebhookAuth_Signature) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *WebhookAuth_Signature) GetSecretVar() string {
+	if x != nil {
+		return x.SecretVar
+	}
+	return ""
+}
+
+func (x *WebhookAuth_Signature) GetFormat() WebhookAuth_SignatureFormat {
+	if x != nil {
+		return x.Format
+	}
+	return WebhookAuth_SIGNATURE_FORMAT_UNSPECIFIED
+}
+
+type WebhookAuth_Basic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username    string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	PasswordVar string `protobuf:"bytes,2,opt,name=password_var,json=passwordVar,proto3" json:"password_var,omitempty"`
+}
+
+func (x *WebhookAuth_Basic) Reset() {
+	*x = WebhookAuth_Basic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookAuth_Basic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookAuth_Basic) ProtoMessage() {}
+
+func (x *WebhookAuth_Basic) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_triggers_v1_trigger_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookAuth_Basic.ProtoReflect.Descriptor instead.
+func (*WebhookAuth_Basic) Descriptor() ([]byte, []int) {
+	return file_autokitteh_triggers_v1_trigger_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *WebhookAuth_Basic) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *WebhookAuth_Basic) GetPasswordVar() string {
+	if x != nil {
+		return x.PasswordVar
+	}
+	return ""
+}
+
 var File_autokitteh_triggers_v1_trigger_proto protoreflect.FileDescriptor
 
 var file_autokitteh_triggers_v1_trigger_proto_rawDesc = []byte{
@@ -226,60 +869,177 @@ var file_autokitteh_triggers_v1_trigger_proto_rawDesc = []byte{
 	0x65, 0x68, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x23,
 	0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72,
 	0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xdb, 0x04, 0x0a, 0x07, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12,
-	0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69,
-	0x74, 0x74, 0x65, 0x68, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x48, 0x0a,
-	0x0d, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xed, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x4b, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x31, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4f, 0x76, 0x65,
+	0x72, 0x6c, 0x61, 0x70, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x22, 0x64, 0x0a,
+	0x07, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x56, 0x45, 0x52,
+	0x4c, 0x41, 0x50, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x50, 0x5f, 0x51, 0x55, 0x45,
+	0x55, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x50, 0x5f,
+	0x53, 0x4b, 0x49, 0x50, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41,
+	0x50, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x49, 0x4f, 0x55,
+	0x53, 0x10, 0x03, 0x22, 0x71, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x9a, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61,
+	0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x2f, 0x0a, 0x13, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x63, 0x6f, 0x65, 0x66, 0x66,
+	0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x62, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74,
+	0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x33,
+	0x0a, 0x16, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0xce, 0x04, 0x0a, 0x0b, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x41,
+	0x75, 0x74, 0x68, 0x12, 0x4b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74,
+	0x74, 0x65, 0x68, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x76, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x65, 0x61, 0x72,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x56, 0x61, 0x72, 0x12, 0x3f, 0x0a, 0x05, 0x62, 0x61,
+	0x73, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x42,
+	0x61, 0x73, 0x69, 0x63, 0x52, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x69, 0x64, 0x72, 0x73,
+	0x1a, 0x8f, 0x01, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x5f, 0x76, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x56, 0x61, 0x72, 0x12, 0x4b, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74,
+	0x65, 0x68, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x1a, 0x46, 0x0a, 0x05, 0x42, 0x61, 0x73, 0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x5f, 0x76, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x72, 0x22, 0x87, 0x01, 0x0a, 0x0f, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x20,
+	0x0a, 0x1c, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x18, 0x0a, 0x14, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x48, 0x45, 0x58, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x49,
+	0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x42,
+	0x41, 0x53, 0x45, 0x36, 0x34, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x49, 0x47, 0x4e, 0x41,
+	0x54, 0x55, 0x52, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x49,
+	0x50, 0x45, 0x10, 0x03, 0x22, 0x3e, 0x0a, 0x12, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x22, 0xf3, 0x08, 0x0a, 0x07, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b,
+	0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x48,
+	0x0a, 0x0d, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74,
+	0x65, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x69, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x4b, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x39, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0b,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65,
-	0x68, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x64,
-	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
-	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x17,
-	0x0a, 0x07, 0x69, 0x73, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x69, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x33, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
-	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
-	0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f,
-	0x73, 0x6c, 0x75, 0x67, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x65, 0x62, 0x68,
-	0x6f, 0x6f, 0x6b, 0x53, 0x6c, 0x75, 0x67, 0x22, 0x78, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x17,
-	0x0a, 0x13, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x45,
-	0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f, 0x55, 0x52, 0x43,
-	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x10,
-	0x03, 0x42, 0xf1, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69,
-	0x74, 0x74, 0x65, 0x68, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31,
-	0x42, 0x0c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x4b, 0x67, 0x6f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e,
-	0x64, 0x65, 0x76, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x75, 0x74, 0x6f,
-	0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2f,
-	0x76, 0x31, 0x3b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x41, 0x54, 0x58, 0xaa, 0x02, 0x16, 0x41, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68,
-	0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x41,
-	0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x5c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x41, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74,
-	0x65, 0x68, 0x5c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x41, 0x75, 0x74,
-	0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x3a, 0x3a, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x68, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x39, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x3c, 0x0a, 0x07, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18,
+	0x33, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x34, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x77,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x35, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x52, 0x0b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x41,
+	0x75, 0x74, 0x68, 0x12, 0x5b, 0x0a, 0x13, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x36, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x12, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x23, 0x0a, 0x0d, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x18, 0x37, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x6c, 0x75, 0x67, 0x22, 0x8f, 0x01, 0x0a, 0x0a, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01,
+	0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c,
+	0x45, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x04, 0x42, 0xf1, 0x01, 0x0a, 0x1a, 0x63,
+	0x6f, 0x6d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x6f, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x64, 0x65, 0x76, 0x2f, 0x61, 0x75, 0x74,
+	0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2f,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x54, 0x58, 0xaa, 0x02, 0x16, 0x41,
+	0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x41, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74,
+	0x65, 0x68, 0x5c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x22, 0x41, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x5c, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x41, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68,
+	0x3a, 0x3a, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -294,21 +1054,45 @@ func file_autokitteh_triggers_v1_trigger_proto_rawDescGZIP() []byte {
 	return file_autokitteh_triggers_v1_trigger_proto_rawDescData
 }
 
-var file_autokitteh_triggers_v1_trigger_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_autokitteh_triggers_v1_trigger_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_autokitteh_triggers_v1_trigger_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_autokitteh_triggers_v1_trigger_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_autokitteh_triggers_v1_trigger_proto_goTypes = []interface{}{
-	(Trigger_SourceType)(0), // 0: autokitteh.triggers.v1.Trigger.SourceType
-	(*Trigger)(nil),         // 1: autokitteh.triggers.v1.Trigger
-	(*v1.CodeLocation)(nil), // 2: autokitteh.program.v1.CodeLocation
+	(ConcurrencyPolicy_Overlap)(0),   // 0: autokitteh.triggers.v1.ConcurrencyPolicy.Overlap
+	(WebhookAuth_SignatureFormat)(0), // 1: autokitteh.triggers.v1.WebhookAuth.SignatureFormat
+	(Trigger_SourceType)(0),          // 2: autokitteh.triggers.v1.Trigger.SourceType
+	(*ConcurrencyPolicy)(nil),        // 3: autokitteh.triggers.v1.ConcurrencyPolicy
+	(*BatchPolicy)(nil),              // 4: autokitteh.triggers.v1.BatchPolicy
+	(*RetryPolicy)(nil),              // 5: autokitteh.triggers.v1.RetryPolicy
+	(*WebhookAuth)(nil),              // 6: autokitteh.triggers.v1.WebhookAuth
+	(*WebhookIdempotency)(nil),       // 7: autokitteh.triggers.v1.WebhookIdempotency
+	(*Trigger)(nil),                  // 8: autokitteh.triggers.v1.Trigger
+	(*WebhookAuth_Signature)(nil),    // 9: autokitteh.triggers.v1.WebhookAuth.Signature
+	(*WebhookAuth_Basic)(nil),        // 10: autokitteh.triggers.v1.WebhookAuth.Basic
+	(*durationpb.Duration)(nil),      // 11: google.protobuf.Duration
+	(*v1.CodeLocation)(nil),          // 12: autokitteh.program.v1.CodeLocation
 }
 var file_autokitteh_triggers_v1_trigger_proto_depIdxs = []int32{
-	0, // 0: autokitteh.triggers.v1.Trigger.source_type:type_name -> autokitteh.triggers.v1.Trigger.SourceType
-	2, // 1: autokitteh.triggers.v1.Trigger.code_location:type_name -> autokitteh.program.v1.CodeLocation
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: autokitteh.triggers.v1.ConcurrencyPolicy.overlap:type_name -> autokitteh.triggers.v1.ConcurrencyPolicy.Overlap
+	11, // 1: autokitteh.triggers.v1.BatchPolicy.window:type_name -> google.protobuf.Duration
+	11, // 2: autokitteh.triggers.v1.RetryPolicy.initial_interval:type_name -> google.protobuf.Duration
+	11, // 3: autokitteh.triggers.v1.RetryPolicy.max_interval:type_name -> google.protobuf.Duration
+	9,  // 4: autokitteh.triggers.v1.WebhookAuth.signature:type_name -> autokitteh.triggers.v1.WebhookAuth.Signature
+	10, // 5: autokitteh.triggers.v1.WebhookAuth.basic:type_name -> autokitteh.triggers.v1.WebhookAuth.Basic
+	2,  // 6: autokitteh.triggers.v1.Trigger.source_type:type_name -> autokitteh.triggers.v1.Trigger.SourceType
+	12, // 7: autokitteh.triggers.v1.Trigger.code_location:type_name -> autokitteh.program.v1.CodeLocation
+	3,  // 8: autokitteh.triggers.v1.Trigger.concurrency:type_name -> autokitteh.triggers.v1.ConcurrencyPolicy
+	4,  // 9: autokitteh.triggers.v1.Trigger.batch:type_name -> autokitteh.triggers.v1.BatchPolicy
+	5,  // 10: autokitteh.triggers.v1.Trigger.retry:type_name -> autokitteh.triggers.v1.RetryPolicy
+	11, // 11: autokitteh.triggers.v1.Trigger.timeout:type_name -> google.protobuf.Duration
+	12, // 12: autokitteh.triggers.v1.Trigger.on_stop:type_name -> autokitteh.program.v1.CodeLocation
+	6,  // 13: autokitteh.triggers.v1.Trigger.webhook_auth:type_name -> autokitteh.triggers.v1.WebhookAuth
+	7,  // 14: autokitteh.triggers.v1.Trigger.webhook_idempotency:type_name -> autokitteh.triggers.v1.WebhookIdempotency
+	1,  // 15: autokitteh.triggers.v1.WebhookAuth.Signature.format:type_name -> autokitteh.triggers.v1.WebhookAuth.SignatureFormat
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_autokitteh_triggers_v1_trigger_proto_init() }
@@ -318,6 +1102,66 @@ func file_autokitteh_triggers_v1_trigger_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_autokitteh_triggers_v1_trigger_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConcurrencyPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autokitteh_triggers_v1_trigger_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autokitteh_triggers_v1_trigger_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autokitteh_triggers_v1_trigger_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookAuth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autokitteh_triggers_v1_trigger_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookIdempotency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autokitteh_triggers_v1_trigger_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Trigger); i {
 			case 0:
 				return &v.state
@@ -329,14 +1173,38 @@ func file_autokitteh_triggers_v1_trigger_proto_init() {
 				return nil
 			}
 		}
+		file_autokitteh_triggers_v1_trigger_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookAuth_Signature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autokitteh_triggers_v1_trigger_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookAuth_Basic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_autokitteh_triggers_v1_trigger_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   1,
+			NumEnums:      3,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
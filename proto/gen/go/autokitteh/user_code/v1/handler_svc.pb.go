@@ -1782,6 +1782,376 @@ func (x *OutcomeResponse) GetError() string {
 	return ""
 }
 
+type SetSessionTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunnerId string `protobuf:"bytes,1,opt,name=runner_id,json=runnerId,proto3" json:"runner_id,omitempty"`
+	Key      string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SetSessionTagRequest) Reset() {
+	*x = SetSessionTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSessionTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSessionTagRequest) ProtoMessage() {}
+
+func (x *SetSessionTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSessionTagRequest.ProtoReflect.Descriptor instead.
+func (*SetSessionTagRequest) Descriptor() ([]byte, []int) {
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetSessionTagRequest) GetRunnerId() string {
+	if x != nil {
+		return x.RunnerId
+	}
+	return ""
+}
+
+func (x *SetSessionTagRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetSessionTagRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SetSessionTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SetSessionTagResponse) Reset() {
+	*x = SetSessionTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSessionTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSessionTagResponse) ProtoMessage() {}
+
+func (x *SetSessionTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSessionTagResponse.ProtoReflect.Descriptor instead.
+func (*SetSessionTagResponse) Descriptor() ([]byte, []int) {
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetSessionTagResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RequestApprovalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunnerId  string   `protobuf:"bytes,1,opt,name=runner_id,json=runnerId,proto3" json:"runner_id,omitempty"`
+	Title     string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Approvers []string `protobuf:"bytes,3,rep,name=approvers,proto3" json:"approvers,omitempty"`                   // emails. empty means any project member.
+	TimeoutMs int64    `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"` // 0 means no timeout.
+}
+
+func (x *RequestApprovalRequest) Reset() {
+	*x = RequestApprovalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestApprovalRequest) ProtoMessage() {}
+
+func (x *RequestApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestApprovalRequest.ProtoReflect.Descriptor instead.
+func (*RequestApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RequestApprovalRequest) GetRunnerId() string {
+	if x != nil {
+		return x.RunnerId
+	}
+	return ""
+}
+
+func (x *RequestApprovalRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RequestApprovalRequest) GetApprovers() []string {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+func (x *RequestApprovalRequest) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type RequestApprovalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error      string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	ApprovalId string `protobuf:"bytes,2,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	State      string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"` // approved, rejected or expired.
+	DecidedBy  string `protobuf:"bytes,4,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	Comment    string `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *RequestApprovalResponse) Reset() {
+	*x = RequestApprovalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestApprovalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestApprovalResponse) ProtoMessage() {}
+
+func (x *RequestApprovalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestApprovalResponse.ProtoReflect.Descriptor instead.
+func (*RequestApprovalResponse) Descriptor() ([]byte, []int) {
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RequestApprovalResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RequestApprovalResponse) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *RequestApprovalResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *RequestApprovalResponse) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *RequestApprovalResponse) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type RegisterCompensationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunnerId string `protobuf:"bytes,1,opt,name=runner_id,json=runnerId,proto3" json:"runner_id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // compensation function name, for display.
+	Data     []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"` // passed back to the runner in ExecuteRequest when the compensation is called.
+}
+
+func (x *RegisterCompensationRequest) Reset() {
+	*x = RegisterCompensationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterCompensationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterCompensationRequest) ProtoMessage() {}
+
+func (x *RegisterCompensationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterCompensationRequest.ProtoReflect.Descriptor instead.
+func (*RegisterCompensationRequest) Descriptor() ([]byte, []int) {
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RegisterCompensationRequest) GetRunnerId() string {
+	if x != nil {
+		return x.RunnerId
+	}
+	return ""
+}
+
+func (x *RegisterCompensationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterCompensationRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RegisterCompensationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *RegisterCompensationResponse) Reset() {
+	*x = RegisterCompensationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterCompensationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterCompensationResponse) ProtoMessage() {}
+
+func (x *RegisterCompensationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterCompensationResponse.ProtoReflect.Descriptor instead.
+func (*RegisterCompensationResponse) Descriptor() ([]byte, []int) {
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RegisterCompensationResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type StartSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1794,23 +2164,219 @@ type StartSessionRequest struct {
 	Project  string `protobuf:"bytes,5,opt,name=project,proto3" json:"project,omitempty"`
 }
 
-func (x *StartSessionRequest) Reset() {
-	*x = StartSessionRequest{}
+func (x *StartSessionRequest) Reset() {
+	*x = StartSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSessionRequest) ProtoMessage() {}
+
+func (x *StartSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSessionRequest.ProtoReflect.Descriptor instead.
+func (*StartSessionRequest) Descriptor() ([]byte, []int) {
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StartSessionRequest) GetRunnerId() string {
+	if x != nil {
+		return x.RunnerId
+	}
+	return ""
+}
+
+func (x *StartSessionRequest) GetLoc() string {
+	if x != nil {
+		return x.Loc
+	}
+	return ""
+}
+
+func (x *StartSessionRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StartSessionRequest) GetMemo() []byte {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *StartSessionRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type StartSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Error     string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *StartSessionResponse) Reset() {
+	*x = StartSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSessionResponse) ProtoMessage() {}
+
+func (x *StartSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSessionResponse.ProtoReflect.Descriptor instead.
+func (*StartSessionResponse) Descriptor() ([]byte, []int) {
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StartSessionResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *StartSessionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type WaitSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunnerId   string   `protobuf:"bytes,1,opt,name=runner_id,json=runnerId,proto3" json:"runner_id,omitempty"`
+	SessionIds []string `protobuf:"bytes,2,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
+	TimeoutMs  int64    `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+}
+
+func (x *WaitSessionsRequest) Reset() {
+	*x = WaitSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitSessionsRequest) ProtoMessage() {}
+
+func (x *WaitSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitSessionsRequest.ProtoReflect.Descriptor instead.
+func (*WaitSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *WaitSessionsRequest) GetRunnerId() string {
+	if x != nil {
+		return x.RunnerId
+	}
+	return ""
+}
+
+func (x *WaitSessionsRequest) GetSessionIds() []string {
+	if x != nil {
+		return x.SessionIds
+	}
+	return nil
+}
+
+func (x *WaitSessionsRequest) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type SessionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string    `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	State     string    `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"` // completed, error or stopped. Empty if the session did not finish in time.
+	Value     *v1.Value `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"` // return value, if completed.
+	Error     string    `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"` // error message or stop reason.
+}
+
+func (x *SessionResult) Reset() {
+	*x = SessionResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[32]
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartSessionRequest) String() string {
+func (x *SessionResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartSessionRequest) ProtoMessage() {}
+func (*SessionResult) ProtoMessage() {}
 
-func (x *StartSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[32]
+func (x *SessionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1821,72 +2387,65 @@ func (x *StartSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartSessionRequest.ProtoReflect.Descriptor instead.
-func (*StartSessionRequest) Descriptor() ([]byte, []int) {
-	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use SessionResult.ProtoReflect.Descriptor instead.
+func (*SessionResult) Descriptor() ([]byte, []int) {
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *StartSessionRequest) GetRunnerId() string {
+func (x *SessionResult) GetSessionId() string {
 	if x != nil {
-		return x.RunnerId
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *StartSessionRequest) GetLoc() string {
+func (x *SessionResult) GetState() string {
 	if x != nil {
-		return x.Loc
+		return x.State
 	}
 	return ""
 }
 
-func (x *StartSessionRequest) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *StartSessionRequest) GetMemo() []byte {
+func (x *SessionResult) GetValue() *v1.Value {
 	if x != nil {
-		return x.Memo
+		return x.Value
 	}
 	return nil
 }
 
-func (x *StartSessionRequest) GetProject() string {
+func (x *SessionResult) GetError() string {
 	if x != nil {
-		return x.Project
+		return x.Error
 	}
 	return ""
 }
 
-type StartSessionResponse struct {
+type WaitSessionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Error     string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Results []*SessionResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Error   string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *StartSessionResponse) Reset() {
-	*x = StartSessionResponse{}
+func (x *WaitSessionsResponse) Reset() {
+	*x = WaitSessionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[33]
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartSessionResponse) String() string {
+func (x *WaitSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartSessionResponse) ProtoMessage() {}
+func (*WaitSessionsResponse) ProtoMessage() {}
 
-func (x *StartSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[33]
+func (x *WaitSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1897,19 +2456,19 @@ func (x *StartSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartSessionResponse.ProtoReflect.Descriptor instead.
-func (*StartSessionResponse) Descriptor() ([]byte, []int) {
-	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use WaitSessionsResponse.ProtoReflect.Descriptor instead.
+func (*WaitSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *StartSessionResponse) GetSessionId() string {
+func (x *WaitSessionsResponse) GetResults() []*SessionResult {
 	if x != nil {
-		return x.SessionId
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *StartSessionResponse) GetError() string {
+func (x *WaitSessionsResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
@@ -1930,7 +2489,7 @@ type EncodeJWTRequest struct {
 func (x *EncodeJWTRequest) Reset() {
 	*x = EncodeJWTRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[34]
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1943,7 +2502,7 @@ func (x *EncodeJWTRequest) String() string {
 func (*EncodeJWTRequest) ProtoMessage() {}
 
 func (x *EncodeJWTRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[34]
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,7 +2515,7 @@ func (x *EncodeJWTRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EncodeJWTRequest.ProtoReflect.Descriptor instead.
 func (*EncodeJWTRequest) Descriptor() ([]byte, []int) {
-	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{34}
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *EncodeJWTRequest) GetRunnerId() string {
@@ -1999,7 +2558,7 @@ type EncodeJWTResponse struct {
 func (x *EncodeJWTResponse) Reset() {
 	*x = EncodeJWTResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[35]
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2012,7 +2571,7 @@ func (x *EncodeJWTResponse) String() string {
 func (*EncodeJWTResponse) ProtoMessage() {}
 
 func (x *EncodeJWTResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[35]
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2025,7 +2584,7 @@ func (x *EncodeJWTResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EncodeJWTResponse.ProtoReflect.Descriptor instead.
 func (*EncodeJWTResponse) Descriptor() ([]byte, []int) {
-	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{35}
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *EncodeJWTResponse) GetJwt() string {
@@ -2055,7 +2614,7 @@ type RefreshRequest struct {
 func (x *RefreshRequest) Reset() {
 	*x = RefreshRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[36]
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2068,7 +2627,7 @@ func (x *RefreshRequest) String() string {
 func (*RefreshRequest) ProtoMessage() {}
 
 func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[36]
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2081,7 +2640,7 @@ func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
 func (*RefreshRequest) Descriptor() ([]byte, []int) {
-	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{36}
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *RefreshRequest) GetRunnerId() string {
@@ -2118,7 +2677,7 @@ type RefreshResponse struct {
 func (x *RefreshResponse) Reset() {
 	*x = RefreshResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[37]
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2131,7 +2690,7 @@ func (x *RefreshResponse) String() string {
 func (*RefreshResponse) ProtoMessage() {}
 
 func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[37]
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2144,7 +2703,7 @@ func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshResponse.ProtoReflect.Descriptor instead.
 func (*RefreshResponse) Descriptor() ([]byte, []int) {
-	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{37}
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *RefreshResponse) GetToken() string {
@@ -2179,7 +2738,7 @@ type IsActiveRunnerRequest struct {
 func (x *IsActiveRunnerRequest) Reset() {
 	*x = IsActiveRunnerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[38]
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2192,7 +2751,7 @@ func (x *IsActiveRunnerRequest) String() string {
 func (*IsActiveRunnerRequest) ProtoMessage() {}
 
 func (x *IsActiveRunnerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[38]
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2205,7 +2764,7 @@ func (x *IsActiveRunnerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsActiveRunnerRequest.ProtoReflect.Descriptor instead.
 func (*IsActiveRunnerRequest) Descriptor() ([]byte, []int) {
-	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{38}
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *IsActiveRunnerRequest) GetRunnerId() string {
@@ -2227,7 +2786,7 @@ type IsActiveRunnerResponse struct {
 func (x *IsActiveRunnerResponse) Reset() {
 	*x = IsActiveRunnerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[39]
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2240,7 +2799,7 @@ func (x *IsActiveRunnerResponse) String() string {
 func (*IsActiveRunnerResponse) ProtoMessage() {}
 
 func (x *IsActiveRunnerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[39]
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2253,7 +2812,7 @@ func (x *IsActiveRunnerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsActiveRunnerResponse.ProtoReflect.Descriptor instead.
 func (*IsActiveRunnerResponse) Descriptor() ([]byte, []int) {
-	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{39}
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *IsActiveRunnerResponse) GetIsActive() bool {
@@ -2279,7 +2838,7 @@ type HandlerHealthRequest struct {
 func (x *HandlerHealthRequest) Reset() {
 	*x = HandlerHealthRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[40]
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2292,7 +2851,7 @@ func (x *HandlerHealthRequest) String() string {
 func (*HandlerHealthRequest) ProtoMessage() {}
 
 func (x *HandlerHealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[40]
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2305,7 +2864,7 @@ func (x *HandlerHealthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandlerHealthRequest.ProtoReflect.Descriptor instead.
 func (*HandlerHealthRequest) Descriptor() ([]byte, []int) {
-	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{40}
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{49}
 }
 
 type HandlerHealthResponse struct {
@@ -2319,7 +2878,7 @@ type HandlerHealthResponse struct {
 func (x *HandlerHealthResponse) Reset() {
 	*x = HandlerHealthResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[41]
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2332,7 +2891,7 @@ func (x *HandlerHealthResponse) String() string {
 func (*HandlerHealthResponse) ProtoMessage() {}
 
 func (x *HandlerHealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[41]
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2345,7 +2904,7 @@ func (x *HandlerHealthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandlerHealthResponse.ProtoReflect.Descriptor instead.
 func (*HandlerHealthResponse) Descriptor() ([]byte, []int) {
-	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{41}
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *HandlerHealthResponse) GetError() string {
@@ -2369,7 +2928,7 @@ type ExecuteReplyRequest struct {
 func (x *ExecuteReplyRequest) Reset() {
 	*x = ExecuteReplyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[42]
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2382,7 +2941,7 @@ func (x *ExecuteReplyRequest) String() string {
 func (*ExecuteReplyRequest) ProtoMessage() {}
 
 func (x *ExecuteReplyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[42]
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2395,7 +2954,7 @@ func (x *ExecuteReplyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteReplyRequest.ProtoReflect.Descriptor instead.
 func (*ExecuteReplyRequest) Descriptor() ([]byte, []int) {
-	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{42}
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ExecuteReplyRequest) GetRunnerId() string {
@@ -2437,7 +2996,7 @@ type ExecuteReplyResponse struct {
 func (x *ExecuteReplyResponse) Reset() {
 	*x = ExecuteReplyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[43]
+		mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2450,7 +3009,7 @@ func (x *ExecuteReplyResponse) String() string {
 func (*ExecuteReplyResponse) ProtoMessage() {}
 
 func (x *ExecuteReplyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[43]
+	mi := &file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2463,7 +3022,7 @@ func (x *ExecuteReplyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteReplyResponse.ProtoReflect.Descriptor instead.
 func (*ExecuteReplyResponse) Descriptor() ([]byte, []int) {
-	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{43}
+	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ExecuteReplyResponse) GetError() string {
@@ -2657,233 +3216,323 @@ var file_autokitteh_user_code_v1_handler_svc_proto_rawDesc = []byte{
 	0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x27, 0x0a, 0x0f, 0x4f, 0x75, 0x74, 0x63,
 	0x6f, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
 	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x72, 0x22, 0x5b, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2d,
+	0x0a, 0x15, 0x53, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x88, 0x01,
+	0x0a, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x6e,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x62, 0x0a, 0x1b, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6e,
 	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75,
-	0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x63, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
-	0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x4b, 0x0a, 0x14, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xfb, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x4a, 0x57, 0x54, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x34,
+	0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
 	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x61, 0x75, 0x74,
-	0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4a, 0x57, 0x54, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x1a, 0x3a, 0x0a, 0x0c, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a, 0x11, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4a,
-	0x57, 0x54, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x77,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x77, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x6f, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x07,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x34, 0x0a, 0x15, 0x49, 0x73, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6d,
+	0x65, 0x6d, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x4b, 0x0a,
+	0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x72, 0x0a, 0x13, 0x57, 0x61,
+	0x69, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4b,
-	0x0a, 0x16, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x48,
-	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x2d, 0x0a, 0x15, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0xbb, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75,
-	0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
-	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69,
-	0x74, 0x74, 0x65, 0x68, 0x2e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x63, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74,
-	0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x72, 0x61, 0x63, 0x65, 0x62, 0x61, 0x63, 0x6b,
-	0x22, 0x2c, 0x0a, 0x14, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xf4,
-	0x10, 0x0a, 0x0e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x61, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x28, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x8d,
+	0x01, 0x0a, 0x0d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65,
+	0x68, 0x2e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6e,
+	0x0a, 0x14, 0x57, 0x61, 0x69, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69,
 	0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65,
-	0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x04, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x75,
+	0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xfb,
+	0x01, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4a, 0x57, 0x54, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x50, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x4a, 0x57, 0x54, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x1a, 0x3a, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a, 0x11,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4a, 0x57, 0x54, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x77, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6a, 0x77, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6f, 0x0a, 0x0e, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x0f, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x34, 0x0a, 0x15, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x6e,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x16, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2d, 0x0a, 0x15, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xbb, 0x01, 0x0a, 0x13, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x33,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x09, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x2c, 0x0a, 0x14, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xd5, 0x14, 0x0a, 0x0e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x12, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65,
+	0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0c, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x2e, 0x61, 0x75,
 	0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x6e, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x03, 0x4c, 0x6f,
-	0x67, 0x12, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74,
-	0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58,
-	0x0a, 0x05, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69,
-	0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x04, 0x44, 0x6f,
+	0x6e, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b,
+	0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x52, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b,
+	0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x25,
 	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72,
 	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x05, 0x53, 0x6c, 0x65, 0x65,
-	0x70, 0x12, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x65, 0x65,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b,
-	0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x64, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
-	0x29, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x75, 0x74,
-	0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x09, 0x4e, 0x65, 0x78, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74,
 	0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4e, 0x65, 0x78, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a,
-	0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x2b, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x75, 0x74,
-	0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0c, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x61, 0x75, 0x74,
-	0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b,
+	0x50, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x58, 0x0a, 0x05, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x12, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b,
 	0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x06, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x6c, 0x12, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75,
-	0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x6c, 0x12, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65,
-	0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
-	0x65, 0x78, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x64, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x2e, 0x61,
+	0x76, 0x31, 0x2e, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x09, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74,
+	0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x64, 0x0a, 0x09, 0x4e, 0x65, 0x78, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e, 0x61,
 	0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69,
 	0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x75,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65,
-	0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x31, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x12, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65,
+	0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x6d, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x12, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6d, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x2d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x73, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x12, 0x2e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
-	0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f,
-	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x6d, 0x0a, 0x0c, 0x57, 0x61, 0x69, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5b, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f,
 	0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x09, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4a,
-	0x57, 0x54, 0x12, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x4a, 0x57, 0x54, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0a,
+	0x4e, 0x65, 0x78, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x2a, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74,
+	0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
 	0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4a, 0x57,
-	0x54, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x11, 0x52,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65,
-	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f,
-	0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
-	0x2d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
-	0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
-	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x73, 0x0a, 0x0e, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e,
-	0x65, 0x72, 0x12, 0x2e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0xf7, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x75,
+	0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0b, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69,
+	0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69,
+	0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74,
+	0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x55,
+	0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x2e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b,
+	0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b,
+	0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x07, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74,
+	0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x0d, 0x53,
+	0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x12, 0x2d, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x75,
 	0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x53, 0x76,
-	0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4d, 0x67, 0x6f, 0x2e, 0x61, 0x75, 0x74,
-	0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x64, 0x65, 0x76, 0x2f, 0x61, 0x75, 0x74, 0x6f,
-	0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2f, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x55, 0x58, 0xaa, 0x02, 0x16,
-	0x41, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x41, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74,
-	0x74, 0x65, 0x68, 0x5c, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x22, 0x41, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x5c, 0x55, 0x73, 0x65,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x41, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65,
-	0x68, 0x3a, 0x3a, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a,
+	0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x12, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x85, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65,
+	0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a,
+	0x09, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4a, 0x57, 0x54, 0x12, 0x29, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4a, 0x57, 0x54, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74,
+	0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4a, 0x57, 0x54, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4f, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b,
+	0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a,
+	0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69,
+	0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74,
+	0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x0e, 0x49, 0x73, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0xf7, 0x01,
+	0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x48,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x53, 0x76, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x4d, 0x67, 0x6f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2e,
+	0x64, 0x65, 0x76, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x61, 0x75, 0x74, 0x6f,
+	0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x2f, 0x76, 0x31, 0x3b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x41, 0x55, 0x58, 0xaa, 0x02, 0x16, 0x41, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74,
+	0x65, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x16, 0x41, 0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x5c, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x41, 0x75, 0x74, 0x6f, 0x6b, 0x69,
+	0x74, 0x74, 0x65, 0x68, 0x5c, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x41,
+	0x75, 0x74, 0x6f, 0x6b, 0x69, 0x74, 0x74, 0x65, 0x68, 0x3a, 0x3a, 0x55, 0x73, 0x65, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2898,124 +3547,143 @@ func file_autokitteh_user_code_v1_handler_svc_proto_rawDescGZIP() []byte {
 	return file_autokitteh_user_code_v1_handler_svc_proto_rawDescData
 }
 
-var file_autokitteh_user_code_v1_handler_svc_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_autokitteh_user_code_v1_handler_svc_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_autokitteh_user_code_v1_handler_svc_proto_goTypes = []interface{}{
-	(*CallInfo)(nil),               // 0: autokitteh.user_code.v1.CallInfo
-	(*ActivityRequest)(nil),        // 1: autokitteh.user_code.v1.ActivityRequest
-	(*ActivityResponse)(nil),       // 2: autokitteh.user_code.v1.ActivityResponse
-	(*DoneRequest)(nil),            // 3: autokitteh.user_code.v1.DoneRequest
-	(*DoneResponse)(nil),           // 4: autokitteh.user_code.v1.DoneResponse
-	(*SleepRequest)(nil),           // 5: autokitteh.user_code.v1.SleepRequest
-	(*SleepResponse)(nil),          // 6: autokitteh.user_code.v1.SleepResponse
-	(*SubscribeRequest)(nil),       // 7: autokitteh.user_code.v1.SubscribeRequest
-	(*SubscribeResponse)(nil),      // 8: autokitteh.user_code.v1.SubscribeResponse
-	(*NextEventRequest)(nil),       // 9: autokitteh.user_code.v1.NextEventRequest
-	(*NextEventResponse)(nil),      // 10: autokitteh.user_code.v1.NextEventResponse
-	(*UnsubscribeRequest)(nil),     // 11: autokitteh.user_code.v1.UnsubscribeRequest
-	(*UnsubscribeResponse)(nil),    // 12: autokitteh.user_code.v1.UnsubscribeResponse
-	(*Signal)(nil),                 // 13: autokitteh.user_code.v1.Signal
-	(*SignalRequest)(nil),          // 14: autokitteh.user_code.v1.SignalRequest
-	(*SignalResponse)(nil),         // 15: autokitteh.user_code.v1.SignalResponse
-	(*NextSignalRequest)(nil),      // 16: autokitteh.user_code.v1.NextSignalRequest
-	(*NextSignalResponse)(nil),     // 17: autokitteh.user_code.v1.NextSignalResponse
-	(*LogRequest)(nil),             // 18: autokitteh.user_code.v1.LogRequest
-	(*LogResponse)(nil),            // 19: autokitteh.user_code.v1.LogResponse
-	(*PrintRequest)(nil),           // 20: autokitteh.user_code.v1.PrintRequest
-	(*PrintResponse)(nil),          // 21: autokitteh.user_code.v1.PrintResponse
-	(*StoreListRequest)(nil),       // 22: autokitteh.user_code.v1.StoreListRequest
-	(*StoreListResponse)(nil),      // 23: autokitteh.user_code.v1.StoreListResponse
-	(*StoreMutateRequest)(nil),     // 24: autokitteh.user_code.v1.StoreMutateRequest
-	(*StoreMutateResponse)(nil),    // 25: autokitteh.user_code.v1.StoreMutateResponse
-	(*StorePublishRequest)(nil),    // 26: autokitteh.user_code.v1.StorePublishRequest
-	(*StorePublishResponse)(nil),   // 27: autokitteh.user_code.v1.StorePublishResponse
-	(*StoreUnpublishRequest)(nil),  // 28: autokitteh.user_code.v1.StoreUnpublishRequest
-	(*StoreUnpublishResponse)(nil), // 29: autokitteh.user_code.v1.StoreUnpublishResponse
-	(*OutcomeRequest)(nil),         // 30: autokitteh.user_code.v1.OutcomeRequest
-	(*OutcomeResponse)(nil),        // 31: autokitteh.user_code.v1.OutcomeResponse
-	(*StartSessionRequest)(nil),    // 32: autokitteh.user_code.v1.StartSessionRequest
-	(*StartSessionResponse)(nil),   // 33: autokitteh.user_code.v1.StartSessionResponse
-	(*EncodeJWTRequest)(nil),       // 34: autokitteh.user_code.v1.EncodeJWTRequest
-	(*EncodeJWTResponse)(nil),      // 35: autokitteh.user_code.v1.EncodeJWTResponse
-	(*RefreshRequest)(nil),         // 36: autokitteh.user_code.v1.RefreshRequest
-	(*RefreshResponse)(nil),        // 37: autokitteh.user_code.v1.RefreshResponse
-	(*IsActiveRunnerRequest)(nil),  // 38: autokitteh.user_code.v1.IsActiveRunnerRequest
-	(*IsActiveRunnerResponse)(nil), // 39: autokitteh.user_code.v1.IsActiveRunnerResponse
-	(*HandlerHealthRequest)(nil),   // 40: autokitteh.user_code.v1.HandlerHealthRequest
-	(*HandlerHealthResponse)(nil),  // 41: autokitteh.user_code.v1.HandlerHealthResponse
-	(*ExecuteReplyRequest)(nil),    // 42: autokitteh.user_code.v1.ExecuteReplyRequest
-	(*ExecuteReplyResponse)(nil),   // 43: autokitteh.user_code.v1.ExecuteReplyResponse
-	nil,                            // 44: autokitteh.user_code.v1.CallInfo.KwargsEntry
-	nil,                            // 45: autokitteh.user_code.v1.EncodeJWTRequest.PayloadEntry
-	(*v1.Value)(nil),               // 46: autokitteh.values.v1.Value
-	(*Frame)(nil),                  // 47: autokitteh.user_code.v1.Frame
-	(*Event)(nil),                  // 48: autokitteh.user_code.v1.Event
-	(*timestamppb.Timestamp)(nil),  // 49: google.protobuf.Timestamp
+	(*CallInfo)(nil),                     // 0: autokitteh.user_code.v1.CallInfo
+	(*ActivityRequest)(nil),              // 1: autokitteh.user_code.v1.ActivityRequest
+	(*ActivityResponse)(nil),             // 2: autokitteh.user_code.v1.ActivityResponse
+	(*DoneRequest)(nil),                  // 3: autokitteh.user_code.v1.DoneRequest
+	(*DoneResponse)(nil),                 // 4: autokitteh.user_code.v1.DoneResponse
+	(*SleepRequest)(nil),                 // 5: autokitteh.user_code.v1.SleepRequest
+	(*SleepResponse)(nil),                // 6: autokitteh.user_code.v1.SleepResponse
+	(*SubscribeRequest)(nil),             // 7: autokitteh.user_code.v1.SubscribeRequest
+	(*SubscribeResponse)(nil),            // 8: autokitteh.user_code.v1.SubscribeResponse
+	(*NextEventRequest)(nil),             // 9: autokitteh.user_code.v1.NextEventRequest
+	(*NextEventResponse)(nil),            // 10: autokitteh.user_code.v1.NextEventResponse
+	(*UnsubscribeRequest)(nil),           // 11: autokitteh.user_code.v1.UnsubscribeRequest
+	(*UnsubscribeResponse)(nil),          // 12: autokitteh.user_code.v1.UnsubscribeResponse
+	(*Signal)(nil),                       // 13: autokitteh.user_code.v1.Signal
+	(*SignalRequest)(nil),                // 14: autokitteh.user_code.v1.SignalRequest
+	(*SignalResponse)(nil),               // 15: autokitteh.user_code.v1.SignalResponse
+	(*NextSignalRequest)(nil),            // 16: autokitteh.user_code.v1.NextSignalRequest
+	(*NextSignalResponse)(nil),           // 17: autokitteh.user_code.v1.NextSignalResponse
+	(*LogRequest)(nil),                   // 18: autokitteh.user_code.v1.LogRequest
+	(*LogResponse)(nil),                  // 19: autokitteh.user_code.v1.LogResponse
+	(*PrintRequest)(nil),                 // 20: autokitteh.user_code.v1.PrintRequest
+	(*PrintResponse)(nil),                // 21: autokitteh.user_code.v1.PrintResponse
+	(*StoreListRequest)(nil),             // 22: autokitteh.user_code.v1.StoreListRequest
+	(*StoreListResponse)(nil),            // 23: autokitteh.user_code.v1.StoreListResponse
+	(*StoreMutateRequest)(nil),           // 24: autokitteh.user_code.v1.StoreMutateRequest
+	(*StoreMutateResponse)(nil),          // 25: autokitteh.user_code.v1.StoreMutateResponse
+	(*StorePublishRequest)(nil),          // 26: autokitteh.user_code.v1.StorePublishRequest
+	(*StorePublishResponse)(nil),         // 27: autokitteh.user_code.v1.StorePublishResponse
+	(*StoreUnpublishRequest)(nil),        // 28: autokitteh.user_code.v1.StoreUnpublishRequest
+	(*StoreUnpublishResponse)(nil),       // 29: autokitteh.user_code.v1.StoreUnpublishResponse
+	(*OutcomeRequest)(nil),               // 30: autokitteh.user_code.v1.OutcomeRequest
+	(*OutcomeResponse)(nil),              // 31: autokitteh.user_code.v1.OutcomeResponse
+	(*SetSessionTagRequest)(nil),         // 32: autokitteh.user_code.v1.SetSessionTagRequest
+	(*SetSessionTagResponse)(nil),        // 33: autokitteh.user_code.v1.SetSessionTagResponse
+	(*RequestApprovalRequest)(nil),       // 34: autokitteh.user_code.v1.RequestApprovalRequest
+	(*RequestApprovalResponse)(nil),      // 35: autokitteh.user_code.v1.RequestApprovalResponse
+	(*RegisterCompensationRequest)(nil),  // 36: autokitteh.user_code.v1.RegisterCompensationRequest
+	(*RegisterCompensationResponse)(nil), // 37: autokitteh.user_code.v1.RegisterCompensationResponse
+	(*StartSessionRequest)(nil),          // 38: autokitteh.user_code.v1.StartSessionRequest
+	(*StartSessionResponse)(nil),         // 39: autokitteh.user_code.v1.StartSessionResponse
+	(*WaitSessionsRequest)(nil),          // 40: autokitteh.user_code.v1.WaitSessionsRequest
+	(*SessionResult)(nil),                // 41: autokitteh.user_code.v1.SessionResult
+	(*WaitSessionsResponse)(nil),         // 42: autokitteh.user_code.v1.WaitSessionsResponse
+	(*EncodeJWTRequest)(nil),             // 43: autokitteh.user_code.v1.EncodeJWTRequest
+	(*EncodeJWTResponse)(nil),            // 44: autokitteh.user_code.v1.EncodeJWTResponse
+	(*RefreshRequest)(nil),               // 45: autokitteh.user_code.v1.RefreshRequest
+	(*RefreshResponse)(nil),              // 46: autokitteh.user_code.v1.RefreshResponse
+	(*IsActiveRunnerRequest)(nil),        // 47: autokitteh.user_code.v1.IsActiveRunnerRequest
+	(*IsActiveRunnerResponse)(nil),       // 48: autokitteh.user_code.v1.IsActiveRunnerResponse
+	(*HandlerHealthRequest)(nil),         // 49: autokitteh.user_code.v1.HandlerHealthRequest
+	(*HandlerHealthResponse)(nil),        // 50: autokitteh.user_code.v1.HandlerHealthResponse
+	(*ExecuteReplyRequest)(nil),          // 51: autokitteh.user_code.v1.ExecuteReplyRequest
+	(*ExecuteReplyResponse)(nil),         // 52: autokitteh.user_code.v1.ExecuteReplyResponse
+	nil,                                  // 53: autokitteh.user_code.v1.CallInfo.KwargsEntry
+	nil,                                  // 54: autokitteh.user_code.v1.EncodeJWTRequest.PayloadEntry
+	(*v1.Value)(nil),                     // 55: autokitteh.values.v1.Value
+	(*Frame)(nil),                        // 56: autokitteh.user_code.v1.Frame
+	(*Event)(nil),                        // 57: autokitteh.user_code.v1.Event
+	(*timestamppb.Timestamp)(nil),        // 58: google.protobuf.Timestamp
 }
 var file_autokitteh_user_code_v1_handler_svc_proto_depIdxs = []int32{
-	46, // 0: autokitteh.user_code.v1.CallInfo.args:type_name -> autokitteh.values.v1.Value
-	44, // 1: autokitteh.user_code.v1.CallInfo.kwargs:type_name -> autokitteh.user_code.v1.CallInfo.KwargsEntry
+	55, // 0: autokitteh.user_code.v1.CallInfo.args:type_name -> autokitteh.values.v1.Value
+	53, // 1: autokitteh.user_code.v1.CallInfo.kwargs:type_name -> autokitteh.user_code.v1.CallInfo.KwargsEntry
 	0,  // 2: autokitteh.user_code.v1.ActivityRequest.call_info:type_name -> autokitteh.user_code.v1.CallInfo
-	46, // 3: autokitteh.user_code.v1.DoneRequest.result:type_name -> autokitteh.values.v1.Value
-	47, // 4: autokitteh.user_code.v1.DoneRequest.traceback:type_name -> autokitteh.user_code.v1.Frame
-	48, // 5: autokitteh.user_code.v1.NextEventResponse.event:type_name -> autokitteh.user_code.v1.Event
-	46, // 6: autokitteh.user_code.v1.Signal.payload:type_name -> autokitteh.values.v1.Value
+	55, // 3: autokitteh.user_code.v1.DoneRequest.result:type_name -> autokitteh.values.v1.Value
+	56, // 4: autokitteh.user_code.v1.DoneRequest.traceback:type_name -> autokitteh.user_code.v1.Frame
+	57, // 5: autokitteh.user_code.v1.NextEventResponse.event:type_name -> autokitteh.user_code.v1.Event
+	55, // 6: autokitteh.user_code.v1.Signal.payload:type_name -> autokitteh.values.v1.Value
 	13, // 7: autokitteh.user_code.v1.SignalRequest.signal:type_name -> autokitteh.user_code.v1.Signal
 	13, // 8: autokitteh.user_code.v1.NextSignalResponse.signal:type_name -> autokitteh.user_code.v1.Signal
-	46, // 9: autokitteh.user_code.v1.StoreMutateRequest.operands:type_name -> autokitteh.values.v1.Value
-	46, // 10: autokitteh.user_code.v1.StoreMutateResponse.result:type_name -> autokitteh.values.v1.Value
-	46, // 11: autokitteh.user_code.v1.OutcomeRequest.value:type_name -> autokitteh.values.v1.Value
-	45, // 12: autokitteh.user_code.v1.EncodeJWTRequest.payload:type_name -> autokitteh.user_code.v1.EncodeJWTRequest.PayloadEntry
-	49, // 13: autokitteh.user_code.v1.RefreshResponse.expires:type_name -> google.protobuf.Timestamp
-	46, // 14: autokitteh.user_code.v1.ExecuteReplyRequest.result:type_name -> autokitteh.values.v1.Value
-	47, // 15: autokitteh.user_code.v1.ExecuteReplyRequest.traceback:type_name -> autokitteh.user_code.v1.Frame
-	46, // 16: autokitteh.user_code.v1.CallInfo.KwargsEntry.value:type_name -> autokitteh.values.v1.Value
-	1,  // 17: autokitteh.user_code.v1.HandlerService.Activity:input_type -> autokitteh.user_code.v1.ActivityRequest
-	42, // 18: autokitteh.user_code.v1.HandlerService.ExecuteReply:input_type -> autokitteh.user_code.v1.ExecuteReplyRequest
-	3,  // 19: autokitteh.user_code.v1.HandlerService.Done:input_type -> autokitteh.user_code.v1.DoneRequest
-	18, // 20: autokitteh.user_code.v1.HandlerService.Log:input_type -> autokitteh.user_code.v1.LogRequest
-	20, // 21: autokitteh.user_code.v1.HandlerService.Print:input_type -> autokitteh.user_code.v1.PrintRequest
-	5,  // 22: autokitteh.user_code.v1.HandlerService.Sleep:input_type -> autokitteh.user_code.v1.SleepRequest
-	7,  // 23: autokitteh.user_code.v1.HandlerService.Subscribe:input_type -> autokitteh.user_code.v1.SubscribeRequest
-	9,  // 24: autokitteh.user_code.v1.HandlerService.NextEvent:input_type -> autokitteh.user_code.v1.NextEventRequest
-	11, // 25: autokitteh.user_code.v1.HandlerService.Unsubscribe:input_type -> autokitteh.user_code.v1.UnsubscribeRequest
-	32, // 26: autokitteh.user_code.v1.HandlerService.StartSession:input_type -> autokitteh.user_code.v1.StartSessionRequest
-	14, // 27: autokitteh.user_code.v1.HandlerService.Signal:input_type -> autokitteh.user_code.v1.SignalRequest
-	16, // 28: autokitteh.user_code.v1.HandlerService.NextSignal:input_type -> autokitteh.user_code.v1.NextSignalRequest
-	22, // 29: autokitteh.user_code.v1.HandlerService.StoreList:input_type -> autokitteh.user_code.v1.StoreListRequest
-	24, // 30: autokitteh.user_code.v1.HandlerService.StoreMutate:input_type -> autokitteh.user_code.v1.StoreMutateRequest
-	26, // 31: autokitteh.user_code.v1.HandlerService.StorePublish:input_type -> autokitteh.user_code.v1.StorePublishRequest
-	28, // 32: autokitteh.user_code.v1.HandlerService.StoreUnpublish:input_type -> autokitteh.user_code.v1.StoreUnpublishRequest
-	30, // 33: autokitteh.user_code.v1.HandlerService.Outcome:input_type -> autokitteh.user_code.v1.OutcomeRequest
-	34, // 34: autokitteh.user_code.v1.HandlerService.EncodeJWT:input_type -> autokitteh.user_code.v1.EncodeJWTRequest
-	36, // 35: autokitteh.user_code.v1.HandlerService.RefreshOAuthToken:input_type -> autokitteh.user_code.v1.RefreshRequest
-	40, // 36: autokitteh.user_code.v1.HandlerService.Health:input_type -> autokitteh.user_code.v1.HandlerHealthRequest
-	38, // 37: autokitteh.user_code.v1.HandlerService.IsActiveRunner:input_type -> autokitteh.user_code.v1.IsActiveRunnerRequest
-	2,  // 38: autokitteh.user_code.v1.HandlerService.Activity:output_type -> autokitteh.user_code.v1.ActivityResponse
-	43, // 39: autokitteh.user_code.v1.HandlerService.ExecuteReply:output_type -> autokitteh.user_code.v1.ExecuteReplyResponse
-	4,  // 40: autokitteh.user_code.v1.HandlerService.Done:output_type -> autokitteh.user_code.v1.DoneResponse
-	19, // 41: autokitteh.user_code.v1.HandlerService.Log:output_type -> autokitteh.user_code.v1.LogResponse
-	21, // 42: autokitteh.user_code.v1.HandlerService.Print:output_type -> autokitteh.user_code.v1.PrintResponse
-	6,  // 43: autokitteh.user_code.v1.HandlerService.Sleep:output_type -> autokitteh.user_code.v1.SleepResponse
-	8,  // 44: autokitteh.user_code.v1.HandlerService.Subscribe:output_type -> autokitteh.user_code.v1.SubscribeResponse
-	10, // 45: autokitteh.user_code.v1.HandlerService.NextEvent:output_type -> autokitteh.user_code.v1.NextEventResponse
-	12, // 46: autokitteh.user_code.v1.HandlerService.Unsubscribe:output_type -> autokitteh.user_code.v1.UnsubscribeResponse
-	33, // 47: autokitteh.user_code.v1.HandlerService.StartSession:output_type -> autokitteh.user_code.v1.StartSessionResponse
-	15, // 48: autokitteh.user_code.v1.HandlerService.Signal:output_type -> autokitteh.user_code.v1.SignalResponse
-	17, // 49: autokitteh.user_code.v1.HandlerService.NextSignal:output_type -> autokitteh.user_code.v1.NextSignalResponse
-	23, // 50: autokitteh.user_code.v1.HandlerService.StoreList:output_type -> autokitteh.user_code.v1.StoreListResponse
-	25, // 51: autokitteh.user_code.v1.HandlerService.StoreMutate:output_type -> autokitteh.user_code.v1.StoreMutateResponse
-	27, // 52: autokitteh.user_code.v1.HandlerService.StorePublish:output_type -> autokitteh.user_code.v1.StorePublishResponse
-	29, // 53: autokitteh.user_code.v1.HandlerService.StoreUnpublish:output_type -> autokitteh.user_code.v1.StoreUnpublishResponse
-	31, // 54: autokitteh.user_code.v1.HandlerService.Outcome:output_type -> autokitteh.user_code.v1.OutcomeResponse
-	35, // 55: autokitteh.user_code.v1.HandlerService.EncodeJWT:output_type -> autokitteh.user_code.v1.EncodeJWTResponse
-	37, // 56: autokitteh.user_code.v1.HandlerService.RefreshOAuthToken:output_type -> autokitteh.user_code.v1.RefreshResponse
-	41, // 57: autokitteh.user_code.v1.HandlerService.Health:output_type -> autokitteh.user_code.v1.HandlerHealthResponse
-	39, // 58: autokitteh.user_code.v1.HandlerService.IsActiveRunner:output_type -> autokitteh.user_code.v1.IsActiveRunnerResponse
-	38, // [38:59] is the sub-list for method output_type
-	17, // [17:38] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	55, // 9: autokitteh.user_code.v1.StoreMutateRequest.operands:type_name -> autokitteh.values.v1.Value
+	55, // 10: autokitteh.user_code.v1.StoreMutateResponse.result:type_name -> autokitteh.values.v1.Value
+	55, // 11: autokitteh.user_code.v1.OutcomeRequest.value:type_name -> autokitteh.values.v1.Value
+	55, // 12: autokitteh.user_code.v1.SessionResult.value:type_name -> autokitteh.values.v1.Value
+	41, // 13: autokitteh.user_code.v1.WaitSessionsResponse.results:type_name -> autokitteh.user_code.v1.SessionResult
+	54, // 14: autokitteh.user_code.v1.EncodeJWTRequest.payload:type_name -> autokitteh.user_code.v1.EncodeJWTRequest.PayloadEntry
+	58, // 15: autokitteh.user_code.v1.RefreshResponse.expires:type_name -> google.protobuf.Timestamp
+	55, // 16: autokitteh.user_code.v1.ExecuteReplyRequest.result:type_name -> autokitteh.values.v1.Value
+	56, // 17: autokitteh.user_code.v1.ExecuteReplyRequest.traceback:type_name -> autokitteh.user_code.v1.Frame
+	55, // 18: autokitteh.user_code.v1.CallInfo.KwargsEntry.value:type_name -> autokitteh.values.v1.Value
+	1,  // 19: autokitteh.user_code.v1.HandlerService.Activity:input_type -> autokitteh.user_code.v1.ActivityRequest
+	51, // 20: autokitteh.user_code.v1.HandlerService.ExecuteReply:input_type -> autokitteh.user_code.v1.ExecuteReplyRequest
+	3,  // 21: autokitteh.user_code.v1.HandlerService.Done:input_type -> autokitteh.user_code.v1.DoneRequest
+	18, // 22: autokitteh.user_code.v1.HandlerService.Log:input_type -> autokitteh.user_code.v1.LogRequest
+	20, // 23: autokitteh.user_code.v1.HandlerService.Print:input_type -> autokitteh.user_code.v1.PrintRequest
+	5,  // 24: autokitteh.user_code.v1.HandlerService.Sleep:input_type -> autokitteh.user_code.v1.SleepRequest
+	7,  // 25: autokitteh.user_code.v1.HandlerService.Subscribe:input_type -> autokitteh.user_code.v1.SubscribeRequest
+	9,  // 26: autokitteh.user_code.v1.HandlerService.NextEvent:input_type -> autokitteh.user_code.v1.NextEventRequest
+	11, // 27: autokitteh.user_code.v1.HandlerService.Unsubscribe:input_type -> autokitteh.user_code.v1.UnsubscribeRequest
+	38, // 28: autokitteh.user_code.v1.HandlerService.StartSession:input_type -> autokitteh.user_code.v1.StartSessionRequest
+	40, // 29: autokitteh.user_code.v1.HandlerService.WaitSessions:input_type -> autokitteh.user_code.v1.WaitSessionsRequest
+	14, // 30: autokitteh.user_code.v1.HandlerService.Signal:input_type -> autokitteh.user_code.v1.SignalRequest
+	16, // 31: autokitteh.user_code.v1.HandlerService.NextSignal:input_type -> autokitteh.user_code.v1.NextSignalRequest
+	22, // 32: autokitteh.user_code.v1.HandlerService.StoreList:input_type -> autokitteh.user_code.v1.StoreListRequest
+	24, // 33: autokitteh.user_code.v1.HandlerService.StoreMutate:input_type -> autokitteh.user_code.v1.StoreMutateRequest
+	26, // 34: autokitteh.user_code.v1.HandlerService.StorePublish:input_type -> autokitteh.user_code.v1.StorePublishRequest
+	28, // 35: autokitteh.user_code.v1.HandlerService.StoreUnpublish:input_type -> autokitteh.user_code.v1.StoreUnpublishRequest
+	30, // 36: autokitteh.user_code.v1.HandlerService.Outcome:input_type -> autokitteh.user_code.v1.OutcomeRequest
+	32, // 37: autokitteh.user_code.v1.HandlerService.SetSessionTag:input_type -> autokitteh.user_code.v1.SetSessionTagRequest
+	34, // 38: autokitteh.user_code.v1.HandlerService.RequestApproval:input_type -> autokitteh.user_code.v1.RequestApprovalRequest
+	36, // 39: autokitteh.user_code.v1.HandlerService.RegisterCompensation:input_type -> autokitteh.user_code.v1.RegisterCompensationRequest
+	43, // 40: autokitteh.user_code.v1.HandlerService.EncodeJWT:input_type -> autokitteh.user_code.v1.EncodeJWTRequest
+	45, // 41: autokitteh.user_code.v1.HandlerService.RefreshOAuthToken:input_type -> autokitteh.user_code.v1.RefreshRequest
+	49, // 42: autokitteh.user_code.v1.HandlerService.Health:input_type -> autokitteh.user_code.v1.HandlerHealthRequest
+	47, // 43: autokitteh.user_code.v1.HandlerService.IsActiveRunner:input_type -> autokitteh.user_code.v1.IsActiveRunnerRequest
+	2,  // 44: autokitteh.user_code.v1.HandlerService.Activity:output_type -> autokitteh.user_code.v1.ActivityResponse
+	52, // 45: autokitteh.user_code.v1.HandlerService.ExecuteReply:output_type -> autokitteh.user_code.v1.ExecuteReplyResponse
+	4,  // 46: autokitteh.user_code.v1.HandlerService.Done:output_type -> autokitteh.user_code.v1.DoneResponse
+	19, // 47: autokitteh.user_code.v1.HandlerService.Log:output_type -> autokitteh.user_code.v1.LogResponse
+	21, // 48: autokitteh.user_code.v1.HandlerService.Print:output_type -> autokitteh.user_code.v1.PrintResponse
+	6,  // 49: autokitteh.user_code.v1.HandlerService.Sleep:output_type -> autokitteh.user_code.v1.SleepResponse
+	8,  // 50: autokitteh.user_code.v1.HandlerService.Subscribe:output_type -> autokitteh.user_code.v1.SubscribeResponse
+	10, // 51: autokitteh.user_code.v1.HandlerService.NextEvent:output_type -> autokitteh.user_code.v1.NextEventResponse
+	12, // 52: autokitteh.user_code.v1.HandlerService.Unsubscribe:output_type -> autokitteh.user_code.v1.UnsubscribeResponse
+	39, // 53: autokitteh.user_code.v1.HandlerService.StartSession:output_type -> autokitteh.user_code.v1.StartSessionResponse
+	42, // 54: autokitteh.user_code.v1.HandlerService.WaitSessions:output_type -> autokitteh.user_code.v1.WaitSessionsResponse
+	15, // 55: autokitteh.user_code.v1.HandlerService.Signal:output_type -> autokitteh.user_code.v1.SignalResponse
+	17, // 56: autokitteh.user_code.v1.HandlerService.NextSignal:output_type -> autokitteh.user_code.v1.NextSignalResponse
+	23, // 57: autokitteh.user_code.v1.HandlerService.StoreList:output_type -> autokitteh.user_code.v1.StoreListResponse
+	25, // 58: autokitteh.user_code.v1.HandlerService.StoreMutate:output_type -> autokitteh.user_code.v1.StoreMutateResponse
+	27, // 59: autokitteh.user_code.v1.HandlerService.StorePublish:output_type -> autokitteh.user_code.v1.StorePublishResponse
+	29, // 60: autokitteh.user_code.v1.HandlerService.StoreUnpublish:output_type -> autokitteh.user_code.v1.StoreUnpublishResponse
+	31, // 61: autokitteh.user_code.v1.HandlerService.Outcome:output_type -> autokitteh.user_code.v1.OutcomeResponse
+	33, // 62: autokitteh.user_code.v1.HandlerService.SetSessionTag:output_type -> autokitteh.user_code.v1.SetSessionTagResponse
+	35, // 63: autokitteh.user_code.v1.HandlerService.RequestApproval:output_type -> autokitteh.user_code.v1.RequestApprovalResponse
+	37, // 64: autokitteh.user_code.v1.HandlerService.RegisterCompensation:output_type -> autokitteh.user_code.v1.RegisterCompensationResponse
+	44, // 65: autokitteh.user_code.v1.HandlerService.EncodeJWT:output_type -> autokitteh.user_code.v1.EncodeJWTResponse
+	46, // 66: autokitteh.user_code.v1.HandlerService.RefreshOAuthToken:output_type -> autokitteh.user_code.v1.RefreshResponse
+	50, // 67: autokitteh.user_code.v1.HandlerService.Health:output_type -> autokitteh.user_code.v1.HandlerHealthResponse
+	48, // 68: autokitteh.user_code.v1.HandlerService.IsActiveRunner:output_type -> autokitteh.user_code.v1.IsActiveRunnerResponse
+	44, // [44:69] is the sub-list for method output_type
+	19, // [19:44] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_autokitteh_user_code_v1_handler_svc_proto_init() }
@@ -3410,7 +4078,7 @@ func file_autokitteh_user_code_v1_handler_svc_proto_init() {
 			}
 		}
 		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartSessionRequest); i {
+			switch v := v.(*SetSessionTagRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3422,7 +4090,7 @@ func file_autokitteh_user_code_v1_handler_svc_proto_init() {
 			}
 		}
 		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartSessionResponse); i {
+			switch v := v.(*SetSessionTagResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3434,7 +4102,7 @@ func file_autokitteh_user_code_v1_handler_svc_proto_init() {
 			}
 		}
 		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncodeJWTRequest); i {
+			switch v := v.(*RequestApprovalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3446,7 +4114,7 @@ func file_autokitteh_user_code_v1_handler_svc_proto_init() {
 			}
 		}
 		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncodeJWTResponse); i {
+			switch v := v.(*RequestApprovalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3458,7 +4126,7 @@ func file_autokitteh_user_code_v1_handler_svc_proto_init() {
 			}
 		}
 		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshRequest); i {
+			switch v := v.(*RegisterCompensationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3470,7 +4138,7 @@ func file_autokitteh_user_code_v1_handler_svc_proto_init() {
 			}
 		}
 		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshResponse); i {
+			switch v := v.(*RegisterCompensationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3482,7 +4150,7 @@ func file_autokitteh_user_code_v1_handler_svc_proto_init() {
 			}
 		}
 		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsActiveRunnerRequest); i {
+			switch v := v.(*StartSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3494,7 +4162,7 @@ func file_autokitteh_user_code_v1_handler_svc_proto_init() {
 			}
 		}
 		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsActiveRunnerResponse); i {
+			switch v := v.(*StartSessionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3506,7 +4174,7 @@ func file_autokitteh_user_code_v1_handler_svc_proto_init() {
 			}
 		}
 		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HandlerHealthRequest); i {
+			switch v := v.(*WaitSessionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3518,7 +4186,7 @@ func file_autokitteh_user_code_v1_handler_svc_proto_init() {
 			}
 		}
 		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HandlerHealthResponse); i {
+			switch v := v.(*SessionResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3530,7 +4198,7 @@ func file_autokitteh_user_code_v1_handler_svc_proto_init() {
 			}
 		}
 		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecuteReplyRequest); i {
+			switch v := v.(*WaitSessionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3542,6 +4210,114 @@ func file_autokitteh_user_code_v1_handler_svc_proto_init() {
 			}
 		}
 		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncodeJWTRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncodeJWTResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsActiveRunnerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsActiveRunnerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandlerHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandlerHealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteReplyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autokitteh_user_code_v1_handler_svc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecuteReplyResponse); i {
 			case 0:
 				return &v.state
@@ -3560,7 +4336,7 @@ func file_autokitteh_user_code_v1_handler_svc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_autokitteh_user_code_v1_handler_svc_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   46,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,27 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	HandlerService_Activity_FullMethodName          = "/autokitteh.user_code.v1.HandlerService/Activity"
-	HandlerService_ExecuteReply_FullMethodName      = "/autokitteh.user_code.v1.HandlerService/ExecuteReply"
-	HandlerService_Done_FullMethodName              = "/autokitteh.user_code.v1.HandlerService/Done"
-	HandlerService_Log_FullMethodName               = "/autokitteh.user_code.v1.HandlerService/Log"
-	HandlerService_Print_FullMethodName             = "/autokitteh.user_code.v1.HandlerService/Print"
-	HandlerService_Sleep_FullMethodName             = "/autokitteh.user_code.v1.HandlerService/Sleep"
-	HandlerService_Subscribe_FullMethodName         = "/autokitteh.user_code.v1.HandlerService/Subscribe"
-	HandlerService_NextEvent_FullMethodName         = "/autokitteh.user_code.v1.HandlerService/NextEvent"
-	HandlerService_Unsubscribe_FullMethodName       = "/autokitteh.user_code.v1.HandlerService/Unsubscribe"
-	HandlerService_StartSession_FullMethodName      = "/autokitteh.user_code.v1.HandlerService/StartSession"
-	HandlerService_Signal_FullMethodName            = "/autokitteh.user_code.v1.HandlerService/Signal"
-	HandlerService_NextSignal_FullMethodName        = "/autokitteh.user_code.v1.HandlerService/NextSignal"
-	HandlerService_StoreList_FullMethodName         = "/autokitteh.user_code.v1.HandlerService/StoreList"
-	HandlerService_StoreMutate_FullMethodName       = "/autokitteh.user_code.v1.HandlerService/StoreMutate"
-	HandlerService_StorePublish_FullMethodName      = "/autokitteh.user_code.v1.HandlerService/StorePublish"
-	HandlerService_StoreUnpublish_FullMethodName    = "/autokitteh.user_code.v1.HandlerService/StoreUnpublish"
-	HandlerService_Outcome_FullMethodName           = "/autokitteh.user_code.v1.HandlerService/Outcome"
-	HandlerService_EncodeJWT_FullMethodName         = "/autokitteh.user_code.v1.HandlerService/EncodeJWT"
-	HandlerService_RefreshOAuthToken_FullMethodName = "/autokitteh.user_code.v1.HandlerService/RefreshOAuthToken"
-	HandlerService_Health_FullMethodName            = "/autokitteh.user_code.v1.HandlerService/Health"
-	HandlerService_IsActiveRunner_FullMethodName    = "/autokitteh.user_code.v1.HandlerService/IsActiveRunner"
+	HandlerService_Activity_FullMethodName             = "/autokitteh.user_code.v1.HandlerService/Activity"
+	HandlerService_ExecuteReply_FullMethodName         = "/autokitteh.user_code.v1.HandlerService/ExecuteReply"
+	HandlerService_Done_FullMethodName                 = "/autokitteh.user_code.v1.HandlerService/Done"
+	HandlerService_Log_FullMethodName                  = "/autokitteh.user_code.v1.HandlerService/Log"
+	HandlerService_Print_FullMethodName                = "/autokitteh.user_code.v1.HandlerService/Print"
+	HandlerService_Sleep_FullMethodName                = "/autokitteh.user_code.v1.HandlerService/Sleep"
+	HandlerService_Subscribe_FullMethodName            = "/autokitteh.user_code.v1.HandlerService/Subscribe"
+	HandlerService_NextEvent_FullMethodName            = "/autokitteh.user_code.v1.HandlerService/NextEvent"
+	HandlerService_Unsubscribe_FullMethodName          = "/autokitteh.user_code.v1.HandlerService/Unsubscribe"
+	HandlerService_StartSession_FullMethodName         = "/autokitteh.user_code.v1.HandlerService/StartSession"
+	HandlerService_WaitSessions_FullMethodName         = "/autokitteh.user_code.v1.HandlerService/WaitSessions"
+	HandlerService_Signal_FullMethodName               = "/autokitteh.user_code.v1.HandlerService/Signal"
+	HandlerService_NextSignal_FullMethodName           = "/autokitteh.user_code.v1.HandlerService/NextSignal"
+	HandlerService_StoreList_FullMethodName            = "/autokitteh.user_code.v1.HandlerService/StoreList"
+	HandlerService_StoreMutate_FullMethodName          = "/autokitteh.user_code.v1.HandlerService/StoreMutate"
+	HandlerService_StorePublish_FullMethodName         = "/autokitteh.user_code.v1.HandlerService/StorePublish"
+	HandlerService_StoreUnpublish_FullMethodName       = "/autokitteh.user_code.v1.HandlerService/StoreUnpublish"
+	HandlerService_Outcome_FullMethodName              = "/autokitteh.user_code.v1.HandlerService/Outcome"
+	HandlerService_SetSessionTag_FullMethodName        = "/autokitteh.user_code.v1.HandlerService/SetSessionTag"
+	HandlerService_RequestApproval_FullMethodName      = "/autokitteh.user_code.v1.HandlerService/RequestApproval"
+	HandlerService_RegisterCompensation_FullMethodName = "/autokitteh.user_code.v1.HandlerService/RegisterCompensation"
+	HandlerService_EncodeJWT_FullMethodName            = "/autokitteh.user_code.v1.HandlerService/EncodeJWT"
+	HandlerService_RefreshOAuthToken_FullMethodName    = "/autokitteh.user_code.v1.HandlerService/RefreshOAuthToken"
+	HandlerService_Health_FullMethodName               = "/autokitteh.user_code.v1.HandlerService/Health"
+	HandlerService_IsActiveRunner_FullMethodName       = "/autokitteh.user_code.v1.HandlerService/IsActiveRunner"
 )
 
 // HandlerServiceClient is the client API for HandlerService service.
@@ -62,6 +66,7 @@ type HandlerServiceClient interface {
 	NextEvent(ctx context.Context, in *NextEventRequest, opts ...grpc.CallOption) (*NextEventResponse, error)
 	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error)
 	StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (*StartSessionResponse, error)
+	WaitSessions(ctx context.Context, in *WaitSessionsRequest, opts ...grpc.CallOption) (*WaitSessionsResponse, error)
 	Signal(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*SignalResponse, error)
 	NextSignal(ctx context.Context, in *NextSignalRequest, opts ...grpc.CallOption) (*NextSignalResponse, error)
 	StoreList(ctx context.Context, in *StoreListRequest, opts ...grpc.CallOption) (*StoreListResponse, error)
@@ -69,6 +74,9 @@ type HandlerServiceClient interface {
 	StorePublish(ctx context.Context, in *StorePublishRequest, opts ...grpc.CallOption) (*StorePublishResponse, error)
 	StoreUnpublish(ctx context.Context, in *StoreUnpublishRequest, opts ...grpc.CallOption) (*StoreUnpublishResponse, error)
 	Outcome(ctx context.Context, in *OutcomeRequest, opts ...grpc.CallOption) (*OutcomeResponse, error)
+	SetSessionTag(ctx context.Context, in *SetSessionTagRequest, opts ...grpc.CallOption) (*SetSessionTagResponse, error)
+	RequestApproval(ctx context.Context, in *RequestApprovalRequest, opts ...grpc.CallOption) (*RequestApprovalResponse, error)
+	RegisterCompensation(ctx context.Context, in *RegisterCompensationRequest, opts ...grpc.CallOption) (*RegisterCompensationResponse, error)
 	// Utility functions
 	EncodeJWT(ctx context.Context, in *EncodeJWTRequest, opts ...grpc.CallOption) (*EncodeJWTResponse, error)
 	RefreshOAuthToken(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error)
@@ -184,6 +192,16 @@ func (c *handlerServiceClient) StartSession(ctx context.Context, in *StartSessio
 	return out, nil
 }
 
+func (c *handlerServiceClient) WaitSessions(ctx context.Context, in *WaitSessionsRequest, opts ...grpc.CallOption) (*WaitSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitSessionsResponse)
+	err := c.cc.Invoke(ctx, HandlerService_WaitSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *handlerServiceClient) Signal(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*SignalResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SignalResponse)
@@ -254,6 +272,36 @@ func (c *handlerServiceClient) Outcome(ctx context.Context, in *OutcomeRequest,
 	return out, nil
 }
 
+func (c *handlerServiceClient) SetSessionTag(ctx context.Context, in *SetSessionTagRequest, opts ...grpc.CallOption) (*SetSessionTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSessionTagResponse)
+	err := c.cc.Invoke(ctx, HandlerService_SetSessionTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *handlerServiceClient) RequestApproval(ctx context.Context, in *RequestApprovalRequest, opts ...grpc.CallOption) (*RequestApprovalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestApprovalResponse)
+	err := c.cc.Invoke(ctx, HandlerService_RequestApproval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *handlerServiceClient) RegisterCompensation(ctx context.Context, in *RegisterCompensationRequest, opts ...grpc.CallOption) (*RegisterCompensationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterCompensationResponse)
+	err := c.cc.Invoke(ctx, HandlerService_RegisterCompensation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *handlerServiceClient) EncodeJWT(ctx context.Context, in *EncodeJWTRequest, opts ...grpc.CallOption) (*EncodeJWTResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EncodeJWTResponse)
@@ -314,6 +362,7 @@ type HandlerServiceServer interface {
 	NextEvent(context.Context, *NextEventRequest) (*NextEventResponse, error)
 	Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error)
 	StartSession(context.Context, *StartSessionRequest) (*StartSessionResponse, error)
+	WaitSessions(context.Context, *WaitSessionsRequest) (*WaitSessionsResponse, error)
 	Signal(context.Context, *SignalRequest) (*SignalResponse, error)
 	NextSignal(context.Context, *NextSignalRequest) (*NextSignalResponse, error)
 	StoreList(context.Context, *StoreListRequest) (*StoreListResponse, error)
@@ -321,6 +370,9 @@ type HandlerServiceServer interface {
 	StorePublish(context.Context, *StorePublishRequest) (*StorePublishResponse, error)
 	StoreUnpublish(context.Context, *StoreUnpublishRequest) (*StoreUnpublishResponse, error)
 	Outcome(context.Context, *OutcomeRequest) (*OutcomeResponse, error)
+	SetSessionTag(context.Context, *SetSessionTagRequest) (*SetSessionTagResponse, error)
+	RequestApproval(context.Context, *RequestApprovalRequest) (*RequestApprovalResponse, error)
+	RegisterCompensation(context.Context, *RegisterCompensationRequest) (*RegisterCompensationResponse, error)
 	// Utility functions
 	EncodeJWT(context.Context, *EncodeJWTRequest) (*EncodeJWTResponse, error)
 	RefreshOAuthToken(context.Context, *RefreshRequest) (*RefreshResponse, error)
@@ -366,6 +418,9 @@ func (UnimplementedHandlerServiceServer) Unsubscribe(context.Context, *Unsubscri
 func (UnimplementedHandlerServiceServer) StartSession(context.Context, *StartSessionRequest) (*StartSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartSession not implemented")
 }
+func (UnimplementedHandlerServiceServer) WaitSessions(context.Context, *WaitSessionsRequest) (*WaitSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitSessions not implemented")
+}
 func (UnimplementedHandlerServiceServer) Signal(context.Context, *SignalRequest) (*SignalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Signal not implemented")
 }
@@ -387,6 +442,15 @@ func (UnimplementedHandlerServiceServer) StoreUnpublish(context.Context, *StoreU
 func (UnimplementedHandlerServiceServer) Outcome(context.Context, *OutcomeRequest) (*OutcomeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Outcome not implemented")
 }
+func (UnimplementedHandlerServiceServer) SetSessionTag(context.Context, *SetSessionTagRequest) (*SetSessionTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSessionTag not implemented")
+}
+func (UnimplementedHandlerServiceServer) RequestApproval(context.Context, *RequestApprovalRequest) (*RequestApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestApproval not implemented")
+}
+func (UnimplementedHandlerServiceServer) RegisterCompensation(context.Context, *RegisterCompensationRequest) (*RegisterCompensationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCompensation not implemented")
+}
 func (UnimplementedHandlerServiceServer) EncodeJWT(context.Context, *EncodeJWTRequest) (*EncodeJWTResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EncodeJWT not implemented")
 }
@@ -600,6 +664,24 @@ func _HandlerService_StartSession_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HandlerService_WaitSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerServiceServer).WaitSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HandlerService_WaitSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerServiceServer).WaitSessions(ctx, req.(*WaitSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HandlerService_Signal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignalRequest)
 	if err := dec(in); err != nil {
@@ -726,6 +808,60 @@ func _HandlerService_Outcome_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HandlerService_SetSessionTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSessionTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerServiceServer).SetSessionTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HandlerService_SetSessionTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerServiceServer).SetSessionTag(ctx, req.(*SetSessionTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HandlerService_RequestApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerServiceServer).RequestApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HandlerService_RequestApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerServiceServer).RequestApproval(ctx, req.(*RequestApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HandlerService_RegisterCompensation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterCompensationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerServiceServer).RegisterCompensation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HandlerService_RegisterCompensation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerServiceServer).RegisterCompensation(ctx, req.(*RegisterCompensationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HandlerService_EncodeJWT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EncodeJWTRequest)
 	if err := dec(in); err != nil {
@@ -845,6 +981,10 @@ var HandlerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StartSession",
 			Handler:    _HandlerService_StartSession_Handler,
 		},
+		{
+			MethodName: "WaitSessions",
+			Handler:    _HandlerService_WaitSessions_Handler,
+		},
 		{
 			MethodName: "Signal",
 			Handler:    _HandlerService_Signal_Handler,
@@ -873,6 +1013,18 @@ var HandlerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Outcome",
 			Handler:    _HandlerService_Outcome_Handler,
 		},
+		{
+			MethodName: "SetSessionTag",
+			Handler:    _HandlerService_SetSessionTag_Handler,
+		},
+		{
+			MethodName: "RequestApproval",
+			Handler:    _HandlerService_RequestApproval_Handler,
+		},
+		{
+			MethodName: "RegisterCompensation",
+			Handler:    _HandlerService_RegisterCompensation_Handler,
+		},
 		{
 			MethodName: "EncodeJWT",
 			Handler:    _HandlerService_EncodeJWT_Handler,
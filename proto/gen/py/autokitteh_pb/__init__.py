@@ -4,4 +4,4 @@ from . import vars
 
 
 
-__all__=["apply","auth","builds","common","connections","deployments","dispatcher","events","integration_provider","integration_registry","integrations","module","orgs","program","projects","runner_manager","runtimes","sessions","store","triggers","users","values","vars",]
+__all__=["apply","approvals","auth","builds","common","connections","deployments","dispatcher","events","integration_provider","integration_registry","integrations","module","orgs","program","projects","runner_manager","runtimes","sessions","store","triggers","users","values","vars",]
@@ -0,0 +1,5 @@
+# This file is autogenerated by fixpy.sh
+
+from . import v1
+
+__all__ = ['v1']
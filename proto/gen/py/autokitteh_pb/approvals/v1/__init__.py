@@ -0,0 +1,9 @@
+# This file is autogenerated by fixpy.sh
+
+
+from .approval_pb2 import (ApprovalState,Approval,)
+from .svc_pb2 import (GetRequest,GetResponse,ListRequest,ListResponse,DecideRequest,DecideResponse,)
+from .svc_pb2_grpc import (ApprovalsServiceStub,ApprovalsServiceServicer,ApprovalsService,)
+
+
+__all__ = ["ApprovalsServiceStub","ApprovalsServiceServicer","ApprovalsService","GetRequest","GetResponse","ListRequest","ListResponse","DecideRequest","DecideResponse","ApprovalState","Approval",]
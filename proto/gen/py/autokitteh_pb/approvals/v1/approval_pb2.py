@@ -0,0 +1,29 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# source: autokitteh/approvals/v1/approval.proto
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n&autokitteh/approvals/v1/approval.proto\x12\x17\x61utokitteh.approvals.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc5\x03\n\x08\x41pproval\x12\x1f\n\x0b\x61pproval_id\x18\x01 \x01(\tR\napprovalId\x12\x1d\n\nsession_id\x18\x02 \x01(\tR\tsessionId\x12\x1d\n\nproject_id\x18\x03 \x01(\tR\tprojectId\x12\x14\n\x05title\x18\x04 \x01(\tR\x05title\x12\x1c\n\tapprovers\x18\x05 \x03(\tR\tapprovers\x12<\n\x05state\x18\x06 \x01(\x0e\x32&.autokitteh.approvals.v1.ApprovalStateR\x05state\x12\x1d\n\ndecided_by\x18\x07 \x01(\tR\tdecidedBy\x12\x18\n\x07\x63omment\x18\x08 \x01(\tR\x07\x63omment\x12\x39\n\ncreated_at\x18\t \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nexpires_at\x18\n \x01(\x0b\x32\x1a.google.protobuf.TimestampR\texpiresAt\x12\x39\n\ndecided_at\x18\x0b \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tdecidedAt*\xa1\x01\n\rApprovalState\x12\x1e\n\x1a\x41PPROVAL_STATE_UNSPECIFIED\x10\x00\x12\x1a\n\x16\x41PPROVAL_STATE_PENDING\x10\x01\x12\x1b\n\x17\x41PPROVAL_STATE_APPROVED\x10\x02\x12\x1b\n\x17\x41PPROVAL_STATE_REJECTED\x10\x03\x12\x1a\n\x16\x41PPROVAL_STATE_EXPIRED\x10\x04\x42\xf9\x01\n\x1b\x63om.autokitteh.approvals.v1B\rApprovalProtoP\x01ZMgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/approvals/v1;approvalsv1\xa2\x02\x03\x41\x41X\xaa\x02\x17\x41utokitteh.Approvals.V1\xca\x02\x17\x41utokitteh\\Approvals\\V1\xe2\x02#Autokitteh\\Approvals\\V1\\GPBMetadata\xea\x02\x19\x41utokitteh::Approvals::V1b\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'autokitteh.approvals.v1.approval_pb2', _globals)
+if _descriptor._USE_C_DESCRIPTORS == False:
+  DESCRIPTOR._options = None
+  DESCRIPTOR._serialized_options = b'\n\033com.autokitteh.approvals.v1B\rApprovalProtoP\001ZMgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/approvals/v1;approvalsv1\242\002\003AAX\252\002\027Autokitteh.Approvals.V1\312\002\027Autokitteh\\Approvals\\V1\342\002#Autokitteh\\Approvals\\V1\\GPBMetadata\352\002\031Autokitteh::Approvals::V1'
+  _globals['_APPROVALSTATE']._serialized_start=557
+  _globals['_APPROVALSTATE']._serialized_end=718
+  _globals['_APPROVAL']._serialized_start=101
+  _globals['_APPROVAL']._serialized_end=554
+# @@protoc_insertion_point(module_scope)
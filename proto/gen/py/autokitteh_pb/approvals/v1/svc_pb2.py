@@ -0,0 +1,50 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# source: autokitteh/approvals/v1/svc.proto
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from autokitteh_pb.approvals.v1 import approval_pb2 as autokitteh_dot_approvals_dot_v1_dot_approval__pb2
+from buf.validate import validate_pb2 as buf_dot_validate_dot_validate__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n!autokitteh/approvals/v1/svc.proto\x12\x17\x61utokitteh.approvals.v1\x1a&autokitteh/approvals/v1/approval.proto\x1a\x1b\x62uf/validate/validate.proto\"7\n\nGetRequest\x12)\n\x0b\x61pproval_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\napprovalId\"U\n\x0bGetResponse\x12\x46\n\x08\x61pproval\x18\x01 \x01(\x0b\x32!.autokitteh.approvals.v1.ApprovalB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x08\x61pproval\"\xb6\x01\n\x0bListRequest\x12\x15\n\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1d\n\nproject_id\x18\x02 \x01(\tR\tprojectId\x12\x1d\n\nsession_id\x18\x03 \x01(\tR\tsessionId\x12<\n\x05state\x18\x04 \x01(\x0e\x32&.autokitteh.approvals.v1.ApprovalStateR\x05state\x12\x14\n\x05limit\x18\x05 \x01(\rR\x05limit\"]\n\x0cListResponse\x12M\n\tapprovals\x18\x01 \x03(\x0b\x32!.autokitteh.approvals.v1.ApprovalB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\tapprovals\"n\n\rDecideRequest\x12)\n\x0b\x61pproval_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\napprovalId\x12\x18\n\x07\x61pprove\x18\x02 \x01(\x08R\x07\x61pprove\x12\x18\n\x07\x63omment\x18\x03 \x01(\tR\x07\x63omment\"X\n\x0e\x44\x65\x63ideResponse\x12\x46\n\x08\x61pproval\x18\x01 \x01(\x0b\x32!.autokitteh.approvals.v1.ApprovalB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x08\x61pproval2\x94\x02\n\x10\x41pprovalsService\x12P\n\x03Get\x12#.autokitteh.approvals.v1.GetRequest\x1a$.autokitteh.approvals.v1.GetResponse\x12S\n\x04List\x12$.autokitteh.approvals.v1.ListRequest\x1a%.autokitteh.approvals.v1.ListResponse\x12Y\n\x06\x44\x65\x63ide\x12&.autokitteh.approvals.v1.DecideRequest\x1a\'.autokitteh.approvals.v1.DecideResponseB\xf4\x01\n\x1b\x63om.autokitteh.approvals.v1B\x08SvcProtoP\x01ZMgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/approvals/v1;approvalsv1\xa2\x02\x03\x41\x41X\xaa\x02\x17\x41utokitteh.Approvals.V1\xca\x02\x17\x41utokitteh\\Approvals\\V1\xe2\x02#Autokitteh\\Approvals\\V1\\GPBMetadata\xea\x02\x19\x41utokitteh::Approvals::V1b\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'autokitteh.approvals.v1.svc_pb2', _globals)
+if _descriptor._USE_C_DESCRIPTORS == False:
+  DESCRIPTOR._options = None
+  DESCRIPTOR._serialized_options = b'\n\033com.autokitteh.approvals.v1B\010SvcProtoP\001ZMgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/approvals/v1;approvalsv1\242\002\003AAX\252\002\027Autokitteh.Approvals.V1\312\002\027Autokitteh\\Approvals\\V1\342\002#Autokitteh\\Approvals\\V1\\GPBMetadata\352\002\031Autokitteh::Approvals::V1'
+  _GETREQUEST.fields_by_name['approval_id']._options = None
+  _GETREQUEST.fields_by_name['approval_id']._serialized_options = b'\372\367\030\004r\002\020\001'
+  _GETRESPONSE.fields_by_name['approval']._options = None
+  _GETRESPONSE.fields_by_name['approval']._serialized_options = b'\372\367\030\003\310\001\001'
+  _LISTRESPONSE.fields_by_name['approvals']._options = None
+  _LISTRESPONSE.fields_by_name['approvals']._serialized_options = b'\372\367\030\010\222\001\005\"\003\310\001\001'
+  _DECIDEREQUEST.fields_by_name['approval_id']._options = None
+  _DECIDEREQUEST.fields_by_name['approval_id']._serialized_options = b'\372\367\030\004r\002\020\001'
+  _DECIDERESPONSE.fields_by_name['approval']._options = None
+  _DECIDERESPONSE.fields_by_name['approval']._serialized_options = b'\372\367\030\003\310\001\001'
+  _globals['_GETREQUEST']._serialized_start=131
+  _globals['_GETREQUEST']._serialized_end=186
+  _globals['_GETRESPONSE']._serialized_start=188
+  _globals['_GETRESPONSE']._serialized_end=273
+  _globals['_LISTREQUEST']._serialized_start=276
+  _globals['_LISTREQUEST']._serialized_end=458
+  _globals['_LISTRESPONSE']._serialized_start=460
+  _globals['_LISTRESPONSE']._serialized_end=553
+  _globals['_DECIDEREQUEST']._serialized_start=555
+  _globals['_DECIDEREQUEST']._serialized_end=665
+  _globals['_DECIDERESPONSE']._serialized_start=667
+  _globals['_DECIDERESPONSE']._serialized_end=755
+  _globals['_APPROVALSSERVICE']._serialized_start=758
+  _globals['_APPROVALSSERVICE']._serialized_end=1034
+# @@protoc_insertion_point(module_scope)
@@ -1,8 +1,9 @@
 # This file is autogenerated by fixpy.sh
 
 
-from .svc_pb2 import (DispatchRequest,DispatchResponse,RedispatchRequest,RedispatchResponse,)
+from .dead_letter_pb2 import (DeadLetterReason,DeadLetter,)
+from .svc_pb2 import (DispatchRequest,DispatchResponse,RedispatchRequest,RedispatchResponse,ListDeadLettersRequest,ListDeadLettersResponse,RetryDeadLetterRequest,RetryDeadLetterResponse,)
 from .svc_pb2_grpc import (DispatcherServiceStub,DispatcherServiceServicer,DispatcherService,)
 
 
-__all__ = ["DispatcherServiceStub","DispatcherServiceServicer","DispatcherService","DispatchRequest","DispatchResponse","RedispatchRequest","RedispatchResponse",]
+__all__ = ["DispatcherServiceStub","DispatcherServiceServicer","DispatcherService","DispatchRequest","DispatchResponse","RedispatchRequest","RedispatchResponse","ListDeadLettersRequest","ListDeadLettersResponse","RetryDeadLetterRequest","RetryDeadLetterResponse","DeadLetterReason","DeadLetter",]
@@ -0,0 +1,29 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# source: autokitteh/dispatcher/v1/dead_letter.proto
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n*autokitteh/dispatcher/v1/dead_letter.proto\x12\x18\x61utokitteh.dispatcher.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa6\x03\n\nDeadLetter\x12$\n\x0e\x64\x65\x61\x64_letter_id\x18\x01 \x01(\tR\x0c\x64\x65\x61\x64LetterId\x12\x19\n\x08\x65vent_id\x18\x02 \x01(\tR\x07\x65ventId\x12\x1d\n\nproject_id\x18\x03 \x01(\tR\tprojectId\x12\x1d\n\ntrigger_id\x18\x04 \x01(\tR\ttriggerId\x12#\n\rdeployment_id\x18\x05 \x01(\tR\x0c\x64\x65ploymentId\x12\x42\n\x06reason\x18\x06 \x01(\x0e\x32*.autokitteh.dispatcher.v1.DeadLetterReasonR\x06reason\x12\x14\n\x05\x65rror\x18\x07 \x01(\tR\x05\x65rror\x12\x39\n\ncreated_at\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12$\n\x0eretry_event_id\x18\t \x01(\tR\x0cretryEventId\x12\x39\n\nretried_at\x18\n \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tretriedAt*\x8c\x02\n\x10\x44\x65\x61\x64LetterReason\x12\"\n\x1e\x44\x45\x41\x44_LETTER_REASON_UNSPECIFIED\x10\x00\x12&\n\"DEAD_LETTER_REASON_NO_DESTINATIONS\x10\x01\x12*\n&DEAD_LETTER_REASON_SESSION_INIT_FAILED\x10\x02\x12+\n\'DEAD_LETTER_REASON_SESSION_START_FAILED\x10\x03\x12)\n%DEAD_LETTER_REASON_RESOURCE_EXHAUSTED\x10\x04\x12(\n$DEAD_LETTER_REASON_CONCURRENCY_LIMIT\x10\x05\x42\x82\x02\n\x1c\x63om.autokitteh.dispatcher.v1B\x0f\x44\x65\x61\x64LetterProtoP\x01ZOgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/dispatcher/v1;dispatcherv1\xa2\x02\x03\x41\x44X\xaa\x02\x18\x41utokitteh.Dispatcher.V1\xca\x02\x18\x41utokitteh\\Dispatcher\\V1\xe2\x02$Autokitteh\\Dispatcher\\V1\\GPBMetadata\xea\x02\x1a\x41utokitteh::Dispatcher::V1b\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'autokitteh.dispatcher.v1.dead_letter_pb2', _globals)
+if _descriptor._USE_C_DESCRIPTORS == False:
+  DESCRIPTOR._options = None
+  DESCRIPTOR._serialized_options = b'\n\034com.autokitteh.dispatcher.v1B\017DeadLetterProtoP\001ZOgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/dispatcher/v1;dispatcherv1\242\002\003ADX\252\002\030Autokitteh.Dispatcher.V1\312\002\030Autokitteh\\Dispatcher\\V1\342\002$Autokitteh\\Dispatcher\\V1\\GPBMetadata\352\002\032Autokitteh::Dispatcher::V1'
+  _globals['_DEADLETTERREASON']._serialized_start=531
+  _globals['_DEADLETTERREASON']._serialized_end=799
+  _globals['_DEADLETTER']._serialized_start=106
+  _globals['_DEADLETTER']._serialized_end=528
+# @@protoc_insertion_point(module_scope)
@@ -0,0 +1,46 @@
+from google.protobuf import timestamp_pb2 as _timestamp_pb2
+from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from typing import ClassVar as _ClassVar, Mapping as _Mapping, Optional as _Optional, Union as _Union
+
+DESCRIPTOR: _descriptor.FileDescriptor
+
+class DeadLetterReason(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = []
+    DEAD_LETTER_REASON_UNSPECIFIED: _ClassVar[DeadLetterReason]
+    DEAD_LETTER_REASON_NO_DESTINATIONS: _ClassVar[DeadLetterReason]
+    DEAD_LETTER_REASON_SESSION_INIT_FAILED: _ClassVar[DeadLetterReason]
+    DEAD_LETTER_REASON_SESSION_START_FAILED: _ClassVar[DeadLetterReason]
+    DEAD_LETTER_REASON_RESOURCE_EXHAUSTED: _ClassVar[DeadLetterReason]
+    DEAD_LETTER_REASON_CONCURRENCY_LIMIT: _ClassVar[DeadLetterReason]
+DEAD_LETTER_REASON_UNSPECIFIED: DeadLetterReason
+DEAD_LETTER_REASON_NO_DESTINATIONS: DeadLetterReason
+DEAD_LETTER_REASON_SESSION_INIT_FAILED: DeadLetterReason
+DEAD_LETTER_REASON_SESSION_START_FAILED: DeadLetterReason
+DEAD_LETTER_REASON_RESOURCE_EXHAUSTED: DeadLetterReason
+DEAD_LETTER_REASON_CONCURRENCY_LIMIT: DeadLetterReason
+
+class DeadLetter(_message.Message):
+    __slots__ = ["dead_letter_id", "event_id", "project_id", "trigger_id", "deployment_id", "reason", "error", "created_at", "retry_event_id", "retried_at"]
+    DEAD_LETTER_ID_FIELD_NUMBER: _ClassVar[int]
+    EVENT_ID_FIELD_NUMBER: _ClassVar[int]
+    PROJECT_ID_FIELD_NUMBER: _ClassVar[int]
+    TRIGGER_ID_FIELD_NUMBER: _ClassVar[int]
+    DEPLOYMENT_ID_FIELD_NUMBER: _ClassVar[int]
+    REASON_FIELD_NUMBER: _ClassVar[int]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
+    CREATED_AT_FIELD_NUMBER: _ClassVar[int]
+    RETRY_EVENT_ID_FIELD_NUMBER: _ClassVar[int]
+    RETRIED_AT_FIELD_NUMBER: _ClassVar[int]
+    dead_letter_id: str
+    event_id: str
+    project_id: str
+    trigger_id: str
+    deployment_id: str
+    reason: DeadLetterReason
+    error: str
+    created_at: _timestamp_pb2.Timestamp
+    retry_event_id: str
+    retried_at: _timestamp_pb2.Timestamp
+    def __init__(self, dead_letter_id: _Optional[str] = ..., event_id: _Optional[str] = ..., project_id: _Optional[str] = ..., trigger_id: _Optional[str] = ..., deployment_id: _Optional[str] = ..., reason: _Optional[_Union[DeadLetterReason, str]] = ..., error: _Optional[str] = ..., created_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., retry_event_id: _Optional[str] = ..., retried_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
@@ -11,11 +11,12 @@ from google.protobuf.internal import builder as _builder
 _sym_db = _symbol_database.Default()
 
 
+from autokitteh_pb.dispatcher.v1 import dead_letter_pb2 as autokitteh_dot_dispatcher_dot_v1_dot_dead__letter__pb2
 from autokitteh_pb.events.v1 import event_pb2 as autokitteh_dot_events_dot_v1_dot_event__pb2
 from buf.validate import validate_pb2 as buf_dot_validate_dot_validate__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\"autokitteh/dispatcher/v1/svc.proto\x12\x18\x61utokitteh.dispatcher.v1\x1a autokitteh/events/v1/event.proto\x1a\x1b\x62uf/validate/validate.proto\"\x90\x02\n\x0f\x44ispatchRequest\x12\x31\n\x05\x65vent\x18\x01 \x01(\x0b\x32\x1b.autokitteh.events.v1.EventR\x05\x65vent\x12#\n\rdeployment_id\x18\x02 \x01(\tR\x0c\x64\x65ploymentId\x12\x18\n\x07project\x18\x03 \x01(\tR\x07project\x12\x12\n\x04wait\x18\x04 \x01(\x08R\x04wait:w\xfa\xf7\x18s\x1aq\n!dispatcher.event_id_must_be_empty\x12\x1e\x65vent_id must not be specified\x1a,has(this.event) && this.event.event_id == \'\'\"\xad\x01\n\x10\x44ispatchResponse\x12\x19\n\x08\x65vent_id\x18\x01 \x01(\tR\x07\x65ventId\x12=\n\x13started_session_ids\x18\x02 \x03(\tB\r\xfa\xf7\x18\t\x92\x01\x06\"\x04r\x02\x10\x01R\x11startedSessionIds\x12?\n\x14signaled_session_ids\x18\x03 \x03(\tB\r\xfa\xf7\x18\t\x92\x01\x06\"\x04r\x02\x10\x01R\x12signaledSessionIds\"q\n\x11RedispatchRequest\x12#\n\x08\x65vent_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\x07\x65ventId\x12#\n\rdeployment_id\x18\x03 \x01(\tR\x0c\x64\x65ploymentId\x12\x12\n\x04wait\x18\x04 \x01(\x08R\x04wait\"\xaf\x01\n\x12RedispatchResponse\x12\x19\n\x08\x65vent_id\x18\x01 \x01(\tR\x07\x65ventId\x12=\n\x13started_session_ids\x18\x02 \x03(\tB\r\xfa\xf7\x18\t\x92\x01\x06\"\x04r\x02\x10\x01R\x11startedSessionIds\x12?\n\x14signaled_session_ids\x18\x03 \x03(\tB\r\xfa\xf7\x18\t\x92\x01\x06\"\x04r\x02\x10\x01R\x12signaledSessionIds2\xdf\x01\n\x11\x44ispatcherService\x12\x61\n\x08\x44ispatch\x12).autokitteh.dispatcher.v1.DispatchRequest\x1a*.autokitteh.dispatcher.v1.DispatchResponse\x12g\n\nRedispatch\x12+.autokitteh.dispatcher.v1.RedispatchRequest\x1a,.autokitteh.dispatcher.v1.RedispatchResponseB\xfb\x01\n\x1c\x63om.autokitteh.dispatcher.v1B\x08SvcProtoP\x01ZOgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/dispatcher/v1;dispatcherv1\xa2\x02\x03\x41\x44X\xaa\x02\x18\x41utokitteh.Dispatcher.V1\xca\x02\x18\x41utokitteh\\Dispatcher\\V1\xe2\x02$Autokitteh\\Dispatcher\\V1\\GPBMetadata\xea\x02\x1a\x41utokitteh::Dispatcher::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\"autokitteh/dispatcher/v1/svc.proto\x12\x18\x61utokitteh.dispatcher.v1\x1a*autokitteh/dispatcher/v1/dead_letter.proto\x1a autokitteh/events/v1/event.proto\x1a\x1b\x62uf/validate/validate.proto\"\x90\x02\n\x0f\x44ispatchRequest\x12\x31\n\x05\x65vent\x18\x01 \x01(\x0b\x32\x1b.autokitteh.events.v1.EventR\x05\x65vent\x12#\n\rdeployment_id\x18\x02 \x01(\tR\x0c\x64\x65ploymentId\x12\x18\n\x07project\x18\x03 \x01(\tR\x07project\x12\x12\n\x04wait\x18\x04 \x01(\x08R\x04wait:w\xfa\xf7\x18s\x1aq\n!dispatcher.event_id_must_be_empty\x12\x1e\x65vent_id must not be specified\x1a,has(this.event) && this.event.event_id == \'\'\"\xad\x01\n\x10\x44ispatchResponse\x12\x19\n\x08\x65vent_id\x18\x01 \x01(\tR\x07\x65ventId\x12=\n\x13started_session_ids\x18\x02 \x03(\tB\r\xfa\xf7\x18\t\x92\x01\x06\"\x04r\x02\x10\x01R\x11startedSessionIds\x12?\n\x14signaled_session_ids\x18\x03 \x03(\tB\r\xfa\xf7\x18\t\x92\x01\x06\"\x04r\x02\x10\x01R\x12signaledSessionIds\"q\n\x11RedispatchRequest\x12#\n\x08\x65vent_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\x07\x65ventId\x12#\n\rdeployment_id\x18\x03 \x01(\tR\x0c\x64\x65ploymentId\x12\x12\n\x04wait\x18\x04 \x01(\x08R\x04wait\"\xaf\x01\n\x12RedispatchResponse\x12\x19\n\x08\x65vent_id\x18\x01 \x01(\tR\x07\x65ventId\x12=\n\x13started_session_ids\x18\x02 \x03(\tB\r\xfa\xf7\x18\t\x92\x01\x06\"\x04r\x02\x10\x01R\x11startedSessionIds\x12?\n\x14signaled_session_ids\x18\x03 \x03(\tB\r\xfa\xf7\x18\t\x92\x01\x06\"\x04r\x02\x10\x01R\x12signaledSessionIds\"\xa8\x01\n\x16ListDeadLettersRequest\x12\x15\n\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1d\n\nproject_id\x18\x02 \x01(\tR\tprojectId\x12\x19\n\x08\x65vent_id\x18\x03 \x01(\tR\x07\x65ventId\x12\'\n\x0finclude_retried\x18\x04 \x01(\x08R\x0eincludeRetried\x12\x14\n\x05limit\x18\x05 \x01(\rR\x05limit\"p\n\x17ListDeadLettersResponse\x12U\n\x0c\x64\x65\x61\x64_letters\x18\x01 \x03(\x0b\x32$.autokitteh.dispatcher.v1.DeadLetterB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\x0b\x64\x65\x61\x64Letters\"\\\n\x16RetryDeadLetterRequest\x12.\n\x0e\x64\x65\x61\x64_letter_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\x0c\x64\x65\x61\x64LetterId\x12\x12\n\x04wait\x18\x02 \x01(\x08R\x04wait\"\xb4\x01\n\x17RetryDeadLetterResponse\x12\x19\n\x08\x65vent_id\x18\x01 \x01(\tR\x07\x65ventId\x12=\n\x13started_session_ids\x18\x02 \x03(\tB\r\xfa\xf7\x18\t\x92\x01\x06\"\x04r\x02\x10\x01R\x11startedSessionIds\x12?\n\x14signaled_session_ids\x18\x03 \x03(\tB\r\xfa\xf7\x18\t\x92\x01\x06\"\x04r\x02\x10\x01R\x12signaledSessionIds2\xcf\x03\n\x11\x44ispatcherService\x12\x61\n\x08\x44ispatch\x12).autokitteh.dispatcher.v1.DispatchRequest\x1a*.autokitteh.dispatcher.v1.DispatchResponse\x12g\n\nRedispatch\x12+.autokitteh.dispatcher.v1.RedispatchRequest\x1a,.autokitteh.dispatcher.v1.RedispatchResponse\x12v\n\x0fListDeadLetters\x12\x30.autokitteh.dispatcher.v1.ListDeadLettersRequest\x1a\x31.autokitteh.dispatcher.v1.ListDeadLettersResponse\x12v\n\x0fRetryDeadLetter\x12\x30.autokitteh.dispatcher.v1.RetryDeadLetterRequest\x1a\x31.autokitteh.dispatcher.v1.RetryDeadLetterResponseB\xfb\x01\n\x1c\x63om.autokitteh.dispatcher.v1B\x08SvcProtoP\x01ZOgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/dispatcher/v1;dispatcherv1\xa2\x02\x03\x41\x44X\xaa\x02\x18\x41utokitteh.Dispatcher.V1\xca\x02\x18\x41utokitteh\\Dispatcher\\V1\xe2\x02$Autokitteh\\Dispatcher\\V1\\GPBMetadata\xea\x02\x1a\x41utokitteh::Dispatcher::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -35,14 +36,30 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _REDISPATCHRESPONSE.fields_by_name['started_session_ids']._serialized_options = b'\372\367\030\t\222\001\006\"\004r\002\020\001'
   _REDISPATCHRESPONSE.fields_by_name['signaled_session_ids']._options = None
   _REDISPATCHRESPONSE.fields_by_name['signaled_session_ids']._serialized_options = b'\372\367\030\t\222\001\006\"\004r\002\020\001'
-  _globals['_DISPATCHREQUEST']._serialized_start=128
-  _globals['_DISPATCHREQUEST']._serialized_end=400
-  _globals['_DISPATCHRESPONSE']._serialized_start=403
-  _globals['_DISPATCHRESPONSE']._serialized_end=576
-  _globals['_REDISPATCHREQUEST']._serialized_start=578
-  _globals['_REDISPATCHREQUEST']._serialized_end=691
-  _globals['_REDISPATCHRESPONSE']._serialized_start=694
-  _globals['_REDISPATCHRESPONSE']._serialized_end=869
-  _globals['_DISPATCHERSERVICE']._serialized_start=872
-  _globals['_DISPATCHERSERVICE']._serialized_end=1095
+  _LISTDEADLETTERSRESPONSE.fields_by_name['dead_letters']._options = None
+  _LISTDEADLETTERSRESPONSE.fields_by_name['dead_letters']._serialized_options = b'\372\367\030\010\222\001\005\"\003\310\001\001'
+  _RETRYDEADLETTERREQUEST.fields_by_name['dead_letter_id']._options = None
+  _RETRYDEADLETTERREQUEST.fields_by_name['dead_letter_id']._serialized_options = b'\372\367\030\004r\002\020\001'
+  _RETRYDEADLETTERRESPONSE.fields_by_name['started_session_ids']._options = None
+  _RETRYDEADLETTERRESPONSE.fields_by_name['started_session_ids']._serialized_options = b'\372\367\030\t\222\001\006\"\004r\002\020\001'
+  _RETRYDEADLETTERRESPONSE.fields_by_name['signaled_session_ids']._options = None
+  _RETRYDEADLETTERRESPONSE.fields_by_name['signaled_session_ids']._serialized_options = b'\372\367\030\t\222\001\006\"\004r\002\020\001'
+  _globals['_DISPATCHREQUEST']._serialized_start=172
+  _globals['_DISPATCHREQUEST']._serialized_end=444
+  _globals['_DISPATCHRESPONSE']._serialized_start=447
+  _globals['_DISPATCHRESPONSE']._serialized_end=620
+  _globals['_REDISPATCHREQUEST']._serialized_start=622
+  _globals['_REDISPATCHREQUEST']._serialized_end=735
+  _globals['_REDISPATCHRESPONSE']._serialized_start=738
+  _globals['_REDISPATCHRESPONSE']._serialized_end=913
+  _globals['_LISTDEADLETTERSREQUEST']._serialized_start=916
+  _globals['_LISTDEADLETTERSREQUEST']._serialized_end=1084
+  _globals['_LISTDEADLETTERSRESPONSE']._serialized_start=1086
+  _globals['_LISTDEADLETTERSRESPONSE']._serialized_end=1198
+  _globals['_RETRYDEADLETTERREQUEST']._serialized_start=1200
+  _globals['_RETRYDEADLETTERREQUEST']._serialized_end=1292
+  _globals['_RETRYDEADLETTERRESPONSE']._serialized_start=1295
+  _globals['_RETRYDEADLETTERRESPONSE']._serialized_end=1475
+  _globals['_DISPATCHERSERVICE']._serialized_start=1478
+  _globals['_DISPATCHERSERVICE']._serialized_end=1941
 # @@protoc_insertion_point(module_scope)
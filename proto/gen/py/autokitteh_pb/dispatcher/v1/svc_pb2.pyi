@@ -1,3 +1,4 @@
+from autokitteh_pb.dispatcher.v1 import dead_letter_pb2 as _dead_letter_pb2
 from autokitteh_pb.events.v1 import event_pb2 as _event_pb2
 from buf.validate import validate_pb2 as _validate_pb2
 from google.protobuf.internal import containers as _containers
@@ -48,3 +49,41 @@ class RedispatchResponse(_message.Message):
     started_session_ids: _containers.RepeatedScalarFieldContainer[str]
     signaled_session_ids: _containers.RepeatedScalarFieldContainer[str]
     def __init__(self, event_id: _Optional[str] = ..., started_session_ids: _Optional[_Iterable[str]] = ..., signaled_session_ids: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class ListDeadLettersRequest(_message.Message):
+    __slots__ = ["org_id", "project_id", "event_id", "include_retried", "limit"]
+    ORG_ID_FIELD_NUMBER: _ClassVar[int]
+    PROJECT_ID_FIELD_NUMBER: _ClassVar[int]
+    EVENT_ID_FIELD_NUMBER: _ClassVar[int]
+    INCLUDE_RETRIED_FIELD_NUMBER: _ClassVar[int]
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    org_id: str
+    project_id: str
+    event_id: str
+    include_retried: bool
+    limit: int
+    def __init__(self, org_id: _Optional[str] = ..., project_id: _Optional[str] = ..., event_id: _Optional[str] = ..., include_retried: bool = ..., limit: _Optional[int] = ...) -> None: ...
+
+class ListDeadLettersResponse(_message.Message):
+    __slots__ = ["dead_letters"]
+    DEAD_LETTERS_FIELD_NUMBER: _ClassVar[int]
+    dead_letters: _containers.RepeatedCompositeFieldContainer[_dead_letter_pb2.DeadLetter]
+    def __init__(self, dead_letters: _Optional[_Iterable[_Union[_dead_letter_pb2.DeadLetter, _Mapping]]] = ...) -> None: ...
+
+class RetryDeadLetterRequest(_message.Message):
+    __slots__ = ["dead_letter_id", "wait"]
+    DEAD_LETTER_ID_FIELD_NUMBER: _ClassVar[int]
+    WAIT_FIELD_NUMBER: _ClassVar[int]
+    dead_letter_id: str
+    wait: bool
+    def __init__(self, dead_letter_id: _Optional[str] = ..., wait: bool = ...) -> None: ...
+
+class RetryDeadLetterResponse(_message.Message):
+    __slots__ = ["event_id", "started_session_ids", "signaled_session_ids"]
+    EVENT_ID_FIELD_NUMBER: _ClassVar[int]
+    STARTED_SESSION_IDS_FIELD_NUMBER: _ClassVar[int]
+    SIGNALED_SESSION_IDS_FIELD_NUMBER: _ClassVar[int]
+    event_id: str
+    started_session_ids: _containers.RepeatedScalarFieldContainer[str]
+    signaled_session_ids: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, event_id: _Optional[str] = ..., started_session_ids: _Optional[_Iterable[str]] = ..., signaled_session_ids: _Optional[_Iterable[str]] = ...) -> None: ...
@@ -24,6 +24,16 @@ class DispatcherServiceStub(object):
                 request_serializer=autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.RedispatchRequest.SerializeToString,
                 response_deserializer=autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.RedispatchResponse.FromString,
                 )
+        self.ListDeadLetters = channel.unary_unary(
+                '/autokitteh.dispatcher.v1.DispatcherService/ListDeadLetters',
+                request_serializer=autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.ListDeadLettersRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.ListDeadLettersResponse.FromString,
+                )
+        self.RetryDeadLetter = channel.unary_unary(
+                '/autokitteh.dispatcher.v1.DispatcherService/RetryDeadLetter',
+                request_serializer=autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.RetryDeadLetterRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.RetryDeadLetterResponse.FromString,
+                )
 
 
 class DispatcherServiceServicer(object):
@@ -44,6 +54,21 @@ class DispatcherServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ListDeadLetters(self, request, context):
+        """Lists events that were dropped during dispatch, most recent first.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RetryDeadLetter(self, request, context):
+        """Redispatches the event of a dead letter to the trigger and deployment
+        it originally failed for, and marks the dead letter as retried.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_DispatcherServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -57,6 +82,16 @@ def add_DispatcherServiceServicer_to_server(servicer, server):
                     request_deserializer=autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.RedispatchRequest.FromString,
                     response_serializer=autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.RedispatchResponse.SerializeToString,
             ),
+            'ListDeadLetters': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListDeadLetters,
+                    request_deserializer=autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.ListDeadLettersRequest.FromString,
+                    response_serializer=autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.ListDeadLettersResponse.SerializeToString,
+            ),
+            'RetryDeadLetter': grpc.unary_unary_rpc_method_handler(
+                    servicer.RetryDeadLetter,
+                    request_deserializer=autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.RetryDeadLetterRequest.FromString,
+                    response_serializer=autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.RetryDeadLetterResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'autokitteh.dispatcher.v1.DispatcherService', rpc_method_handlers)
@@ -100,3 +135,37 @@ class DispatcherService(object):
             autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.RedispatchResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListDeadLetters(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/autokitteh.dispatcher.v1.DispatcherService/ListDeadLetters',
+            autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.ListDeadLettersRequest.SerializeToString,
+            autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.ListDeadLettersResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def RetryDeadLetter(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/autokitteh.dispatcher.v1.DispatcherService/RetryDeadLetter',
+            autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.RetryDeadLetterRequest.SerializeToString,
+            autokitteh_dot_dispatcher_dot_v1_dot_svc__pb2.RetryDeadLetterResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
@@ -18,7 +18,7 @@ from google.protobuf import duration_pb2 as google_dot_protobuf_dot_duration__pb
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n$autokitteh/sessions/v1/session.proto\x12\x16\x61utokitteh.sessions.v1\x1a#autokitteh/program/v1/program.proto\x1a!autokitteh/values/v1/values.proto\x1a\x1b\x62uf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x88\x07\n\x0cSessionState\x12\x46\n\x07\x63reated\x18\n \x01(\x0b\x32,.autokitteh.sessions.v1.SessionState.CreatedR\x07\x63reated\x12\x46\n\x07running\x18\x0b \x01(\x0b\x32,.autokitteh.sessions.v1.SessionState.RunningR\x07running\x12@\n\x05\x65rror\x18\x0c \x01(\x0b\x32*.autokitteh.sessions.v1.SessionState.ErrorR\x05\x65rror\x12L\n\tcompleted\x18\r \x01(\x0b\x32..autokitteh.sessions.v1.SessionState.CompletedR\tcompleted\x12\x46\n\x07stopped\x18\x0e \x01(\x0b\x32,.autokitteh.sessions.v1.SessionState.StoppedR\x07stopped\x1a\t\n\x07\x43reated\x1aZ\n\x07Running\x12\x1e\n\x06run_id\x18\x01 \x01(\tB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x05runId\x12/\n\x04\x63\x61ll\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x04\x63\x61ll\x1a\\\n\x05\x45rror\x12\x16\n\x06prints\x18\x01 \x03(\tR\x06prints\x12;\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x1c.autokitteh.program.v1.ErrorB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x05\x65rror\x1a\xa7\x02\n\tCompleted\x12\x16\n\x06prints\x18\x01 \x03(\tR\x06prints\x12i\n\x07\x65xports\x18\x02 \x03(\x0b\x32;.autokitteh.sessions.v1.SessionState.Completed.ExportsEntryB\x12\xfa\xf7\x18\x0e\x9a\x01\x0b\"\x04r\x02\x10\x01*\x03\xc8\x01\x01R\x07\x65xports\x12>\n\x0creturn_value\x18\x03 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x0breturnValue\x1aW\n\x0c\x45xportsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value:\x02\x38\x01\x1a!\n\x07Stopped\x12\x16\n\x06reason\x18\x01 \x01(\tR\x06reason\"\xc3\x08\n\x04\x43\x61ll\x12>\n\x04spec\x18\x01 \x01(\x0b\x32!.autokitteh.sessions.v1.Call.SpecB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x04spec\x12N\n\x08\x61ttempts\x18\x02 \x03(\x0b\x32$.autokitteh.sessions.v1.Call.AttemptB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\x08\x61ttempts\x1a\xcc\x02\n\x04Spec\x12@\n\x08\x66unction\x18\x01 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x08\x66unction\x12=\n\x04\x61rgs\x18\x02 \x03(\x0b\x32\x1b.autokitteh.values.v1.ValueB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\x04\x61rgs\x12Y\n\x06kwargs\x18\x03 \x03(\x0b\x32-.autokitteh.sessions.v1.Call.Spec.KwargsEntryB\x12\xfa\xf7\x18\x0e\x9a\x01\x0b\"\x04r\x02\x10\x01*\x03\xc8\x01\x01R\x06kwargs\x12\x10\n\x03seq\x18\x04 \x01(\rR\x03seq\x1aV\n\x0bKwargsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value:\x02\x38\x01\x1a\xdb\x04\n\x07\x41ttempt\x12@\n\x05start\x18\x01 \x01(\x0b\x32*.autokitteh.sessions.v1.Call.Attempt.StartR\x05start\x12I\n\x08\x63omplete\x18\x02 \x01(\x0b\x32-.autokitteh.sessions.v1.Call.Attempt.CompleteR\x08\x63omplete\x1ao\n\x06Result\x12\x31\n\x05value\x18\n \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value\x12\x32\n\x05\x65rror\x18\x0b \x01(\x0b\x32\x1c.autokitteh.program.v1.ErrorR\x05\x65rror\x1a]\n\x05Start\x12\x42\n\nstarted_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\tstartedAt\x12\x10\n\x03num\x18\x05 \x01(\rR\x03num\x1a\xf2\x01\n\x08\x43omplete\x12\x46\n\x0c\x63ompleted_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x0b\x63ompletedAt\x12@\n\x0eretry_interval\x18\x02 \x01(\x0b\x32\x19.google.protobuf.DurationR\rretryInterval\x12\x17\n\x07is_last\x18\x03 \x01(\x08R\x06isLast\x12\x43\n\x06result\x18\x04 \x01(\x0b\x32+.autokitteh.sessions.v1.Call.Attempt.ResultR\x06result\"\xa3\x08\n\x10SessionLogRecord\x12(\n\x01t\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x01t\x12\x1d\n\nprocess_id\x18\x02 \x01(\tR\tprocessId\x12\x44\n\x05print\x18\n \x01(\x0b\x32..autokitteh.sessions.v1.SessionLogRecord.PrintR\x05print\x12>\n\tcall_spec\x18\x0b \x01(\x0b\x32!.autokitteh.sessions.v1.Call.SpecR\x08\x63\x61llSpec\x12X\n\x12\x63\x61ll_attempt_start\x18\x0c \x01(\x0b\x32*.autokitteh.sessions.v1.Call.Attempt.StartR\x10\x63\x61llAttemptStart\x12\x61\n\x15\x63\x61ll_attempt_complete\x18\r \x01(\x0b\x32-.autokitteh.sessions.v1.Call.Attempt.CompleteR\x13\x63\x61llAttemptComplete\x12:\n\x05state\x18\x0e \x01(\x0b\x32$.autokitteh.sessions.v1.SessionStateR\x05state\x12W\n\x0cstop_request\x18\x0f \x01(\x0b\x32\x34.autokitteh.sessions.v1.SessionLogRecord.StopRequestR\x0bstopRequest\x12J\n\x07outcome\x18\x10 \x01(\x0b\x32\x30.autokitteh.sessions.v1.SessionLogRecord.OutcomeR\x07outcome\x1ai\n\x05Print\x12\x12\n\x04text\x18\x01 \x01(\tR\x04text\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value\x12\x19\n\x08\x63\x61ll_seq\x18\x03 \x01(\rR\x07\x63\x61llSeq\x1a%\n\x0bStopRequest\x12\x16\n\x06reason\x18\x02 \x01(\tR\x06reason\x1aW\n\x07Outcome\x12\x31\n\x05value\x18\x01 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value\x12\x19\n\x08\x65vent_id\x18\x02 \x01(\tR\x07\x65ventId\"\xb6\x01\n\x04Type\x12\x14\n\x10TYPE_UNSPECIFIED\x10\x00\x12\x0e\n\nTYPE_PRINT\x10\x01\x12\x12\n\x0eTYPE_CALL_SPEC\x10\x02\x12\x1b\n\x17TYPE_CALL_ATTEMPT_START\x10\x04\x12\x1e\n\x1aTYPE_CALL_ATTEMPT_COMPLETE\x10\x08\x12\x0e\n\nTYPE_STATE\x10\x10\x12\x15\n\x11TYPE_STOP_REQUEST\x10 \x12\x10\n\x0cTYPE_OUTCOME\x10@\"\x9a\x06\n\x07Session\x12\x1d\n\nsession_id\x18\x01 \x01(\tR\tsessionId\x12\x19\n\x08\x62uild_id\x18\x02 \x01(\tR\x07\x62uildId\x12\x1d\n\nproject_id\x18\x03 \x01(\tR\tprojectId\x12L\n\nentrypoint\x18\x04 \x01(\x0b\x32#.autokitteh.program.v1.CodeLocationB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\nentrypoint\x12W\n\x06inputs\x18\x05 \x03(\x0b\x32+.autokitteh.sessions.v1.Session.InputsEntryB\x12\xfa\xf7\x18\x0e\x9a\x01\x0b\"\x04r\x02\x10\x01*\x03\xc8\x01\x01R\x06inputs\x12*\n\x11parent_session_id\x18\x06 \x01(\tR\x0fparentSessionId\x12=\n\x04memo\x18\x07 \x03(\x0b\x32).autokitteh.sessions.v1.Session.MemoEntryR\x04memo\x12\x39\n\ncreated_at\x18\n \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x0b \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n\x05state\x18\x0c \x01(\x0e\x32(.autokitteh.sessions.v1.SessionStateTypeR\x05state\x12\x1d\n\nis_durable\x18\r \x01(\x08R\tisDurable\x12#\n\rdeployment_id\x18\x14 \x01(\tR\x0c\x64\x65ploymentId\x12\x19\n\x08\x65vent_id\x18\x15 \x01(\tR\x07\x65ventId\x1aV\n\x0bInputsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value:\x02\x38\x01\x1a\x37\n\tMemoEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01*\xd6\x01\n\x10SessionStateType\x12\"\n\x1eSESSION_STATE_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n\x1aSESSION_STATE_TYPE_CREATED\x10\x01\x12\x1e\n\x1aSESSION_STATE_TYPE_RUNNING\x10\x02\x12\x1c\n\x18SESSION_STATE_TYPE_ERROR\x10\x03\x12 \n\x1cSESSION_STATE_TYPE_COMPLETED\x10\x04\x12\x1e\n\x1aSESSION_STATE_TYPE_STOPPED\x10\x05\x42\xf1\x01\n\x1a\x63om.autokitteh.sessions.v1B\x0cSessionProtoP\x01ZKgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/sessions/v1;sessionsv1\xa2\x02\x03\x41SX\xaa\x02\x16\x41utokitteh.Sessions.V1\xca\x02\x16\x41utokitteh\\Sessions\\V1\xe2\x02\"Autokitteh\\Sessions\\V1\\GPBMetadata\xea\x02\x18\x41utokitteh::Sessions::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n$autokitteh/sessions/v1/session.proto\x12\x16\x61utokitteh.sessions.v1\x1a#autokitteh/program/v1/program.proto\x1a!autokitteh/values/v1/values.proto\x1a\x1b\x62uf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa5\x07\n\x0cSessionState\x12\x46\n\x07\x63reated\x18\n \x01(\x0b\x32,.autokitteh.sessions.v1.SessionState.CreatedR\x07\x63reated\x12\x46\n\x07running\x18\x0b \x01(\x0b\x32,.autokitteh.sessions.v1.SessionState.RunningR\x07running\x12@\n\x05\x65rror\x18\x0c \x01(\x0b\x32*.autokitteh.sessions.v1.SessionState.ErrorR\x05\x65rror\x12L\n\tcompleted\x18\r \x01(\x0b\x32..autokitteh.sessions.v1.SessionState.CompletedR\tcompleted\x12\x46\n\x07stopped\x18\x0e \x01(\x0b\x32,.autokitteh.sessions.v1.SessionState.StoppedR\x07stopped\x1a\t\n\x07\x43reated\x1aZ\n\x07Running\x12\x1e\n\x06run_id\x18\x01 \x01(\tB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x05runId\x12/\n\x04\x63\x61ll\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x04\x63\x61ll\x1a\\\n\x05\x45rror\x12\x16\n\x06prints\x18\x01 \x03(\tR\x06prints\x12;\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x1c.autokitteh.program.v1.ErrorB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x05\x65rror\x1a\xa7\x02\n\tCompleted\x12\x16\n\x06prints\x18\x01 \x03(\tR\x06prints\x12i\n\x07\x65xports\x18\x02 \x03(\x0b\x32;.autokitteh.sessions.v1.SessionState.Completed.ExportsEntryB\x12\xfa\xf7\x18\x0e\x9a\x01\x0b\"\x04r\x02\x10\x01*\x03\xc8\x01\x01R\x07\x65xports\x12>\n\x0creturn_value\x18\x03 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x0breturnValue\x1aW\n\x0c\x45xportsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value:\x02\x38\x01\x1a>\n\x07Stopped\x12\x16\n\x06reason\x18\x01 \x01(\tR\x06reason\x12\x1b\n\ttimed_out\x18\x02 \x01(\x08R\x08timedOut\"\xc3\x08\n\x04\x43\x61ll\x12>\n\x04spec\x18\x01 \x01(\x0b\x32!.autokitteh.sessions.v1.Call.SpecB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x04spec\x12N\n\x08\x61ttempts\x18\x02 \x03(\x0b\x32$.autokitteh.sessions.v1.Call.AttemptB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\x08\x61ttempts\x1a\xcc\x02\n\x04Spec\x12@\n\x08\x66unction\x18\x01 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x08\x66unction\x12=\n\x04\x61rgs\x18\x02 \x03(\x0b\x32\x1b.autokitteh.values.v1.ValueB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\x04\x61rgs\x12Y\n\x06kwargs\x18\x03 \x03(\x0b\x32-.autokitteh.sessions.v1.Call.Spec.KwargsEntryB\x12\xfa\xf7\x18\x0e\x9a\x01\x0b\"\x04r\x02\x10\x01*\x03\xc8\x01\x01R\x06kwargs\x12\x10\n\x03seq\x18\x04 \x01(\rR\x03seq\x1aV\n\x0bKwargsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value:\x02\x38\x01\x1a\xdb\x04\n\x07\x41ttempt\x12@\n\x05start\x18\x01 \x01(\x0b\x32*.autokitteh.sessions.v1.Call.Attempt.StartR\x05start\x12I\n\x08\x63omplete\x18\x02 \x01(\x0b\x32-.autokitteh.sessions.v1.Call.Attempt.CompleteR\x08\x63omplete\x1ao\n\x06Result\x12\x31\n\x05value\x18\n \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value\x12\x32\n\x05\x65rror\x18\x0b \x01(\x0b\x32\x1c.autokitteh.program.v1.ErrorR\x05\x65rror\x1a]\n\x05Start\x12\x42\n\nstarted_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\tstartedAt\x12\x10\n\x03num\x18\x05 \x01(\rR\x03num\x1a\xf2\x01\n\x08\x43omplete\x12\x46\n\x0c\x63ompleted_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x0b\x63ompletedAt\x12@\n\x0eretry_interval\x18\x02 \x01(\x0b\x32\x19.google.protobuf.DurationR\rretryInterval\x12\x17\n\x07is_last\x18\x03 \x01(\x08R\x06isLast\x12\x43\n\x06result\x18\x04 \x01(\x0b\x32+.autokitteh.sessions.v1.Call.Attempt.ResultR\x06result\"\xaf\n\n\x10SessionLogRecord\x12(\n\x01t\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x01t\x12\x1d\n\nprocess_id\x18\x02 \x01(\tR\tprocessId\x12\x44\n\x05print\x18\n \x01(\x0b\x32..autokitteh.sessions.v1.SessionLogRecord.PrintR\x05print\x12>\n\tcall_spec\x18\x0b \x01(\x0b\x32!.autokitteh.sessions.v1.Call.SpecR\x08\x63\x61llSpec\x12X\n\x12\x63\x61ll_attempt_start\x18\x0c \x01(\x0b\x32*.autokitteh.sessions.v1.Call.Attempt.StartR\x10\x63\x61llAttemptStart\x12\x61\n\x15\x63\x61ll_attempt_complete\x18\r \x01(\x0b\x32-.autokitteh.sessions.v1.Call.Attempt.CompleteR\x13\x63\x61llAttemptComplete\x12:\n\x05state\x18\x0e \x01(\x0b\x32$.autokitteh.sessions.v1.SessionStateR\x05state\x12W\n\x0cstop_request\x18\x0f \x01(\x0b\x32\x34.autokitteh.sessions.v1.SessionLogRecord.StopRequestR\x0bstopRequest\x12J\n\x07outcome\x18\x10 \x01(\x0b\x32\x30.autokitteh.sessions.v1.SessionLogRecord.OutcomeR\x07outcome\x12Y\n\x0c\x63ompensation\x18\x11 \x01(\x0b\x32\x35.autokitteh.sessions.v1.SessionLogRecord.CompensationR\x0c\x63ompensation\x1ai\n\x05Print\x12\x12\n\x04text\x18\x01 \x01(\tR\x04text\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value\x12\x19\n\x08\x63\x61ll_seq\x18\x03 \x01(\rR\x07\x63\x61llSeq\x1a%\n\x0bStopRequest\x12\x16\n\x06reason\x18\x02 \x01(\tR\x06reason\x1aW\n\x07Outcome\x12\x31\n\x05value\x18\x01 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value\x12\x19\n\x08\x65vent_id\x18\x02 \x01(\tR\x07\x65ventId\x1a\x96\x01\n\x0c\x43ompensation\x12\x37\n\x08\x66unction\x18\x01 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x08\x66unction\x12\x19\n\x08\x63\x61ll_seq\x18\x02 \x01(\rR\x07\x63\x61llSeq\x12\x32\n\x05\x65rror\x18\x03 \x01(\x0b\x32\x1c.autokitteh.program.v1.ErrorR\x05\x65rror\"\xce\x01\n\x04Type\x12\x14\n\x10TYPE_UNSPECIFIED\x10\x00\x12\x0e\n\nTYPE_PRINT\x10\x01\x12\x12\n\x0eTYPE_CALL_SPEC\x10\x02\x12\x1b\n\x17TYPE_CALL_ATTEMPT_START\x10\x04\x12\x1e\n\x1aTYPE_CALL_ATTEMPT_COMPLETE\x10\x08\x12\x0e\n\nTYPE_STATE\x10\x10\x12\x15\n\x11TYPE_STOP_REQUEST\x10 \x12\x10\n\x0cTYPE_OUTCOME\x10@\x12\x16\n\x11TYPE_COMPENSATION\x10\x80\x01\"\xed\x08\n\x07Session\x12\x1d\n\nsession_id\x18\x01 \x01(\tR\tsessionId\x12\x19\n\x08\x62uild_id\x18\x02 \x01(\tR\x07\x62uildId\x12\x1d\n\nproject_id\x18\x03 \x01(\tR\tprojectId\x12L\n\nentrypoint\x18\x04 \x01(\x0b\x32#.autokitteh.program.v1.CodeLocationB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\nentrypoint\x12W\n\x06inputs\x18\x05 \x03(\x0b\x32+.autokitteh.sessions.v1.Session.InputsEntryB\x12\xfa\xf7\x18\x0e\x9a\x01\x0b\"\x04r\x02\x10\x01*\x03\xc8\x01\x01R\x06inputs\x12*\n\x11parent_session_id\x18\x06 \x01(\tR\x0fparentSessionId\x12=\n\x04memo\x18\x07 \x03(\x0b\x32).autokitteh.sessions.v1.Session.MemoEntryR\x04memo\x12\x39\n\ncreated_at\x18\n \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x0b \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n\x05state\x18\x0c \x01(\x0e\x32(.autokitteh.sessions.v1.SessionStateTypeR\x05state\x12\x1d\n\nis_durable\x18\r \x01(\x08R\tisDurable\x12-\n\x13retry_of_session_id\x18\x0e \x01(\tR\x10retryOfSessionId\x12\x18\n\x07\x61ttempt\x18\x0f \x01(\rR\x07\x61ttempt\x12\x33\n\x07timeout\x18\x10 \x01(\x0b\x32\x19.google.protobuf.DurationR\x07timeout\x12<\n\x07on_stop\x18\x11 \x01(\x0b\x32#.autokitteh.program.v1.CodeLocationR\x06onStop\x12=\n\x04tags\x18\x12 \x03(\x0b\x32).autokitteh.sessions.v1.Session.TagsEntryR\x04tags\x12#\n\rdeployment_id\x18\x14 \x01(\tR\x0c\x64\x65ploymentId\x12\x19\n\x08\x65vent_id\x18\x15 \x01(\tR\x07\x65ventId\x12\x1d\n\ntrigger_id\x18\x16 \x01(\tR\ttriggerId\x1aV\n\x0bInputsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value:\x02\x38\x01\x1a\x37\n\tMemoEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\x1a\x37\n\tTagsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01*\xd6\x01\n\x10SessionStateType\x12\"\n\x1eSESSION_STATE_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n\x1aSESSION_STATE_TYPE_CREATED\x10\x01\x12\x1e\n\x1aSESSION_STATE_TYPE_RUNNING\x10\x02\x12\x1c\n\x18SESSION_STATE_TYPE_ERROR\x10\x03\x12 \n\x1cSESSION_STATE_TYPE_COMPLETED\x10\x04\x12\x1e\n\x1aSESSION_STATE_TYPE_STOPPED\x10\x05\x42\xf1\x01\n\x1a\x63om.autokitteh.sessions.v1B\x0cSessionProtoP\x01ZKgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/sessions/v1;sessionsv1\xa2\x02\x03\x41SX\xaa\x02\x16\x41utokitteh.Sessions.V1\xca\x02\x16\x41utokitteh\\Sessions\\V1\xe2\x02\"Autokitteh\\Sessions\\V1\\GPBMetadata\xea\x02\x18\x41utokitteh::Sessions::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -54,14 +54,16 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _SESSION_INPUTSENTRY._serialized_options = b'8\001'
   _SESSION_MEMOENTRY._options = None
   _SESSION_MEMOENTRY._serialized_options = b'8\001'
+  _SESSION_TAGSENTRY._options = None
+  _SESSION_TAGSENTRY._serialized_options = b'8\001'
   _SESSION.fields_by_name['entrypoint']._options = None
   _SESSION.fields_by_name['entrypoint']._serialized_options = b'\372\367\030\003\310\001\001'
   _SESSION.fields_by_name['inputs']._options = None
   _SESSION.fields_by_name['inputs']._serialized_options = b'\372\367\030\016\232\001\013\"\004r\002\020\001*\003\310\001\001'
-  _globals['_SESSIONSTATETYPE']._serialized_start=4091
-  _globals['_SESSIONSTATETYPE']._serialized_end=4305
+  _globals['_SESSIONSTATETYPE']._serialized_start=4727
+  _globals['_SESSIONSTATETYPE']._serialized_end=4941
   _globals['_SESSIONSTATE']._serialized_start=231
-  _globals['_SESSIONSTATE']._serialized_end=1135
+  _globals['_SESSIONSTATE']._serialized_end=1164
   _globals['_SESSIONSTATE_CREATED']._serialized_start=607
   _globals['_SESSIONSTATE_CREATED']._serialized_end=616
   _globals['_SESSIONSTATE_RUNNING']._serialized_start=618
@@ -73,35 +75,39 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _globals['_SESSIONSTATE_COMPLETED_EXPORTSENTRY']._serialized_start=1013
   _globals['_SESSIONSTATE_COMPLETED_EXPORTSENTRY']._serialized_end=1100
   _globals['_SESSIONSTATE_STOPPED']._serialized_start=1102
-  _globals['_SESSIONSTATE_STOPPED']._serialized_end=1135
-  _globals['_CALL']._serialized_start=1138
-  _globals['_CALL']._serialized_end=2229
-  _globals['_CALL_SPEC']._serialized_start=1291
-  _globals['_CALL_SPEC']._serialized_end=1623
-  _globals['_CALL_SPEC_KWARGSENTRY']._serialized_start=1537
-  _globals['_CALL_SPEC_KWARGSENTRY']._serialized_end=1623
-  _globals['_CALL_ATTEMPT']._serialized_start=1626
-  _globals['_CALL_ATTEMPT']._serialized_end=2229
-  _globals['_CALL_ATTEMPT_RESULT']._serialized_start=1778
-  _globals['_CALL_ATTEMPT_RESULT']._serialized_end=1889
-  _globals['_CALL_ATTEMPT_START']._serialized_start=1891
-  _globals['_CALL_ATTEMPT_START']._serialized_end=1984
-  _globals['_CALL_ATTEMPT_COMPLETE']._serialized_start=1987
-  _globals['_CALL_ATTEMPT_COMPLETE']._serialized_end=2229
-  _globals['_SESSIONLOGRECORD']._serialized_start=2232
-  _globals['_SESSIONLOGRECORD']._serialized_end=3291
-  _globals['_SESSIONLOGRECORD_PRINT']._serialized_start=2873
-  _globals['_SESSIONLOGRECORD_PRINT']._serialized_end=2978
-  _globals['_SESSIONLOGRECORD_STOPREQUEST']._serialized_start=2980
-  _globals['_SESSIONLOGRECORD_STOPREQUEST']._serialized_end=3017
-  _globals['_SESSIONLOGRECORD_OUTCOME']._serialized_start=3019
-  _globals['_SESSIONLOGRECORD_OUTCOME']._serialized_end=3106
-  _globals['_SESSIONLOGRECORD_TYPE']._serialized_start=3109
-  _globals['_SESSIONLOGRECORD_TYPE']._serialized_end=3291
-  _globals['_SESSION']._serialized_start=3294
-  _globals['_SESSION']._serialized_end=4088
-  _globals['_SESSION_INPUTSENTRY']._serialized_start=3945
-  _globals['_SESSION_INPUTSENTRY']._serialized_end=4031
-  _globals['_SESSION_MEMOENTRY']._serialized_start=4033
-  _globals['_SESSION_MEMOENTRY']._serialized_end=4088
+  _globals['_SESSIONSTATE_STOPPED']._serialized_end=1164
+  _globals['_CALL']._serialized_start=1167
+  _globals['_CALL']._serialized_end=2258
+  _globals['_CALL_SPEC']._serialized_start=1320
+  _globals['_CALL_SPEC']._serialized_end=1652
+  _globals['_CALL_SPEC_KWARGSENTRY']._serialized_start=1566
+  _globals['_CALL_SPEC_KWARGSENTRY']._serialized_end=1652
+  _globals['_CALL_ATTEMPT']._serialized_start=1655
+  _globals['_CALL_ATTEMPT']._serialized_end=2258
+  _globals['_CALL_ATTEMPT_RESULT']._serialized_start=1807
+  _globals['_CALL_ATTEMPT_RESULT']._serialized_end=1918
+  _globals['_CALL_ATTEMPT_START']._serialized_start=1920
+  _globals['_CALL_ATTEMPT_START']._serialized_end=2013
+  _globals['_CALL_ATTEMPT_COMPLETE']._serialized_start=2016
+  _globals['_CALL_ATTEMPT_COMPLETE']._serialized_end=2258
+  _globals['_SESSIONLOGRECORD']._serialized_start=2261
+  _globals['_SESSIONLOGRECORD']._serialized_end=3588
+  _globals['_SESSIONLOGRECORD_PRINT']._serialized_start=2993
+  _globals['_SESSIONLOGRECORD_PRINT']._serialized_end=3098
+  _globals['_SESSIONLOGRECORD_STOPREQUEST']._serialized_start=3100
+  _globals['_SESSIONLOGRECORD_STOPREQUEST']._serialized_end=3137
+  _globals['_SESSIONLOGRECORD_OUTCOME']._serialized_start=3139
+  _globals['_SESSIONLOGRECORD_OUTCOME']._serialized_end=3226
+  _globals['_SESSIONLOGRECORD_COMPENSATION']._serialized_start=3229
+  _globals['_SESSIONLOGRECORD_COMPENSATION']._serialized_end=3379
+  _globals['_SESSIONLOGRECORD_TYPE']._serialized_start=3382
+  _globals['_SESSIONLOGRECORD_TYPE']._serialized_end=3588
+  _globals['_SESSION']._serialized_start=3591
+  _globals['_SESSION']._serialized_end=4724
+  _globals['_SESSION_INPUTSENTRY']._serialized_start=4524
+  _globals['_SESSION_INPUTSENTRY']._serialized_end=4610
+  _globals['_SESSION_MEMOENTRY']._serialized_start=4612
+  _globals['_SESSION_MEMOENTRY']._serialized_end=4667
+  _globals['_SESSION_TAGSENTRY']._serialized_start=4669
+  _globals['_SESSION_TAGSENTRY']._serialized_end=4724
 # @@protoc_insertion_point(module_scope)
@@ -62,10 +62,12 @@ class SessionState(_message.Message):
         return_value: _values_pb2.Value
         def __init__(self, prints: _Optional[_Iterable[str]] = ..., exports: _Optional[_Mapping[str, _values_pb2.Value]] = ..., return_value: _Optional[_Union[_values_pb2.Value, _Mapping]] = ...) -> None: ...
     class Stopped(_message.Message):
-        __slots__ = ["reason"]
+        __slots__ = ["reason", "timed_out"]
         REASON_FIELD_NUMBER: _ClassVar[int]
+        TIMED_OUT_FIELD_NUMBER: _ClassVar[int]
         reason: str
-        def __init__(self, reason: _Optional[str] = ...) -> None: ...
+        timed_out: bool
+        def __init__(self, reason: _Optional[str] = ..., timed_out: bool = ...) -> None: ...
     CREATED_FIELD_NUMBER: _ClassVar[int]
     RUNNING_FIELD_NUMBER: _ClassVar[int]
     ERROR_FIELD_NUMBER: _ClassVar[int]
@@ -137,7 +139,7 @@ class Call(_message.Message):
     def __init__(self, spec: _Optional[_Union[Call.Spec, _Mapping]] = ..., attempts: _Optional[_Iterable[_Union[Call.Attempt, _Mapping]]] = ...) -> None: ...
 
 class SessionLogRecord(_message.Message):
-    __slots__ = ["t", "process_id", "print", "call_spec", "call_attempt_start", "call_attempt_complete", "state", "stop_request", "outcome"]
+    __slots__ = ["t", "process_id", "print", "call_spec", "call_attempt_start", "call_attempt_complete", "state", "stop_request", "outcome", "compensation"]
     class Type(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
         __slots__ = []
         TYPE_UNSPECIFIED: _ClassVar[SessionLogRecord.Type]
@@ -148,6 +150,7 @@ class SessionLogRecord(_message.Message):
         TYPE_STATE: _ClassVar[SessionLogRecord.Type]
         TYPE_STOP_REQUEST: _ClassVar[SessionLogRecord.Type]
         TYPE_OUTCOME: _ClassVar[SessionLogRecord.Type]
+        TYPE_COMPENSATION: _ClassVar[SessionLogRecord.Type]
     TYPE_UNSPECIFIED: SessionLogRecord.Type
     TYPE_PRINT: SessionLogRecord.Type
     TYPE_CALL_SPEC: SessionLogRecord.Type
@@ -156,6 +159,7 @@ class SessionLogRecord(_message.Message):
     TYPE_STATE: SessionLogRecord.Type
     TYPE_STOP_REQUEST: SessionLogRecord.Type
     TYPE_OUTCOME: SessionLogRecord.Type
+    TYPE_COMPENSATION: SessionLogRecord.Type
     class Print(_message.Message):
         __slots__ = ["text", "value", "call_seq"]
         TEXT_FIELD_NUMBER: _ClassVar[int]
@@ -177,6 +181,15 @@ class SessionLogRecord(_message.Message):
         value: _values_pb2.Value
         event_id: str
         def __init__(self, value: _Optional[_Union[_values_pb2.Value, _Mapping]] = ..., event_id: _Optional[str] = ...) -> None: ...
+    class Compensation(_message.Message):
+        __slots__ = ["function", "call_seq", "error"]
+        FUNCTION_FIELD_NUMBER: _ClassVar[int]
+        CALL_SEQ_FIELD_NUMBER: _ClassVar[int]
+        ERROR_FIELD_NUMBER: _ClassVar[int]
+        function: _values_pb2.Value
+        call_seq: int
+        error: _program_pb2.Error
+        def __init__(self, function: _Optional[_Union[_values_pb2.Value, _Mapping]] = ..., call_seq: _Optional[int] = ..., error: _Optional[_Union[_program_pb2.Error, _Mapping]] = ...) -> None: ...
     T_FIELD_NUMBER: _ClassVar[int]
     PROCESS_ID_FIELD_NUMBER: _ClassVar[int]
     PRINT_FIELD_NUMBER: _ClassVar[int]
@@ -186,6 +199,7 @@ class SessionLogRecord(_message.Message):
     STATE_FIELD_NUMBER: _ClassVar[int]
     STOP_REQUEST_FIELD_NUMBER: _ClassVar[int]
     OUTCOME_FIELD_NUMBER: _ClassVar[int]
+    COMPENSATION_FIELD_NUMBER: _ClassVar[int]
     t: _timestamp_pb2.Timestamp
     process_id: str
     print: SessionLogRecord.Print
@@ -195,10 +209,11 @@ class SessionLogRecord(_message.Message):
     state: SessionState
     stop_request: SessionLogRecord.StopRequest
     outcome: SessionLogRecord.Outcome
-    def __init__(self, t: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., process_id: _Optional[str] = ..., print: _Optional[_Union[SessionLogRecord.Print, _Mapping]] = ..., call_spec: _Optional[_Union[Call.Spec, _Mapping]] = ..., call_attempt_start: _Optional[_Union[Call.Attempt.Start, _Mapping]] = ..., call_attempt_complete: _Optional[_Union[Call.Attempt.Complete, _Mapping]] = ..., state: _Optional[_Union[SessionState, _Mapping]] = ..., stop_request: _Optional[_Union[SessionLogRecord.StopRequest, _Mapping]] = ..., outcome: _Optional[_Union[SessionLogRecord.Outcome, _Mapping]] = ...) -> None: ...
+    compensation: SessionLogRecord.Compensation
+    def __init__(self, t: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., process_id: _Optional[str] = ..., print: _Optional[_Union[SessionLogRecord.Print, _Mapping]] = ..., call_spec: _Optional[_Union[Call.Spec, _Mapping]] = ..., call_attempt_start: _Optional[_Union[Call.Attempt.Start, _Mapping]] = ..., call_attempt_complete: _Optional[_Union[Call.Attempt.Complete, _Mapping]] = ..., state: _Optional[_Union[SessionState, _Mapping]] = ..., stop_request: _Optional[_Union[SessionLogRecord.StopRequest, _Mapping]] = ..., outcome: _Optional[_Union[SessionLogRecord.Outcome, _Mapping]] = ..., compensation: _Optional[_Union[SessionLogRecord.Compensation, _Mapping]] = ...) -> None: ...
 
 class Session(_message.Message):
-    __slots__ = ["session_id", "build_id", "project_id", "entrypoint", "inputs", "parent_session_id", "memo", "created_at", "updated_at", "state", "is_durable", "deployment_id", "event_id"]
+    __slots__ = ["session_id", "build_id", "project_id", "entrypoint", "inputs", "parent_session_id", "memo", "created_at", "updated_at", "state", "is_durable", "retry_of_session_id", "attempt", "timeout", "on_stop", "tags", "deployment_id", "event_id", "trigger_id"]
     class InputsEntry(_message.Message):
         __slots__ = ["key", "value"]
         KEY_FIELD_NUMBER: _ClassVar[int]
@@ -213,6 +228,13 @@ class Session(_message.Message):
         key: str
         value: str
         def __init__(self, key: _Optional[str] = ..., value: _Optional[str] = ...) -> None: ...
+    class TagsEntry(_message.Message):
+        __slots__ = ["key", "value"]
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: str
+        def __init__(self, key: _Optional[str] = ..., value: _Optional[str] = ...) -> None: ...
     SESSION_ID_FIELD_NUMBER: _ClassVar[int]
     BUILD_ID_FIELD_NUMBER: _ClassVar[int]
     PROJECT_ID_FIELD_NUMBER: _ClassVar[int]
@@ -224,8 +246,14 @@ class Session(_message.Message):
     UPDATED_AT_FIELD_NUMBER: _ClassVar[int]
     STATE_FIELD_NUMBER: _ClassVar[int]
     IS_DURABLE_FIELD_NUMBER: _ClassVar[int]
+    RETRY_OF_SESSION_ID_FIELD_NUMBER: _ClassVar[int]
+    ATTEMPT_FIELD_NUMBER: _ClassVar[int]
+    TIMEOUT_FIELD_NUMBER: _ClassVar[int]
+    ON_STOP_FIELD_NUMBER: _ClassVar[int]
+    TAGS_FIELD_NUMBER: _ClassVar[int]
     DEPLOYMENT_ID_FIELD_NUMBER: _ClassVar[int]
     EVENT_ID_FIELD_NUMBER: _ClassVar[int]
+    TRIGGER_ID_FIELD_NUMBER: _ClassVar[int]
     session_id: str
     build_id: str
     project_id: str
@@ -237,6 +265,12 @@ class Session(_message.Message):
     updated_at: _timestamp_pb2.Timestamp
     state: SessionStateType
     is_durable: bool
+    retry_of_session_id: str
+    attempt: int
+    timeout: _duration_pb2.Duration
+    on_stop: _program_pb2.CodeLocation
+    tags: _containers.ScalarMap[str, str]
     deployment_id: str
     event_id: str
-    def __init__(self, session_id: _Optional[str] = ..., build_id: _Optional[str] = ..., project_id: _Optional[str] = ..., entrypoint: _Optional[_Union[_program_pb2.CodeLocation, _Mapping]] = ..., inputs: _Optional[_Mapping[str, _values_pb2.Value]] = ..., parent_session_id: _Optional[str] = ..., memo: _Optional[_Mapping[str, str]] = ..., created_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., updated_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., state: _Optional[_Union[SessionStateType, str]] = ..., is_durable: bool = ..., deployment_id: _Optional[str] = ..., event_id: _Optional[str] = ...) -> None: ...
+    trigger_id: str
+    def __init__(self, session_id: _Optional[str] = ..., build_id: _Optional[str] = ..., project_id: _Optional[str] = ..., entrypoint: _Optional[_Union[_program_pb2.CodeLocation, _Mapping]] = ..., inputs: _Optional[_Mapping[str, _values_pb2.Value]] = ..., parent_session_id: _Optional[str] = ..., memo: _Optional[_Mapping[str, str]] = ..., created_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., updated_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., state: _Optional[_Union[SessionStateType, str]] = ..., is_durable: bool = ..., retry_of_session_id: _Optional[str] = ..., attempt: _Optional[int] = ..., timeout: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., on_stop: _Optional[_Union[_program_pb2.CodeLocation, _Mapping]] = ..., tags: _Optional[_Mapping[str, str]] = ..., deployment_id: _Optional[str] = ..., event_id: _Optional[str] = ..., trigger_id: _Optional[str] = ...) -> None: ...
@@ -11,6 +11,7 @@ from google.protobuf.internal import builder as _builder
 _sym_db = _symbol_database.Default()
 
 
+from autokitteh_pb.program.v1 import program_pb2 as autokitteh_dot_program_dot_v1_dot_program__pb2
 from autokitteh_pb.sessions.v1 import session_pb2 as autokitteh_dot_sessions_dot_v1_dot_session__pb2
 from autokitteh_pb.values.v1 import values_pb2 as autokitteh_dot_values_dot_v1_dot_values__pb2
 from buf.validate import validate_pb2 as buf_dot_validate_dot_validate__pb2
@@ -18,7 +19,7 @@ from google.protobuf import duration_pb2 as google_dot_protobuf_dot_duration__pb
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n autokitteh/sessions/v1/svc.proto\x12\x16\x61utokitteh.sessions.v1\x1a$autokitteh/sessions/v1/session.proto\x1a!autokitteh/values/v1/values.proto\x1a\x1b\x62uf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x94\x03\n\x0cStartRequest\x12\x42\n\x07session\x18\x01 \x01(\x0b\x32\x1f.autokitteh.sessions.v1.SessionB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x07session\x12U\n\x0bjson_inputs\x18\x02 \x03(\x0b\x32\x34.autokitteh.sessions.v1.StartRequest.JsonInputsEntryR\njsonInputs\x12*\n\x11json_object_input\x18\x03 \x01(\tR\x0fjsonObjectInput\x1a=\n\x0fJsonInputsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01:~\xfa\xf7\x18z\x1ax\n session.session_id_must_be_empty\x12 session_id must not be specified\x1a\x32has(this.session) && this.session.session_id == \'\'\"8\n\rStartResponse\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\"\xb4\x01\n\x0bStopRequest\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\x12\x16\n\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1c\n\tterminate\x18\x03 \x01(\x08R\tterminate\x12\x46\n\x11termination_delay\x18\x04 \x01(\x0b\x32\x19.google.protobuf.DurationR\x10terminationDelay\"\x0e\n\x0cStopResponse\"\x96\x03\n\x0bListRequest\x12#\n\rdeployment_id\x18\x01 \x01(\tR\x0c\x64\x65ploymentId\x12\x1d\n\nproject_id\x18\x02 \x01(\tR\tprojectId\x12\x19\n\x08\x65vent_id\x18\x03 \x01(\tR\x07\x65ventId\x12\x19\n\x08\x62uild_id\x18\x04 \x01(\tR\x07\x62uildId\x12R\n\nstate_type\x18\x05 \x01(\x0e\x32(.autokitteh.sessions.v1.SessionStateTypeB\t\xfa\xf7\x18\x05\x82\x01\x02\x10\x01R\tstateType\x12\x15\n\x06org_id\x18\x06 \x01(\tR\x05orgId\x12\x1d\n\ncount_only\x18\n \x01(\x08R\tcountOnly\x12\x1b\n\tpage_size\x18\x14 \x01(\x05R\x08pageSize\x12G\n\x04skip\x18\x15 \x01(\x05\x42\x33\xfa\xf7\x18/\xba\x01,\n\x11session.list.skip\x12\x0cMust be >= 0\x1a\tthis >= 0R\x04skip\x12\x1d\n\npage_token\x18\x16 \x01(\tR\tpageToken\"\x97\x01\n\x0cListResponse\x12I\n\x08sessions\x18\x01 \x03(\x0b\x32\x1f.autokitteh.sessions.v1.SessionB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\x08sessions\x12\x14\n\x05\x63ount\x18\x02 \x01(\x03R\x05\x63ount\x12&\n\x0fnext_page_token\x18\n \x01(\tR\rnextPageToken\"V\n\nGetRequest\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\x12\x1f\n\x0bjson_values\x18\x02 \x01(\x08R\njsonValues\"Q\n\x0bGetResponse\x12\x42\n\x07session\x18\x01 \x01(\x0b\x32\x1f.autokitteh.sessions.v1.SessionB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x07session\"\xc1\x02\n\rGetLogRequest\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\x12\x1f\n\x0bjson_values\x18\x02 \x01(\x08R\njsonValues\x12\x43\n\x05types\x18\x03 \x01(\x0e\x32-.autokitteh.sessions.v1.SessionLogRecord.TypeR\x05types\x12\x1c\n\tascending\x18\x0b \x01(\x08R\tascending\x12\x1b\n\tpage_size\x18\x14 \x01(\x05R\x08pageSize\x12G\n\x04skip\x18\x15 \x01(\x05\x42\x33\xfa\xf7\x18/\xba\x01,\n\x11session.list.skip\x12\x0cMust be >= 0\x1a\tthis >= 0R\x04skip\x12\x1d\n\npage_token\x18\x16 \x01(\tR\tpageToken\"\xa6\x01\n\x0eGetLogResponse\x12\x14\n\x05\x63ount\x18\x02 \x01(\x03R\x05\x63ount\x12P\n\x07records\x18\x03 \x03(\x0b\x32(.autokitteh.sessions.v1.SessionLogRecordB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\x07records\x12&\n\x0fnext_page_token\x18\n \x01(\tR\rnextPageTokenJ\x04\x08\x01\x10\x02\">\n\x13\x44ownloadLogsRequest\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\"*\n\x14\x44ownloadLogsResponse\x12\x12\n\x04\x64\x61ta\x18\x01 \x01(\x0cR\x04\x64\x61ta\"\xde\x01\n\x10GetPrintsRequest\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\x12\x1c\n\tascending\x18\x0b \x01(\x08R\tascending\x12\x1b\n\tpage_size\x18\x14 \x01(\x05R\x08pageSize\x12G\n\x04skip\x18\x15 \x01(\x05\x42\x33\xfa\xf7\x18/\xba\x01,\n\x11session.list.skip\x12\x0cMust be >= 0\x1a\tthis >= 0R\x04skip\x12\x1d\n\npage_token\x18\x16 \x01(\tR\tpageToken\"\xf0\x01\n\x11GetPrintsResponse\x12U\n\x06prints\x18\x01 \x03(\x0b\x32/.autokitteh.sessions.v1.GetPrintsResponse.PrintB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\x06prints\x12&\n\x0fnext_page_token\x18\n \x01(\tR\rnextPageToken\x1a\\\n\x05Print\x12)\n\x01v\x18\x01 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x01v\x12(\n\x01t\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x01t\"8\n\rDeleteRequest\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\"\x10\n\x0e\x44\x65leteResponse2\xdc\x05\n\x0fSessionsService\x12T\n\x05Start\x12$.autokitteh.sessions.v1.StartRequest\x1a%.autokitteh.sessions.v1.StartResponse\x12Q\n\x04Stop\x12#.autokitteh.sessions.v1.StopRequest\x1a$.autokitteh.sessions.v1.StopResponse\x12Q\n\x04List\x12#.autokitteh.sessions.v1.ListRequest\x1a$.autokitteh.sessions.v1.ListResponse\x12N\n\x03Get\x12\".autokitteh.sessions.v1.GetRequest\x1a#.autokitteh.sessions.v1.GetResponse\x12W\n\x06GetLog\x12%.autokitteh.sessions.v1.GetLogRequest\x1a&.autokitteh.sessions.v1.GetLogResponse\x12i\n\x0c\x44ownloadLogs\x12+.autokitteh.sessions.v1.DownloadLogsRequest\x1a,.autokitteh.sessions.v1.DownloadLogsResponse\x12`\n\tGetPrints\x12(.autokitteh.sessions.v1.GetPrintsRequest\x1a).autokitteh.sessions.v1.GetPrintsResponse\x12W\n\x06\x44\x65lete\x12%.autokitteh.sessions.v1.DeleteRequest\x1a&.autokitteh.sessions.v1.DeleteResponseB\xed\x01\n\x1a\x63om.autokitteh.sessions.v1B\x08SvcProtoP\x01ZKgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/sessions/v1;sessionsv1\xa2\x02\x03\x41SX\xaa\x02\x16\x41utokitteh.Sessions.V1\xca\x02\x16\x41utokitteh\\Sessions\\V1\xe2\x02\"Autokitteh\\Sessions\\V1\\GPBMetadata\xea\x02\x18\x41utokitteh::Sessions::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n autokitteh/sessions/v1/svc.proto\x12\x16\x61utokitteh.sessions.v1\x1a#autokitteh/program/v1/program.proto\x1a$autokitteh/sessions/v1/session.proto\x1a!autokitteh/values/v1/values.proto\x1a\x1b\x62uf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x94\x03\n\x0cStartRequest\x12\x42\n\x07session\x18\x01 \x01(\x0b\x32\x1f.autokitteh.sessions.v1.SessionB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x07session\x12U\n\x0bjson_inputs\x18\x02 \x03(\x0b\x32\x34.autokitteh.sessions.v1.StartRequest.JsonInputsEntryR\njsonInputs\x12*\n\x11json_object_input\x18\x03 \x01(\tR\x0fjsonObjectInput\x1a=\n\x0fJsonInputsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01:~\xfa\xf7\x18z\x1ax\n session.session_id_must_be_empty\x12 session_id must not be specified\x1a\x32has(this.session) && this.session.session_id == \'\'\"8\n\rStartResponse\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\"\xb4\x01\n\x0bStopRequest\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\x12\x16\n\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1c\n\tterminate\x18\x03 \x01(\x08R\tterminate\x12\x46\n\x11termination_delay\x18\x04 \x01(\x0b\x32\x19.google.protobuf.DurationR\x10terminationDelay\"\x0e\n\x0cStopResponse\"\x96\x05\n\x0bListRequest\x12#\n\rdeployment_id\x18\x01 \x01(\tR\x0c\x64\x65ploymentId\x12\x1d\n\nproject_id\x18\x02 \x01(\tR\tprojectId\x12\x19\n\x08\x65vent_id\x18\x03 \x01(\tR\x07\x65ventId\x12\x19\n\x08\x62uild_id\x18\x04 \x01(\tR\x07\x62uildId\x12R\n\nstate_type\x18\x05 \x01(\x0e\x32(.autokitteh.sessions.v1.SessionStateTypeB\t\xfa\xf7\x18\x05\x82\x01\x02\x10\x01R\tstateType\x12\x15\n\x06org_id\x18\x06 \x01(\tR\x05orgId\x12\x41\n\x04tags\x18\x07 \x03(\x0b\x32-.autokitteh.sessions.v1.ListRequest.TagsEntryR\x04tags\x12?\n\rcreated_after\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x0c\x63reatedAfter\x12\x41\n\x0e\x63reated_before\x18\t \x01(\x0b\x32\x1a.google.protobuf.TimestampR\rcreatedBefore\x12\x1d\n\ncount_only\x18\n \x01(\x08R\tcountOnly\x12\x1b\n\tpage_size\x18\x14 \x01(\x05R\x08pageSize\x12G\n\x04skip\x18\x15 \x01(\x05\x42\x33\xfa\xf7\x18/\xba\x01,\n\x11session.list.skip\x12\x0cMust be >= 0\x1a\tthis >= 0R\x04skip\x12\x1d\n\npage_token\x18\x16 \x01(\tR\tpageToken\x1a\x37\n\tTagsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\"\x97\x01\n\x0cListResponse\x12I\n\x08sessions\x18\x01 \x03(\x0b\x32\x1f.autokitteh.sessions.v1.SessionB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\x08sessions\x12\x14\n\x05\x63ount\x18\x02 \x01(\x03R\x05\x63ount\x12&\n\x0fnext_page_token\x18\n \x01(\tR\rnextPageToken\"\xf3\x01\n\rSearchRequest\x12\x44\n\x06\x66ilter\x18\x01 \x01(\x0b\x32#.autokitteh.sessions.v1.ListRequestB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x06\x66ilter\x12%\n\x0eprints_contain\x18\x02 \x01(\tR\rprintsContain\x12%\n\x0e\x65rror_contains\x18\x03 \x01(\tR\rerrorContains\x12!\n\x0ctrigger_name\x18\x06 \x01(\tR\x0btriggerName\x12\x1f\n\x0b\x65ntry_point\x18\x07 \x01(\tR\nentryPointJ\x04\x08\x04\x10\x05J\x04\x08\x05\x10\x06\"\x99\x01\n\x0eSearchResponse\x12I\n\x08sessions\x18\x01 \x03(\x0b\x32\x1f.autokitteh.sessions.v1.SessionB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\x08sessions\x12\x14\n\x05\x63ount\x18\x02 \x01(\x03R\x05\x63ount\x12&\n\x0fnext_page_token\x18\n \x01(\tR\rnextPageToken\"V\n\nGetRequest\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\x12\x1f\n\x0bjson_values\x18\x02 \x01(\x08R\njsonValues\"Q\n\x0bGetResponse\x12\x42\n\x07session\x18\x01 \x01(\x0b\x32\x1f.autokitteh.sessions.v1.SessionB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x07session\"\xc1\x02\n\rGetLogRequest\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\x12\x1f\n\x0bjson_values\x18\x02 \x01(\x08R\njsonValues\x12\x43\n\x05types\x18\x03 \x01(\x0e\x32-.autokitteh.sessions.v1.SessionLogRecord.TypeR\x05types\x12\x1c\n\tascending\x18\x0b \x01(\x08R\tascending\x12\x1b\n\tpage_size\x18\x14 \x01(\x05R\x08pageSize\x12G\n\x04skip\x18\x15 \x01(\x05\x42\x33\xfa\xf7\x18/\xba\x01,\n\x11session.list.skip\x12\x0cMust be >= 0\x1a\tthis >= 0R\x04skip\x12\x1d\n\npage_token\x18\x16 \x01(\tR\tpageToken\"\xa6\x01\n\x0eGetLogResponse\x12\x14\n\x05\x63ount\x18\x02 \x01(\x03R\x05\x63ount\x12P\n\x07records\x18\x03 \x03(\x0b\x32(.autokitteh.sessions.v1.SessionLogRecordB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\x07records\x12&\n\x0fnext_page_token\x18\n \x01(\tR\rnextPageTokenJ\x04\x08\x01\x10\x02\"\x7f\n\x0fWatchLogRequest\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\x12\x43\n\x05types\x18\x02 \x01(\x0e\x32-.autokitteh.sessions.v1.SessionLogRecord.TypeR\x05types\"]\n\x10WatchLogResponse\x12I\n\x06record\x18\x01 \x01(\x0b\x32(.autokitteh.sessions.v1.SessionLogRecordB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x06record\">\n\x13\x44ownloadLogsRequest\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\"*\n\x14\x44ownloadLogsResponse\x12\x12\n\x04\x64\x61ta\x18\x01 \x01(\x0cR\x04\x64\x61ta\"\xde\x01\n\x10GetPrintsRequest\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\x12\x1c\n\tascending\x18\x0b \x01(\x08R\tascending\x12\x1b\n\tpage_size\x18\x14 \x01(\x05R\x08pageSize\x12G\n\x04skip\x18\x15 \x01(\x05\x42\x33\xfa\xf7\x18/\xba\x01,\n\x11session.list.skip\x12\x0cMust be >= 0\x1a\tthis >= 0R\x04skip\x12\x1d\n\npage_token\x18\x16 \x01(\tR\tpageToken\"\xf0\x01\n\x11GetPrintsResponse\x12U\n\x06prints\x18\x01 \x03(\x0b\x32/.autokitteh.sessions.v1.GetPrintsResponse.PrintB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\x06prints\x12&\n\x0fnext_page_token\x18\n \x01(\tR\rnextPageToken\x1a\\\n\x05Print\x12)\n\x01v\x18\x01 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x01v\x12(\n\x01t\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x01t\"X\n\rReplayRequest\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\x12\x1e\n\x0bstop_at_seq\x18\x02 \x01(\rR\tstopAtSeq\"\xd7\x04\n\x0eReplayResponse\x12W\n\x05\x63\x61lls\x18\x01 \x03(\x0b\x32\x33.autokitteh.sessions.v1.ReplayResponse.ReplayedCallB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\x05\x63\x61lls\x12\x16\n\x06prints\x18\x02 \x03(\tR\x06prints\x12\x18\n\x07stopped\x18\x03 \x01(\x08R\x07stopped\x12X\n\x06values\x18\x04 \x03(\x0b\x32\x32.autokitteh.sessions.v1.ReplayResponse.ValuesEntryB\x0c\xfa\xf7\x18\x08\x9a\x01\x05*\x03\xc8\x01\x01R\x06values\x12>\n\x0creturn_value\x18\x05 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x0breturnValue\x12\x32\n\x05\x65rror\x18\x06 \x01(\x0b\x32\x1c.autokitteh.program.v1.ErrorR\x05\x65rror\x1a\x93\x01\n\x0cReplayedCall\x12>\n\x04spec\x18\x01 \x01(\x0b\x32!.autokitteh.sessions.v1.Call.SpecB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x04spec\x12\x43\n\x06result\x18\x02 \x01(\x0b\x32+.autokitteh.sessions.v1.Call.Attempt.ResultR\x06result\x1aV\n\x0bValuesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value:\x02\x38\x01\"8\n\rExportRequest\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\"(\n\x0e\x45xportResponse\x12\x16\n\x06\x62undle\x18\x01 \x01(\x0cR\x06\x62undle\"Z\n\rImportRequest\x12\'\n\nproject_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tprojectId\x12 \n\x06\x62undle\x18\x02 \x01(\x0c\x42\x08\xfa\xf7\x18\x04z\x02\x10\x01R\x06\x62undle\"/\n\x0eImportResponse\x12\x1d\n\nsession_id\x18\x01 \x01(\tR\tsessionId\"8\n\rDeleteRequest\x12\'\n\nsession_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\tsessionId\"\x10\n\x0e\x44\x65leteResponse\"\x8d\x01\n\x0fStopManyRequest\x12\x44\n\x06\x66ilter\x18\x01 \x01(\x0b\x32#.autokitteh.sessions.v1.ListRequestB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x06\x66ilter\x12\x16\n\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1c\n\tterminate\x18\x03 \x01(\x08R\tterminate\"?\n\x10StopManyResponse\x12+\n\x0coperation_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\x0boperationId\"Y\n\x11\x44\x65leteManyRequest\x12\x44\n\x06\x66ilter\x18\x01 \x01(\x0b\x32#.autokitteh.sessions.v1.ListRequestB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x06\x66ilter\"A\n\x12\x44\x65leteManyResponse\x12+\n\x0coperation_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\x0boperationId\"Z\n\x12RestartManyRequest\x12\x44\n\x06\x66ilter\x18\x01 \x01(\x0b\x32#.autokitteh.sessions.v1.ListRequestB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x06\x66ilter\"B\n\x13RestartManyResponse\x12+\n\x0coperation_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\x0boperationId\"\xbe\x01\n\rBulkOperation\x12!\n\x0coperation_id\x18\x01 \x01(\tR\x0boperationId\x12\x12\n\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n\x04\x64one\x18\x03 \x01(\x08R\x04\x64one\x12\x14\n\x05total\x18\x04 \x01(\x03R\x05total\x12\x1c\n\tsucceeded\x18\x05 \x01(\x03R\tsucceeded\x12\x16\n\x06\x66\x61iled\x18\x06 \x01(\x03R\x06\x66\x61iled\x12\x16\n\x06\x65rrors\x18\x07 \x03(\tR\x06\x65rrors\"F\n\x17GetBulkOperationRequest\x12+\n\x0coperation_id\x18\x01 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\x0boperationId\"h\n\x18GetBulkOperationResponse\x12L\n\toperation\x18\x01 \x01(\x0b\x32%.autokitteh.sessions.v1.BulkOperationB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\toperation\"\xfd\x02\n\x0fRetentionPolicy\x12\x15\n\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1d\n\nproject_id\x18\x02 \x01(\tR\tprojectId\x12\x32\n\x07max_age\x18\x03 \x01(\x0b\x32\x19.google.protobuf.DurationR\x06maxAge\x12%\n\tmax_count\x18\x04 \x01(\x05\x42\x08\xfa\xf7\x18\x04\x1a\x02(\x00R\x08maxCount\x12P\n\x06states\x18\x05 \x03(\x0e\x32(.autokitteh.sessions.v1.SessionStateTypeB\x0e\xfa\xf7\x18\n\x92\x01\x07\"\x05\x82\x01\x02\x10\x01R\x06states:\x86\x01\xfa\xf7\x18\x81\x01\x1a\x7f\n\x16retention_policy.scope\x12\x35\x65xactly one of org_id or project_id must be specified\x1a.(this.org_id == \'\') != (this.project_id == \'\')\"e\n\x19SetRetentionPolicyRequest\x12H\n\x06policy\x18\x01 \x01(\x0b\x32\'.autokitteh.sessions.v1.RetentionPolicyB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x06policy\"\x1c\n\x1aSetRetentionPolicyResponse\"\xdf\x01\n\x19GetRetentionPolicyRequest\x12\x15\n\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1d\n\nproject_id\x18\x02 \x01(\tR\tprojectId:\x8b\x01\xfa\xf7\x18\x86\x01\x1a\x83\x01\n\x1aget_retention_policy.scope\x12\x35\x65xactly one of org_id or project_id must be specified\x1a.(this.org_id == \'\') != (this.project_id == \'\')\"]\n\x1aGetRetentionPolicyResponse\x12?\n\x06policy\x18\x01 \x01(\x0b\x32\'.autokitteh.sessions.v1.RetentionPolicyR\x06policy\"\xe5\x01\n\x1c\x44\x65leteRetentionPolicyRequest\x12\x15\n\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1d\n\nproject_id\x18\x02 \x01(\tR\tprojectId:\x8e\x01\xfa\xf7\x18\x89\x01\x1a\x86\x01\n\x1d\x64\x65lete_retention_policy.scope\x12\x35\x65xactly one of org_id or project_id must be specified\x1a.(this.org_id == \'\') != (this.project_id == \'\')\"\x1f\n\x1d\x44\x65leteRetentionPolicyResponse2\xc5\x0f\n\x0fSessionsService\x12T\n\x05Start\x12$.autokitteh.sessions.v1.StartRequest\x1a%.autokitteh.sessions.v1.StartResponse\x12Q\n\x04Stop\x12#.autokitteh.sessions.v1.StopRequest\x1a$.autokitteh.sessions.v1.StopResponse\x12Q\n\x04List\x12#.autokitteh.sessions.v1.ListRequest\x1a$.autokitteh.sessions.v1.ListResponse\x12W\n\x06Search\x12%.autokitteh.sessions.v1.SearchRequest\x1a&.autokitteh.sessions.v1.SearchResponse\x12N\n\x03Get\x12\".autokitteh.sessions.v1.GetRequest\x1a#.autokitteh.sessions.v1.GetResponse\x12W\n\x06GetLog\x12%.autokitteh.sessions.v1.GetLogRequest\x1a&.autokitteh.sessions.v1.GetLogResponse\x12_\n\x08WatchLog\x12\'.autokitteh.sessions.v1.WatchLogRequest\x1a(.autokitteh.sessions.v1.WatchLogResponse0\x01\x12i\n\x0c\x44ownloadLogs\x12+.autokitteh.sessions.v1.DownloadLogsRequest\x1a,.autokitteh.sessions.v1.DownloadLogsResponse\x12`\n\tGetPrints\x12(.autokitteh.sessions.v1.GetPrintsRequest\x1a).autokitteh.sessions.v1.GetPrintsResponse\x12W\n\x06\x44\x65lete\x12%.autokitteh.sessions.v1.DeleteRequest\x1a&.autokitteh.sessions.v1.DeleteResponse\x12W\n\x06Replay\x12%.autokitteh.sessions.v1.ReplayRequest\x1a&.autokitteh.sessions.v1.ReplayResponse\x12W\n\x06\x45xport\x12%.autokitteh.sessions.v1.ExportRequest\x1a&.autokitteh.sessions.v1.ExportResponse\x12W\n\x06Import\x12%.autokitteh.sessions.v1.ImportRequest\x1a&.autokitteh.sessions.v1.ImportResponse\x12]\n\x08StopMany\x12\'.autokitteh.sessions.v1.StopManyRequest\x1a(.autokitteh.sessions.v1.StopManyResponse\x12\x63\n\nDeleteMany\x12).autokitteh.sessions.v1.DeleteManyRequest\x1a*.autokitteh.sessions.v1.DeleteManyResponse\x12\x66\n\x0bRestartMany\x12*.autokitteh.sessions.v1.RestartManyRequest\x1a+.autokitteh.sessions.v1.RestartManyResponse\x12u\n\x10GetBulkOperation\x12/.autokitteh.sessions.v1.GetBulkOperationRequest\x1a\x30.autokitteh.sessions.v1.GetBulkOperationResponse\x12{\n\x12SetRetentionPolicy\x12\x31.autokitteh.sessions.v1.SetRetentionPolicyRequest\x1a\x32.autokitteh.sessions.v1.SetRetentionPolicyResponse\x12{\n\x12GetRetentionPolicy\x12\x31.autokitteh.sessions.v1.GetRetentionPolicyRequest\x1a\x32.autokitteh.sessions.v1.GetRetentionPolicyResponse\x12\x84\x01\n\x15\x44\x65leteRetentionPolicy\x12\x34.autokitteh.sessions.v1.DeleteRetentionPolicyRequest\x1a\x35.autokitteh.sessions.v1.DeleteRetentionPolicyResponseB\xed\x01\n\x1a\x63om.autokitteh.sessions.v1B\x08SvcProtoP\x01ZKgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/sessions/v1;sessionsv1\xa2\x02\x03\x41SX\xaa\x02\x16\x41utokitteh.Sessions.V1\xca\x02\x16\x41utokitteh\\Sessions\\V1\xe2\x02\"Autokitteh\\Sessions\\V1\\GPBMetadata\xea\x02\x18\x41utokitteh::Sessions::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -36,12 +37,18 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _STARTRESPONSE.fields_by_name['session_id']._serialized_options = b'\372\367\030\004r\002\020\001'
   _STOPREQUEST.fields_by_name['session_id']._options = None
   _STOPREQUEST.fields_by_name['session_id']._serialized_options = b'\372\367\030\004r\002\020\001'
+  _LISTREQUEST_TAGSENTRY._options = None
+  _LISTREQUEST_TAGSENTRY._serialized_options = b'8\001'
   _LISTREQUEST.fields_by_name['state_type']._options = None
   _LISTREQUEST.fields_by_name['state_type']._serialized_options = b'\372\367\030\005\202\001\002\020\001'
   _LISTREQUEST.fields_by_name['skip']._options = None
   _LISTREQUEST.fields_by_name['skip']._serialized_options = b'\372\367\030/\272\001,\n\021session.list.skip\022\014Must be >= 0\032\tthis >= 0'
   _LISTRESPONSE.fields_by_name['sessions']._options = None
   _LISTRESPONSE.fields_by_name['sessions']._serialized_options = b'\372\367\030\010\222\001\005\"\003\310\001\001'
+  _SEARCHREQUEST.fields_by_name['filter']._options = None
+  _SEARCHREQUEST.fields_by_name['filter']._serialized_options = b'\372\367\030\003\310\001\001'
+  _SEARCHRESPONSE.fields_by_name['sessions']._options = None
+  _SEARCHRESPONSE.fields_by_name['sessions']._serialized_options = b'\372\367\030\010\222\001\005\"\003\310\001\001'
   _GETREQUEST.fields_by_name['session_id']._options = None
   _GETREQUEST.fields_by_name['session_id']._serialized_options = b'\372\367\030\004r\002\020\001'
   _GETRESPONSE.fields_by_name['session']._options = None
@@ -52,6 +59,10 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _GETLOGREQUEST.fields_by_name['skip']._serialized_options = b'\372\367\030/\272\001,\n\021session.list.skip\022\014Must be >= 0\032\tthis >= 0'
   _GETLOGRESPONSE.fields_by_name['records']._options = None
   _GETLOGRESPONSE.fields_by_name['records']._serialized_options = b'\372\367\030\010\222\001\005\"\003\310\001\001'
+  _WATCHLOGREQUEST.fields_by_name['session_id']._options = None
+  _WATCHLOGREQUEST.fields_by_name['session_id']._serialized_options = b'\372\367\030\004r\002\020\001'
+  _WATCHLOGRESPONSE.fields_by_name['record']._options = None
+  _WATCHLOGRESPONSE.fields_by_name['record']._serialized_options = b'\372\367\030\003\310\001\001'
   _DOWNLOADLOGSREQUEST.fields_by_name['session_id']._options = None
   _DOWNLOADLOGSREQUEST.fields_by_name['session_id']._serialized_options = b'\372\367\030\004r\002\020\001'
   _GETPRINTSREQUEST.fields_by_name['session_id']._options = None
@@ -60,44 +71,134 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _GETPRINTSREQUEST.fields_by_name['skip']._serialized_options = b'\372\367\030/\272\001,\n\021session.list.skip\022\014Must be >= 0\032\tthis >= 0'
   _GETPRINTSRESPONSE.fields_by_name['prints']._options = None
   _GETPRINTSRESPONSE.fields_by_name['prints']._serialized_options = b'\372\367\030\010\222\001\005\"\003\310\001\001'
+  _REPLAYREQUEST.fields_by_name['session_id']._options = None
+  _REPLAYREQUEST.fields_by_name['session_id']._serialized_options = b'\372\367\030\004r\002\020\001'
+  _REPLAYRESPONSE_REPLAYEDCALL.fields_by_name['spec']._options = None
+  _REPLAYRESPONSE_REPLAYEDCALL.fields_by_name['spec']._serialized_options = b'\372\367\030\003\310\001\001'
+  _REPLAYRESPONSE_VALUESENTRY._options = None
+  _REPLAYRESPONSE_VALUESENTRY._serialized_options = b'8\001'
+  _REPLAYRESPONSE.fields_by_name['calls']._options = None
+  _REPLAYRESPONSE.fields_by_name['calls']._serialized_options = b'\372\367\030\010\222\001\005\"\003\310\001\001'
+  _REPLAYRESPONSE.fields_by_name['values']._options = None
+  _REPLAYRESPONSE.fields_by_name['values']._serialized_options = b'\372\367\030\010\232\001\005*\003\310\001\001'
+  _EXPORTREQUEST.fields_by_name['session_id']._options = None
+  _EXPORTREQUEST.fields_by_name['session_id']._serialized_options = b'\372\367\030\004r\002\020\001'
+  _IMPORTREQUEST.fields_by_name['project_id']._options = None
+  _IMPORTREQUEST.fields_by_name['project_id']._serialized_options = b'\372\367\030\004r\002\020\001'
+  _IMPORTREQUEST.fields_by_name['bundle']._options = None
+  _IMPORTREQUEST.fields_by_name['bundle']._serialized_options = b'\372\367\030\004z\002\020\001'
   _DELETEREQUEST.fields_by_name['session_id']._options = None
   _DELETEREQUEST.fields_by_name['session_id']._serialized_options = b'\372\367\030\004r\002\020\001'
-  _globals['_STARTREQUEST']._serialized_start=228
-  _globals['_STARTREQUEST']._serialized_end=632
-  _globals['_STARTREQUEST_JSONINPUTSENTRY']._serialized_start=443
-  _globals['_STARTREQUEST_JSONINPUTSENTRY']._serialized_end=504
-  _globals['_STARTRESPONSE']._serialized_start=634
-  _globals['_STARTRESPONSE']._serialized_end=690
-  _globals['_STOPREQUEST']._serialized_start=693
-  _globals['_STOPREQUEST']._serialized_end=873
-  _globals['_STOPRESPONSE']._serialized_start=875
-  _globals['_STOPRESPONSE']._serialized_end=889
-  _globals['_LISTREQUEST']._serialized_start=892
-  _globals['_LISTREQUEST']._serialized_end=1298
-  _globals['_LISTRESPONSE']._serialized_start=1301
-  _globals['_LISTRESPONSE']._serialized_end=1452
-  _globals['_GETREQUEST']._serialized_start=1454
-  _globals['_GETREQUEST']._serialized_end=1540
-  _globals['_GETRESPONSE']._serialized_start=1542
-  _globals['_GETRESPONSE']._serialized_end=1623
-  _globals['_GETLOGREQUEST']._serialized_start=1626
-  _globals['_GETLOGREQUEST']._serialized_end=1947
-  _globals['_GETLOGRESPONSE']._serialized_start=1950
-  _globals['_GETLOGRESPONSE']._serialized_end=2116
-  _globals['_DOWNLOADLOGSREQUEST']._serialized_start=2118
-  _globals['_DOWNLOADLOGSREQUEST']._serialized_end=2180
-  _globals['_DOWNLOADLOGSRESPONSE']._serialized_start=2182
-  _globals['_DOWNLOADLOGSRESPONSE']._serialized_end=2224
-  _globals['_GETPRINTSREQUEST']._serialized_start=2227
-  _globals['_GETPRINTSREQUEST']._serialized_end=2449
-  _globals['_GETPRINTSRESPONSE']._serialized_start=2452
-  _globals['_GETPRINTSRESPONSE']._serialized_end=2692
-  _globals['_GETPRINTSRESPONSE_PRINT']._serialized_start=2600
-  _globals['_GETPRINTSRESPONSE_PRINT']._serialized_end=2692
-  _globals['_DELETEREQUEST']._serialized_start=2694
-  _globals['_DELETEREQUEST']._serialized_end=2750
-  _globals['_DELETERESPONSE']._serialized_start=2752
-  _globals['_DELETERESPONSE']._serialized_end=2768
-  _globals['_SESSIONSSERVICE']._serialized_start=2771
-  _globals['_SESSIONSSERVICE']._serialized_end=3503
+  _STOPMANYREQUEST.fields_by_name['filter']._options = None
+  _STOPMANYREQUEST.fields_by_name['filter']._serialized_options = b'\372\367\030\003\310\001\001'
+  _STOPMANYRESPONSE.fields_by_name['operation_id']._options = None
+  _STOPMANYRESPONSE.fields_by_name['operation_id']._serialized_options = b'\372\367\030\004r\002\020\001'
+  _DELETEMANYREQUEST.fields_by_name['filter']._options = None
+  _DELETEMANYREQUEST.fields_by_name['filter']._serialized_options = b'\372\367\030\003\310\001\001'
+  _DELETEMANYRESPONSE.fields_by_name['operation_id']._options = None
+  _DELETEMANYRESPONSE.fields_by_name['operation_id']._serialized_options = b'\372\367\030\004r\002\020\001'
+  _RESTARTMANYREQUEST.fields_by_name['filter']._options = None
+  _RESTARTMANYREQUEST.fields_by_name['filter']._serialized_options = b'\372\367\030\003\310\001\001'
+  _RESTARTMANYRESPONSE.fields_by_name['operation_id']._options = None
+  _RESTARTMANYRESPONSE.fields_by_name['operation_id']._serialized_options = b'\372\367\030\004r\002\020\001'
+  _GETBULKOPERATIONREQUEST.fields_by_name['operation_id']._options = None
+  _GETBULKOPERATIONREQUEST.fields_by_name['operation_id']._serialized_options = b'\372\367\030\004r\002\020\001'
+  _GETBULKOPERATIONRESPONSE.fields_by_name['operation']._options = None
+  _GETBULKOPERATIONRESPONSE.fields_by_name['operation']._serialized_options = b'\372\367\030\003\310\001\001'
+  _globals['_STARTREQUEST']._serialized_start=265
+  _globals['_STARTREQUEST']._serialized_end=669
+  _globals['_STARTREQUEST_JSONINPUTSENTRY']._serialized_start=480
+  _globals['_STARTREQUEST_JSONINPUTSENTRY']._serialized_end=541
+  _globals['_STARTRESPONSE']._serialized_start=671
+  _globals['_STARTRESPONSE']._serialized_end=727
+  _globals['_STOPREQUEST']._serialized_start=730
+  _globals['_STOPREQUEST']._serialized_end=910
+  _globals['_STOPRESPONSE']._serialized_start=912
+  _globals['_STOPRESPONSE']._serialized_end=926
+  _globals['_LISTREQUEST']._serialized_start=929
+  _globals['_LISTREQUEST']._serialized_end=1591
+  _globals['_LISTREQUEST_TAGSENTRY']._serialized_start=1536
+  _globals['_LISTREQUEST_TAGSENTRY']._serialized_end=1591
+  _globals['_LISTRESPONSE']._serialized_start=1594
+  _globals['_LISTRESPONSE']._serialized_end=1745
+  _globals['_SEARCHREQUEST']._serialized_start=1748
+  _globals['_SEARCHREQUEST']._serialized_end=1991
+  _globals['_SEARCHRESPONSE']._serialized_start=1994
+  _globals['_SEARCHRESPONSE']._serialized_end=2147
+  _globals['_GETREQUEST']._serialized_start=2149
+  _globals['_GETREQUEST']._serialized_end=2235
+  _globals['_GETRESPONSE']._serialized_start=2237
+  _globals['_GETRESPONSE']._serialized_end=2318
+  _globals['_GETLOGREQUEST']._serialized_start=2321
+  _globals['_GETLOGREQUEST']._serialized_end=2642
+  _globals['_GETLOGRESPONSE']._serialized_start=2645
+  _globals['_GETLOGRESPONSE']._serialized_end=2811
+  _globals['_WATCHLOGREQUEST']._serialized_start=2813
+  _globals['_WATCHLOGREQUEST']._serialized_end=2940
+  _globals['_WATCHLOGRESPONSE']._serialized_start=2942
+  _globals['_WATCHLOGRESPONSE']._serialized_end=3035
+  _globals['_DOWNLOADLOGSREQUEST']._serialized_start=3037
+  _globals['_DOWNLOADLOGSREQUEST']._serialized_end=3099
+  _globals['_DOWNLOADLOGSRESPONSE']._serialized_start=3101
+  _globals['_DOWNLOADLOGSRESPONSE']._serialized_end=3143
+  _globals['_GETPRINTSREQUEST']._serialized_start=3146
+  _globals['_GETPRINTSREQUEST']._serialized_end=3368
+  _globals['_GETPRINTSRESPONSE']._serialized_start=3371
+  _globals['_GETPRINTSRESPONSE']._serialized_end=3611
+  _globals['_GETPRINTSRESPONSE_PRINT']._serialized_start=3519
+  _globals['_GETPRINTSRESPONSE_PRINT']._serialized_end=3611
+  _globals['_REPLAYREQUEST']._serialized_start=3613
+  _globals['_REPLAYREQUEST']._serialized_end=3701
+  _globals['_REPLAYRESPONSE']._serialized_start=3704
+  _globals['_REPLAYRESPONSE']._serialized_end=4303
+  _globals['_REPLAYRESPONSE_REPLAYEDCALL']._serialized_start=4068
+  _globals['_REPLAYRESPONSE_REPLAYEDCALL']._serialized_end=4215
+  _globals['_REPLAYRESPONSE_VALUESENTRY']._serialized_start=4217
+  _globals['_REPLAYRESPONSE_VALUESENTRY']._serialized_end=4303
+  _globals['_EXPORTREQUEST']._serialized_start=4305
+  _globals['_EXPORTREQUEST']._serialized_end=4361
+  _globals['_EXPORTRESPONSE']._serialized_start=4363
+  _globals['_EXPORTRESPONSE']._serialized_end=4403
+  _globals['_IMPORTREQUEST']._serialized_start=4405
+  _globals['_IMPORTREQUEST']._serialized_end=4495
+  _globals['_IMPORTRESPONSE']._serialized_start=4497
+  _globals['_IMPORTRESPONSE']._serialized_end=4544
+  _globals['_DELETEREQUEST']._serialized_start=4546
+  _globals['_DELETEREQUEST']._serialized_end=4602
+  _globals['_DELETERESPONSE']._serialized_start=4604
+  _globals['_DELETERESPONSE']._serialized_end=4620
+  _globals['_STOPMANYREQUEST']._serialized_start=4623
+  _globals['_STOPMANYREQUEST']._serialized_end=4764
+  _globals['_STOPMANYRESPONSE']._serialized_start=4766
+  _globals['_STOPMANYRESPONSE']._serialized_end=4829
+  _globals['_DELETEMANYREQUEST']._serialized_start=4831
+  _globals['_DELETEMANYREQUEST']._serialized_end=4920
+  _globals['_DELETEMANYRESPONSE']._serialized_start=4922
+  _globals['_DELETEMANYRESPONSE']._serialized_end=4987
+  _globals['_RESTARTMANYREQUEST']._serialized_start=4989
+  _globals['_RESTARTMANYREQUEST']._serialized_end=5079
+  _globals['_RESTARTMANYRESPONSE']._serialized_start=5081
+  _globals['_RESTARTMANYRESPONSE']._serialized_end=5147
+  _globals['_BULKOPERATION']._serialized_start=5150
+  _globals['_BULKOPERATION']._serialized_end=5340
+  _globals['_GETBULKOPERATIONREQUEST']._serialized_start=5342
+  _globals['_GETBULKOPERATIONREQUEST']._serialized_end=5412
+  _globals['_GETBULKOPERATIONRESPONSE']._serialized_start=5414
+  _globals['_GETBULKOPERATIONRESPONSE']._serialized_end=5518
+  _globals['_RETENTIONPOLICY']._serialized_start=5521
+  _globals['_RETENTIONPOLICY']._serialized_end=5902
+  _globals['_SETRETENTIONPOLICYREQUEST']._serialized_start=5904
+  _globals['_SETRETENTIONPOLICYREQUEST']._serialized_end=6005
+  _globals['_SETRETENTIONPOLICYRESPONSE']._serialized_start=6007
+  _globals['_SETRETENTIONPOLICYRESPONSE']._serialized_end=6035
+  _globals['_GETRETENTIONPOLICYREQUEST']._serialized_start=6038
+  _globals['_GETRETENTIONPOLICYREQUEST']._serialized_end=6261
+  _globals['_GETRETENTIONPOLICYRESPONSE']._serialized_start=6263
+  _globals['_GETRETENTIONPOLICYRESPONSE']._serialized_end=6356
+  _globals['_DELETERETENTIONPOLICYREQUEST']._serialized_start=6359
+  _globals['_DELETERETENTIONPOLICYREQUEST']._serialized_end=6588
+  _globals['_DELETERETENTIONPOLICYRESPONSE']._serialized_start=6590
+  _globals['_DELETERETENTIONPOLICYRESPONSE']._serialized_end=6621
+  _globals['_SESSIONSSERVICE']._serialized_start=6624
+  _globals['_SESSIONSSERVICE']._serialized_end=8613
 # @@protoc_insertion_point(module_scope)
@@ -1,3 +1,4 @@
+from autokitteh_pb.program.v1 import program_pb2 as _program_pb2
 from autokitteh_pb.sessions.v1 import session_pb2 as _session_pb2
 from autokitteh_pb.values.v1 import values_pb2 as _values_pb2
 from buf.validate import validate_pb2 as _validate_pb2
@@ -50,13 +51,23 @@ class StopResponse(_message.Message):
     def __init__(self) -> None: ...
 
 class ListRequest(_message.Message):
-    __slots__ = ["deployment_id", "project_id", "event_id", "build_id", "state_type", "org_id", "count_only", "page_size", "skip", "page_token"]
+    __slots__ = ["deployment_id", "project_id", "event_id", "build_id", "state_type", "org_id", "tags", "created_after", "created_before", "count_only", "page_size", "skip", "page_token"]
+    class TagsEntry(_message.Message):
+        __slots__ = ["key", "value"]
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: str
+        def __init__(self, key: _Optional[str] = ..., value: _Optional[str] = ...) -> None: ...
     DEPLOYMENT_ID_FIELD_NUMBER: _ClassVar[int]
     PROJECT_ID_FIELD_NUMBER: _ClassVar[int]
     EVENT_ID_FIELD_NUMBER: _ClassVar[int]
     BUILD_ID_FIELD_NUMBER: _ClassVar[int]
     STATE_TYPE_FIELD_NUMBER: _ClassVar[int]
     ORG_ID_FIELD_NUMBER: _ClassVar[int]
+    TAGS_FIELD_NUMBER: _ClassVar[int]
+    CREATED_AFTER_FIELD_NUMBER: _ClassVar[int]
+    CREATED_BEFORE_FIELD_NUMBER: _ClassVar[int]
     COUNT_ONLY_FIELD_NUMBER: _ClassVar[int]
     PAGE_SIZE_FIELD_NUMBER: _ClassVar[int]
     SKIP_FIELD_NUMBER: _ClassVar[int]
@@ -67,11 +78,14 @@ class ListRequest(_message.Message):
     build_id: str
     state_type: _session_pb2.SessionStateType
     org_id: str
+    tags: _containers.ScalarMap[str, str]
+    created_after: _timestamp_pb2.Timestamp
+    created_before: _timestamp_pb2.Timestamp
     count_only: bool
     page_size: int
     skip: int
     page_token: str
-    def __init__(self, deployment_id: _Optional[str] = ..., project_id: _Optional[str] = ..., event_id: _Optional[str] = ..., build_id: _Optional[str] = ..., state_type: _Optional[_Union[_session_pb2.SessionStateType, str]] = ..., org_id: _Optional[str] = ..., count_only: bool = ..., page_size: _Optional[int] = ..., skip: _Optional[int] = ..., page_token: _Optional[str] = ...) -> None: ...
+    def __init__(self, deployment_id: _Optional[str] = ..., project_id: _Optional[str] = ..., event_id: _Optional[str] = ..., build_id: _Optional[str] = ..., state_type: _Optional[_Union[_session_pb2.SessionStateType, str]] = ..., org_id: _Optional[str] = ..., tags: _Optional[_Mapping[str, str]] = ..., created_after: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., created_before: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., count_only: bool = ..., page_size: _Optional[int] = ..., skip: _Optional[int] = ..., page_token: _Optional[str] = ...) -> None: ...
 
 class ListResponse(_message.Message):
     __slots__ = ["sessions", "count", "next_page_token"]
@@ -83,6 +97,30 @@ class ListResponse(_message.Message):
     next_page_token: str
     def __init__(self, sessions: _Optional[_Iterable[_Union[_session_pb2.Session, _Mapping]]] = ..., count: _Optional[int] = ..., next_page_token: _Optional[str] = ...) -> None: ...
 
+class SearchRequest(_message.Message):
+    __slots__ = ["filter", "prints_contain", "error_contains", "trigger_name", "entry_point"]
+    FILTER_FIELD_NUMBER: _ClassVar[int]
+    PRINTS_CONTAIN_FIELD_NUMBER: _ClassVar[int]
+    ERROR_CONTAINS_FIELD_NUMBER: _ClassVar[int]
+    TRIGGER_NAME_FIELD_NUMBER: _ClassVar[int]
+    ENTRY_POINT_FIELD_NUMBER: _ClassVar[int]
+    filter: ListRequest
+    prints_contain: str
+    error_contains: str
+    trigger_name: str
+    entry_point: str
+    def __init__(self, filter: _Optional[_Union[ListRequest, _Mapping]] = ..., prints_contain: _Optional[str] = ..., error_contains: _Optional[str] = ..., trigger_name: _Optional[str] = ..., entry_point: _Optional[str] = ...) -> None: ...
+
+class SearchResponse(_message.Message):
+    __slots__ = ["sessions", "count", "next_page_token"]
+    SESSIONS_FIELD_NUMBER: _ClassVar[int]
+    COUNT_FIELD_NUMBER: _ClassVar[int]
+    NEXT_PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    sessions: _containers.RepeatedCompositeFieldContainer[_session_pb2.Session]
+    count: int
+    next_page_token: str
+    def __init__(self, sessions: _Optional[_Iterable[_Union[_session_pb2.Session, _Mapping]]] = ..., count: _Optional[int] = ..., next_page_token: _Optional[str] = ...) -> None: ...
+
 class GetRequest(_message.Message):
     __slots__ = ["session_id", "json_values"]
     SESSION_ID_FIELD_NUMBER: _ClassVar[int]
@@ -125,6 +163,20 @@ class GetLogResponse(_message.Message):
     next_page_token: str
     def __init__(self, count: _Optional[int] = ..., records: _Optional[_Iterable[_Union[_session_pb2.SessionLogRecord, _Mapping]]] = ..., next_page_token: _Optional[str] = ...) -> None: ...
 
+class WatchLogRequest(_message.Message):
+    __slots__ = ["session_id", "types"]
+    SESSION_ID_FIELD_NUMBER: _ClassVar[int]
+    TYPES_FIELD_NUMBER: _ClassVar[int]
+    session_id: str
+    types: _session_pb2.SessionLogRecord.Type
+    def __init__(self, session_id: _Optional[str] = ..., types: _Optional[_Union[_session_pb2.SessionLogRecord.Type, str]] = ...) -> None: ...
+
+class WatchLogResponse(_message.Message):
+    __slots__ = ["record"]
+    RECORD_FIELD_NUMBER: _ClassVar[int]
+    record: _session_pb2.SessionLogRecord
+    def __init__(self, record: _Optional[_Union[_session_pb2.SessionLogRecord, _Mapping]] = ...) -> None: ...
+
 class DownloadLogsRequest(_message.Message):
     __slots__ = ["session_id"]
     SESSION_ID_FIELD_NUMBER: _ClassVar[int]
@@ -166,6 +218,70 @@ class GetPrintsResponse(_message.Message):
     next_page_token: str
     def __init__(self, prints: _Optional[_Iterable[_Union[GetPrintsResponse.Print, _Mapping]]] = ..., next_page_token: _Optional[str] = ...) -> None: ...
 
+class ReplayRequest(_message.Message):
+    __slots__ = ["session_id", "stop_at_seq"]
+    SESSION_ID_FIELD_NUMBER: _ClassVar[int]
+    STOP_AT_SEQ_FIELD_NUMBER: _ClassVar[int]
+    session_id: str
+    stop_at_seq: int
+    def __init__(self, session_id: _Optional[str] = ..., stop_at_seq: _Optional[int] = ...) -> None: ...
+
+class ReplayResponse(_message.Message):
+    __slots__ = ["calls", "prints", "stopped", "values", "return_value", "error"]
+    class ReplayedCall(_message.Message):
+        __slots__ = ["spec", "result"]
+        SPEC_FIELD_NUMBER: _ClassVar[int]
+        RESULT_FIELD_NUMBER: _ClassVar[int]
+        spec: _session_pb2.Call.Spec
+        result: _session_pb2.Call.Attempt.Result
+        def __init__(self, spec: _Optional[_Union[_session_pb2.Call.Spec, _Mapping]] = ..., result: _Optional[_Union[_session_pb2.Call.Attempt.Result, _Mapping]] = ...) -> None: ...
+    class ValuesEntry(_message.Message):
+        __slots__ = ["key", "value"]
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: _values_pb2.Value
+        def __init__(self, key: _Optional[str] = ..., value: _Optional[_Union[_values_pb2.Value, _Mapping]] = ...) -> None: ...
+    CALLS_FIELD_NUMBER: _ClassVar[int]
+    PRINTS_FIELD_NUMBER: _ClassVar[int]
+    STOPPED_FIELD_NUMBER: _ClassVar[int]
+    VALUES_FIELD_NUMBER: _ClassVar[int]
+    RETURN_VALUE_FIELD_NUMBER: _ClassVar[int]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
+    calls: _containers.RepeatedCompositeFieldContainer[ReplayResponse.ReplayedCall]
+    prints: _containers.RepeatedScalarFieldContainer[str]
+    stopped: bool
+    values: _containers.MessageMap[str, _values_pb2.Value]
+    return_value: _values_pb2.Value
+    error: _program_pb2.Error
+    def __init__(self, calls: _Optional[_Iterable[_Union[ReplayResponse.ReplayedCall, _Mapping]]] = ..., prints: _Optional[_Iterable[str]] = ..., stopped: bool = ..., values: _Optional[_Mapping[str, _values_pb2.Value]] = ..., return_value: _Optional[_Union[_values_pb2.Value, _Mapping]] = ..., error: _Optional[_Union[_program_pb2.Error, _Mapping]] = ...) -> None: ...
+
+class ExportRequest(_message.Message):
+    __slots__ = ["session_id"]
+    SESSION_ID_FIELD_NUMBER: _ClassVar[int]
+    session_id: str
+    def __init__(self, session_id: _Optional[str] = ...) -> None: ...
+
+class ExportResponse(_message.Message):
+    __slots__ = ["bundle"]
+    BUNDLE_FIELD_NUMBER: _ClassVar[int]
+    bundle: bytes
+    def __init__(self, bundle: _Optional[bytes] = ...) -> None: ...
+
+class ImportRequest(_message.Message):
+    __slots__ = ["project_id", "bundle"]
+    PROJECT_ID_FIELD_NUMBER: _ClassVar[int]
+    BUNDLE_FIELD_NUMBER: _ClassVar[int]
+    project_id: str
+    bundle: bytes
+    def __init__(self, project_id: _Optional[str] = ..., bundle: _Optional[bytes] = ...) -> None: ...
+
+class ImportResponse(_message.Message):
+    __slots__ = ["session_id"]
+    SESSION_ID_FIELD_NUMBER: _ClassVar[int]
+    session_id: str
+    def __init__(self, session_id: _Optional[str] = ...) -> None: ...
+
 class DeleteRequest(_message.Message):
     __slots__ = ["session_id"]
     SESSION_ID_FIELD_NUMBER: _ClassVar[int]
@@ -175,3 +291,123 @@ class DeleteRequest(_message.Message):
 class DeleteResponse(_message.Message):
     __slots__ = []
     def __init__(self) -> None: ...
+
+class StopManyRequest(_message.Message):
+    __slots__ = ["filter", "reason", "terminate"]
+    FILTER_FIELD_NUMBER: _ClassVar[int]
+    REASON_FIELD_NUMBER: _ClassVar[int]
+    TERMINATE_FIELD_NUMBER: _ClassVar[int]
+    filter: ListRequest
+    reason: str
+    terminate: bool
+    def __init__(self, filter: _Optional[_Union[ListRequest, _Mapping]] = ..., reason: _Optional[str] = ..., terminate: bool = ...) -> None: ...
+
+class StopManyResponse(_message.Message):
+    __slots__ = ["operation_id"]
+    OPERATION_ID_FIELD_NUMBER: _ClassVar[int]
+    operation_id: str
+    def __init__(self, operation_id: _Optional[str] = ...) -> None: ...
+
+class DeleteManyRequest(_message.Message):
+    __slots__ = ["filter"]
+    FILTER_FIELD_NUMBER: _ClassVar[int]
+    filter: ListRequest
+    def __init__(self, filter: _Optional[_Union[ListRequest, _Mapping]] = ...) -> None: ...
+
+class DeleteManyResponse(_message.Message):
+    __slots__ = ["operation_id"]
+    OPERATION_ID_FIELD_NUMBER: _ClassVar[int]
+    operation_id: str
+    def __init__(self, operation_id: _Optional[str] = ...) -> None: ...
+
+class RestartManyRequest(_message.Message):
+    __slots__ = ["filter"]
+    FILTER_FIELD_NUMBER: _ClassVar[int]
+    filter: ListRequest
+    def __init__(self, filter: _Optional[_Union[ListRequest, _Mapping]] = ...) -> None: ...
+
+class RestartManyResponse(_message.Message):
+    __slots__ = ["operation_id"]
+    OPERATION_ID_FIELD_NUMBER: _ClassVar[int]
+    operation_id: str
+    def __init__(self, operation_id: _Optional[str] = ...) -> None: ...
+
+class BulkOperation(_message.Message):
+    __slots__ = ["operation_id", "kind", "done", "total", "succeeded", "failed", "errors"]
+    OPERATION_ID_FIELD_NUMBER: _ClassVar[int]
+    KIND_FIELD_NUMBER: _ClassVar[int]
+    DONE_FIELD_NUMBER: _ClassVar[int]
+    TOTAL_FIELD_NUMBER: _ClassVar[int]
+    SUCCEEDED_FIELD_NUMBER: _ClassVar[int]
+    FAILED_FIELD_NUMBER: _ClassVar[int]
+    ERRORS_FIELD_NUMBER: _ClassVar[int]
+    operation_id: str
+    kind: str
+    done: bool
+    total: int
+    succeeded: int
+    failed: int
+    errors: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, operation_id: _Optional[str] = ..., kind: _Optional[str] = ..., done: bool = ..., total: _Optional[int] = ..., succeeded: _Optional[int] = ..., failed: _Optional[int] = ..., errors: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class GetBulkOperationRequest(_message.Message):
+    __slots__ = ["operation_id"]
+    OPERATION_ID_FIELD_NUMBER: _ClassVar[int]
+    operation_id: str
+    def __init__(self, operation_id: _Optional[str] = ...) -> None: ...
+
+class GetBulkOperationResponse(_message.Message):
+    __slots__ = ["operation"]
+    OPERATION_FIELD_NUMBER: _ClassVar[int]
+    operation: BulkOperation
+    def __init__(self, operation: _Optional[_Union[BulkOperation, _Mapping]] = ...) -> None: ...
+
+class RetentionPolicy(_message.Message):
+    __slots__ = ["org_id", "project_id", "max_age", "max_count", "states"]
+    ORG_ID_FIELD_NUMBER: _ClassVar[int]
+    PROJECT_ID_FIELD_NUMBER: _ClassVar[int]
+    MAX_AGE_FIELD_NUMBER: _ClassVar[int]
+    MAX_COUNT_FIELD_NUMBER: _ClassVar[int]
+    STATES_FIELD_NUMBER: _ClassVar[int]
+    org_id: str
+    project_id: str
+    max_age: _duration_pb2.Duration
+    max_count: int
+    states: _containers.RepeatedScalarFieldContainer[_session_pb2.SessionStateType]
+    def __init__(self, org_id: _Optional[str] = ..., project_id: _Optional[str] = ..., max_age: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., max_count: _Optional[int] = ..., states: _Optional[_Iterable[_Union[_session_pb2.SessionStateType, str]]] = ...) -> None: ...
+
+class SetRetentionPolicyRequest(_message.Message):
+    __slots__ = ["policy"]
+    POLICY_FIELD_NUMBER: _ClassVar[int]
+    policy: RetentionPolicy
+    def __init__(self, policy: _Optional[_Union[RetentionPolicy, _Mapping]] = ...) -> None: ...
+
+class SetRetentionPolicyResponse(_message.Message):
+    __slots__ = []
+    def __init__(self) -> None: ...
+
+class GetRetentionPolicyRequest(_message.Message):
+    __slots__ = ["org_id", "project_id"]
+    ORG_ID_FIELD_NUMBER: _ClassVar[int]
+    PROJECT_ID_FIELD_NUMBER: _ClassVar[int]
+    org_id: str
+    project_id: str
+    def __init__(self, org_id: _Optional[str] = ..., project_id: _Optional[str] = ...) -> None: ...
+
+class GetRetentionPolicyResponse(_message.Message):
+    __slots__ = ["policy"]
+    POLICY_FIELD_NUMBER: _ClassVar[int]
+    policy: RetentionPolicy
+    def __init__(self, policy: _Optional[_Union[RetentionPolicy, _Mapping]] = ...) -> None: ...
+
+class DeleteRetentionPolicyRequest(_message.Message):
+    __slots__ = ["org_id", "project_id"]
+    ORG_ID_FIELD_NUMBER: _ClassVar[int]
+    PROJECT_ID_FIELD_NUMBER: _ClassVar[int]
+    org_id: str
+    project_id: str
+    def __init__(self, org_id: _Optional[str] = ..., project_id: _Optional[str] = ...) -> None: ...
+
+class DeleteRetentionPolicyResponse(_message.Message):
+    __slots__ = []
+    def __init__(self) -> None: ...
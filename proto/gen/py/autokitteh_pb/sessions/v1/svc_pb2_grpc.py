@@ -29,6 +29,11 @@ class SessionsServiceStub(object):
                 request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ListRequest.SerializeToString,
                 response_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ListResponse.FromString,
                 )
+        self.Search = channel.unary_unary(
+                '/autokitteh.sessions.v1.SessionsService/Search',
+                request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.SearchRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.SearchResponse.FromString,
+                )
         self.Get = channel.unary_unary(
                 '/autokitteh.sessions.v1.SessionsService/Get',
                 request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetRequest.SerializeToString,
@@ -39,6 +44,11 @@ class SessionsServiceStub(object):
                 request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetLogRequest.SerializeToString,
                 response_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetLogResponse.FromString,
                 )
+        self.WatchLog = channel.unary_stream(
+                '/autokitteh.sessions.v1.SessionsService/WatchLog',
+                request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.WatchLogRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.WatchLogResponse.FromString,
+                )
         self.DownloadLogs = channel.unary_unary(
                 '/autokitteh.sessions.v1.SessionsService/DownloadLogs',
                 request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DownloadLogsRequest.SerializeToString,
@@ -54,6 +64,56 @@ class SessionsServiceStub(object):
                 request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteRequest.SerializeToString,
                 response_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteResponse.FromString,
                 )
+        self.Replay = channel.unary_unary(
+                '/autokitteh.sessions.v1.SessionsService/Replay',
+                request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ReplayRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ReplayResponse.FromString,
+                )
+        self.Export = channel.unary_unary(
+                '/autokitteh.sessions.v1.SessionsService/Export',
+                request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ExportRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ExportResponse.FromString,
+                )
+        self.Import = channel.unary_unary(
+                '/autokitteh.sessions.v1.SessionsService/Import',
+                request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ImportRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ImportResponse.FromString,
+                )
+        self.StopMany = channel.unary_unary(
+                '/autokitteh.sessions.v1.SessionsService/StopMany',
+                request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.StopManyRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.StopManyResponse.FromString,
+                )
+        self.DeleteMany = channel.unary_unary(
+                '/autokitteh.sessions.v1.SessionsService/DeleteMany',
+                request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteManyRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteManyResponse.FromString,
+                )
+        self.RestartMany = channel.unary_unary(
+                '/autokitteh.sessions.v1.SessionsService/RestartMany',
+                request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.RestartManyRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.RestartManyResponse.FromString,
+                )
+        self.GetBulkOperation = channel.unary_unary(
+                '/autokitteh.sessions.v1.SessionsService/GetBulkOperation',
+                request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetBulkOperationRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetBulkOperationResponse.FromString,
+                )
+        self.SetRetentionPolicy = channel.unary_unary(
+                '/autokitteh.sessions.v1.SessionsService/SetRetentionPolicy',
+                request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.SetRetentionPolicyRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.SetRetentionPolicyResponse.FromString,
+                )
+        self.GetRetentionPolicy = channel.unary_unary(
+                '/autokitteh.sessions.v1.SessionsService/GetRetentionPolicy',
+                request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetRetentionPolicyRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetRetentionPolicyResponse.FromString,
+                )
+        self.DeleteRetentionPolicy = channel.unary_unary(
+                '/autokitteh.sessions.v1.SessionsService/DeleteRetentionPolicy',
+                request_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteRetentionPolicyRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteRetentionPolicyResponse.FromString,
+                )
 
 
 class SessionsServiceServicer(object):
@@ -80,6 +140,14 @@ class SessionsServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def Search(self, request, context):
+        """Search is like List, but can also match on prints, errors and
+        additional session attributes.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def Get(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -92,6 +160,14 @@ class SessionsServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def WatchLog(self, request, context):
+        """WatchLog streams the session log from its beginning, including prints,
+        as it is written. The stream ends once the session reaches a final state.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def DownloadLogs(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -110,6 +186,75 @@ class SessionsServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def Replay(self, request, context):
+        """Replay re-runs a finished durable session, feeding each call its
+        recorded result instead of executing it.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Export(self, request, context):
+        """Export returns a portable bundle of a finished session, which can
+        be imported into another autokitteh instance for reproduction.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Import(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def StopMany(self, request, context):
+        """StopMany, DeleteMany and RestartMany apply the respective operation
+        to all sessions matching a filter, in the background. Their progress
+        can be tracked using GetBulkOperation.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteMany(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RestartMany(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetBulkOperation(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetRetentionPolicy(self, request, context):
+        """SetRetentionPolicy sets the session retention policy of an org or a
+        project, which is enforced periodically by the server.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetRetentionPolicy(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteRetentionPolicy(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_SessionsServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -128,6 +273,11 @@ def add_SessionsServiceServicer_to_server(servicer, server):
                     request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ListRequest.FromString,
                     response_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ListResponse.SerializeToString,
             ),
+            'Search': grpc.unary_unary_rpc_method_handler(
+                    servicer.Search,
+                    request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.SearchRequest.FromString,
+                    response_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.SearchResponse.SerializeToString,
+            ),
             'Get': grpc.unary_unary_rpc_method_handler(
                     servicer.Get,
                     request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetRequest.FromString,
@@ -138,6 +288,11 @@ def add_SessionsServiceServicer_to_server(servicer, server):
                     request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetLogRequest.FromString,
                     response_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetLogResponse.SerializeToString,
             ),
+            'WatchLog': grpc.unary_stream_rpc_method_handler(
+                    servicer.WatchLog,
+                    request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.WatchLogRequest.FromString,
+                    response_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.WatchLogResponse.SerializeToString,
+            ),
             'DownloadLogs': grpc.unary_unary_rpc_method_handler(
                     servicer.DownloadLogs,
                     request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DownloadLogsRequest.FromString,
@@ -153,6 +308,56 @@ def add_SessionsServiceServicer_to_server(servicer, server):
                     request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteRequest.FromString,
                     response_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteResponse.SerializeToString,
             ),
+            'Replay': grpc.unary_unary_rpc_method_handler(
+                    servicer.Replay,
+                    request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ReplayRequest.FromString,
+                    response_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ReplayResponse.SerializeToString,
+            ),
+            'Export': grpc.unary_unary_rpc_method_handler(
+                    servicer.Export,
+                    request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ExportRequest.FromString,
+                    response_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ExportResponse.SerializeToString,
+            ),
+            'Import': grpc.unary_unary_rpc_method_handler(
+                    servicer.Import,
+                    request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ImportRequest.FromString,
+                    response_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ImportResponse.SerializeToString,
+            ),
+            'StopMany': grpc.unary_unary_rpc_method_handler(
+                    servicer.StopMany,
+                    request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.StopManyRequest.FromString,
+                    response_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.StopManyResponse.SerializeToString,
+            ),
+            'DeleteMany': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteMany,
+                    request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteManyRequest.FromString,
+                    response_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteManyResponse.SerializeToString,
+            ),
+            'RestartMany': grpc.unary_unary_rpc_method_handler(
+                    servicer.RestartMany,
+                    request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.RestartManyRequest.FromString,
+                    response_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.RestartManyResponse.SerializeToString,
+            ),
+            'GetBulkOperation': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetBulkOperation,
+                    request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetBulkOperationRequest.FromString,
+                    response_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetBulkOperationResponse.SerializeToString,
+            ),
+            'SetRetentionPolicy': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetRetentionPolicy,
+                    request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.SetRetentionPolicyRequest.FromString,
+                    response_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.SetRetentionPolicyResponse.SerializeToString,
+            ),
+            'GetRetentionPolicy': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetRetentionPolicy,
+                    request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetRetentionPolicyRequest.FromString,
+                    response_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetRetentionPolicyResponse.SerializeToString,
+            ),
+            'DeleteRetentionPolicy': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteRetentionPolicy,
+                    request_deserializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteRetentionPolicyRequest.FromString,
+                    response_serializer=autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteRetentionPolicyResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'autokitteh.sessions.v1.SessionsService', rpc_method_handlers)
@@ -214,6 +419,23 @@ class SessionsService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def Search(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/autokitteh.sessions.v1.SessionsService/Search',
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.SearchRequest.SerializeToString,
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.SearchResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def Get(request,
             target,
@@ -248,6 +470,23 @@ class SessionsService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def WatchLog(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/autokitteh.sessions.v1.SessionsService/WatchLog',
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.WatchLogRequest.SerializeToString,
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.WatchLogResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def DownloadLogs(request,
             target,
@@ -298,3 +537,173 @@ class SessionsService(object):
             autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Replay(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/autokitteh.sessions.v1.SessionsService/Replay',
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ReplayRequest.SerializeToString,
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ReplayResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Export(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/autokitteh.sessions.v1.SessionsService/Export',
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ExportRequest.SerializeToString,
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ExportResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Import(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/autokitteh.sessions.v1.SessionsService/Import',
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ImportRequest.SerializeToString,
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.ImportResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def StopMany(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/autokitteh.sessions.v1.SessionsService/StopMany',
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.StopManyRequest.SerializeToString,
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.StopManyResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteMany(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/autokitteh.sessions.v1.SessionsService/DeleteMany',
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteManyRequest.SerializeToString,
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteManyResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def RestartMany(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/autokitteh.sessions.v1.SessionsService/RestartMany',
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.RestartManyRequest.SerializeToString,
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.RestartManyResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetBulkOperation(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/autokitteh.sessions.v1.SessionsService/GetBulkOperation',
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetBulkOperationRequest.SerializeToString,
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetBulkOperationResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SetRetentionPolicy(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/autokitteh.sessions.v1.SessionsService/SetRetentionPolicy',
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.SetRetentionPolicyRequest.SerializeToString,
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.SetRetentionPolicyResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetRetentionPolicy(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/autokitteh.sessions.v1.SessionsService/GetRetentionPolicy',
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetRetentionPolicyRequest.SerializeToString,
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.GetRetentionPolicyResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteRetentionPolicy(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/autokitteh.sessions.v1.SessionsService/DeleteRetentionPolicy',
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteRetentionPolicyRequest.SerializeToString,
+            autokitteh_dot_sessions_dot_v1_dot_svc__pb2.DeleteRetentionPolicyResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
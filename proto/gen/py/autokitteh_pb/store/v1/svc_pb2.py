@@ -15,7 +15,7 @@ from autokitteh_pb.values.v1 import values_pb2 as autokitteh_dot_values_dot_v1_d
 from buf.validate import validate_pb2 as buf_dot_validate_dot_validate__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1d\x61utokitteh/store/v1/svc.proto\x12\x13\x61utokitteh.store.v1\x1a!autokitteh/values/v1/values.proto\x1a\x1b\x62uf/validate/validate.proto\"\xb9\x01\n\rMutateRequest\x12\x1d\n\nproject_id\x18\x01 \x01(\tR\tprojectId\x12\x1a\n\x03key\x18\x02 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\x03key\x12&\n\toperation\x18\x03 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\toperation\x12\x45\n\x08operands\x18\x04 \x03(\x0b\x32\x1b.autokitteh.values.v1.ValueB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\x08operands\"L\n\x0eMutateResponse\x12:\n\x05value\x18\x01 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x05value\"N\n\nGetRequest\x12\x1d\n\nproject_id\x18\x01 \x01(\tR\tprojectId\x12!\n\x04keys\x18\x02 \x03(\tB\r\xfa\xf7\x18\t\x92\x01\x06\"\x04r\x02\x10\x01R\x04keys\"\xbf\x01\n\x0bGetResponse\x12X\n\x06values\x18\x01 \x03(\x0b\x32,.autokitteh.store.v1.GetResponse.ValuesEntryB\x12\xfa\xf7\x18\x0e\x9a\x01\x0b\"\x04r\x02\x10\x01*\x03\xc8\x01\x01R\x06values\x1aV\n\x0bValuesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value:\x02\x38\x01\",\n\x0bListRequest\x12\x1d\n\nproject_id\x18\x01 \x01(\tR\tprojectId\"1\n\x0cListResponse\x12!\n\x04keys\x18\x01 \x03(\tB\r\xfa\xf7\x18\t\x92\x01\x06\"\x04r\x02\x10\x01R\x04keys\"K\n\x0ePublishRequest\x12\x1d\n\nproject_id\x18\x01 \x01(\tR\tprojectId\x12\x1a\n\x03key\x18\x02 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\x03key\"\x11\n\x0fPublishResponse\"M\n\x10UnpublishRequest\x12\x1d\n\nproject_id\x18\x01 \x01(\tR\tprojectId\x12\x1a\n\x03key\x18\x02 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\x03key\"\x13\n\x11UnpublishResponse2\xaa\x03\n\x0cStoreService\x12Q\n\x06Mutate\x12\".autokitteh.store.v1.MutateRequest\x1a#.autokitteh.store.v1.MutateResponse\x12H\n\x03Get\x12\x1f.autokitteh.store.v1.GetRequest\x1a .autokitteh.store.v1.GetResponse\x12T\n\x07Publish\x12#.autokitteh.store.v1.PublishRequest\x1a$.autokitteh.store.v1.PublishResponse\x12Z\n\tUnpublish\x12%.autokitteh.store.v1.UnpublishRequest\x1a&.autokitteh.store.v1.UnpublishResponse\x12K\n\x04List\x12 .autokitteh.store.v1.ListRequest\x1a!.autokitteh.store.v1.ListResponseB\xd8\x01\n\x17\x63om.autokitteh.store.v1B\x08SvcProtoP\x01ZEgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/store/v1;storev1\xa2\x02\x03\x41SX\xaa\x02\x13\x41utokitteh.Store.V1\xca\x02\x13\x41utokitteh\\Store\\V1\xe2\x02\x1f\x41utokitteh\\Store\\V1\\GPBMetadata\xea\x02\x15\x41utokitteh::Store::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1d\x61utokitteh/store/v1/svc.proto\x12\x13\x61utokitteh.store.v1\x1a!autokitteh/values/v1/values.proto\x1a\x1b\x62uf/validate/validate.proto\"\xb9\x01\n\rMutateRequest\x12\x1d\n\nproject_id\x18\x01 \x01(\tR\tprojectId\x12\x1a\n\x03key\x18\x02 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\x03key\x12&\n\toperation\x18\x03 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\toperation\x12\x45\n\x08operands\x18\x04 \x03(\x0b\x32\x1b.autokitteh.values.v1.ValueB\x0c\xfa\xf7\x18\x08\x92\x01\x05\"\x03\xc8\x01\x01R\x08operands\"L\n\x0eMutateResponse\x12:\n\x05value\x18\x01 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueB\x07\xfa\xf7\x18\x03\xc8\x01\x01R\x05value\"N\n\nGetRequest\x12\x1d\n\nproject_id\x18\x01 \x01(\tR\tprojectId\x12!\n\x04keys\x18\x02 \x03(\tB\r\xfa\xf7\x18\t\x92\x01\x06\"\x04r\x02\x10\x01R\x04keys\"\xbf\x01\n\x0bGetResponse\x12X\n\x06values\x18\x01 \x03(\x0b\x32,.autokitteh.store.v1.GetResponse.ValuesEntryB\x12\xfa\xf7\x18\x0e\x9a\x01\x0b\"\x04r\x02\x10\x01*\x03\xc8\x01\x01R\x06values\x1aV\n\x0bValuesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value:\x02\x38\x01\"\xa6\x02\n\x0bListRequest\x12\x1d\n\nproject_id\x18\x01 \x01(\tR\tprojectId\x12\x16\n\x06prefix\x18\x02 \x01(\tR\x06prefix\x12%\n\x0epublished_only\x18\x03 \x01(\x08R\rpublishedOnly\x12S\n\tpage_size\x18\x14 \x01(\x05\x42\x36\xfa\xf7\x18\x32\xba\x01/\n\x14store.list.page_size\x12\x0cMust be >= 0\x1a\tthis >= 0R\x08pageSize\x12\x45\n\x04skip\x18\x15 \x01(\x05\x42\x31\xfa\xf7\x18-\xba\x01*\n\x0fstore.list.skip\x12\x0cMust be >= 0\x1a\tthis >= 0R\x04skip\x12\x1d\n\npage_token\x18\x16 \x01(\tR\tpageToken\"o\n\x0cListResponse\x12!\n\x04keys\x18\x01 \x03(\tB\r\xfa\xf7\x18\t\x92\x01\x06\"\x04r\x02\x10\x01R\x04keys\x12\x14\n\x05\x63ount\x18\x02 \x01(\x03R\x05\x63ount\x12&\n\x0fnext_page_token\x18\n \x01(\tR\rnextPageToken\"K\n\x0ePublishRequest\x12\x1d\n\nproject_id\x18\x01 \x01(\tR\tprojectId\x12\x1a\n\x03key\x18\x02 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\x03key\"\x11\n\x0fPublishResponse\"M\n\x10UnpublishRequest\x12\x1d\n\nproject_id\x18\x01 \x01(\tR\tprojectId\x12\x1a\n\x03key\x18\x02 \x01(\tB\x08\xfa\xf7\x18\x04r\x02\x10\x01R\x03key\"\x13\n\x11UnpublishResponse2\xaa\x03\n\x0cStoreService\x12Q\n\x06Mutate\x12\".autokitteh.store.v1.MutateRequest\x1a#.autokitteh.store.v1.MutateResponse\x12H\n\x03Get\x12\x1f.autokitteh.store.v1.GetRequest\x1a .autokitteh.store.v1.GetResponse\x12T\n\x07Publish\x12#.autokitteh.store.v1.PublishRequest\x1a$.autokitteh.store.v1.PublishResponse\x12Z\n\tUnpublish\x12%.autokitteh.store.v1.UnpublishRequest\x1a&.autokitteh.store.v1.UnpublishResponse\x12K\n\x04List\x12 .autokitteh.store.v1.ListRequest\x1a!.autokitteh.store.v1.ListResponseB\xd8\x01\n\x17\x63om.autokitteh.store.v1B\x08SvcProtoP\x01ZEgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/store/v1;storev1\xa2\x02\x03\x41SX\xaa\x02\x13\x41utokitteh.Store.V1\xca\x02\x13\x41utokitteh\\Store\\V1\xe2\x02\x1f\x41utokitteh\\Store\\V1\\GPBMetadata\xea\x02\x15\x41utokitteh::Store::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -37,6 +37,10 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _GETRESPONSE_VALUESENTRY._serialized_options = b'8\001'
   _GETRESPONSE.fields_by_name['values']._options = None
   _GETRESPONSE.fields_by_name['values']._serialized_options = b'\372\367\030\016\232\001\013\"\004r\002\020\001*\003\310\001\001'
+  _LISTREQUEST.fields_by_name['page_size']._options = None
+  _LISTREQUEST.fields_by_name['page_size']._serialized_options = b'\372\367\0302\272\001/\n\024store.list.page_size\022\014Must be >= 0\032\tthis >= 0'
+  _LISTREQUEST.fields_by_name['skip']._options = None
+  _LISTREQUEST.fields_by_name['skip']._serialized_options = b'\372\367\030-\272\001*\n\017store.list.skip\022\014Must be >= 0\032\tthis >= 0'
   _LISTRESPONSE.fields_by_name['keys']._options = None
   _LISTRESPONSE.fields_by_name['keys']._serialized_options = b'\372\367\030\t\222\001\006\"\004r\002\020\001'
   _PUBLISHREQUEST.fields_by_name['key']._options = None
@@ -53,18 +57,18 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _globals['_GETRESPONSE']._serialized_end=656
   _globals['_GETRESPONSE_VALUESENTRY']._serialized_start=570
   _globals['_GETRESPONSE_VALUESENTRY']._serialized_end=656
-  _globals['_LISTREQUEST']._serialized_start=658
-  _globals['_LISTREQUEST']._serialized_end=702
-  _globals['_LISTRESPONSE']._serialized_start=704
-  _globals['_LISTRESPONSE']._serialized_end=753
-  _globals['_PUBLISHREQUEST']._serialized_start=755
-  _globals['_PUBLISHREQUEST']._serialized_end=830
-  _globals['_PUBLISHRESPONSE']._serialized_start=832
-  _globals['_PUBLISHRESPONSE']._serialized_end=849
-  _globals['_UNPUBLISHREQUEST']._serialized_start=851
-  _globals['_UNPUBLISHREQUEST']._serialized_end=928
-  _globals['_UNPUBLISHRESPONSE']._serialized_start=930
-  _globals['_UNPUBLISHRESPONSE']._serialized_end=949
-  _globals['_STORESERVICE']._serialized_start=952
-  _globals['_STORESERVICE']._serialized_end=1378
+  _globals['_LISTREQUEST']._serialized_start=659
+  _globals['_LISTREQUEST']._serialized_end=953
+  _globals['_LISTRESPONSE']._serialized_start=955
+  _globals['_LISTRESPONSE']._serialized_end=1066
+  _globals['_PUBLISHREQUEST']._serialized_start=1068
+  _globals['_PUBLISHREQUEST']._serialized_end=1143
+  _globals['_PUBLISHRESPONSE']._serialized_start=1145
+  _globals['_PUBLISHRESPONSE']._serialized_end=1162
+  _globals['_UNPUBLISHREQUEST']._serialized_start=1164
+  _globals['_UNPUBLISHREQUEST']._serialized_end=1241
+  _globals['_UNPUBLISHRESPONSE']._serialized_start=1243
+  _globals['_UNPUBLISHRESPONSE']._serialized_end=1262
+  _globals['_STORESERVICE']._serialized_start=1265
+  _globals['_STORESERVICE']._serialized_end=1691
 # @@protoc_insertion_point(module_scope)
@@ -47,16 +47,30 @@ class GetResponse(_message.Message):
     def __init__(self, values: _Optional[_Mapping[str, _values_pb2.Value]] = ...) -> None: ...
 
 class ListRequest(_message.Message):
-    __slots__ = ["project_id"]
+    __slots__ = ["project_id", "prefix", "published_only", "page_size", "skip", "page_token"]
     PROJECT_ID_FIELD_NUMBER: _ClassVar[int]
+    PREFIX_FIELD_NUMBER: _ClassVar[int]
+    PUBLISHED_ONLY_FIELD_NUMBER: _ClassVar[int]
+    PAGE_SIZE_FIELD_NUMBER: _ClassVar[int]
+    SKIP_FIELD_NUMBER: _ClassVar[int]
+    PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
     project_id: str
-    def __init__(self, project_id: _Optional[str] = ...) -> None: ...
+    prefix: str
+    published_only: bool
+    page_size: int
+    skip: int
+    page_token: str
+    def __init__(self, project_id: _Optional[str] = ..., prefix: _Optional[str] = ..., published_only: bool = ..., page_size: _Optional[int] = ..., skip: _Optional[int] = ..., page_token: _Optional[str] = ...) -> None: ...
 
 class ListResponse(_message.Message):
-    __slots__ = ["keys"]
+    __slots__ = ["keys", "count", "next_page_token"]
     KEYS_FIELD_NUMBER: _ClassVar[int]
+    COUNT_FIELD_NUMBER: _ClassVar[int]
+    NEXT_PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
     keys: _containers.RepeatedScalarFieldContainer[str]
-    def __init__(self, keys: _Optional[_Iterable[str]] = ...) -> None: ...
+    count: int
+    next_page_token: str
+    def __init__(self, keys: _Optional[_Iterable[str]] = ..., count: _Optional[int] = ..., next_page_token: _Optional[str] = ...) -> None: ...
 
 class PublishRequest(_message.Message):
     __slots__ = ["project_id", "key"]
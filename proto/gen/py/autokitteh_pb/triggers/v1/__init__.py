@@ -3,7 +3,7 @@
 
 from .svc_pb2 import (CreateRequest,CreateResponse,UpdateRequest,UpdateResponse,DeleteRequest,DeleteResponse,GetRequest,GetResponse,ListRequest,ListResponse,)
 from .svc_pb2_grpc import (TriggersServiceStub,TriggersServiceServicer,TriggersService,)
-from .trigger_pb2 import (Trigger,)
+from .trigger_pb2 import (ConcurrencyPolicy,BatchPolicy,RetryPolicy,WebhookAuth,WebhookIdempotency,Trigger,)
 
 
-__all__ = ["Trigger","TriggersServiceStub","TriggersServiceServicer","TriggersService","CreateRequest","CreateResponse","UpdateRequest","UpdateResponse","DeleteRequest","DeleteResponse","GetRequest","GetResponse","ListRequest","ListResponse",]
+__all__ = ["ConcurrencyPolicy","BatchPolicy","RetryPolicy","WebhookAuth","WebhookIdempotency","Trigger","TriggersServiceStub","TriggersServiceServicer","TriggersService","CreateRequest","CreateResponse","UpdateRequest","UpdateResponse","DeleteRequest","DeleteResponse","GetRequest","GetResponse","ListRequest","ListResponse",]
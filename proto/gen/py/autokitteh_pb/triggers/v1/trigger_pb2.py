@@ -12,9 +12,10 @@ _sym_db = _symbol_database.Default()
 
 
 from autokitteh_pb.program.v1 import program_pb2 as autokitteh_dot_program_dot_v1_dot_program__pb2
+from google.protobuf import duration_pb2 as google_dot_protobuf_dot_duration__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n$autokitteh/triggers/v1/trigger.proto\x12\x16\x61utokitteh.triggers.v1\x1a#autokitteh/program/v1/program.proto\"\xdb\x04\n\x07Trigger\x12\x1d\n\ntrigger_id\x18\x01 \x01(\tR\ttriggerId\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12K\n\x0bsource_type\x18\x03 \x01(\x0e\x32*.autokitteh.triggers.v1.Trigger.SourceTypeR\nsourceType\x12\x1d\n\nproject_id\x18\x04 \x01(\tR\tprojectId\x12\x1d\n\nevent_type\x18\x05 \x01(\tR\teventType\x12H\n\rcode_location\x18\x06 \x01(\x0b\x32#.autokitteh.program.v1.CodeLocationR\x0c\x63odeLocation\x12\x16\n\x06\x66ilter\x18\x07 \x01(\tR\x06\x66ilter\x12\x1d\n\nis_durable\x18\x08 \x01(\x08R\tisDurable\x12\x17\n\x07is_sync\x18\t \x01(\x08R\x06isSync\x12#\n\rconnection_id\x18\x32 \x01(\tR\x0c\x63onnectionId\x12\x1a\n\x08schedule\x18\x33 \x01(\tR\x08schedule\x12\x1a\n\x08timezone\x18\x34 \x01(\tR\x08timezone\x12!\n\x0cwebhook_slug\x18\x64 \x01(\tR\x0bwebhookSlug\"x\n\nSourceType\x12\x1b\n\x17SOURCE_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n\x16SOURCE_TYPE_CONNECTION\x10\x01\x12\x17\n\x13SOURCE_TYPE_WEBHOOK\x10\x02\x12\x18\n\x14SOURCE_TYPE_SCHEDULE\x10\x03\x42\xf1\x01\n\x1a\x63om.autokitteh.triggers.v1B\x0cTriggerProtoP\x01ZKgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/triggers/v1;triggersv1\xa2\x02\x03\x41TX\xaa\x02\x16\x41utokitteh.Triggers.V1\xca\x02\x16\x41utokitteh\\Triggers\\V1\xe2\x02\"Autokitteh\\Triggers\\V1\\GPBMetadata\xea\x02\x18\x41utokitteh::Triggers::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n$autokitteh/triggers/v1/trigger.proto\x12\x16\x61utokitteh.triggers.v1\x1a#autokitteh/program/v1/program.proto\x1a\x1egoogle/protobuf/duration.proto\"\xed\x01\n\x11\x43oncurrencyPolicy\x12%\n\x0emax_concurrent\x18\x01 \x01(\rR\rmaxConcurrent\x12K\n\x07overlap\x18\x02 \x01(\x0e\x32\x31.autokitteh.triggers.v1.ConcurrencyPolicy.OverlapR\x07overlap\"d\n\x07Overlap\x12\x17\n\x13OVERLAP_UNSPECIFIED\x10\x00\x12\x11\n\rOVERLAP_QUEUE\x10\x01\x12\x10\n\x0cOVERLAP_SKIP\x10\x02\x12\x1b\n\x17OVERLAP_CANCEL_PREVIOUS\x10\x03\"q\n\x0b\x42\x61tchPolicy\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x31\n\x06window\x18\x02 \x01(\x0b\x32\x19.google.protobuf.DurationR\x06window\x12\x1d\n\nmax_events\x18\x03 \x01(\rR\tmaxEvents\"\x9a\x02\n\x0bRetryPolicy\x12!\n\x0cmax_attempts\x18\x01 \x01(\rR\x0bmaxAttempts\x12\x44\n\x10initial_interval\x18\x02 \x01(\x0b\x32\x19.google.protobuf.DurationR\x0finitialInterval\x12/\n\x13\x62\x61\x63koff_coefficient\x18\x03 \x01(\x01R\x12\x62\x61\x63koffCoefficient\x12<\n\x0cmax_interval\x18\x04 \x01(\x0b\x32\x19.google.protobuf.DurationR\x0bmaxInterval\x12\x33\n\x16retry_on_program_error\x18\x05 \x01(\x08R\x13retryOnProgramError\"\xce\x04\n\x0bWebhookAuth\x12K\n\tsignature\x18\x01 \x01(\x0b\x32-.autokitteh.triggers.v1.WebhookAuth.SignatureR\tsignature\x12(\n\x10\x62\x65\x61rer_token_var\x18\x02 \x01(\tR\x0e\x62\x65\x61rerTokenVar\x12?\n\x05\x62\x61sic\x18\x03 \x01(\x0b\x32).autokitteh.triggers.v1.WebhookAuth.BasicR\x05\x62\x61sic\x12#\n\rallowed_cidrs\x18\x04 \x03(\tR\x0c\x61llowedCidrs\x1a\x8f\x01\n\tSignature\x12\x16\n\x06header\x18\x01 \x01(\tR\x06header\x12\x1d\n\nsecret_var\x18\x02 \x01(\tR\tsecretVar\x12K\n\x06\x66ormat\x18\x03 \x01(\x0e\x32\x33.autokitteh.triggers.v1.WebhookAuth.SignatureFormatR\x06\x66ormat\x1a\x46\n\x05\x42\x61sic\x12\x1a\n\x08username\x18\x01 \x01(\tR\x08username\x12!\n\x0cpassword_var\x18\x02 \x01(\tR\x0bpasswordVar\"\x87\x01\n\x0fSignatureFormat\x12 \n\x1cSIGNATURE_FORMAT_UNSPECIFIED\x10\x00\x12\x18\n\x14SIGNATURE_FORMAT_HEX\x10\x01\x12\x1b\n\x17SIGNATURE_FORMAT_BASE64\x10\x02\x12\x1b\n\x17SIGNATURE_FORMAT_STRIPE\x10\x03\">\n\x12WebhookIdempotency\x12\x16\n\x06header\x18\x01 \x01(\tR\x06header\x12\x10\n\x03key\x18\x02 \x01(\tR\x03key\"\xf3\x08\n\x07Trigger\x12\x1d\n\ntrigger_id\x18\x01 \x01(\tR\ttriggerId\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12K\n\x0bsource_type\x18\x03 \x01(\x0e\x32*.autokitteh.triggers.v1.Trigger.SourceTypeR\nsourceType\x12\x1d\n\nproject_id\x18\x04 \x01(\tR\tprojectId\x12\x1d\n\nevent_type\x18\x05 \x01(\tR\teventType\x12H\n\rcode_location\x18\x06 \x01(\x0b\x32#.autokitteh.program.v1.CodeLocationR\x0c\x63odeLocation\x12\x16\n\x06\x66ilter\x18\x07 \x01(\tR\x06\x66ilter\x12\x1d\n\nis_durable\x18\x08 \x01(\x08R\tisDurable\x12\x17\n\x07is_sync\x18\t \x01(\x08R\x06isSync\x12K\n\x0b\x63oncurrency\x18\n \x01(\x0b\x32).autokitteh.triggers.v1.ConcurrencyPolicyR\x0b\x63oncurrency\x12\x39\n\x05\x62\x61tch\x18\x0b \x01(\x0b\x32#.autokitteh.triggers.v1.BatchPolicyR\x05\x62\x61tch\x12\x39\n\x05retry\x18\x0c \x01(\x0b\x32#.autokitteh.triggers.v1.RetryPolicyR\x05retry\x12\x33\n\x07timeout\x18\r \x01(\x0b\x32\x19.google.protobuf.DurationR\x07timeout\x12<\n\x07on_stop\x18\x0e \x01(\x0b\x32#.autokitteh.program.v1.CodeLocationR\x06onStop\x12#\n\rconnection_id\x18\x32 \x01(\tR\x0c\x63onnectionId\x12\x1a\n\x08schedule\x18\x33 \x01(\tR\x08schedule\x12\x1a\n\x08timezone\x18\x34 \x01(\tR\x08timezone\x12\x46\n\x0cwebhook_auth\x18\x35 \x01(\x0b\x32#.autokitteh.triggers.v1.WebhookAuthR\x0bwebhookAuth\x12[\n\x13webhook_idempotency\x18\x36 \x01(\x0b\x32*.autokitteh.triggers.v1.WebhookIdempotencyR\x12webhookIdempotency\x12#\n\rwebhook_route\x18\x37 \x01(\tR\x0cwebhookRoute\x12!\n\x0cwebhook_slug\x18\x64 \x01(\tR\x0bwebhookSlug\"\x8f\x01\n\nSourceType\x12\x1b\n\x17SOURCE_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n\x16SOURCE_TYPE_CONNECTION\x10\x01\x12\x17\n\x13SOURCE_TYPE_WEBHOOK\x10\x02\x12\x18\n\x14SOURCE_TYPE_SCHEDULE\x10\x03\x12\x15\n\x11SOURCE_TYPE_STORE\x10\x04\x42\xf1\x01\n\x1a\x63om.autokitteh.triggers.v1B\x0cTriggerProtoP\x01ZKgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/triggers/v1;triggersv1\xa2\x02\x03\x41TX\xaa\x02\x16\x41utokitteh.Triggers.V1\xca\x02\x16\x41utokitteh\\Triggers\\V1\xe2\x02\"Autokitteh\\Triggers\\V1\\GPBMetadata\xea\x02\x18\x41utokitteh::Triggers::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -22,8 +23,26 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'autokitteh.triggers.v1.trig
 if _descriptor._USE_C_DESCRIPTORS == False:
   DESCRIPTOR._options = None
   DESCRIPTOR._serialized_options = b'\n\032com.autokitteh.triggers.v1B\014TriggerProtoP\001ZKgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/triggers/v1;triggersv1\242\002\003ATX\252\002\026Autokitteh.Triggers.V1\312\002\026Autokitteh\\Triggers\\V1\342\002\"Autokitteh\\Triggers\\V1\\GPBMetadata\352\002\030Autokitteh::Triggers::V1'
-  _globals['_TRIGGER']._serialized_start=102
-  _globals['_TRIGGER']._serialized_end=705
-  _globals['_TRIGGER_SOURCETYPE']._serialized_start=585
-  _globals['_TRIGGER_SOURCETYPE']._serialized_end=705
+  _globals['_CONCURRENCYPOLICY']._serialized_start=134
+  _globals['_CONCURRENCYPOLICY']._serialized_end=371
+  _globals['_CONCURRENCYPOLICY_OVERLAP']._serialized_start=271
+  _globals['_CONCURRENCYPOLICY_OVERLAP']._serialized_end=371
+  _globals['_BATCHPOLICY']._serialized_start=373
+  _globals['_BATCHPOLICY']._serialized_end=486
+  _globals['_RETRYPOLICY']._serialized_start=489
+  _globals['_RETRYPOLICY']._serialized_end=771
+  _globals['_WEBHOOKAUTH']._serialized_start=774
+  _globals['_WEBHOOKAUTH']._serialized_end=1364
+  _globals['_WEBHOOKAUTH_SIGNATURE']._serialized_start=1011
+  _globals['_WEBHOOKAUTH_SIGNATURE']._serialized_end=1154
+  _globals['_WEBHOOKAUTH_BASIC']._serialized_start=1156
+  _globals['_WEBHOOKAUTH_BASIC']._serialized_end=1226
+  _globals['_WEBHOOKAUTH_SIGNATUREFORMAT']._serialized_start=1229
+  _globals['_WEBHOOKAUTH_SIGNATUREFORMAT']._serialized_end=1364
+  _globals['_WEBHOOKIDEMPOTENCY']._serialized_start=1366
+  _globals['_WEBHOOKIDEMPOTENCY']._serialized_end=1428
+  _globals['_TRIGGER']._serialized_start=1431
+  _globals['_TRIGGER']._serialized_end=2570
+  _globals['_TRIGGER_SOURCETYPE']._serialized_start=2427
+  _globals['_TRIGGER_SOURCETYPE']._serialized_end=2570
 # @@protoc_insertion_point(module_scope)
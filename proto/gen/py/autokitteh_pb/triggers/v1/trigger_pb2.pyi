@@ -1,23 +1,115 @@
 from autokitteh_pb.program.v1 import program_pb2 as _program_pb2
+from google.protobuf import duration_pb2 as _duration_pb2
+from google.protobuf.internal import containers as _containers
 from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
-from typing import ClassVar as _ClassVar, Mapping as _Mapping, Optional as _Optional, Union as _Union
+from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Mapping, Optional as _Optional, Union as _Union
 
 DESCRIPTOR: _descriptor.FileDescriptor
 
+class ConcurrencyPolicy(_message.Message):
+    __slots__ = ["max_concurrent", "overlap"]
+    class Overlap(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+        __slots__ = []
+        OVERLAP_UNSPECIFIED: _ClassVar[ConcurrencyPolicy.Overlap]
+        OVERLAP_QUEUE: _ClassVar[ConcurrencyPolicy.Overlap]
+        OVERLAP_SKIP: _ClassVar[ConcurrencyPolicy.Overlap]
+        OVERLAP_CANCEL_PREVIOUS: _ClassVar[ConcurrencyPolicy.Overlap]
+    OVERLAP_UNSPECIFIED: ConcurrencyPolicy.Overlap
+    OVERLAP_QUEUE: ConcurrencyPolicy.Overlap
+    OVERLAP_SKIP: ConcurrencyPolicy.Overlap
+    OVERLAP_CANCEL_PREVIOUS: ConcurrencyPolicy.Overlap
+    MAX_CONCURRENT_FIELD_NUMBER: _ClassVar[int]
+    OVERLAP_FIELD_NUMBER: _ClassVar[int]
+    max_concurrent: int
+    overlap: ConcurrencyPolicy.Overlap
+    def __init__(self, max_concurrent: _Optional[int] = ..., overlap: _Optional[_Union[ConcurrencyPolicy.Overlap, str]] = ...) -> None: ...
+
+class BatchPolicy(_message.Message):
+    __slots__ = ["key", "window", "max_events"]
+    KEY_FIELD_NUMBER: _ClassVar[int]
+    WINDOW_FIELD_NUMBER: _ClassVar[int]
+    MAX_EVENTS_FIELD_NUMBER: _ClassVar[int]
+    key: str
+    window: _duration_pb2.Duration
+    max_events: int
+    def __init__(self, key: _Optional[str] = ..., window: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., max_events: _Optional[int] = ...) -> None: ...
+
+class RetryPolicy(_message.Message):
+    __slots__ = ["max_attempts", "initial_interval", "backoff_coefficient", "max_interval", "retry_on_program_error"]
+    MAX_ATTEMPTS_FIELD_NUMBER: _ClassVar[int]
+    INITIAL_INTERVAL_FIELD_NUMBER: _ClassVar[int]
+    BACKOFF_COEFFICIENT_FIELD_NUMBER: _ClassVar[int]
+    MAX_INTERVAL_FIELD_NUMBER: _ClassVar[int]
+    RETRY_ON_PROGRAM_ERROR_FIELD_NUMBER: _ClassVar[int]
+    max_attempts: int
+    initial_interval: _duration_pb2.Duration
+    backoff_coefficient: float
+    max_interval: _duration_pb2.Duration
+    retry_on_program_error: bool
+    def __init__(self, max_attempts: _Optional[int] = ..., initial_interval: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., backoff_coefficient: _Optional[float] = ..., max_interval: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., retry_on_program_error: bool = ...) -> None: ...
+
+class WebhookAuth(_message.Message):
+    __slots__ = ["signature", "bearer_token_var", "basic", "allowed_cidrs"]
+    class SignatureFormat(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+        __slots__ = []
+        SIGNATURE_FORMAT_UNSPECIFIED: _ClassVar[WebhookAuth.SignatureFormat]
+        SIGNATURE_FORMAT_HEX: _ClassVar[WebhookAuth.SignatureFormat]
+        SIGNATURE_FORMAT_BASE64: _ClassVar[WebhookAuth.SignatureFormat]
+        SIGNATURE_FORMAT_STRIPE: _ClassVar[WebhookAuth.SignatureFormat]
+    SIGNATURE_FORMAT_UNSPECIFIED: WebhookAuth.SignatureFormat
+    SIGNATURE_FORMAT_HEX: WebhookAuth.SignatureFormat
+    SIGNATURE_FORMAT_BASE64: WebhookAuth.SignatureFormat
+    SIGNATURE_FORMAT_STRIPE: WebhookAuth.SignatureFormat
+    class Signature(_message.Message):
+        __slots__ = ["header", "secret_var", "format"]
+        HEADER_FIELD_NUMBER: _ClassVar[int]
+        SECRET_VAR_FIELD_NUMBER: _ClassVar[int]
+        FORMAT_FIELD_NUMBER: _ClassVar[int]
+        header: str
+        secret_var: str
+        format: WebhookAuth.SignatureFormat
+        def __init__(self, header: _Optional[str] = ..., secret_var: _Optional[str] = ..., format: _Optional[_Union[WebhookAuth.SignatureFormat, str]] = ...) -> None: ...
+    class Basic(_message.Message):
+        __slots__ = ["username", "password_var"]
+        USERNAME_FIELD_NUMBER: _ClassVar[int]
+        PASSWORD_VAR_FIELD_NUMBER: _ClassVar[int]
+        username: str
+        password_var: str
+        def __init__(self, username: _Optional[str] = ..., password_var: _Optional[str] = ...) -> None: ...
+    SIGNATURE_FIELD_NUMBER: _ClassVar[int]
+    BEARER_TOKEN_VAR_FIELD_NUMBER: _ClassVar[int]
+    BASIC_FIELD_NUMBER: _ClassVar[int]
+    ALLOWED_CIDRS_FIELD_NUMBER: _ClassVar[int]
+    signature: WebhookAuth.Signature
+    bearer_token_var: str
+    basic: WebhookAuth.Basic
+    allowed_cidrs: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, signature: _Optional[_Union[WebhookAuth.Signature, _Mapping]] = ..., bearer_token_var: _Optional[str] = ..., basic: _Optional[_Union[WebhookAuth.Basic, _Mapping]] = ..., allowed_cidrs: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class WebhookIdempotency(_message.Message):
+    __slots__ = ["header", "key"]
+    HEADER_FIELD_NUMBER: _ClassVar[int]
+    KEY_FIELD_NUMBER: _ClassVar[int]
+    header: str
+    key: str
+    def __init__(self, header: _Optional[str] = ..., key: _Optional[str] = ...) -> None: ...
+
 class Trigger(_message.Message):
-    __slots__ = ["trigger_id", "name", "source_type", "project_id", "event_type", "code_location", "filter", "is_durable", "is_sync", "connection_id", "schedule", "timezone", "webhook_slug"]
+    __slots__ = ["trigger_id", "name", "source_type", "project_id", "event_type", "code_location", "filter", "is_durable", "is_sync", "concurrency", "batch", "retry", "timeout", "on_stop", "connection_id", "schedule", "timezone", "webhook_auth", "webhook_idempotency", "webhook_route", "webhook_slug"]
     class SourceType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
         __slots__ = []
         SOURCE_TYPE_UNSPECIFIED: _ClassVar[Trigger.SourceType]
         SOURCE_TYPE_CONNECTION: _ClassVar[Trigger.SourceType]
         SOURCE_TYPE_WEBHOOK: _ClassVar[Trigger.SourceType]
         SOURCE_TYPE_SCHEDULE: _ClassVar[Trigger.SourceType]
+        SOURCE_TYPE_STORE: _ClassVar[Trigger.SourceType]
     SOURCE_TYPE_UNSPECIFIED: Trigger.SourceType
     SOURCE_TYPE_CONNECTION: Trigger.SourceType
     SOURCE_TYPE_WEBHOOK: Trigger.SourceType
     SOURCE_TYPE_SCHEDULE: Trigger.SourceType
+    SOURCE_TYPE_STORE: Trigger.SourceType
     TRIGGER_ID_FIELD_NUMBER: _ClassVar[int]
     NAME_FIELD_NUMBER: _ClassVar[int]
     SOURCE_TYPE_FIELD_NUMBER: _ClassVar[int]
@@ -27,9 +119,17 @@ class Trigger(_message.Message):
     FILTER_FIELD_NUMBER: _ClassVar[int]
     IS_DURABLE_FIELD_NUMBER: _ClassVar[int]
     IS_SYNC_FIELD_NUMBER: _ClassVar[int]
+    CONCURRENCY_FIELD_NUMBER: _ClassVar[int]
+    BATCH_FIELD_NUMBER: _ClassVar[int]
+    RETRY_FIELD_NUMBER: _ClassVar[int]
+    TIMEOUT_FIELD_NUMBER: _ClassVar[int]
+    ON_STOP_FIELD_NUMBER: _ClassVar[int]
     CONNECTION_ID_FIELD_NUMBER: _ClassVar[int]
     SCHEDULE_FIELD_NUMBER: _ClassVar[int]
     TIMEZONE_FIELD_NUMBER: _ClassVar[int]
+    WEBHOOK_AUTH_FIELD_NUMBER: _ClassVar[int]
+    WEBHOOK_IDEMPOTENCY_FIELD_NUMBER: _ClassVar[int]
+    WEBHOOK_ROUTE_FIELD_NUMBER: _ClassVar[int]
     WEBHOOK_SLUG_FIELD_NUMBER: _ClassVar[int]
     trigger_id: str
     name: str
@@ -40,8 +140,16 @@ class Trigger(_message.Message):
     filter: str
     is_durable: bool
     is_sync: bool
+    concurrency: ConcurrencyPolicy
+    batch: BatchPolicy
+    retry: RetryPolicy
+    timeout: _duration_pb2.Duration
+    on_stop: _program_pb2.CodeLocation
     connection_id: str
     schedule: str
     timezone: str
+    webhook_auth: WebhookAuth
+    webhook_idempotency: WebhookIdempotency
+    webhook_route: str
     webhook_slug: str
-    def __init__(self, trigger_id: _Optional[str] = ..., name: _Optional[str] = ..., source_type: _Optional[_Union[Trigger.SourceType, str]] = ..., project_id: _Optional[str] = ..., event_type: _Optional[str] = ..., code_location: _Optional[_Union[_program_pb2.CodeLocation, _Mapping]] = ..., filter: _Optional[str] = ..., is_durable: bool = ..., is_sync: bool = ..., connection_id: _Optional[str] = ..., schedule: _Optional[str] = ..., timezone: _Optional[str] = ..., webhook_slug: _Optional[str] = ...) -> None: ...
+    def __init__(self, trigger_id: _Optional[str] = ..., name: _Optional[str] = ..., source_type: _Optional[_Union[Trigger.SourceType, str]] = ..., project_id: _Optional[str] = ..., event_type: _Optional[str] = ..., code_location: _Optional[_Union[_program_pb2.CodeLocation, _Mapping]] = ..., filter: _Optional[str] = ..., is_durable: bool = ..., is_sync: bool = ..., concurrency: _Optional[_Union[ConcurrencyPolicy, _Mapping]] = ..., batch: _Optional[_Union[BatchPolicy, _Mapping]] = ..., retry: _Optional[_Union[RetryPolicy, _Mapping]] = ..., timeout: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., on_stop: _Optional[_Union[_program_pb2.CodeLocation, _Mapping]] = ..., connection_id: _Optional[str] = ..., schedule: _Optional[str] = ..., timezone: _Optional[str] = ..., webhook_auth: _Optional[_Union[WebhookAuth, _Mapping]] = ..., webhook_idempotency: _Optional[_Union[WebhookIdempotency, _Mapping]] = ..., webhook_route: _Optional[str] = ..., webhook_slug: _Optional[str] = ...) -> None: ...
@@ -0,0 +1,153 @@
+// @generated by protoc-gen-es v1.5.1 with parameter "target=ts"
+// @generated from file autokitteh/approvals/v1/approval.proto (package autokitteh.approvals.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum autokitteh.approvals.v1.ApprovalState
+ */
+export enum ApprovalState {
+  /**
+   * @generated from enum value: APPROVAL_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: APPROVAL_STATE_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: APPROVAL_STATE_APPROVED = 2;
+   */
+  APPROVED = 2,
+
+  /**
+   * @generated from enum value: APPROVAL_STATE_REJECTED = 3;
+   */
+  REJECTED = 3,
+
+  /**
+   * no decision was made before the session stopped waiting.
+   *
+   * @generated from enum value: APPROVAL_STATE_EXPIRED = 4;
+   */
+  EXPIRED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ApprovalState)
+proto3.util.setEnumType(ApprovalState, "autokitteh.approvals.v1.ApprovalState", [
+  { no: 0, name: "APPROVAL_STATE_UNSPECIFIED" },
+  { no: 1, name: "APPROVAL_STATE_PENDING" },
+  { no: 2, name: "APPROVAL_STATE_APPROVED" },
+  { no: 3, name: "APPROVAL_STATE_REJECTED" },
+  { no: 4, name: "APPROVAL_STATE_EXPIRED" },
+]);
+
+/**
+ * A request made by a session for a human to approve or reject a step.
+ *
+ * @generated from message autokitteh.approvals.v1.Approval
+ */
+export class Approval extends Message<Approval> {
+  /**
+   * @generated from field: string approval_id = 1;
+   */
+  approvalId = "";
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: string project_id = 3;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string title = 4;
+   */
+  title = "";
+
+  /**
+   * emails of users allowed to decide. empty means any project member.
+   *
+   * @generated from field: repeated string approvers = 5;
+   */
+  approvers: string[] = [];
+
+  /**
+   * @generated from field: autokitteh.approvals.v1.ApprovalState state = 6;
+   */
+  state = ApprovalState.UNSPECIFIED;
+
+  /**
+   * email of the user who decided.
+   *
+   * @generated from field: string decided_by = 7;
+   */
+  decidedBy = "";
+
+  /**
+   * @generated from field: string comment = 8;
+   */
+  comment = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * not set if the session waits indefinitely.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 10;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp decided_at = 11;
+   */
+  decidedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Approval>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.approvals.v1.Approval";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "approval_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "approvers", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "state", kind: "enum", T: proto3.getEnumType(ApprovalState) },
+    { no: 7, name: "decided_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "comment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "created_at", kind: "message", T: Timestamp },
+    { no: 10, name: "expires_at", kind: "message", T: Timestamp },
+    { no: 11, name: "decided_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Approval {
+    return new Approval().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Approval {
+    return new Approval().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Approval {
+    return new Approval().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Approval | PlainMessage<Approval> | undefined, b: Approval | PlainMessage<Approval> | undefined): boolean {
+    return proto3.util.equals(Approval, a, b);
+  }
+}
+
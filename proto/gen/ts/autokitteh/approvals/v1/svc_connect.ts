@@ -0,0 +1,49 @@
+// @generated by protoc-gen-connect-es v1.1.4 with parameter "target=ts"
+// @generated from file autokitteh/approvals/v1/svc.proto (package autokitteh.approvals.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { DecideRequest, DecideResponse, GetRequest, GetResponse, ListRequest, ListResponse } from "./svc_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service autokitteh.approvals.v1.ApprovalsService
+ */
+export const ApprovalsService = {
+  typeName: "autokitteh.approvals.v1.ApprovalsService",
+  methods: {
+    /**
+     * @generated from rpc autokitteh.approvals.v1.ApprovalsService.Get
+     */
+    get: {
+      name: "Get",
+      I: GetRequest,
+      O: GetResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Lists approvals, most recent first.
+     *
+     * @generated from rpc autokitteh.approvals.v1.ApprovalsService.List
+     */
+    list: {
+      name: "List",
+      I: ListRequest,
+      O: ListResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Approves or rejects a pending approval, and signals the
+     * waiting session with the decision.
+     *
+     * @generated from rpc autokitteh.approvals.v1.ApprovalsService.Decide
+     */
+    decide: {
+      name: "Decide",
+      I: DecideRequest,
+      O: DecideResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
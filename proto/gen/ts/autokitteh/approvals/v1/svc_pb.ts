@@ -0,0 +1,269 @@
+// @generated by protoc-gen-es v1.5.1 with parameter "target=ts"
+// @generated from file autokitteh/approvals/v1/svc.proto (package autokitteh.approvals.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+import { Approval, ApprovalState } from "./approval_pb.js";
+
+/**
+ * @generated from message autokitteh.approvals.v1.GetRequest
+ */
+export class GetRequest extends Message<GetRequest> {
+  /**
+   * @generated from field: string approval_id = 1;
+   */
+  approvalId = "";
+
+  constructor(data?: PartialMessage<GetRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.approvals.v1.GetRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "approval_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRequest {
+    return new GetRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRequest {
+    return new GetRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRequest {
+    return new GetRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRequest | PlainMessage<GetRequest> | undefined, b: GetRequest | PlainMessage<GetRequest> | undefined): boolean {
+    return proto3.util.equals(GetRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.approvals.v1.GetResponse
+ */
+export class GetResponse extends Message<GetResponse> {
+  /**
+   * @generated from field: autokitteh.approvals.v1.Approval approval = 1;
+   */
+  approval?: Approval;
+
+  constructor(data?: PartialMessage<GetResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.approvals.v1.GetResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "approval", kind: "message", T: Approval },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetResponse {
+    return new GetResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetResponse {
+    return new GetResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetResponse {
+    return new GetResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetResponse | PlainMessage<GetResponse> | undefined, b: GetResponse | PlainMessage<GetResponse> | undefined): boolean {
+    return proto3.util.equals(GetResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.approvals.v1.ListRequest
+ */
+export class ListRequest extends Message<ListRequest> {
+  /**
+   * @generated from field: string org_id = 1;
+   */
+  orgId = "";
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string session_id = 3;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: autokitteh.approvals.v1.ApprovalState state = 4;
+   */
+  state = ApprovalState.UNSPECIFIED;
+
+  /**
+   * @generated from field: uint32 limit = 5;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<ListRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.approvals.v1.ListRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "org_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "state", kind: "enum", T: proto3.getEnumType(ApprovalState) },
+    { no: 5, name: "limit", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRequest {
+    return new ListRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRequest {
+    return new ListRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRequest {
+    return new ListRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRequest | PlainMessage<ListRequest> | undefined, b: ListRequest | PlainMessage<ListRequest> | undefined): boolean {
+    return proto3.util.equals(ListRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.approvals.v1.ListResponse
+ */
+export class ListResponse extends Message<ListResponse> {
+  /**
+   * @generated from field: repeated autokitteh.approvals.v1.Approval approvals = 1;
+   */
+  approvals: Approval[] = [];
+
+  constructor(data?: PartialMessage<ListResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.approvals.v1.ListResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "approvals", kind: "message", T: Approval, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListResponse {
+    return new ListResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListResponse {
+    return new ListResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListResponse {
+    return new ListResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListResponse | PlainMessage<ListResponse> | undefined, b: ListResponse | PlainMessage<ListResponse> | undefined): boolean {
+    return proto3.util.equals(ListResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.approvals.v1.DecideRequest
+ */
+export class DecideRequest extends Message<DecideRequest> {
+  /**
+   * @generated from field: string approval_id = 1;
+   */
+  approvalId = "";
+
+  /**
+   * false means reject.
+   *
+   * @generated from field: bool approve = 2;
+   */
+  approve = false;
+
+  /**
+   * @generated from field: string comment = 3;
+   */
+  comment = "";
+
+  constructor(data?: PartialMessage<DecideRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.approvals.v1.DecideRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "approval_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "approve", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "comment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DecideRequest {
+    return new DecideRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DecideRequest {
+    return new DecideRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DecideRequest {
+    return new DecideRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DecideRequest | PlainMessage<DecideRequest> | undefined, b: DecideRequest | PlainMessage<DecideRequest> | undefined): boolean {
+    return proto3.util.equals(DecideRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.approvals.v1.DecideResponse
+ */
+export class DecideResponse extends Message<DecideResponse> {
+  /**
+   * @generated from field: autokitteh.approvals.v1.Approval approval = 1;
+   */
+  approval?: Approval;
+
+  constructor(data?: PartialMessage<DecideResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.approvals.v1.DecideResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "approval", kind: "message", T: Approval },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DecideResponse {
+    return new DecideResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DecideResponse {
+    return new DecideResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DecideResponse {
+    return new DecideResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DecideResponse | PlainMessage<DecideResponse> | undefined, b: DecideResponse | PlainMessage<DecideResponse> | undefined): boolean {
+    return proto3.util.equals(DecideResponse, a, b);
+  }
+}
+
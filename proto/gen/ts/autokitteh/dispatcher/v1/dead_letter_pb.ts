@@ -0,0 +1,155 @@
+// @generated by protoc-gen-es v1.5.1 with parameter "target=ts"
+// @generated from file autokitteh/dispatcher/v1/dead_letter.proto (package autokitteh.dispatcher.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum autokitteh.dispatcher.v1.DeadLetterReason
+ */
+export enum DeadLetterReason {
+  /**
+   * @generated from enum value: DEAD_LETTER_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * no trigger, deployment or waiting signal matched the event.
+   *
+   * @generated from enum value: DEAD_LETTER_REASON_NO_DESTINATIONS = 1;
+   */
+  NO_DESTINATIONS = 1,
+
+  /**
+   * @generated from enum value: DEAD_LETTER_REASON_SESSION_INIT_FAILED = 2;
+   */
+  SESSION_INIT_FAILED = 2,
+
+  /**
+   * @generated from enum value: DEAD_LETTER_REASON_SESSION_START_FAILED = 3;
+   */
+  SESSION_START_FAILED = 3,
+
+  /**
+   * @generated from enum value: DEAD_LETTER_REASON_RESOURCE_EXHAUSTED = 4;
+   */
+  RESOURCE_EXHAUSTED = 4,
+
+  /**
+   * skipped due to the trigger's concurrency policy.
+   *
+   * @generated from enum value: DEAD_LETTER_REASON_CONCURRENCY_LIMIT = 5;
+   */
+  CONCURRENCY_LIMIT = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DeadLetterReason)
+proto3.util.setEnumType(DeadLetterReason, "autokitteh.dispatcher.v1.DeadLetterReason", [
+  { no: 0, name: "DEAD_LETTER_REASON_UNSPECIFIED" },
+  { no: 1, name: "DEAD_LETTER_REASON_NO_DESTINATIONS" },
+  { no: 2, name: "DEAD_LETTER_REASON_SESSION_INIT_FAILED" },
+  { no: 3, name: "DEAD_LETTER_REASON_SESSION_START_FAILED" },
+  { no: 4, name: "DEAD_LETTER_REASON_RESOURCE_EXHAUSTED" },
+  { no: 5, name: "DEAD_LETTER_REASON_CONCURRENCY_LIMIT" },
+]);
+
+/**
+ * A record of an event that did not result in a session.
+ *
+ * @generated from message autokitteh.dispatcher.v1.DeadLetter
+ */
+export class DeadLetter extends Message<DeadLetter> {
+  /**
+   * @generated from field: string dead_letter_id = 1;
+   */
+  deadLetterId = "";
+
+  /**
+   * @generated from field: string event_id = 2;
+   */
+  eventId = "";
+
+  /**
+   * @generated from field: string project_id = 3;
+   */
+  projectId = "";
+
+  /**
+   * empty if no trigger matched.
+   *
+   * @generated from field: string trigger_id = 4;
+   */
+  triggerId = "";
+
+  /**
+   * empty if no deployment matched.
+   *
+   * @generated from field: string deployment_id = 5;
+   */
+  deploymentId = "";
+
+  /**
+   * @generated from field: autokitteh.dispatcher.v1.DeadLetterReason reason = 6;
+   */
+  reason = DeadLetterReason.UNSPECIFIED;
+
+  /**
+   * @generated from field: string error = 7;
+   */
+  error = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * Set once the dead letter was retried.
+   *
+   * @generated from field: string retry_event_id = 9;
+   */
+  retryEventId = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp retried_at = 10;
+   */
+  retriedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<DeadLetter>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.dispatcher.v1.DeadLetter";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dead_letter_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "event_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "trigger_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "reason", kind: "enum", T: proto3.getEnumType(DeadLetterReason) },
+    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "created_at", kind: "message", T: Timestamp },
+    { no: 9, name: "retry_event_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "retried_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeadLetter {
+    return new DeadLetter().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeadLetter {
+    return new DeadLetter().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeadLetter {
+    return new DeadLetter().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeadLetter | PlainMessage<DeadLetter> | undefined, b: DeadLetter | PlainMessage<DeadLetter> | undefined): boolean {
+    return proto3.util.equals(DeadLetter, a, b);
+  }
+}
+
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { DispatchRequest, DispatchResponse, RedispatchRequest, RedispatchResponse } from "./svc_pb.js";
+import { DispatchRequest, DispatchResponse, ListDeadLettersRequest, ListDeadLettersResponse, RedispatchRequest, RedispatchResponse, RetryDeadLetterRequest, RetryDeadLetterResponse } from "./svc_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -34,6 +34,29 @@ export const DispatcherService = {
       O: RedispatchResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Lists events that were dropped during dispatch, most recent first.
+     *
+     * @generated from rpc autokitteh.dispatcher.v1.DispatcherService.ListDeadLetters
+     */
+    listDeadLetters: {
+      name: "ListDeadLetters",
+      I: ListDeadLettersRequest,
+      O: ListDeadLettersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Redispatches the event of a dead letter to the trigger and deployment
+     * it originally failed for, and marks the dead letter as retried.
+     *
+     * @generated from rpc autokitteh.dispatcher.v1.DispatcherService.RetryDeadLetter
+     */
+    retryDeadLetter: {
+      name: "RetryDeadLetter",
+      I: RetryDeadLetterRequest,
+      O: RetryDeadLetterResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
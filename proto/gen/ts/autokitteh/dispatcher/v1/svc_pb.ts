@@ -6,6 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 import { Event } from "../../events/v1/event_pb.js";
+import { DeadLetter } from "./dead_letter_pb.js";
 
 /**
  * @generated from message autokitteh.dispatcher.v1.DispatchRequest
@@ -223,3 +224,199 @@ export class RedispatchResponse extends Message<RedispatchResponse> {
   }
 }
 
+/**
+ * @generated from message autokitteh.dispatcher.v1.ListDeadLettersRequest
+ */
+export class ListDeadLettersRequest extends Message<ListDeadLettersRequest> {
+  /**
+   * @generated from field: string org_id = 1;
+   */
+  orgId = "";
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string event_id = 3;
+   */
+  eventId = "";
+
+  /**
+   * @generated from field: bool include_retried = 4;
+   */
+  includeRetried = false;
+
+  /**
+   * @generated from field: uint32 limit = 5;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<ListDeadLettersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.dispatcher.v1.ListDeadLettersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "org_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "event_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "include_retried", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "limit", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeadLettersRequest {
+    return new ListDeadLettersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeadLettersRequest {
+    return new ListDeadLettersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeadLettersRequest {
+    return new ListDeadLettersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDeadLettersRequest | PlainMessage<ListDeadLettersRequest> | undefined, b: ListDeadLettersRequest | PlainMessage<ListDeadLettersRequest> | undefined): boolean {
+    return proto3.util.equals(ListDeadLettersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.dispatcher.v1.ListDeadLettersResponse
+ */
+export class ListDeadLettersResponse extends Message<ListDeadLettersResponse> {
+  /**
+   * @generated from field: repeated autokitteh.dispatcher.v1.DeadLetter dead_letters = 1;
+   */
+  deadLetters: DeadLetter[] = [];
+
+  constructor(data?: PartialMessage<ListDeadLettersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.dispatcher.v1.ListDeadLettersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dead_letters", kind: "message", T: DeadLetter, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeadLettersResponse {
+    return new ListDeadLettersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeadLettersResponse {
+    return new ListDeadLettersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeadLettersResponse {
+    return new ListDeadLettersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDeadLettersResponse | PlainMessage<ListDeadLettersResponse> | undefined, b: ListDeadLettersResponse | PlainMessage<ListDeadLettersResponse> | undefined): boolean {
+    return proto3.util.equals(ListDeadLettersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.dispatcher.v1.RetryDeadLetterRequest
+ */
+export class RetryDeadLetterRequest extends Message<RetryDeadLetterRequest> {
+  /**
+   * @generated from field: string dead_letter_id = 1;
+   */
+  deadLetterId = "";
+
+  /**
+   * if true, the call will block until the dispatch is done.
+   *
+   * @generated from field: bool wait = 2;
+   */
+  wait = false;
+
+  constructor(data?: PartialMessage<RetryDeadLetterRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.dispatcher.v1.RetryDeadLetterRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dead_letter_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "wait", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryDeadLetterRequest {
+    return new RetryDeadLetterRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryDeadLetterRequest {
+    return new RetryDeadLetterRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryDeadLetterRequest {
+    return new RetryDeadLetterRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetryDeadLetterRequest | PlainMessage<RetryDeadLetterRequest> | undefined, b: RetryDeadLetterRequest | PlainMessage<RetryDeadLetterRequest> | undefined): boolean {
+    return proto3.util.equals(RetryDeadLetterRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.dispatcher.v1.RetryDeadLetterResponse
+ */
+export class RetryDeadLetterResponse extends Message<RetryDeadLetterResponse> {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId = "";
+
+  /**
+   * only if wait
+   *
+   * @generated from field: repeated string started_session_ids = 2;
+   */
+  startedSessionIds: string[] = [];
+
+  /**
+   * only if wait
+   *
+   * @generated from field: repeated string signaled_session_ids = 3;
+   */
+  signaledSessionIds: string[] = [];
+
+  constructor(data?: PartialMessage<RetryDeadLetterResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.dispatcher.v1.RetryDeadLetterResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "started_session_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "signaled_session_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryDeadLetterResponse {
+    return new RetryDeadLetterResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryDeadLetterResponse {
+    return new RetryDeadLetterResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryDeadLetterResponse {
+    return new RetryDeadLetterResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetryDeadLetterResponse | PlainMessage<RetryDeadLetterResponse> | undefined, b: RetryDeadLetterResponse | PlainMessage<RetryDeadLetterResponse> | undefined): boolean {
+    return proto3.util.equals(RetryDeadLetterResponse, a, b);
+  }
+}
+
@@ -48,6 +48,14 @@ export class Event extends Message<Event> {
    */
   seq = protoInt64.zero;
 
+  /**
+   * Events to the same destination with the same key are dispatched only once
+   * within a configured period. Later ones return the ID of the first one.
+   *
+   * @generated from field: string idempotency_key = 8;
+   */
+  idempotencyKey = "";
+
   constructor(data?: PartialMessage<Event>) {
     super();
     proto3.util.initPartial(data, this);
@@ -63,6 +71,7 @@ export class Event extends Message<Event> {
     { no: 5, name: "memo", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 6, name: "created_at", kind: "message", T: Timestamp },
     { no: 7, name: "seq", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 8, name: "idempotency_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Event {
@@ -294,6 +294,13 @@ export class SessionState_Stopped extends Message<SessionState_Stopped> {
    */
   reason = "";
 
+  /**
+   * Stopped since the session's execution timeout has passed.
+   *
+   * @generated from field: bool timed_out = 2;
+   */
+  timedOut = false;
+
   constructor(data?: PartialMessage<SessionState_Stopped>) {
     super();
     proto3.util.initPartial(data, this);
@@ -303,6 +310,7 @@ export class SessionState_Stopped extends Message<SessionState_Stopped> {
   static readonly typeName = "autokitteh.sessions.v1.SessionState.Stopped";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "timed_out", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SessionState_Stopped {
@@ -661,6 +669,11 @@ export class SessionLogRecord extends Message<SessionLogRecord> {
    */
   outcome?: SessionLogRecord_Outcome;
 
+  /**
+   * @generated from field: autokitteh.sessions.v1.SessionLogRecord.Compensation compensation = 17;
+   */
+  compensation?: SessionLogRecord_Compensation;
+
   constructor(data?: PartialMessage<SessionLogRecord>) {
     super();
     proto3.util.initPartial(data, this);
@@ -678,6 +691,7 @@ export class SessionLogRecord extends Message<SessionLogRecord> {
     { no: 14, name: "state", kind: "message", T: SessionState },
     { no: 15, name: "stop_request", kind: "message", T: SessionLogRecord_StopRequest },
     { no: 16, name: "outcome", kind: "message", T: SessionLogRecord_Outcome },
+    { no: 17, name: "compensation", kind: "message", T: SessionLogRecord_Compensation },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SessionLogRecord {
@@ -742,6 +756,11 @@ export enum SessionLogRecord_Type {
    * @generated from enum value: TYPE_OUTCOME = 64;
    */
   OUTCOME = 64,
+
+  /**
+   * @generated from enum value: TYPE_COMPENSATION = 128;
+   */
+  COMPENSATION = 128,
 }
 // Retrieve enum metadata with: proto3.getEnumType(SessionLogRecord_Type)
 proto3.util.setEnumType(SessionLogRecord_Type, "autokitteh.sessions.v1.SessionLogRecord.Type", [
@@ -753,6 +772,7 @@ proto3.util.setEnumType(SessionLogRecord_Type, "autokitteh.sessions.v1.SessionLo
   { no: 16, name: "TYPE_STATE" },
   { no: 32, name: "TYPE_STOP_REQUEST" },
   { no: 64, name: "TYPE_OUTCOME" },
+  { no: 128, name: "TYPE_COMPENSATION" },
 ]);
 
 /**
@@ -890,6 +910,62 @@ export class SessionLogRecord_Outcome extends Message<SessionLogRecord_Outcome>
   }
 }
 
+/**
+ * Recorded when a registered compensation is run due to the session
+ * ending with an error or being stopped.
+ *
+ * @generated from message autokitteh.sessions.v1.SessionLogRecord.Compensation
+ */
+export class SessionLogRecord_Compensation extends Message<SessionLogRecord_Compensation> {
+  /**
+   * @generated from field: autokitteh.values.v1.Value function = 1;
+   */
+  function?: Value;
+
+  /**
+   * the call seq used to run the compensation.
+   *
+   * @generated from field: uint32 call_seq = 2;
+   */
+  callSeq = 0;
+
+  /**
+   * present if the compensation failed.
+   *
+   * @generated from field: autokitteh.program.v1.Error error = 3;
+   */
+  error?: Error;
+
+  constructor(data?: PartialMessage<SessionLogRecord_Compensation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.SessionLogRecord.Compensation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "function", kind: "message", T: Value },
+    { no: 2, name: "call_seq", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "error", kind: "message", T: Error },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SessionLogRecord_Compensation {
+    return new SessionLogRecord_Compensation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SessionLogRecord_Compensation {
+    return new SessionLogRecord_Compensation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SessionLogRecord_Compensation {
+    return new SessionLogRecord_Compensation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SessionLogRecord_Compensation | PlainMessage<SessionLogRecord_Compensation> | undefined, b: SessionLogRecord_Compensation | PlainMessage<SessionLogRecord_Compensation> | undefined): boolean {
+    return proto3.util.equals(SessionLogRecord_Compensation, a, b);
+  }
+}
+
 /**
  * @generated from message autokitteh.sessions.v1.Session
  */
@@ -949,6 +1025,42 @@ export class Session extends Message<Session> {
    */
   isDurable = false;
 
+  /**
+   * Set if this session is an automatic retry of a failed session, according
+   * to its trigger's retry policy. Always refers to the first attempt.
+   *
+   * @generated from field: string retry_of_session_id = 14;
+   */
+  retryOfSessionId = "";
+
+  /**
+   * 1-based. Zero for sessions that are not retries.
+   *
+   * @generated from field: uint32 attempt = 15;
+   */
+  attempt = 0;
+
+  /**
+   * If set, the session is stopped if it is still running after this long.
+   *
+   * @generated from field: google.protobuf.Duration timeout = 16;
+   */
+  timeout?: Duration;
+
+  /**
+   * If set, called when the session is stopped, to let it clean up.
+   *
+   * @generated from field: autokitteh.program.v1.CodeLocation on_stop = 17;
+   */
+  onStop?: CodeLocation;
+
+  /**
+   * User defined labels, set by the session itself.
+   *
+   * @generated from field: map<string, string> tags = 18;
+   */
+  tags: { [key: string]: string } = {};
+
   /**
    * These are for auditing/searches only.
    *
@@ -961,6 +1073,11 @@ export class Session extends Message<Session> {
    */
   eventId = "";
 
+  /**
+   * @generated from field: string trigger_id = 22;
+   */
+  triggerId = "";
+
   constructor(data?: PartialMessage<Session>) {
     super();
     proto3.util.initPartial(data, this);
@@ -980,8 +1097,14 @@ export class Session extends Message<Session> {
     { no: 11, name: "updated_at", kind: "message", T: Timestamp },
     { no: 12, name: "state", kind: "enum", T: proto3.getEnumType(SessionStateType) },
     { no: 13, name: "is_durable", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 14, name: "retry_of_session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 15, name: "attempt", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 16, name: "timeout", kind: "message", T: Duration },
+    { no: 17, name: "on_stop", kind: "message", T: CodeLocation },
+    { no: 18, name: "tags", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 20, name: "deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 21, name: "event_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 22, name: "trigger_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Session {
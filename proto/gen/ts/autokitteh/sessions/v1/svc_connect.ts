@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { DeleteRequest, DeleteResponse, DownloadLogsRequest, DownloadLogsResponse, GetLogRequest, GetLogResponse, GetPrintsRequest, GetPrintsResponse, GetRequest, GetResponse, ListRequest, ListResponse, StartRequest, StartResponse, StopRequest, StopResponse } from "./svc_pb.js";
+import { DeleteManyRequest, DeleteManyResponse, DeleteRequest, DeleteResponse, DeleteRetentionPolicyRequest, DeleteRetentionPolicyResponse, DownloadLogsRequest, DownloadLogsResponse, ExportRequest, ExportResponse, GetBulkOperationRequest, GetBulkOperationResponse, GetLogRequest, GetLogResponse, GetPrintsRequest, GetPrintsResponse, GetRequest, GetResponse, GetRetentionPolicyRequest, GetRetentionPolicyResponse, ImportRequest, ImportResponse, ListRequest, ListResponse, ReplayRequest, ReplayResponse, RestartManyRequest, RestartManyResponse, SearchRequest, SearchResponse, SetRetentionPolicyRequest, SetRetentionPolicyResponse, StartRequest, StartResponse, StopManyRequest, StopManyResponse, StopRequest, StopResponse, WatchLogRequest, WatchLogResponse } from "./svc_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -44,6 +44,18 @@ export const SessionsService = {
       O: ListResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Search is like List, but can also match on prints, errors and
+     * additional session attributes.
+     *
+     * @generated from rpc autokitteh.sessions.v1.SessionsService.Search
+     */
+    search: {
+      name: "Search",
+      I: SearchRequest,
+      O: SearchResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc autokitteh.sessions.v1.SessionsService.Get
      */
@@ -62,6 +74,18 @@ export const SessionsService = {
       O: GetLogResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * WatchLog streams the session log from its beginning, including prints,
+     * as it is written. The stream ends once the session reaches a final state.
+     *
+     * @generated from rpc autokitteh.sessions.v1.SessionsService.WatchLog
+     */
+    watchLog: {
+      name: "WatchLog",
+      I: WatchLogRequest,
+      O: WatchLogResponse,
+      kind: MethodKind.ServerStreaming,
+    },
     /**
      * @generated from rpc autokitteh.sessions.v1.SessionsService.DownloadLogs
      */
@@ -89,6 +113,109 @@ export const SessionsService = {
       O: DeleteResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Replay re-runs a finished durable session, feeding each call its
+     * recorded result instead of executing it.
+     *
+     * @generated from rpc autokitteh.sessions.v1.SessionsService.Replay
+     */
+    replay: {
+      name: "Replay",
+      I: ReplayRequest,
+      O: ReplayResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Export returns a portable bundle of a finished session, which can
+     * be imported into another autokitteh instance for reproduction.
+     *
+     * @generated from rpc autokitteh.sessions.v1.SessionsService.Export
+     */
+    export: {
+      name: "Export",
+      I: ExportRequest,
+      O: ExportResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc autokitteh.sessions.v1.SessionsService.Import
+     */
+    import: {
+      name: "Import",
+      I: ImportRequest,
+      O: ImportResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * StopMany, DeleteMany and RestartMany apply the respective operation
+     * to all sessions matching a filter, in the background. Their progress
+     * can be tracked using GetBulkOperation.
+     *
+     * @generated from rpc autokitteh.sessions.v1.SessionsService.StopMany
+     */
+    stopMany: {
+      name: "StopMany",
+      I: StopManyRequest,
+      O: StopManyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc autokitteh.sessions.v1.SessionsService.DeleteMany
+     */
+    deleteMany: {
+      name: "DeleteMany",
+      I: DeleteManyRequest,
+      O: DeleteManyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc autokitteh.sessions.v1.SessionsService.RestartMany
+     */
+    restartMany: {
+      name: "RestartMany",
+      I: RestartManyRequest,
+      O: RestartManyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc autokitteh.sessions.v1.SessionsService.GetBulkOperation
+     */
+    getBulkOperation: {
+      name: "GetBulkOperation",
+      I: GetBulkOperationRequest,
+      O: GetBulkOperationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetRetentionPolicy sets the session retention policy of an org or a
+     * project, which is enforced periodically by the server.
+     *
+     * @generated from rpc autokitteh.sessions.v1.SessionsService.SetRetentionPolicy
+     */
+    setRetentionPolicy: {
+      name: "SetRetentionPolicy",
+      I: SetRetentionPolicyRequest,
+      O: SetRetentionPolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc autokitteh.sessions.v1.SessionsService.GetRetentionPolicy
+     */
+    getRetentionPolicy: {
+      name: "GetRetentionPolicy",
+      I: GetRetentionPolicyRequest,
+      O: GetRetentionPolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc autokitteh.sessions.v1.SessionsService.DeleteRetentionPolicy
+     */
+    deleteRetentionPolicy: {
+      name: "DeleteRetentionPolicy",
+      I: DeleteRetentionPolicyRequest,
+      O: DeleteRetentionPolicyResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -5,8 +5,9 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Duration, Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
-import { Session, SessionLogRecord, SessionLogRecord_Type, SessionStateType } from "./session_pb.js";
+import { Call_Attempt_Result, Call_Spec, Session, SessionLogRecord, SessionLogRecord_Type, SessionStateType } from "./session_pb.js";
 import { Value } from "../../values/v1/values_pb.js";
+import { Error } from "../../program/v1/program_pb.js";
 
 /**
  * @generated from message autokitteh.sessions.v1.StartRequest
@@ -225,6 +226,25 @@ export class ListRequest extends Message<ListRequest> {
    */
   orgId = "";
 
+  /**
+   * Only sessions that have all of these tags.
+   *
+   * @generated from field: map<string, string> tags = 7;
+   */
+  tags: { [key: string]: string } = {};
+
+  /**
+   * Session creation time range, both optional.
+   *
+   * @generated from field: google.protobuf.Timestamp created_after = 8;
+   */
+  createdAfter?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_before = 9;
+   */
+  createdBefore?: Timestamp;
+
   /**
    * @generated from field: bool count_only = 10;
    */
@@ -262,6 +282,9 @@ export class ListRequest extends Message<ListRequest> {
     { no: 4, name: "build_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "state_type", kind: "enum", T: proto3.getEnumType(SessionStateType) },
     { no: 6, name: "org_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "tags", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 8, name: "created_after", kind: "message", T: Timestamp },
+    { no: 9, name: "created_before", kind: "message", T: Timestamp },
     { no: 10, name: "count_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 20, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 21, name: "skip", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
@@ -336,6 +359,127 @@ export class ListResponse extends Message<ListResponse> {
   }
 }
 
+/**
+ * @generated from message autokitteh.sessions.v1.SearchRequest
+ */
+export class SearchRequest extends Message<SearchRequest> {
+  /**
+   * Pagination, state, id, tags and time range filters are the same as in List.
+   *
+   * @generated from field: autokitteh.sessions.v1.ListRequest filter = 1;
+   */
+  filter?: ListRequest;
+
+  /**
+   * Case insensitive substring of any of the session's prints.
+   *
+   * @generated from field: string prints_contain = 2;
+   */
+  printsContain = "";
+
+  /**
+   * Case insensitive substring of the session's error message.
+   *
+   * @generated from field: string error_contains = 3;
+   */
+  errorContains = "";
+
+  /**
+   * @generated from field: string trigger_name = 6;
+   */
+  triggerName = "";
+
+  /**
+   * Either a full entry point ("main.py:on_event") or just
+   * its path ("main.py"), matching all entry points in it.
+   *
+   * @generated from field: string entry_point = 7;
+   */
+  entryPoint = "";
+
+  constructor(data?: PartialMessage<SearchRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.SearchRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "filter", kind: "message", T: ListRequest },
+    { no: 2, name: "prints_contain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "error_contains", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "trigger_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "entry_point", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchRequest {
+    return new SearchRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchRequest {
+    return new SearchRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchRequest {
+    return new SearchRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchRequest | PlainMessage<SearchRequest> | undefined, b: SearchRequest | PlainMessage<SearchRequest> | undefined): boolean {
+    return proto3.util.equals(SearchRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.SearchResponse
+ */
+export class SearchResponse extends Message<SearchResponse> {
+  /**
+   * Sessions without their data.
+   *
+   * @generated from field: repeated autokitteh.sessions.v1.Session sessions = 1;
+   */
+  sessions: Session[] = [];
+
+  /**
+   * @generated from field: int64 count = 2;
+   */
+  count = protoInt64.zero;
+
+  /**
+   * @generated from field: string next_page_token = 10;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<SearchResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.SearchResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sessions", kind: "message", T: Session, repeated: true },
+    { no: 2, name: "count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchResponse {
+    return new SearchResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchResponse {
+    return new SearchResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchResponse {
+    return new SearchResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchResponse | PlainMessage<SearchResponse> | undefined, b: SearchResponse | PlainMessage<SearchResponse> | undefined): boolean {
+    return proto3.util.equals(SearchResponse, a, b);
+  }
+}
+
 /**
  * @generated from message autokitteh.sessions.v1.GetRequest
  */
@@ -548,6 +692,88 @@ export class GetLogResponse extends Message<GetLogResponse> {
   }
 }
 
+/**
+ * @generated from message autokitteh.sessions.v1.WatchLogRequest
+ */
+export class WatchLogRequest extends Message<WatchLogRequest> {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  /**
+   * Bitmask: If 0 or 0xFF, include all.
+   *
+   * @generated from field: autokitteh.sessions.v1.SessionLogRecord.Type types = 2;
+   */
+  types = SessionLogRecord_Type.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<WatchLogRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.WatchLogRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "types", kind: "enum", T: proto3.getEnumType(SessionLogRecord_Type) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchLogRequest {
+    return new WatchLogRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchLogRequest {
+    return new WatchLogRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchLogRequest {
+    return new WatchLogRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchLogRequest | PlainMessage<WatchLogRequest> | undefined, b: WatchLogRequest | PlainMessage<WatchLogRequest> | undefined): boolean {
+    return proto3.util.equals(WatchLogRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.WatchLogResponse
+ */
+export class WatchLogResponse extends Message<WatchLogResponse> {
+  /**
+   * @generated from field: autokitteh.sessions.v1.SessionLogRecord record = 1;
+   */
+  record?: SessionLogRecord;
+
+  constructor(data?: PartialMessage<WatchLogResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.WatchLogResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "record", kind: "message", T: SessionLogRecord },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchLogResponse {
+    return new WatchLogResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchLogResponse {
+    return new WatchLogResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchLogResponse {
+    return new WatchLogResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchLogResponse | PlainMessage<WatchLogResponse> | undefined, b: WatchLogResponse | PlainMessage<WatchLogResponse> | undefined): boolean {
+    return proto3.util.equals(WatchLogResponse, a, b);
+  }
+}
+
 /**
  * @generated from message autokitteh.sessions.v1.DownloadLogsRequest
  */
@@ -770,70 +996,1091 @@ export class GetPrintsResponse_Print extends Message<GetPrintsResponse_Print> {
 }
 
 /**
- * @generated from message autokitteh.sessions.v1.DeleteRequest
+ * @generated from message autokitteh.sessions.v1.ReplayRequest
  */
-export class DeleteRequest extends Message<DeleteRequest> {
+export class ReplayRequest extends Message<ReplayRequest> {
   /**
    * @generated from field: string session_id = 1;
    */
   sessionId = "";
 
-  constructor(data?: PartialMessage<DeleteRequest>) {
+  /**
+   * Stop when the session makes the call with this sequence number,
+   * before its recorded result is returned to the program.
+   * If 0, the session is replayed to completion.
+   *
+   * @generated from field: uint32 stop_at_seq = 2;
+   */
+  stopAtSeq = 0;
+
+  constructor(data?: PartialMessage<ReplayRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "autokitteh.sessions.v1.DeleteRequest";
+  static readonly typeName = "autokitteh.sessions.v1.ReplayRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "stop_at_seq", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRequest {
-    return new DeleteRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReplayRequest {
+    return new ReplayRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRequest {
-    return new DeleteRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReplayRequest {
+    return new ReplayRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRequest {
-    return new DeleteRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReplayRequest {
+    return new ReplayRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteRequest | PlainMessage<DeleteRequest> | undefined, b: DeleteRequest | PlainMessage<DeleteRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteRequest, a, b);
+  static equals(a: ReplayRequest | PlainMessage<ReplayRequest> | undefined, b: ReplayRequest | PlainMessage<ReplayRequest> | undefined): boolean {
+    return proto3.util.equals(ReplayRequest, a, b);
   }
 }
 
 /**
- * @generated from message autokitteh.sessions.v1.DeleteResponse
+ * @generated from message autokitteh.sessions.v1.ReplayResponse
  */
-export class DeleteResponse extends Message<DeleteResponse> {
-  constructor(data?: PartialMessage<DeleteResponse>) {
+export class ReplayResponse extends Message<ReplayResponse> {
+  /**
+   * Calls made by the replayed session, in order.
+   *
+   * @generated from field: repeated autokitteh.sessions.v1.ReplayResponse.ReplayedCall calls = 1;
+   */
+  calls: ReplayResponse_ReplayedCall[] = [];
+
+  /**
+   * @generated from field: repeated string prints = 2;
+   */
+  prints: string[] = [];
+
+  /**
+   * True if the replay stopped at stop_at_seq, in which case the
+   * last call is the one it stopped at.
+   *
+   * @generated from field: bool stopped = 3;
+   */
+  stopped = false;
+
+  /**
+   * Top level values of the entry point module.
+   *
+   * @generated from field: map<string, autokitteh.values.v1.Value> values = 4;
+   */
+  values: { [key: string]: Value } = {};
+
+  /**
+   * @generated from field: autokitteh.values.v1.Value return_value = 5;
+   */
+  returnValue?: Value;
+
+  /**
+   * @generated from field: autokitteh.program.v1.Error error = 6;
+   */
+  error?: Error;
+
+  constructor(data?: PartialMessage<ReplayResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "autokitteh.sessions.v1.DeleteResponse";
+  static readonly typeName = "autokitteh.sessions.v1.ReplayResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "calls", kind: "message", T: ReplayResponse_ReplayedCall, repeated: true },
+    { no: 2, name: "prints", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "stopped", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "values", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: Value} },
+    { no: 5, name: "return_value", kind: "message", T: Value },
+    { no: 6, name: "error", kind: "message", T: Error },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteResponse {
-    return new DeleteResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReplayResponse {
+    return new ReplayResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteResponse {
-    return new DeleteResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReplayResponse {
+    return new ReplayResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteResponse {
-    return new DeleteResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReplayResponse {
+    return new ReplayResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteResponse | PlainMessage<DeleteResponse> | undefined, b: DeleteResponse | PlainMessage<DeleteResponse> | undefined): boolean {
-    return proto3.util.equals(DeleteResponse, a, b);
+  static equals(a: ReplayResponse | PlainMessage<ReplayResponse> | undefined, b: ReplayResponse | PlainMessage<ReplayResponse> | undefined): boolean {
+    return proto3.util.equals(ReplayResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.ReplayResponse.ReplayedCall
+ */
+export class ReplayResponse_ReplayedCall extends Message<ReplayResponse_ReplayedCall> {
+  /**
+   * @generated from field: autokitteh.sessions.v1.Call.Spec spec = 1;
+   */
+  spec?: Call_Spec;
+
+  /**
+   * Result as recorded in the session history.
+   *
+   * @generated from field: autokitteh.sessions.v1.Call.Attempt.Result result = 2;
+   */
+  result?: Call_Attempt_Result;
+
+  constructor(data?: PartialMessage<ReplayResponse_ReplayedCall>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.ReplayResponse.ReplayedCall";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "spec", kind: "message", T: Call_Spec },
+    { no: 2, name: "result", kind: "message", T: Call_Attempt_Result },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReplayResponse_ReplayedCall {
+    return new ReplayResponse_ReplayedCall().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReplayResponse_ReplayedCall {
+    return new ReplayResponse_ReplayedCall().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReplayResponse_ReplayedCall {
+    return new ReplayResponse_ReplayedCall().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReplayResponse_ReplayedCall | PlainMessage<ReplayResponse_ReplayedCall> | undefined, b: ReplayResponse_ReplayedCall | PlainMessage<ReplayResponse_ReplayedCall> | undefined): boolean {
+    return proto3.util.equals(ReplayResponse_ReplayedCall, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.ExportRequest
+ */
+export class ExportRequest extends Message<ExportRequest> {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  constructor(data?: PartialMessage<ExportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.ExportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportRequest {
+    return new ExportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportRequest {
+    return new ExportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportRequest {
+    return new ExportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportRequest | PlainMessage<ExportRequest> | undefined, b: ExportRequest | PlainMessage<ExportRequest> | undefined): boolean {
+    return proto3.util.equals(ExportRequest, a, b);
   }
 }
 
+/**
+ * @generated from message autokitteh.sessions.v1.ExportResponse
+ */
+export class ExportResponse extends Message<ExportResponse> {
+  /**
+   * Gzipped tar bundle of the session, its triggering event,
+   * log (including calls), build and build file.
+   *
+   * @generated from field: bytes bundle = 1;
+   */
+  bundle = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<ExportResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.ExportResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "bundle", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportResponse {
+    return new ExportResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportResponse {
+    return new ExportResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportResponse {
+    return new ExportResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportResponse | PlainMessage<ExportResponse> | undefined, b: ExportResponse | PlainMessage<ExportResponse> | undefined): boolean {
+    return proto3.util.equals(ExportResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.ImportRequest
+ */
+export class ImportRequest extends Message<ImportRequest> {
+  /**
+   * Project to import the session into.
+   *
+   * @generated from field: string project_id = 1;
+   */
+  projectId = "";
+
+  /**
+   * As returned from Export.
+   *
+   * @generated from field: bytes bundle = 2;
+   */
+  bundle = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<ImportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.ImportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "bundle", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportRequest {
+    return new ImportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportRequest {
+    return new ImportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportRequest {
+    return new ImportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportRequest | PlainMessage<ImportRequest> | undefined, b: ImportRequest | PlainMessage<ImportRequest> | undefined): boolean {
+    return proto3.util.equals(ImportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.ImportResponse
+ */
+export class ImportResponse extends Message<ImportResponse> {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  constructor(data?: PartialMessage<ImportResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.ImportResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportResponse {
+    return new ImportResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportResponse {
+    return new ImportResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportResponse {
+    return new ImportResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportResponse | PlainMessage<ImportResponse> | undefined, b: ImportResponse | PlainMessage<ImportResponse> | undefined): boolean {
+    return proto3.util.equals(ImportResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.DeleteRequest
+ */
+export class DeleteRequest extends Message<DeleteRequest> {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  constructor(data?: PartialMessage<DeleteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.DeleteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRequest {
+    return new DeleteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRequest {
+    return new DeleteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRequest {
+    return new DeleteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteRequest | PlainMessage<DeleteRequest> | undefined, b: DeleteRequest | PlainMessage<DeleteRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.DeleteResponse
+ */
+export class DeleteResponse extends Message<DeleteResponse> {
+  constructor(data?: PartialMessage<DeleteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.DeleteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteResponse {
+    return new DeleteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteResponse {
+    return new DeleteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteResponse {
+    return new DeleteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteResponse | PlainMessage<DeleteResponse> | undefined, b: DeleteResponse | PlainMessage<DeleteResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.StopManyRequest
+ */
+export class StopManyRequest extends Message<StopManyRequest> {
+  /**
+   * Pagination is ignored.
+   *
+   * @generated from field: autokitteh.sessions.v1.ListRequest filter = 1;
+   */
+  filter?: ListRequest;
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  /**
+   * Forcefully terminate sessions right after gracefully terminating them.
+   *
+   * @generated from field: bool terminate = 3;
+   */
+  terminate = false;
+
+  constructor(data?: PartialMessage<StopManyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.StopManyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "filter", kind: "message", T: ListRequest },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "terminate", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StopManyRequest {
+    return new StopManyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StopManyRequest {
+    return new StopManyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StopManyRequest {
+    return new StopManyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StopManyRequest | PlainMessage<StopManyRequest> | undefined, b: StopManyRequest | PlainMessage<StopManyRequest> | undefined): boolean {
+    return proto3.util.equals(StopManyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.StopManyResponse
+ */
+export class StopManyResponse extends Message<StopManyResponse> {
+  /**
+   * @generated from field: string operation_id = 1;
+   */
+  operationId = "";
+
+  constructor(data?: PartialMessage<StopManyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.StopManyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StopManyResponse {
+    return new StopManyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StopManyResponse {
+    return new StopManyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StopManyResponse {
+    return new StopManyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StopManyResponse | PlainMessage<StopManyResponse> | undefined, b: StopManyResponse | PlainMessage<StopManyResponse> | undefined): boolean {
+    return proto3.util.equals(StopManyResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.DeleteManyRequest
+ */
+export class DeleteManyRequest extends Message<DeleteManyRequest> {
+  /**
+   * Pagination is ignored.
+   *
+   * @generated from field: autokitteh.sessions.v1.ListRequest filter = 1;
+   */
+  filter?: ListRequest;
+
+  constructor(data?: PartialMessage<DeleteManyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.DeleteManyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "filter", kind: "message", T: ListRequest },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteManyRequest {
+    return new DeleteManyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteManyRequest {
+    return new DeleteManyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteManyRequest {
+    return new DeleteManyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteManyRequest | PlainMessage<DeleteManyRequest> | undefined, b: DeleteManyRequest | PlainMessage<DeleteManyRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteManyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.DeleteManyResponse
+ */
+export class DeleteManyResponse extends Message<DeleteManyResponse> {
+  /**
+   * @generated from field: string operation_id = 1;
+   */
+  operationId = "";
+
+  constructor(data?: PartialMessage<DeleteManyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.DeleteManyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteManyResponse {
+    return new DeleteManyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteManyResponse {
+    return new DeleteManyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteManyResponse {
+    return new DeleteManyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteManyResponse | PlainMessage<DeleteManyResponse> | undefined, b: DeleteManyResponse | PlainMessage<DeleteManyResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteManyResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.RestartManyRequest
+ */
+export class RestartManyRequest extends Message<RestartManyRequest> {
+  /**
+   * Pagination is ignored.
+   *
+   * @generated from field: autokitteh.sessions.v1.ListRequest filter = 1;
+   */
+  filter?: ListRequest;
+
+  constructor(data?: PartialMessage<RestartManyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.RestartManyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "filter", kind: "message", T: ListRequest },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestartManyRequest {
+    return new RestartManyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestartManyRequest {
+    return new RestartManyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestartManyRequest {
+    return new RestartManyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestartManyRequest | PlainMessage<RestartManyRequest> | undefined, b: RestartManyRequest | PlainMessage<RestartManyRequest> | undefined): boolean {
+    return proto3.util.equals(RestartManyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.RestartManyResponse
+ */
+export class RestartManyResponse extends Message<RestartManyResponse> {
+  /**
+   * @generated from field: string operation_id = 1;
+   */
+  operationId = "";
+
+  constructor(data?: PartialMessage<RestartManyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.RestartManyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestartManyResponse {
+    return new RestartManyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestartManyResponse {
+    return new RestartManyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestartManyResponse {
+    return new RestartManyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestartManyResponse | PlainMessage<RestartManyResponse> | undefined, b: RestartManyResponse | PlainMessage<RestartManyResponse> | undefined): boolean {
+    return proto3.util.equals(RestartManyResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.BulkOperation
+ */
+export class BulkOperation extends Message<BulkOperation> {
+  /**
+   * @generated from field: string operation_id = 1;
+   */
+  operationId = "";
+
+  /**
+   * "stop", "delete" or "restart".
+   *
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * True once all matching sessions were processed.
+   *
+   * @generated from field: bool done = 3;
+   */
+  done = false;
+
+  /**
+   * Number of sessions that matched the filter when the operation started.
+   *
+   * @generated from field: int64 total = 4;
+   */
+  total = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 succeeded = 5;
+   */
+  succeeded = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 failed = 6;
+   */
+  failed = protoInt64.zero;
+
+  /**
+   * Errors of the first failed sessions, prefixed by their session id.
+   *
+   * @generated from field: repeated string errors = 7;
+   */
+  errors: string[] = [];
+
+  constructor(data?: PartialMessage<BulkOperation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.BulkOperation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "done", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "total", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "succeeded", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "failed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "errors", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BulkOperation {
+    return new BulkOperation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BulkOperation {
+    return new BulkOperation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BulkOperation {
+    return new BulkOperation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BulkOperation | PlainMessage<BulkOperation> | undefined, b: BulkOperation | PlainMessage<BulkOperation> | undefined): boolean {
+    return proto3.util.equals(BulkOperation, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.GetBulkOperationRequest
+ */
+export class GetBulkOperationRequest extends Message<GetBulkOperationRequest> {
+  /**
+   * @generated from field: string operation_id = 1;
+   */
+  operationId = "";
+
+  constructor(data?: PartialMessage<GetBulkOperationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.GetBulkOperationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBulkOperationRequest {
+    return new GetBulkOperationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBulkOperationRequest {
+    return new GetBulkOperationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBulkOperationRequest {
+    return new GetBulkOperationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetBulkOperationRequest | PlainMessage<GetBulkOperationRequest> | undefined, b: GetBulkOperationRequest | PlainMessage<GetBulkOperationRequest> | undefined): boolean {
+    return proto3.util.equals(GetBulkOperationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.GetBulkOperationResponse
+ */
+export class GetBulkOperationResponse extends Message<GetBulkOperationResponse> {
+  /**
+   * @generated from field: autokitteh.sessions.v1.BulkOperation operation = 1;
+   */
+  operation?: BulkOperation;
+
+  constructor(data?: PartialMessage<GetBulkOperationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.GetBulkOperationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operation", kind: "message", T: BulkOperation },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBulkOperationResponse {
+    return new GetBulkOperationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBulkOperationResponse {
+    return new GetBulkOperationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBulkOperationResponse {
+    return new GetBulkOperationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetBulkOperationResponse | PlainMessage<GetBulkOperationResponse> | undefined, b: GetBulkOperationResponse | PlainMessage<GetBulkOperationResponse> | undefined): boolean {
+    return proto3.util.equals(GetBulkOperationResponse, a, b);
+  }
+}
+
+
+/**
+ * RetentionPolicy determines which sessions of an org or a project are
+ * purged, along with their log records and events that are not referenced
+ * by other sessions. A project policy takes precedence over the policy of
+ * its org. Sessions that are not in a final state are never purged.
+ *
+ * @generated from message autokitteh.sessions.v1.RetentionPolicy
+ */
+export class RetentionPolicy extends Message<RetentionPolicy> {
+  /**
+   * @generated from field: string org_id = 1;
+   */
+  orgId = "";
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId = "";
+
+  /**
+   * Purge sessions older than this. Zero means no age limit.
+   *
+   * @generated from field: google.protobuf.Duration max_age = 3;
+   */
+  maxAge?: Duration;
+
+  /**
+   * Purge all but this many most recent sessions per project.
+   * Zero means no count limit.
+   *
+   * @generated from field: int32 max_count = 4;
+   */
+  maxCount = 0;
+
+  /**
+   * Only purge sessions in these states. Empty means all final states.
+   *
+   * @generated from field: repeated autokitteh.sessions.v1.SessionStateType states = 5;
+   */
+  states: SessionStateType[] = [];
+
+  constructor(data?: PartialMessage<RetentionPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.RetentionPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "org_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "max_age", kind: "message", T: Duration },
+    { no: 4, name: "max_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "states", kind: "enum", T: proto3.getEnumType(SessionStateType), repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetentionPolicy {
+    return new RetentionPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetentionPolicy {
+    return new RetentionPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetentionPolicy {
+    return new RetentionPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetentionPolicy | PlainMessage<RetentionPolicy> | undefined, b: RetentionPolicy | PlainMessage<RetentionPolicy> | undefined): boolean {
+    return proto3.util.equals(RetentionPolicy, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.SetRetentionPolicyRequest
+ */
+export class SetRetentionPolicyRequest extends Message<SetRetentionPolicyRequest> {
+  /**
+   * @generated from field: autokitteh.sessions.v1.RetentionPolicy policy = 1;
+   */
+  policy?: RetentionPolicy;
+
+  constructor(data?: PartialMessage<SetRetentionPolicyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.SetRetentionPolicyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy", kind: "message", T: RetentionPolicy },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetRetentionPolicyRequest {
+    return new SetRetentionPolicyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetRetentionPolicyRequest {
+    return new SetRetentionPolicyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetRetentionPolicyRequest {
+    return new SetRetentionPolicyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetRetentionPolicyRequest | PlainMessage<SetRetentionPolicyRequest> | undefined, b: SetRetentionPolicyRequest | PlainMessage<SetRetentionPolicyRequest> | undefined): boolean {
+    return proto3.util.equals(SetRetentionPolicyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.SetRetentionPolicyResponse
+ */
+export class SetRetentionPolicyResponse extends Message<SetRetentionPolicyResponse> {
+  constructor(data?: PartialMessage<SetRetentionPolicyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.SetRetentionPolicyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetRetentionPolicyResponse {
+    return new SetRetentionPolicyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetRetentionPolicyResponse {
+    return new SetRetentionPolicyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetRetentionPolicyResponse {
+    return new SetRetentionPolicyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetRetentionPolicyResponse | PlainMessage<SetRetentionPolicyResponse> | undefined, b: SetRetentionPolicyResponse | PlainMessage<SetRetentionPolicyResponse> | undefined): boolean {
+    return proto3.util.equals(SetRetentionPolicyResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.GetRetentionPolicyRequest
+ */
+export class GetRetentionPolicyRequest extends Message<GetRetentionPolicyRequest> {
+  /**
+   * @generated from field: string org_id = 1;
+   */
+  orgId = "";
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId = "";
+
+  constructor(data?: PartialMessage<GetRetentionPolicyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.GetRetentionPolicyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "org_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRetentionPolicyRequest {
+    return new GetRetentionPolicyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRetentionPolicyRequest {
+    return new GetRetentionPolicyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRetentionPolicyRequest {
+    return new GetRetentionPolicyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRetentionPolicyRequest | PlainMessage<GetRetentionPolicyRequest> | undefined, b: GetRetentionPolicyRequest | PlainMessage<GetRetentionPolicyRequest> | undefined): boolean {
+    return proto3.util.equals(GetRetentionPolicyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.GetRetentionPolicyResponse
+ */
+export class GetRetentionPolicyResponse extends Message<GetRetentionPolicyResponse> {
+  /**
+   * empty if not set.
+   *
+   * @generated from field: autokitteh.sessions.v1.RetentionPolicy policy = 1;
+   */
+  policy?: RetentionPolicy;
+
+  constructor(data?: PartialMessage<GetRetentionPolicyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.GetRetentionPolicyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy", kind: "message", T: RetentionPolicy },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRetentionPolicyResponse {
+    return new GetRetentionPolicyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRetentionPolicyResponse {
+    return new GetRetentionPolicyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRetentionPolicyResponse {
+    return new GetRetentionPolicyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRetentionPolicyResponse | PlainMessage<GetRetentionPolicyResponse> | undefined, b: GetRetentionPolicyResponse | PlainMessage<GetRetentionPolicyResponse> | undefined): boolean {
+    return proto3.util.equals(GetRetentionPolicyResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.DeleteRetentionPolicyRequest
+ */
+export class DeleteRetentionPolicyRequest extends Message<DeleteRetentionPolicyRequest> {
+  /**
+   * @generated from field: string org_id = 1;
+   */
+  orgId = "";
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId = "";
+
+  constructor(data?: PartialMessage<DeleteRetentionPolicyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.DeleteRetentionPolicyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "org_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRetentionPolicyRequest {
+    return new DeleteRetentionPolicyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRetentionPolicyRequest {
+    return new DeleteRetentionPolicyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRetentionPolicyRequest {
+    return new DeleteRetentionPolicyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteRetentionPolicyRequest | PlainMessage<DeleteRetentionPolicyRequest> | undefined, b: DeleteRetentionPolicyRequest | PlainMessage<DeleteRetentionPolicyRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteRetentionPolicyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.sessions.v1.DeleteRetentionPolicyResponse
+ */
+export class DeleteRetentionPolicyResponse extends Message<DeleteRetentionPolicyResponse> {
+  constructor(data?: PartialMessage<DeleteRetentionPolicyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.sessions.v1.DeleteRetentionPolicyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRetentionPolicyResponse {
+    return new DeleteRetentionPolicyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRetentionPolicyResponse {
+    return new DeleteRetentionPolicyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRetentionPolicyResponse {
+    return new DeleteRetentionPolicyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteRetentionPolicyResponse | PlainMessage<DeleteRetentionPolicyResponse> | undefined, b: DeleteRetentionPolicyResponse | PlainMessage<DeleteRetentionPolicyResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteRetentionPolicyResponse, a, b);
+  }
+}
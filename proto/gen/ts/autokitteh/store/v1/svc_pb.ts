@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 import { Value } from "../../values/v1/values_pb.js";
 
 /**
@@ -188,6 +188,37 @@ export class ListRequest extends Message<ListRequest> {
    */
   projectId = "";
 
+  /**
+   * If not empty, only keys that start with this prefix are listed.
+   *
+   * @generated from field: string prefix = 2;
+   */
+  prefix = "";
+
+  /**
+   * @generated from field: bool published_only = 3;
+   */
+  publishedOnly = false;
+
+  /**
+   * Keys are listed in ascending order. Zero page_size means no limit.
+   *
+   * @generated from field: int32 page_size = 20;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: int32 skip = 21;
+   */
+  skip = 0;
+
+  /**
+   * The last key of the previous page.
+   *
+   * @generated from field: string page_token = 22;
+   */
+  pageToken = "";
+
   constructor(data?: PartialMessage<ListRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -197,6 +228,11 @@ export class ListRequest extends Message<ListRequest> {
   static readonly typeName = "autokitteh.store.v1.ListRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "prefix", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "published_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 20, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 21, name: "skip", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 22, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRequest {
@@ -225,6 +261,18 @@ export class ListResponse extends Message<ListResponse> {
    */
   keys: string[] = [];
 
+  /**
+   * Total number of keys matching the request, regardless of pagination.
+   *
+   * @generated from field: int64 count = 2;
+   */
+  count = protoInt64.zero;
+
+  /**
+   * @generated from field: string next_page_token = 10;
+   */
+  nextPageToken = "";
+
   constructor(data?: PartialMessage<ListResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -234,6 +282,8 @@ export class ListResponse extends Message<ListResponse> {
   static readonly typeName = "autokitteh.store.v1.ListResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "keys", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListResponse {
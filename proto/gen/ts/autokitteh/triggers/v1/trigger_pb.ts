@@ -4,9 +4,473 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Duration, Message, proto3 } from "@bufbuild/protobuf";
 import { CodeLocation } from "../../program/v1/program_pb.js";
 
+/**
+ * @generated from message autokitteh.triggers.v1.ConcurrencyPolicy
+ */
+export class ConcurrencyPolicy extends Message<ConcurrencyPolicy> {
+  /**
+   * zero means unlimited, unless overlap is specified, in which case it is 1.
+   *
+   * @generated from field: uint32 max_concurrent = 1;
+   */
+  maxConcurrent = 0;
+
+  /**
+   * @generated from field: autokitteh.triggers.v1.ConcurrencyPolicy.Overlap overlap = 2;
+   */
+  overlap = ConcurrencyPolicy_Overlap.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<ConcurrencyPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.triggers.v1.ConcurrencyPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "max_concurrent", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "overlap", kind: "enum", T: proto3.getEnumType(ConcurrencyPolicy_Overlap) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConcurrencyPolicy {
+    return new ConcurrencyPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConcurrencyPolicy {
+    return new ConcurrencyPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConcurrencyPolicy {
+    return new ConcurrencyPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConcurrencyPolicy | PlainMessage<ConcurrencyPolicy> | undefined, b: ConcurrencyPolicy | PlainMessage<ConcurrencyPolicy> | undefined): boolean {
+    return proto3.util.equals(ConcurrencyPolicy, a, b);
+  }
+}
+
+/**
+ * What to do with an event when max_concurrent sessions are already running.
+ *
+ * @generated from enum autokitteh.triggers.v1.ConcurrencyPolicy.Overlap
+ */
+export enum ConcurrencyPolicy_Overlap {
+  /**
+   * same as QUEUE.
+   *
+   * @generated from enum value: OVERLAP_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * wait until a session finishes, in order of arrival.
+   *
+   * @generated from enum value: OVERLAP_QUEUE = 1;
+   */
+  QUEUE = 1,
+
+  /**
+   * drop the event.
+   *
+   * @generated from enum value: OVERLAP_SKIP = 2;
+   */
+  SKIP = 2,
+
+  /**
+   * stop the oldest running session.
+   *
+   * @generated from enum value: OVERLAP_CANCEL_PREVIOUS = 3;
+   */
+  CANCEL_PREVIOUS = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ConcurrencyPolicy_Overlap)
+proto3.util.setEnumType(ConcurrencyPolicy_Overlap, "autokitteh.triggers.v1.ConcurrencyPolicy.Overlap", [
+  { no: 0, name: "OVERLAP_UNSPECIFIED" },
+  { no: 1, name: "OVERLAP_QUEUE" },
+  { no: 2, name: "OVERLAP_SKIP" },
+  { no: 3, name: "OVERLAP_CANCEL_PREVIOUS" },
+]);
+
+/**
+ * @generated from message autokitteh.triggers.v1.BatchPolicy
+ */
+export class BatchPolicy extends Message<BatchPolicy> {
+  /**
+   * CEL expression evaluated like the filter. Events that evaluate to the same
+   * value are batched together. Empty means all events go into a single batch.
+   *
+   * @generated from field: string key = 1;
+   */
+  key = "";
+
+  /**
+   * How long to collect events, starting from the first event in the batch.
+   *
+   * @generated from field: google.protobuf.Duration window = 2;
+   */
+  window?: Duration;
+
+  /**
+   * Start the session once this many events were collected, even if the window
+   * did not pass yet. At least one of window or max_events must be specified.
+   *
+   * @generated from field: uint32 max_events = 3;
+   */
+  maxEvents = 0;
+
+  constructor(data?: PartialMessage<BatchPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.triggers.v1.BatchPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "window", kind: "message", T: Duration },
+    { no: 3, name: "max_events", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BatchPolicy {
+    return new BatchPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BatchPolicy {
+    return new BatchPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BatchPolicy {
+    return new BatchPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BatchPolicy | PlainMessage<BatchPolicy> | undefined, b: BatchPolicy | PlainMessage<BatchPolicy> | undefined): boolean {
+    return proto3.util.equals(BatchPolicy, a, b);
+  }
+}
+
+/**
+ * Retries sessions that ended in an error. Defaults follow Temporal's.
+ *
+ * @generated from message autokitteh.triggers.v1.RetryPolicy
+ */
+export class RetryPolicy extends Message<RetryPolicy> {
+  /**
+   * Total number of attempts, including the first one.
+   *
+   * @generated from field: uint32 max_attempts = 1;
+   */
+  maxAttempts = 0;
+
+  /**
+   * Delay before the first retry. Default: 1s.
+   *
+   * @generated from field: google.protobuf.Duration initial_interval = 2;
+   */
+  initialInterval?: Duration;
+
+  /**
+   * Each retry is delayed by the previous delay times this. Default: 2.
+   *
+   * @generated from field: double backoff_coefficient = 3;
+   */
+  backoffCoefficient = 0;
+
+  /**
+   * Maximum delay between retries. Default: 100 times initial_interval.
+   *
+   * @generated from field: google.protobuf.Duration max_interval = 4;
+   */
+  maxInterval?: Duration;
+
+  /**
+   * By default only infrastructure errors are retried, not errors raised by
+   * the program itself.
+   *
+   * @generated from field: bool retry_on_program_error = 5;
+   */
+  retryOnProgramError = false;
+
+  constructor(data?: PartialMessage<RetryPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.triggers.v1.RetryPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "max_attempts", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "initial_interval", kind: "message", T: Duration },
+    { no: 3, name: "backoff_coefficient", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "max_interval", kind: "message", T: Duration },
+    { no: 5, name: "retry_on_program_error", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryPolicy {
+    return new RetryPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryPolicy {
+    return new RetryPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryPolicy {
+    return new RetryPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetryPolicy | PlainMessage<RetryPolicy> | undefined, b: RetryPolicy | PlainMessage<RetryPolicy> | undefined): boolean {
+    return proto3.util.equals(RetryPolicy, a, b);
+  }
+}
+
+/**
+ * Verifies requests to a webhook trigger before they are dispatched. A request
+ * must pass all of the configured checks, otherwise it is rejected with 401.
+ * Secrets are referenced by the names of project variables holding them.
+ *
+ * @generated from message autokitteh.triggers.v1.WebhookAuth
+ */
+export class WebhookAuth extends Message<WebhookAuth> {
+  /**
+   * @generated from field: autokitteh.triggers.v1.WebhookAuth.Signature signature = 1;
+   */
+  signature?: WebhookAuth_Signature;
+
+  /**
+   * @generated from field: string bearer_token_var = 2;
+   */
+  bearerTokenVar = "";
+
+  /**
+   * @generated from field: autokitteh.triggers.v1.WebhookAuth.Basic basic = 3;
+   */
+  basic?: WebhookAuth_Basic;
+
+  /**
+   * Allowed remote addresses, e.g. "192.30.252.0/22". Empty allows all.
+   *
+   * @generated from field: repeated string allowed_cidrs = 4;
+   */
+  allowedCidrs: string[] = [];
+
+  constructor(data?: PartialMessage<WebhookAuth>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.triggers.v1.WebhookAuth";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "signature", kind: "message", T: WebhookAuth_Signature },
+    { no: 2, name: "bearer_token_var", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "basic", kind: "message", T: WebhookAuth_Basic },
+    { no: 4, name: "allowed_cidrs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WebhookAuth {
+    return new WebhookAuth().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WebhookAuth {
+    return new WebhookAuth().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WebhookAuth {
+    return new WebhookAuth().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WebhookAuth | PlainMessage<WebhookAuth> | undefined, b: WebhookAuth | PlainMessage<WebhookAuth> | undefined): boolean {
+    return proto3.util.equals(WebhookAuth, a, b);
+  }
+}
+
+/**
+ * @generated from enum autokitteh.triggers.v1.WebhookAuth.SignatureFormat
+ */
+export enum WebhookAuth_SignatureFormat {
+  /**
+   * same as HEX.
+   *
+   * @generated from enum value: SIGNATURE_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Hex digest, optionally prefixed with "sha256=", e.g. GitHub's X-Hub-Signature-256.
+   *
+   * @generated from enum value: SIGNATURE_FORMAT_HEX = 1;
+   */
+  HEX = 1,
+
+  /**
+   * Base64 digest, e.g. Shopify's X-Shopify-Hmac-Sha256.
+   *
+   * @generated from enum value: SIGNATURE_FORMAT_BASE64 = 2;
+   */
+  BASE64 = 2,
+
+  /**
+   * "t=<timestamp>,v1=<hex digest>" signed over "<timestamp>.<body>", e.g. Stripe-Signature.
+   *
+   * @generated from enum value: SIGNATURE_FORMAT_STRIPE = 3;
+   */
+  STRIPE = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(WebhookAuth_SignatureFormat)
+proto3.util.setEnumType(WebhookAuth_SignatureFormat, "autokitteh.triggers.v1.WebhookAuth.SignatureFormat", [
+  { no: 0, name: "SIGNATURE_FORMAT_UNSPECIFIED" },
+  { no: 1, name: "SIGNATURE_FORMAT_HEX" },
+  { no: 2, name: "SIGNATURE_FORMAT_BASE64" },
+  { no: 3, name: "SIGNATURE_FORMAT_STRIPE" },
+]);
+
+/**
+ * HMAC-SHA256 signature of the request body.
+ *
+ * @generated from message autokitteh.triggers.v1.WebhookAuth.Signature
+ */
+export class WebhookAuth_Signature extends Message<WebhookAuth_Signature> {
+  /**
+   * @generated from field: string header = 1;
+   */
+  header = "";
+
+  /**
+   * @generated from field: string secret_var = 2;
+   */
+  secretVar = "";
+
+  /**
+   * @generated from field: autokitteh.triggers.v1.WebhookAuth.SignatureFormat format = 3;
+   */
+  format = WebhookAuth_SignatureFormat.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<WebhookAuth_Signature>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.triggers.v1.WebhookAuth.Signature";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "header", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "secret_var", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "format", kind: "enum", T: proto3.getEnumType(WebhookAuth_SignatureFormat) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WebhookAuth_Signature {
+    return new WebhookAuth_Signature().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WebhookAuth_Signature {
+    return new WebhookAuth_Signature().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WebhookAuth_Signature {
+    return new WebhookAuth_Signature().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WebhookAuth_Signature | PlainMessage<WebhookAuth_Signature> | undefined, b: WebhookAuth_Signature | PlainMessage<WebhookAuth_Signature> | undefined): boolean {
+    return proto3.util.equals(WebhookAuth_Signature, a, b);
+  }
+}
+
+/**
+ * @generated from message autokitteh.triggers.v1.WebhookAuth.Basic
+ */
+export class WebhookAuth_Basic extends Message<WebhookAuth_Basic> {
+  /**
+   * @generated from field: string username = 1;
+   */
+  username = "";
+
+  /**
+   * @generated from field: string password_var = 2;
+   */
+  passwordVar = "";
+
+  constructor(data?: PartialMessage<WebhookAuth_Basic>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.triggers.v1.WebhookAuth.Basic";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "password_var", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WebhookAuth_Basic {
+    return new WebhookAuth_Basic().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WebhookAuth_Basic {
+    return new WebhookAuth_Basic().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WebhookAuth_Basic {
+    return new WebhookAuth_Basic().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WebhookAuth_Basic | PlainMessage<WebhookAuth_Basic> | undefined, b: WebhookAuth_Basic | PlainMessage<WebhookAuth_Basic> | undefined): boolean {
+    return proto3.util.equals(WebhookAuth_Basic, a, b);
+  }
+}
+
+/**
+ * Derives an idempotency key from webhook requests. Repeated requests with the
+ * same key are not dispatched again, but get the response of the first one.
+ * Exactly one of header or key must be set.
+ *
+ * @generated from message autokitteh.triggers.v1.WebhookIdempotency
+ */
+export class WebhookIdempotency extends Message<WebhookIdempotency> {
+  /**
+   * request header that contains the key.
+   *
+   * @generated from field: string header = 1;
+   */
+  header = "";
+
+  /**
+   * expression over the event, in the same language as filters.
+   *
+   * @generated from field: string key = 2;
+   */
+  key = "";
+
+  constructor(data?: PartialMessage<WebhookIdempotency>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "autokitteh.triggers.v1.WebhookIdempotency";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "header", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WebhookIdempotency {
+    return new WebhookIdempotency().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WebhookIdempotency {
+    return new WebhookIdempotency().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WebhookIdempotency {
+    return new WebhookIdempotency().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WebhookIdempotency | PlainMessage<WebhookIdempotency> | undefined, b: WebhookIdempotency | PlainMessage<WebhookIdempotency> | undefined): boolean {
+    return proto3.util.equals(WebhookIdempotency, a, b);
+  }
+}
+
 /**
  * @generated from message autokitteh.triggers.v1.Trigger
  */
@@ -56,6 +520,35 @@ export class Trigger extends Message<Trigger> {
    */
   isSync = false;
 
+  /**
+   * @generated from field: autokitteh.triggers.v1.ConcurrencyPolicy concurrency = 10;
+   */
+  concurrency?: ConcurrencyPolicy;
+
+  /**
+   * @generated from field: autokitteh.triggers.v1.BatchPolicy batch = 11;
+   */
+  batch?: BatchPolicy;
+
+  /**
+   * @generated from field: autokitteh.triggers.v1.RetryPolicy retry = 12;
+   */
+  retry?: RetryPolicy;
+
+  /**
+   * Execution timeout for sessions started by this trigger.
+   *
+   * @generated from field: google.protobuf.Duration timeout = 13;
+   */
+  timeout?: Duration;
+
+  /**
+   * Called when a session started by this trigger is stopped.
+   *
+   * @generated from field: autokitteh.program.v1.CodeLocation on_stop = 14;
+   */
+  onStop?: CodeLocation;
+
   /**
    * if source_type == CONNECTION.
    *
@@ -77,6 +570,29 @@ export class Trigger extends Message<Trigger> {
    */
   timezone = "";
 
+  /**
+   * if source_type == WEBHOOK.
+   *
+   * @generated from field: autokitteh.triggers.v1.WebhookAuth webhook_auth = 53;
+   */
+  webhookAuth?: WebhookAuth;
+
+  /**
+   * if source_type == WEBHOOK.
+   *
+   * @generated from field: autokitteh.triggers.v1.WebhookIdempotency webhook_idempotency = 54;
+   */
+  webhookIdempotency?: WebhookIdempotency;
+
+  /**
+   * if source_type == WEBHOOK. Method and path template relative to the webhook
+   * URL, e.g. "POST /orders/{id}". Webhook triggers created with routes in the
+   * same project share a slug, and each request goes to the best matching route.
+   *
+   * @generated from field: string webhook_route = 55;
+   */
+  webhookRoute = "";
+
   /**
    * read only.
    *
@@ -103,9 +619,17 @@ export class Trigger extends Message<Trigger> {
     { no: 7, name: "filter", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 8, name: "is_durable", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 9, name: "is_sync", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "concurrency", kind: "message", T: ConcurrencyPolicy },
+    { no: 11, name: "batch", kind: "message", T: BatchPolicy },
+    { no: 12, name: "retry", kind: "message", T: RetryPolicy },
+    { no: 13, name: "timeout", kind: "message", T: Duration },
+    { no: 14, name: "on_stop", kind: "message", T: CodeLocation },
     { no: 50, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 51, name: "schedule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 52, name: "timezone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 53, name: "webhook_auth", kind: "message", T: WebhookAuth },
+    { no: 54, name: "webhook_idempotency", kind: "message", T: WebhookIdempotency },
+    { no: 55, name: "webhook_route", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 100, name: "webhook_slug", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
@@ -149,6 +673,11 @@ export enum Trigger_SourceType {
    * @generated from enum value: SOURCE_TYPE_SCHEDULE = 3;
    */
   SCHEDULE = 3,
+
+  /**
+   * @generated from enum value: SOURCE_TYPE_STORE = 4;
+   */
+  STORE = 4,
 }
 // Retrieve enum metadata with: proto3.getEnumType(Trigger_SourceType)
 proto3.util.setEnumType(Trigger_SourceType, "autokitteh.triggers.v1.Trigger.SourceType", [
@@ -156,5 +685,6 @@ proto3.util.setEnumType(Trigger_SourceType, "autokitteh.triggers.v1.Trigger.Sour
   { no: 1, name: "SOURCE_TYPE_CONNECTION" },
   { no: 2, name: "SOURCE_TYPE_WEBHOOK" },
   { no: 3, name: "SOURCE_TYPE_SCHEDULE" },
+  { no: 4, name: "SOURCE_TYPE_STORE" },
 ]);
 
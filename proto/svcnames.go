@@ -2,6 +2,7 @@ package proto
 
 import (
 	"go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/apply/v1/applyv1connect"
+	"go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/approvals/v1/approvalsv1connect"
 	"go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/auth/v1/authv1connect"
 	"go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/builds/v1/buildsv1connect"
 	"go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/connections/v1/connectionsv1connect"
@@ -23,6 +24,7 @@ import (
 
 var ServiceNames = []string{
 	applyv1connect.ApplyServiceName,
+	approvalsv1connect.ApprovalsServiceName,
 	authv1connect.AuthServiceName,
 	buildsv1connect.BuildsServiceName,
 	connectionsv1connect.ConnectionsServiceName,
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	applyv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/apply/v1"
+	approvalsv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/approvals/v1"
 	authv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/auth/v1"
 	buildsv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/builds/v1"
 	commonv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/common/v1"
@@ -57,6 +58,8 @@ func parse(fds []protoreflect.FileDescriptor) func(proto.Message, ...protovalida
 
 var fds = []protoreflect.FileDescriptor{
 	applyv1.File_autokitteh_apply_v1_svc_proto,
+	approvalsv1.File_autokitteh_approvals_v1_approval_proto,
+	approvalsv1.File_autokitteh_approvals_v1_svc_proto,
 	authv1.File_autokitteh_auth_v1_svc_proto,
 	buildsv1.File_autokitteh_builds_v1_build_proto,
 	buildsv1.File_autokitteh_builds_v1_svc_proto,
@@ -65,6 +68,7 @@ var fds = []protoreflect.FileDescriptor{
 	connectionsv1.File_autokitteh_connections_v1_svc_proto,
 	deploymentsv1.File_autokitteh_deployments_v1_deployment_proto,
 	deploymentsv1.File_autokitteh_deployments_v1_svc_proto,
+	dispatcherv1.File_autokitteh_dispatcher_v1_dead_letter_proto,
 	dispatcherv1.File_autokitteh_dispatcher_v1_svc_proto,
 	eventsv1.File_autokitteh_events_v1_event_proto,
 	eventsv1.File_autokitteh_events_v1_svc_proto,
@@ -5,13 +5,17 @@
 
 from . import errors
 from .activities import activity, inhibit_activities, register_no_activity
+from .approvals import Approval, request_approval
 from .attr_dict import AttrDict
+from .compensations import register_compensation
 from .errors import AutoKittehError
 from .event import Event
 from .events import next_event, start, subscribe, unsubscribe
-from .outcomes import http_outcome, outcome
+from .outcomes import http_outcome, http_stream, outcome
+from .sessions import join_all, set_session_tag, wait_session
 from .signals import Signal, next_signal, signal
 from .store import (
+    acquire_lock,
     add_values,
     check_and_set_value,
     del_value,
@@ -20,6 +24,8 @@ from .store import (
     list_values_keys,
     mutate_value,
     publish_value,
+    release_lock,
+    renew_lock,
     set_value,
     store,
     unpublish_value,
@@ -32,12 +38,22 @@ __all__ = [
     "errors",
     "get_webhook_url",
     "http_outcome",
+    "http_stream",
     "outcome",
+    "set_session_tag",
     "start",
+    # Sessions
+    "join_all",
+    "wait_session",
     # Activities
     "activity",
     "inhibit_activities",
     "register_no_activity",
+    # Approvals
+    "Approval",
+    "request_approval",
+    # Compensations
+    "register_compensation",
     # Events
     "Event",
     "next_event",
@@ -48,6 +64,7 @@ __all__ = [
     "signal",
     "Signal",
     # Values
+    "acquire_lock",
     "add_values",
     "check_and_set_value",
     "del_value",
@@ -56,6 +73,8 @@ __all__ = [
     "list_values_keys",
     "mutate_value",
     "publish_value",
+    "release_lock",
+    "renew_lock",
     "set_value",
     "store",
     "unpublish_value",
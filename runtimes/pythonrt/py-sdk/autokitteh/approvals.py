@@ -0,0 +1,44 @@
+"""Human-in-the-loop approvals."""
+
+from dataclasses import dataclass
+from datetime import timedelta
+
+
+@dataclass
+class Approval:
+    id: str
+    state: str  # "approved", "rejected" or "expired".
+    decided_by: str = ""
+    comment: str = ""
+
+    @property
+    def approved(self) -> bool:
+        return self.state == "approved"
+
+
+def request_approval(
+    title: str,
+    approvers: list[str] | None = None,
+    *,
+    timeout: timedelta | int | float = None,
+) -> Approval:
+    """Request an approval and wait for a decision.
+
+    The approval can be approved or rejected with "ak approvals approve/reject",
+    the approvals gRPC service or a POST to "/approvals/<id>/approve" (or reject).
+
+    Cannot be used in an activity.
+    Works only for durable sessions.
+
+    Args:
+        title: What is being approved, shown to the approvers.
+        approvers: Emails of the users allowed to decide. If empty,
+            any member of the project's organization may decide.
+        timeout: How long to wait for a decision. If it passes, the
+            returned approval is expired. Wait forever if not specified.
+
+    Returns:
+        The decided (or expired) approval.
+    """
+    # Dummy implementation for local development.
+    return Approval(id="", state="approved")
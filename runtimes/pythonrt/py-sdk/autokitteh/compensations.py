@@ -0,0 +1,28 @@
+"""Sagas: compensations which undo side effects of a failed session."""
+
+from collections.abc import Callable
+
+
+def register_compensation(fn: Callable, *args, **kw) -> None:
+    """Register a function to undo a side effect of the session.
+
+    Registered compensations are called, in reverse order of registration,
+    if the session ends with an error or is stopped. Each compensation is
+    called as an activity, and is recorded in the session log. A failing
+    compensation does not prevent the rest from being called.
+
+    Typically called right after an activity with a side effect succeeds:
+
+        charge_id = charge_card(order)
+        register_compensation(refund, charge_id)
+
+    Cannot be used in an activity.
+    Works only for durable sessions.
+
+    Args:
+        fn: Function to call. Must be a top-level function in the user code.
+        args: Positional arguments to call fn with.
+        kw: Keyword arguments to call fn with.
+    """
+    # Dummy implementation for local development.
+    pass
@@ -1,4 +1,5 @@
-from typing import Any
+from collections.abc import Iterable
+from typing import Any, Literal
 
 
 def outcome(v: Any, *, event_id: str | None = None) -> None:
@@ -22,6 +23,8 @@ def http_outcome(
     json: Any = None,
     headers: dict[str, str] = {},
     more: bool = False,
+    stream: Literal["chunked", "sse"] | None = None,
+    sse_event: str | None = None,
     event_id: str | None = None,
 ) -> None:
     """Respond to an HTTP request.
@@ -36,7 +39,41 @@ def http_outcome(
             header will be set to application/json. Cannot be used together with body.
         headers: dict of headers to return.
         more: If True, indicates that more responses will follow for this request.
+        stream: Stream the response, writing each response as soon as it is made:
+            "chunked" writes them as chunks, and "sse" writes them as Server-Sent
+            Events. Ignored if not the first response to a request.
+        sse_event: Event name, if the response is streamed as Server-Sent Events.
         event_id: Optional event ID to associate the outcome with.
     """
     # Dummy implementation for local development.
     pass
+
+
+def http_stream(
+    chunks: Iterable[Any],
+    *,
+    sse: bool = False,
+    sse_event: str | None = None,
+    status_code: int = 200,
+    headers: dict[str, str] = {},
+    event_id: str | None = None,
+) -> None:
+    """Stream a response to an HTTP request, e.g. tokens from an LLM.
+
+    Each chunk is sent to the client as soon as it is produced, and the
+    response ends when there are no more chunks.
+
+    Works both in durable and nondurable sessions.
+
+    Args:
+        chunks: Bodies of the chunks. See http_outcome for how they are written.
+        sse: If True, stream as Server-Sent Events, one per chunk. Otherwise,
+            use chunked transfer encoding.
+        sse_event: Event name for all events, if sse is True.
+        status_code: HTTP status code to return.
+        headers: dict of headers to return.
+        event_id: Optional event ID to associate the outcome with.
+    """
+    # Dummy implementation for local development.
+    for _ in chunks:
+        pass
@@ -0,0 +1,70 @@
+from datetime import timedelta
+from typing import Any
+
+
+def set_session_tag(key: str, value: str) -> None:
+    """Set a tag on the current session.
+
+    Tags are user defined labels which can be used to search for sessions,
+    e.g. "ak session list --tag customer_id=42". Setting an existing tag
+    overwrites its value, and setting it to an empty value removes it.
+
+    Works both in durable and nondurable sessions.
+
+    Args:
+        key: Tag key. Must not contain "=" or '"'.
+        value: Tag value.
+
+    """
+    # Dummy implementation for local development.
+    pass
+
+
+def wait_session(session_id: str, *, timeout: timedelta | int | float = None) -> Any:
+    """Wait for a child session to finish and return its result.
+
+    Blocks until the session, which must have been started by the current
+    session using start, reaches a final state.
+
+    Cannot be used in an activity.
+    Works both in durable and nondurable sessions.
+
+    Args:
+        session_id: ID of the child session, as returned by start.
+        timeout: How long to wait. Waits forever if not specified.
+
+    Returns:
+        The return value of the child session's entry point.
+
+    Raises:
+        AutoKittehError: The child session ended with an error or was stopped.
+        TimeoutError: The child session did not finish before the timeout.
+    """
+    # Dummy implementation for local development.
+    return None
+
+
+def join_all(
+    session_ids: list[str], *, timeout: timedelta | int | float = None
+) -> list[Any]:
+    """Wait for multiple child sessions to finish and return their results.
+
+    Same as wait_session, but for all given sessions at once. The timeout
+    applies to the whole wait, not to each session separately.
+
+    Cannot be used in an activity.
+    Works both in durable and nondurable sessions.
+
+    Args:
+        session_ids: IDs of the child sessions, as returned by start.
+        timeout: How long to wait. Waits forever if not specified.
+
+    Returns:
+        The return values of the child sessions, in the same order as the IDs.
+
+    Raises:
+        AutoKittehError: A child session ended with an error or was stopped.
+        TimeoutError: Not all child sessions finished before the timeout.
+    """
+    # Dummy implementation for local development.
+    return [None] * len(session_ids)
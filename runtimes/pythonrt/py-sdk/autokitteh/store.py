@@ -1,5 +1,6 @@
 import os
 from collections.abc import MutableMapping
+from datetime import timedelta
 from enum import StrEnum
 from typing import Any
 
@@ -97,7 +98,12 @@ def get_value(key: str) -> Any:
     return _local_dev_store.get(key)
 
 
-def check_and_set_value(key: str, expected_value: Any, new_value: Any) -> bool:
+def check_and_set_value(
+    key: str,
+    expected_value: Any,
+    new_value: Any,
+    ttl: timedelta | float | None = None,
+) -> bool:
     """Check and set a stored value.
 
     This operation is atomic.
@@ -108,6 +114,8 @@ def check_and_set_value(key: str, expected_value: Any, new_value: Any) -> bool:
         key: Key of the value to set.
         expected_value: Expected current value.
         new_value: New value to store if the current value matches the expected value.
+        ttl: Optional time to live (timedelta or seconds). Once it passes,
+            the value is treated as if it was deleted.
     Returns:
         bool: True if the value was set, False otherwise.
     """
@@ -120,7 +128,7 @@ def check_and_set_value(key: str, expected_value: Any, new_value: Any) -> bool:
     return False
 
 
-def set_value(key: str, value: Any) -> None:
+def set_value(key: str, value: Any, ttl: timedelta | float | None = None) -> None:
     """Set a stored value.
 
     Works both for durable and non-durable sessions.
@@ -128,6 +136,8 @@ def set_value(key: str, value: Any) -> None:
     Args:
         key: Key of the value to set.
         value: Value to store. If Value is None, it will be deleted. Value must be serializable.
+        ttl: Optional time to live (timedelta or seconds). Once it passes,
+            the value is treated as if it was deleted.
 
     Returns:
         None.
@@ -214,3 +224,69 @@ def unpublish_value(key: str) -> None:
         key: Key of the value to unpublish.
     """
     pass
+
+
+# Dummy lock owner for local development.
+_local_dev_lock_owner = "local"
+
+
+def acquire_lock(key: str, lease: timedelta | float) -> bool:
+    """Acquire a lock.
+
+    This operation is atomic. The lock is owned by the calling session,
+    and is released automatically when the session ends or the lease
+    expires, whichever comes first. Acquiring a lock that is already held
+    by the calling session extends its lease. While the lock is held, other
+    store operations on its key, such as set or delete, fail.
+
+    Works both for durable and non-durable sessions.
+
+    Args:
+        key: Key of the lock.
+        lease: How long to hold the lock for (timedelta or seconds).
+
+    Returns:
+        bool: True if the lock was acquired, False if it is held by another session.
+    """
+
+    # Dummy implementation for local development, ignores lease.
+    if _local_dev_store.get(key, _local_dev_lock_owner) != _local_dev_lock_owner:
+        return False
+    _local_dev_store[key] = _local_dev_lock_owner
+    return True
+
+
+def renew_lock(key: str, lease: timedelta | float) -> bool:
+    """Renew the lease of a lock held by the calling session.
+
+    Works both for durable and non-durable sessions.
+
+    Args:
+        key: Key of the lock.
+        lease: New lease duration, starting now (timedelta or seconds).
+
+    Returns:
+        bool: True if the lock was renewed, False if it is not held by the calling session.
+    """
+
+    # Dummy implementation for local development, ignores lease.
+    return _local_dev_store.get(key) == _local_dev_lock_owner
+
+
+def release_lock(key: str) -> bool:
+    """Release a lock held by the calling session.
+
+    Works both for durable and non-durable sessions.
+
+    Args:
+        key: Key of the lock.
+
+    Returns:
+        bool: True if the lock was released, False if it is not held by the calling session.
+    """
+
+    # Dummy implementation for local development.
+    if _local_dev_store.get(key) != _local_dev_lock_owner:
+        return False
+    del _local_dev_store[key]
+    return True
@@ -14,6 +14,7 @@ import (
 	"runtime"
 	"slices"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -96,6 +97,12 @@ type pySvc struct {
 	runnerID string
 
 	firstCall bool // first call is the trigger, other calls are activities
+	runClosed bool // Close was already called after `Run`.
+
+	// Set by the gRPC handler once user code registered a compensation. If the
+	// trigger call fails, the Python process is kept so the compensations can
+	// be called, and is killed only on Close.
+	hasCompensations atomic.Bool
 
 	channels comChannels
 
@@ -397,9 +404,29 @@ func (py *pySvc) Values() map[string]sdktypes.Value {
 }
 
 func (py *pySvc) Close() {
-	py.log.Info("closing (not really)")
-	// AK calls Close after `Run`, but we need the Python process running for `Call` as well.
-	// We kill the Python process once the initial `Call` is completed.
+	if py.firstCall && !py.runClosed {
+		py.log.Info("closing (not really)")
+		// AK calls Close after `Run`, but we need the Python process running for `Call` as well.
+		// We kill the Python process once the initial `Call` is completed.
+		py.runClosed = true
+		return
+	}
+
+	// Python process might have been kept after the initial `Call` for compensations,
+	// or the initial `Call` was never made.
+	py.finish()
+}
+
+// finish kills the Python process and stops consuming its prints.
+func (py *pySvc) finish() {
+	if py.didCleanup {
+		return
+	}
+
+	py.cleanup(context.Background())
+
+	time.Sleep(10 * time.Millisecond) // Give time to print consumer to finish
+	close(py.printDone)
 }
 
 func (py *pySvc) kwToEvent(kwargs map[string]sdktypes.Value) (map[string]any, error) {
@@ -568,7 +595,7 @@ func (py *pySvc) tracebackToLocation(traceback []*pbUserCode.Frame) []sdktypes.C
 
 // Call handles a function call from autokitteh.
 // First used of Call start a workflow, later invocations are activity calls.
-func (py *pySvc) Call(ctx context.Context, v sdktypes.Value, args []sdktypes.Value, kwargs map[string]sdktypes.Value) (sdktypes.Value, error) {
+func (py *pySvc) Call(ctx context.Context, v sdktypes.Value, args []sdktypes.Value, kwargs map[string]sdktypes.Value) (_ sdktypes.Value, callErr error) {
 	ctx, span := telemetry.T().Start(ctx, "pythonrt.Call")
 	defer span.End()
 
@@ -605,10 +632,12 @@ func (py *pySvc) Call(ctx context.Context, v sdktypes.Value, args []sdktypes.Val
 		}
 
 		defer func() {
-			py.cleanup(context.Background())
+			if callErr != nil && py.hasCompensations.Load() {
+				py.log.Info("keeping runner for compensations")
+				return
+			}
 
-			time.Sleep(10 * time.Millisecond) // Give time to print consumer to finish
-			close(py.printDone)
+			py.finish()
 		}()
 
 	} else {
@@ -20,6 +20,8 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"go.autokitteh.dev/autokitteh/internal/kittehs"
 	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
@@ -376,3 +378,52 @@ func TestProgramError(t *testing.T) {
 	require.Contains(t, err.Error(), "division by zero")
 	require.Contains(t, err.Error(), "progerr.py")
 }
+
+// stopsRunnerManager counts the runners stopped.
+type stopsRunnerManager struct {
+	RunnerManager
+	stops int
+}
+
+func (m *stopsRunnerManager) Stop(context.Context, string, sdktypes.SessionID) error {
+	m.stops++
+	return nil
+}
+
+func TestCloseStopsRunner(t *testing.T) {
+	mgr := &stopsRunnerManager{}
+	prev := runnerManager
+	runnerManager = mgr
+	defer func() { runnerManager = prev }()
+
+	newPySvc := func() *pySvc {
+		cc, err := grpc.NewClient("passthrough:///runner", grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+
+		return &pySvc{
+			log:       zap.NewNop(),
+			runner:    &RunnerClient{cc: cc},
+			firstCall: true,
+			printDone: make(chan struct{}),
+		}
+	}
+
+	// Entry point never called.
+	py := newPySvc()
+	py.Close() // after `Run`.
+	require.Equal(t, 0, mgr.stops)
+	py.Close()
+	require.Equal(t, 1, mgr.stops)
+
+	// Runner kept for compensations after the entry point call failed.
+	py = newPySvc()
+	py.Close() // after `Run`.
+	py.firstCall = false
+	py.hasCompensations.Store(true)
+	py.Close()
+	require.Equal(t, 2, mgr.stops)
+
+	// Closing again does nothing.
+	py.Close()
+	require.Equal(t, 2, mgr.stops)
+}
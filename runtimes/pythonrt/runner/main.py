@@ -310,6 +310,7 @@ class Runner(pb.runner_rpc.RunnerService):
         )
         self._inactivity_timer.start()
         self._stopped = False
+        self.syscalls: SysCalls = None  # set on Start.
 
         self.large_objects_mngr = large_objects_manager
 
@@ -393,20 +394,26 @@ class Runner(pb.runner_rpc.RunnerService):
         connections.encode_jwt = self.syscalls.ak_encode_jwt
         connections.refresh_oauth = self.syscalls.ak_refresh_oauth
 
+        autokitteh.acquire_lock = self.syscalls.ak_acquire_lock
         autokitteh.check_and_set_value = self.syscalls.ak_check_and_set_value
         autokitteh.del_value = self.syscalls.ak_del_value
         autokitteh.get_value = self.syscalls.ak_get_value
         autokitteh.list_values_keys = self.syscalls.ak_list_values_keys
         autokitteh.mutate_value = self.syscalls.ak_mutate_value
         autokitteh.publish_value = self.syscalls.ak_publish_value
+        autokitteh.release_lock = self.syscalls.ak_release_lock
+        autokitteh.renew_lock = self.syscalls.ak_renew_lock
 
         # Need to patch autokitteh.store as well for the Store API
+        autokitteh.store.acquire_lock = self.syscalls.ak_acquire_lock
         autokitteh.store.check_and_set_value = self.syscalls.ak_check_and_set_value
         autokitteh.store.del_value = self.syscalls.ak_del_value
         autokitteh.store.get_value = self.syscalls.ak_get_value
         autokitteh.store.list_values_keys = self.syscalls.ak_list_values_keys
         autokitteh.store.mutate_value = self.syscalls.ak_mutate_value
         autokitteh.store.publish_value = self.syscalls.ak_publish_value
+        autokitteh.store.release_lock = self.syscalls.ak_release_lock
+        autokitteh.store.renew_lock = self.syscalls.ak_renew_lock
 
         autokitteh.next_event = self.syscalls.ak_next_event
         autokitteh.next_signal = self.syscalls.ak_next_signal
@@ -414,10 +421,16 @@ class Runner(pb.runner_rpc.RunnerService):
         autokitteh.add_values = self.syscalls.ak_add_values
         autokitteh.signal = self.syscalls.ak_signal
         autokitteh.start = self.syscalls.ak_start
+        autokitteh.wait_session = self.syscalls.ak_wait_session
+        autokitteh.join_all = self.syscalls.ak_join_all
         autokitteh.subscribe = self.syscalls.ak_subscribe
         autokitteh.unsubscribe = self.syscalls.ak_unsubscribe
         autokitteh.outcome = self.syscalls.ak_outcome
         autokitteh.http_outcome = self.syscalls.ak_http_outcome
+        autokitteh.http_stream = self.syscalls.ak_http_stream
+        autokitteh.set_session_tag = self.syscalls.ak_set_session_tag
+        autokitteh.request_approval = self.syscalls.ak_request_approval
+        autokitteh.register_compensation = self.syscalls.ak_register_compensation
 
         # Not ak, but patching print as well
         builtins.print = self.ak_print
@@ -559,6 +572,14 @@ class Runner(pb.runner_rpc.RunnerService):
         force_close(self.server)
 
     def Execute(self, request: pb.runner.ExecuteRequest, context: grpc.ServicerContext):
+        # Compensations are called by ak after the handler is done, by the data
+        # given when they were registered.
+        compensation = self.syscalls and self.syscalls.compensations.get(request.data)
+        if compensation:
+            fn, args, kw = compensation
+            self.submit_to_threadpool(self.execute, fn, list(args), dict(kw))
+            return pb.runner.ExecuteResponse()
+
         with self.lock:
             call: Call = self.activity_call
 
@@ -772,6 +793,11 @@ class Runner(pb.runner_rpc.RunnerService):
         except Exception as err:
             log.error("on_event: done send error: %r", err)
 
+        if result.error and self.syscalls.compensations:
+            # ak calls the compensations and then stops the runner.
+            log.info("keeping runner for %d compensations", len(self.syscalls.compensations))
+            return
+
         force_close(self.server)
 
     @mark_no_activity
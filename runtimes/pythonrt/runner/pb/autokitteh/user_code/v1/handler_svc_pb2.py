@@ -16,7 +16,7 @@ from pb.autokitteh.values.v1 import values_pb2 as autokitteh_dot_values_dot_v1_d
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n)autokitteh/user_code/v1/handler_svc.proto\x12\x17\x61utokitteh.user_code.v1\x1a\'autokitteh/user_code/v1/user_code.proto\x1a!autokitteh/values/v1/values.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf6\x01\n\x08\x43\x61llInfo\x12\x1a\n\x08\x66unction\x18\x01 \x01(\tR\x08\x66unction\x12/\n\x04\x61rgs\x18\x02 \x03(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x04\x61rgs\x12\x45\n\x06kwargs\x18\x03 \x03(\x0b\x32-.autokitteh.user_code.v1.CallInfo.KwargsEntryR\x06kwargs\x1aV\n\x0bKwargsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value:\x02\x38\x01\"\x82\x01\n\x0f\x41\x63tivityRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x12\n\x04\x64\x61ta\x18\x02 \x01(\x0cR\x04\x64\x61ta\x12>\n\tcall_info\x18\x03 \x01(\x0b\x32!.autokitteh.user_code.v1.CallInfoR\x08\x63\x61llInfo\"(\n\x10\x41\x63tivityResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"\xb3\x01\n\x0b\x44oneRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x33\n\x06result\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x06result\x12\x14\n\x05\x65rror\x18\x03 \x01(\tR\x05\x65rror\x12<\n\ttraceback\x18\x04 \x03(\x0b\x32\x1e.autokitteh.user_code.v1.FrameR\ttraceback\"\x0e\n\x0c\x44oneResponse\"L\n\x0cSleepRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x1f\n\x0b\x64uration_ms\x18\x02 \x01(\x03R\ndurationMs\"%\n\rSleepResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"g\n\x10SubscribeRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x1e\n\nconnection\x18\x02 \x01(\tR\nconnection\x12\x16\n\x06\x66ilter\x18\x03 \x01(\tR\x06\x66ilter\"F\n\x11SubscribeResponse\x12\x1b\n\tsignal_id\x18\x01 \x01(\tR\x08signalId\x12\x14\n\x05\x65rror\x18\x02 \x01(\tR\x05\x65rror\"m\n\x10NextEventRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x1d\n\nsignal_ids\x18\x02 \x03(\tR\tsignalIds\x12\x1d\n\ntimeout_ms\x18\x03 \x01(\x03R\ttimeoutMs\"_\n\x11NextEventResponse\x12\x34\n\x05\x65vent\x18\x01 \x01(\x0b\x32\x1e.autokitteh.user_code.v1.EventR\x05\x65vent\x12\x14\n\x05\x65rror\x18\x03 \x01(\tR\x05\x65rror\"N\n\x12UnsubscribeRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x1b\n\tsignal_id\x18\x02 \x01(\tR\x08signalId\"+\n\x13UnsubscribeResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"S\n\x06Signal\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12\x35\n\x07payload\x18\x03 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x07payload\"\x84\x01\n\rSignalRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x37\n\x06signal\x18\x02 \x01(\x0b\x32\x1f.autokitteh.user_code.v1.SignalR\x06signal\x12\x1d\n\nsession_id\x18\x03 \x01(\tR\tsessionId\"&\n\x0eSignalResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"e\n\x11NextSignalRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x14\n\x05names\x18\x02 \x03(\tR\x05names\x12\x1d\n\ntimeout_ms\x18\x03 \x01(\x03R\ttimeoutMs\"c\n\x12NextSignalResponse\x12\x37\n\x06signal\x18\x01 \x01(\x0b\x32\x1f.autokitteh.user_code.v1.SignalR\x06signal\x12\x14\n\x05\x65rror\x18\x02 \x01(\tR\x05\x65rror\"Y\n\nLogRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x14\n\x05level\x18\x02 \x01(\tR\x05level\x12\x18\n\x07message\x18\x03 \x01(\tR\x07message\"#\n\x0bLogResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"E\n\x0cPrintRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x18\n\x07message\x18\x02 \x01(\tR\x07message\"%\n\rPrintResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"/\n\x10StoreListRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\"=\n\x11StoreListResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\x12\x12\n\x04keys\x18\x02 \x03(\tR\x04keys\"\x9a\x01\n\x12StoreMutateRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x10\n\x03key\x18\x02 \x01(\tR\x03key\x12\x1c\n\toperation\x18\x03 \x01(\tR\toperation\x12\x37\n\x08operands\x18\x04 \x03(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x08operands\"`\n\x13StoreMutateResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\x12\x33\n\x06result\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x06result\"D\n\x13StorePublishRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x10\n\x03key\x18\x02 \x01(\tR\x03key\",\n\x14StorePublishResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"F\n\x15StoreUnpublishRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x10\n\x03key\x18\x02 \x01(\tR\x03key\".\n\x16StoreUnpublishResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"{\n\x0eOutcomeRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value\x12\x19\n\x08\x65vent_id\x18\x03 \x01(\tR\x07\x65ventId\"\'\n\x0fOutcomeResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"\x86\x01\n\x13StartSessionRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x10\n\x03loc\x18\x02 \x01(\tR\x03loc\x12\x12\n\x04\x64\x61ta\x18\x03 \x01(\x0cR\x04\x64\x61ta\x12\x12\n\x04memo\x18\x04 \x01(\x0cR\x04memo\x12\x18\n\x07project\x18\x05 \x01(\tR\x07project\"K\n\x14StartSessionResponse\x12\x1d\n\nsession_id\x18\x01 \x01(\tR\tsessionId\x12\x14\n\x05\x65rror\x18\x02 \x01(\tR\x05\x65rror\"\xfb\x01\n\x10\x45ncodeJWTRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12P\n\x07payload\x18\x02 \x03(\x0b\x32\x36.autokitteh.user_code.v1.EncodeJWTRequest.PayloadEntryR\x07payload\x12\x1e\n\nconnection\x18\x03 \x01(\tR\nconnection\x12\x1c\n\talgorithm\x18\x04 \x01(\tR\talgorithm\x1a:\n\x0cPayloadEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x03R\x05value:\x02\x38\x01\";\n\x11\x45ncodeJWTResponse\x12\x10\n\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x14\n\x05\x65rror\x18\x02 \x01(\tR\x05\x65rror\"o\n\x0eRefreshRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12 \n\x0bintegration\x18\x02 \x01(\tR\x0bintegration\x12\x1e\n\nconnection\x18\x03 \x01(\tR\nconnection\"s\n\x0fRefreshResponse\x12\x14\n\x05token\x18\x01 \x01(\tR\x05token\x12\x34\n\x07\x65xpires\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x07\x65xpires\x12\x14\n\x05\x65rror\x18\x03 \x01(\tR\x05\x65rror\"4\n\x15IsActiveRunnerRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\"K\n\x16IsActiveRunnerResponse\x12\x1b\n\tis_active\x18\x01 \x01(\x08R\x08isActive\x12\x14\n\x05\x65rror\x18\x02 \x01(\tR\x05\x65rror\"\x16\n\x14HandlerHealthRequest\"-\n\x15HandlerHealthResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"\xbb\x01\n\x13\x45xecuteReplyRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x33\n\x06result\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x06result\x12\x14\n\x05\x65rror\x18\x03 \x01(\tR\x05\x65rror\x12<\n\ttraceback\x18\x04 \x03(\x0b\x32\x1e.autokitteh.user_code.v1.FrameR\ttraceback\",\n\x14\x45xecuteReplyResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror2\xf4\x10\n\x0eHandlerService\x12\x61\n\x08\x41\x63tivity\x12(.autokitteh.user_code.v1.ActivityRequest\x1a).autokitteh.user_code.v1.ActivityResponse\"\x00\x12m\n\x0c\x45xecuteReply\x12,.autokitteh.user_code.v1.ExecuteReplyRequest\x1a-.autokitteh.user_code.v1.ExecuteReplyResponse\"\x00\x12U\n\x04\x44one\x12$.autokitteh.user_code.v1.DoneRequest\x1a%.autokitteh.user_code.v1.DoneResponse\"\x00\x12R\n\x03Log\x12#.autokitteh.user_code.v1.LogRequest\x1a$.autokitteh.user_code.v1.LogResponse\"\x00\x12X\n\x05Print\x12%.autokitteh.user_code.v1.PrintRequest\x1a&.autokitteh.user_code.v1.PrintResponse\"\x00\x12X\n\x05Sleep\x12%.autokitteh.user_code.v1.SleepRequest\x1a&.autokitteh.user_code.v1.SleepResponse\"\x00\x12\x64\n\tSubscribe\x12).autokitteh.user_code.v1.SubscribeRequest\x1a*.autokitteh.user_code.v1.SubscribeResponse\"\x00\x12\x64\n\tNextEvent\x12).autokitteh.user_code.v1.NextEventRequest\x1a*.autokitteh.user_code.v1.NextEventResponse\"\x00\x12j\n\x0bUnsubscribe\x12+.autokitteh.user_code.v1.UnsubscribeRequest\x1a,.autokitteh.user_code.v1.UnsubscribeResponse\"\x00\x12m\n\x0cStartSession\x12,.autokitteh.user_code.v1.StartSessionRequest\x1a-.autokitteh.user_code.v1.StartSessionResponse\"\x00\x12[\n\x06Signal\x12&.autokitteh.user_code.v1.SignalRequest\x1a\'.autokitteh.user_code.v1.SignalResponse\"\x00\x12g\n\nNextSignal\x12*.autokitteh.user_code.v1.NextSignalRequest\x1a+.autokitteh.user_code.v1.NextSignalResponse\"\x00\x12\x64\n\tStoreList\x12).autokitteh.user_code.v1.StoreListRequest\x1a*.autokitteh.user_code.v1.StoreListResponse\"\x00\x12j\n\x0bStoreMutate\x12+.autokitteh.user_code.v1.StoreMutateRequest\x1a,.autokitteh.user_code.v1.StoreMutateResponse\"\x00\x12m\n\x0cStorePublish\x12,.autokitteh.user_code.v1.StorePublishRequest\x1a-.autokitteh.user_code.v1.StorePublishResponse\"\x00\x12s\n\x0eStoreUnpublish\x12..autokitteh.user_code.v1.StoreUnpublishRequest\x1a/.autokitteh.user_code.v1.StoreUnpublishResponse\"\x00\x12^\n\x07Outcome\x12\'.autokitteh.user_code.v1.OutcomeRequest\x1a(.autokitteh.user_code.v1.OutcomeResponse\"\x00\x12\x64\n\tEncodeJWT\x12).autokitteh.user_code.v1.EncodeJWTRequest\x1a*.autokitteh.user_code.v1.EncodeJWTResponse\"\x00\x12h\n\x11RefreshOAuthToken\x12\'.autokitteh.user_code.v1.RefreshRequest\x1a(.autokitteh.user_code.v1.RefreshResponse\"\x00\x12i\n\x06Health\x12-.autokitteh.user_code.v1.HandlerHealthRequest\x1a..autokitteh.user_code.v1.HandlerHealthResponse\"\x00\x12s\n\x0eIsActiveRunner\x12..autokitteh.user_code.v1.IsActiveRunnerRequest\x1a/.autokitteh.user_code.v1.IsActiveRunnerResponse\"\x00\x42\xf7\x01\n\x1b\x63om.autokitteh.user_code.v1B\x0fHandlerSvcProtoP\x01ZMgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/user_code/v1;user_codev1\xa2\x02\x03\x41UX\xaa\x02\x16\x41utokitteh.UserCode.V1\xca\x02\x16\x41utokitteh\\UserCode\\V1\xe2\x02\"Autokitteh\\UserCode\\V1\\GPBMetadata\xea\x02\x18\x41utokitteh::UserCode::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n)autokitteh/user_code/v1/handler_svc.proto\x12\x17\x61utokitteh.user_code.v1\x1a\'autokitteh/user_code/v1/user_code.proto\x1a!autokitteh/values/v1/values.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf6\x01\n\x08\x43\x61llInfo\x12\x1a\n\x08\x66unction\x18\x01 \x01(\tR\x08\x66unction\x12/\n\x04\x61rgs\x18\x02 \x03(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x04\x61rgs\x12\x45\n\x06kwargs\x18\x03 \x03(\x0b\x32-.autokitteh.user_code.v1.CallInfo.KwargsEntryR\x06kwargs\x1aV\n\x0bKwargsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value:\x02\x38\x01\"\x82\x01\n\x0f\x41\x63tivityRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x12\n\x04\x64\x61ta\x18\x02 \x01(\x0cR\x04\x64\x61ta\x12>\n\tcall_info\x18\x03 \x01(\x0b\x32!.autokitteh.user_code.v1.CallInfoR\x08\x63\x61llInfo\"(\n\x10\x41\x63tivityResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"\xb3\x01\n\x0b\x44oneRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x33\n\x06result\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x06result\x12\x14\n\x05\x65rror\x18\x03 \x01(\tR\x05\x65rror\x12<\n\ttraceback\x18\x04 \x03(\x0b\x32\x1e.autokitteh.user_code.v1.FrameR\ttraceback\"\x0e\n\x0c\x44oneResponse\"L\n\x0cSleepRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x1f\n\x0b\x64uration_ms\x18\x02 \x01(\x03R\ndurationMs\"%\n\rSleepResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"g\n\x10SubscribeRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x1e\n\nconnection\x18\x02 \x01(\tR\nconnection\x12\x16\n\x06\x66ilter\x18\x03 \x01(\tR\x06\x66ilter\"F\n\x11SubscribeResponse\x12\x1b\n\tsignal_id\x18\x01 \x01(\tR\x08signalId\x12\x14\n\x05\x65rror\x18\x02 \x01(\tR\x05\x65rror\"m\n\x10NextEventRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x1d\n\nsignal_ids\x18\x02 \x03(\tR\tsignalIds\x12\x1d\n\ntimeout_ms\x18\x03 \x01(\x03R\ttimeoutMs\"_\n\x11NextEventResponse\x12\x34\n\x05\x65vent\x18\x01 \x01(\x0b\x32\x1e.autokitteh.user_code.v1.EventR\x05\x65vent\x12\x14\n\x05\x65rror\x18\x03 \x01(\tR\x05\x65rror\"N\n\x12UnsubscribeRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x1b\n\tsignal_id\x18\x02 \x01(\tR\x08signalId\"+\n\x13UnsubscribeResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"S\n\x06Signal\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12\x35\n\x07payload\x18\x03 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x07payload\"\x84\x01\n\rSignalRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x37\n\x06signal\x18\x02 \x01(\x0b\x32\x1f.autokitteh.user_code.v1.SignalR\x06signal\x12\x1d\n\nsession_id\x18\x03 \x01(\tR\tsessionId\"&\n\x0eSignalResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"e\n\x11NextSignalRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x14\n\x05names\x18\x02 \x03(\tR\x05names\x12\x1d\n\ntimeout_ms\x18\x03 \x01(\x03R\ttimeoutMs\"c\n\x12NextSignalResponse\x12\x37\n\x06signal\x18\x01 \x01(\x0b\x32\x1f.autokitteh.user_code.v1.SignalR\x06signal\x12\x14\n\x05\x65rror\x18\x02 \x01(\tR\x05\x65rror\"Y\n\nLogRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x14\n\x05level\x18\x02 \x01(\tR\x05level\x12\x18\n\x07message\x18\x03 \x01(\tR\x07message\"#\n\x0bLogResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"E\n\x0cPrintRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x18\n\x07message\x18\x02 \x01(\tR\x07message\"%\n\rPrintResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"/\n\x10StoreListRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\"=\n\x11StoreListResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\x12\x12\n\x04keys\x18\x02 \x03(\tR\x04keys\"\x9a\x01\n\x12StoreMutateRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x10\n\x03key\x18\x02 \x01(\tR\x03key\x12\x1c\n\toperation\x18\x03 \x01(\tR\toperation\x12\x37\n\x08operands\x18\x04 \x03(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x08operands\"`\n\x13StoreMutateResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\x12\x33\n\x06result\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x06result\"D\n\x13StorePublishRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x10\n\x03key\x18\x02 \x01(\tR\x03key\",\n\x14StorePublishResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"F\n\x15StoreUnpublishRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x10\n\x03key\x18\x02 \x01(\tR\x03key\".\n\x16StoreUnpublishResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"{\n\x0eOutcomeRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x31\n\x05value\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value\x12\x19\n\x08\x65vent_id\x18\x03 \x01(\tR\x07\x65ventId\"\'\n\x0fOutcomeResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"[\n\x14SetSessionTagRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x10\n\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n\x05value\x18\x03 \x01(\tR\x05value\"-\n\x15SetSessionTagResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"\x88\x01\n\x16RequestApprovalRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x14\n\x05title\x18\x02 \x01(\tR\x05title\x12\x1c\n\tapprovers\x18\x03 \x03(\tR\tapprovers\x12\x1d\n\ntimeout_ms\x18\x04 \x01(\x03R\ttimeoutMs\"\x9f\x01\n\x17RequestApprovalResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\x12\x1f\n\x0b\x61pproval_id\x18\x02 \x01(\tR\napprovalId\x12\x14\n\x05state\x18\x03 \x01(\tR\x05state\x12\x1d\n\ndecided_by\x18\x04 \x01(\tR\tdecidedBy\x12\x18\n\x07\x63omment\x18\x05 \x01(\tR\x07\x63omment\"b\n\x1bRegisterCompensationRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n\x04\x64\x61ta\x18\x03 \x01(\x0cR\x04\x64\x61ta\"4\n\x1cRegisterCompensationResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"\x86\x01\n\x13StartSessionRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x10\n\x03loc\x18\x02 \x01(\tR\x03loc\x12\x12\n\x04\x64\x61ta\x18\x03 \x01(\x0cR\x04\x64\x61ta\x12\x12\n\x04memo\x18\x04 \x01(\x0cR\x04memo\x12\x18\n\x07project\x18\x05 \x01(\tR\x07project\"K\n\x14StartSessionResponse\x12\x1d\n\nsession_id\x18\x01 \x01(\tR\tsessionId\x12\x14\n\x05\x65rror\x18\x02 \x01(\tR\x05\x65rror\"r\n\x13WaitSessionsRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x1f\n\x0bsession_ids\x18\x02 \x03(\tR\nsessionIds\x12\x1d\n\ntimeout_ms\x18\x03 \x01(\x03R\ttimeoutMs\"\x8d\x01\n\rSessionResult\x12\x1d\n\nsession_id\x18\x01 \x01(\tR\tsessionId\x12\x14\n\x05state\x18\x02 \x01(\tR\x05state\x12\x31\n\x05value\x18\x03 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x05value\x12\x14\n\x05\x65rror\x18\x04 \x01(\tR\x05\x65rror\"n\n\x14WaitSessionsResponse\x12@\n\x07results\x18\x01 \x03(\x0b\x32&.autokitteh.user_code.v1.SessionResultR\x07results\x12\x14\n\x05\x65rror\x18\x02 \x01(\tR\x05\x65rror\"\xfb\x01\n\x10\x45ncodeJWTRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12P\n\x07payload\x18\x02 \x03(\x0b\x32\x36.autokitteh.user_code.v1.EncodeJWTRequest.PayloadEntryR\x07payload\x12\x1e\n\nconnection\x18\x03 \x01(\tR\nconnection\x12\x1c\n\talgorithm\x18\x04 \x01(\tR\talgorithm\x1a:\n\x0cPayloadEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x03R\x05value:\x02\x38\x01\";\n\x11\x45ncodeJWTResponse\x12\x10\n\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x14\n\x05\x65rror\x18\x02 \x01(\tR\x05\x65rror\"o\n\x0eRefreshRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12 \n\x0bintegration\x18\x02 \x01(\tR\x0bintegration\x12\x1e\n\nconnection\x18\x03 \x01(\tR\nconnection\"s\n\x0fRefreshResponse\x12\x14\n\x05token\x18\x01 \x01(\tR\x05token\x12\x34\n\x07\x65xpires\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x07\x65xpires\x12\x14\n\x05\x65rror\x18\x03 \x01(\tR\x05\x65rror\"4\n\x15IsActiveRunnerRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\"K\n\x16IsActiveRunnerResponse\x12\x1b\n\tis_active\x18\x01 \x01(\x08R\x08isActive\x12\x14\n\x05\x65rror\x18\x02 \x01(\tR\x05\x65rror\"\x16\n\x14HandlerHealthRequest\"-\n\x15HandlerHealthResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror\"\xbb\x01\n\x13\x45xecuteReplyRequest\x12\x1b\n\trunner_id\x18\x01 \x01(\tR\x08runnerId\x12\x33\n\x06result\x18\x02 \x01(\x0b\x32\x1b.autokitteh.values.v1.ValueR\x06result\x12\x14\n\x05\x65rror\x18\x03 \x01(\tR\x05\x65rror\x12<\n\ttraceback\x18\x04 \x03(\x0b\x32\x1e.autokitteh.user_code.v1.FrameR\ttraceback\",\n\x14\x45xecuteReplyResponse\x12\x14\n\x05\x65rror\x18\x01 \x01(\tR\x05\x65rror2\xd5\x14\n\x0eHandlerService\x12\x61\n\x08\x41\x63tivity\x12(.autokitteh.user_code.v1.ActivityRequest\x1a).autokitteh.user_code.v1.ActivityResponse\"\x00\x12m\n\x0c\x45xecuteReply\x12,.autokitteh.user_code.v1.ExecuteReplyRequest\x1a-.autokitteh.user_code.v1.ExecuteReplyResponse\"\x00\x12U\n\x04\x44one\x12$.autokitteh.user_code.v1.DoneRequest\x1a%.autokitteh.user_code.v1.DoneResponse\"\x00\x12R\n\x03Log\x12#.autokitteh.user_code.v1.LogRequest\x1a$.autokitteh.user_code.v1.LogResponse\"\x00\x12X\n\x05Print\x12%.autokitteh.user_code.v1.PrintRequest\x1a&.autokitteh.user_code.v1.PrintResponse\"\x00\x12X\n\x05Sleep\x12%.autokitteh.user_code.v1.SleepRequest\x1a&.autokitteh.user_code.v1.SleepResponse\"\x00\x12\x64\n\tSubscribe\x12).autokitteh.user_code.v1.SubscribeRequest\x1a*.autokitteh.user_code.v1.SubscribeResponse\"\x00\x12\x64\n\tNextEvent\x12).autokitteh.user_code.v1.NextEventRequest\x1a*.autokitteh.user_code.v1.NextEventResponse\"\x00\x12j\n\x0bUnsubscribe\x12+.autokitteh.user_code.v1.UnsubscribeRequest\x1a,.autokitteh.user_code.v1.UnsubscribeResponse\"\x00\x12m\n\x0cStartSession\x12,.autokitteh.user_code.v1.StartSessionRequest\x1a-.autokitteh.user_code.v1.StartSessionResponse\"\x00\x12m\n\x0cWaitSessions\x12,.autokitteh.user_code.v1.WaitSessionsRequest\x1a-.autokitteh.user_code.v1.WaitSessionsResponse\"\x00\x12[\n\x06Signal\x12&.autokitteh.user_code.v1.SignalRequest\x1a\'.autokitteh.user_code.v1.SignalResponse\"\x00\x12g\n\nNextSignal\x12*.autokitteh.user_code.v1.NextSignalRequest\x1a+.autokitteh.user_code.v1.NextSignalResponse\"\x00\x12\x64\n\tStoreList\x12).autokitteh.user_code.v1.StoreListRequest\x1a*.autokitteh.user_code.v1.StoreListResponse\"\x00\x12j\n\x0bStoreMutate\x12+.autokitteh.user_code.v1.StoreMutateRequest\x1a,.autokitteh.user_code.v1.StoreMutateResponse\"\x00\x12m\n\x0cStorePublish\x12,.autokitteh.user_code.v1.StorePublishRequest\x1a-.autokitteh.user_code.v1.StorePublishResponse\"\x00\x12s\n\x0eStoreUnpublish\x12..autokitteh.user_code.v1.StoreUnpublishRequest\x1a/.autokitteh.user_code.v1.StoreUnpublishResponse\"\x00\x12^\n\x07Outcome\x12\'.autokitteh.user_code.v1.OutcomeRequest\x1a(.autokitteh.user_code.v1.OutcomeResponse\"\x00\x12p\n\rSetSessionTag\x12-.autokitteh.user_code.v1.SetSessionTagRequest\x1a..autokitteh.user_code.v1.SetSessionTagResponse\"\x00\x12v\n\x0fRequestApproval\x12/.autokitteh.user_code.v1.RequestApprovalRequest\x1a\x30.autokitteh.user_code.v1.RequestApprovalResponse\"\x00\x12\x85\x01\n\x14RegisterCompensation\x12\x34.autokitteh.user_code.v1.RegisterCompensationRequest\x1a\x35.autokitteh.user_code.v1.RegisterCompensationResponse\"\x00\x12\x64\n\tEncodeJWT\x12).autokitteh.user_code.v1.EncodeJWTRequest\x1a*.autokitteh.user_code.v1.EncodeJWTResponse\"\x00\x12h\n\x11RefreshOAuthToken\x12\'.autokitteh.user_code.v1.RefreshRequest\x1a(.autokitteh.user_code.v1.RefreshResponse\"\x00\x12i\n\x06Health\x12-.autokitteh.user_code.v1.HandlerHealthRequest\x1a..autokitteh.user_code.v1.HandlerHealthResponse\"\x00\x12s\n\x0eIsActiveRunner\x12..autokitteh.user_code.v1.IsActiveRunnerRequest\x1a/.autokitteh.user_code.v1.IsActiveRunnerResponse\"\x00\x42\xf7\x01\n\x1b\x63om.autokitteh.user_code.v1B\x0fHandlerSvcProtoP\x01ZMgo.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/user_code/v1;user_codev1\xa2\x02\x03\x41UX\xaa\x02\x16\x41utokitteh.UserCode.V1\xca\x02\x16\x41utokitteh\\UserCode\\V1\xe2\x02\"Autokitteh\\UserCode\\V1\\GPBMetadata\xea\x02\x18\x41utokitteh::UserCode::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -94,32 +94,50 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _globals['_OUTCOMEREQUEST']._serialized_end=2856
   _globals['_OUTCOMERESPONSE']._serialized_start=2858
   _globals['_OUTCOMERESPONSE']._serialized_end=2897
-  _globals['_STARTSESSIONREQUEST']._serialized_start=2900
-  _globals['_STARTSESSIONREQUEST']._serialized_end=3034
-  _globals['_STARTSESSIONRESPONSE']._serialized_start=3036
-  _globals['_STARTSESSIONRESPONSE']._serialized_end=3111
-  _globals['_ENCODEJWTREQUEST']._serialized_start=3114
-  _globals['_ENCODEJWTREQUEST']._serialized_end=3365
-  _globals['_ENCODEJWTREQUEST_PAYLOADENTRY']._serialized_start=3307
-  _globals['_ENCODEJWTREQUEST_PAYLOADENTRY']._serialized_end=3365
-  _globals['_ENCODEJWTRESPONSE']._serialized_start=3367
-  _globals['_ENCODEJWTRESPONSE']._serialized_end=3426
-  _globals['_REFRESHREQUEST']._serialized_start=3428
-  _globals['_REFRESHREQUEST']._serialized_end=3539
-  _globals['_REFRESHRESPONSE']._serialized_start=3541
-  _globals['_REFRESHRESPONSE']._serialized_end=3656
-  _globals['_ISACTIVERUNNERREQUEST']._serialized_start=3658
-  _globals['_ISACTIVERUNNERREQUEST']._serialized_end=3710
-  _globals['_ISACTIVERUNNERRESPONSE']._serialized_start=3712
-  _globals['_ISACTIVERUNNERRESPONSE']._serialized_end=3787
-  _globals['_HANDLERHEALTHREQUEST']._serialized_start=3789
-  _globals['_HANDLERHEALTHREQUEST']._serialized_end=3811
-  _globals['_HANDLERHEALTHRESPONSE']._serialized_start=3813
-  _globals['_HANDLERHEALTHRESPONSE']._serialized_end=3858
-  _globals['_EXECUTEREPLYREQUEST']._serialized_start=3861
-  _globals['_EXECUTEREPLYREQUEST']._serialized_end=4048
-  _globals['_EXECUTEREPLYRESPONSE']._serialized_start=4050
-  _globals['_EXECUTEREPLYRESPONSE']._serialized_end=4094
-  _globals['_HANDLERSERVICE']._serialized_start=4097
-  _globals['_HANDLERSERVICE']._serialized_end=6261
+  _globals['_SETSESSIONTAGREQUEST']._serialized_start=2899
+  _globals['_SETSESSIONTAGREQUEST']._serialized_end=2990
+  _globals['_SETSESSIONTAGRESPONSE']._serialized_start=2992
+  _globals['_SETSESSIONTAGRESPONSE']._serialized_end=3037
+  _globals['_REQUESTAPPROVALREQUEST']._serialized_start=3040
+  _globals['_REQUESTAPPROVALREQUEST']._serialized_end=3176
+  _globals['_REQUESTAPPROVALRESPONSE']._serialized_start=3179
+  _globals['_REQUESTAPPROVALRESPONSE']._serialized_end=3338
+  _globals['_REGISTERCOMPENSATIONREQUEST']._serialized_start=3340
+  _globals['_REGISTERCOMPENSATIONREQUEST']._serialized_end=3438
+  _globals['_REGISTERCOMPENSATIONRESPONSE']._serialized_start=3440
+  _globals['_REGISTERCOMPENSATIONRESPONSE']._serialized_end=3492
+  _globals['_STARTSESSIONREQUEST']._serialized_start=3495
+  _globals['_STARTSESSIONREQUEST']._serialized_end=3629
+  _globals['_STARTSESSIONRESPONSE']._serialized_start=3631
+  _globals['_STARTSESSIONRESPONSE']._serialized_end=3706
+  _globals['_WAITSESSIONSREQUEST']._serialized_start=3708
+  _globals['_WAITSESSIONSREQUEST']._serialized_end=3822
+  _globals['_SESSIONRESULT']._serialized_start=3825
+  _globals['_SESSIONRESULT']._serialized_end=3966
+  _globals['_WAITSESSIONSRESPONSE']._serialized_start=3968
+  _globals['_WAITSESSIONSRESPONSE']._serialized_end=4078
+  _globals['_ENCODEJWTREQUEST']._serialized_start=4081
+  _globals['_ENCODEJWTREQUEST']._serialized_end=4332
+  _globals['_ENCODEJWTREQUEST_PAYLOADENTRY']._serialized_start=4274
+  _globals['_ENCODEJWTREQUEST_PAYLOADENTRY']._serialized_end=4332
+  _globals['_ENCODEJWTRESPONSE']._serialized_start=4334
+  _globals['_ENCODEJWTRESPONSE']._serialized_end=4393
+  _globals['_REFRESHREQUEST']._serialized_start=4395
+  _globals['_REFRESHREQUEST']._serialized_end=4506
+  _globals['_REFRESHRESPONSE']._serialized_start=4508
+  _globals['_REFRESHRESPONSE']._serialized_end=4623
+  _globals['_ISACTIVERUNNERREQUEST']._serialized_start=4625
+  _globals['_ISACTIVERUNNERREQUEST']._serialized_end=4677
+  _globals['_ISACTIVERUNNERRESPONSE']._serialized_start=4679
+  _globals['_ISACTIVERUNNERRESPONSE']._serialized_end=4754
+  _globals['_HANDLERHEALTHREQUEST']._serialized_start=4756
+  _globals['_HANDLERHEALTHREQUEST']._serialized_end=4778
+  _globals['_HANDLERHEALTHRESPONSE']._serialized_start=4780
+  _globals['_HANDLERHEALTHRESPONSE']._serialized_end=4825
+  _globals['_EXECUTEREPLYREQUEST']._serialized_start=4828
+  _globals['_EXECUTEREPLYREQUEST']._serialized_end=5015
+  _globals['_EXECUTEREPLYRESPONSE']._serialized_start=5017
+  _globals['_EXECUTEREPLYRESPONSE']._serialized_end=5061
+  _globals['_HANDLERSERVICE']._serialized_start=5064
+  _globals['_HANDLERSERVICE']._serialized_end=7709
 # @@protoc_insertion_point(module_scope)
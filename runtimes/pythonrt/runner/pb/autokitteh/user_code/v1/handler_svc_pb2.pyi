@@ -271,6 +271,64 @@ class OutcomeResponse(_message.Message):
     error: str
     def __init__(self, error: _Optional[str] = ...) -> None: ...
 
+class SetSessionTagRequest(_message.Message):
+    __slots__ = ["runner_id", "key", "value"]
+    RUNNER_ID_FIELD_NUMBER: _ClassVar[int]
+    KEY_FIELD_NUMBER: _ClassVar[int]
+    VALUE_FIELD_NUMBER: _ClassVar[int]
+    runner_id: str
+    key: str
+    value: str
+    def __init__(self, runner_id: _Optional[str] = ..., key: _Optional[str] = ..., value: _Optional[str] = ...) -> None: ...
+
+class SetSessionTagResponse(_message.Message):
+    __slots__ = ["error"]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
+    error: str
+    def __init__(self, error: _Optional[str] = ...) -> None: ...
+
+class RequestApprovalRequest(_message.Message):
+    __slots__ = ["runner_id", "title", "approvers", "timeout_ms"]
+    RUNNER_ID_FIELD_NUMBER: _ClassVar[int]
+    TITLE_FIELD_NUMBER: _ClassVar[int]
+    APPROVERS_FIELD_NUMBER: _ClassVar[int]
+    TIMEOUT_MS_FIELD_NUMBER: _ClassVar[int]
+    runner_id: str
+    title: str
+    approvers: _containers.RepeatedScalarFieldContainer[str]
+    timeout_ms: int
+    def __init__(self, runner_id: _Optional[str] = ..., title: _Optional[str] = ..., approvers: _Optional[_Iterable[str]] = ..., timeout_ms: _Optional[int] = ...) -> None: ...
+
+class RequestApprovalResponse(_message.Message):
+    __slots__ = ["error", "approval_id", "state", "decided_by", "comment"]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
+    APPROVAL_ID_FIELD_NUMBER: _ClassVar[int]
+    STATE_FIELD_NUMBER: _ClassVar[int]
+    DECIDED_BY_FIELD_NUMBER: _ClassVar[int]
+    COMMENT_FIELD_NUMBER: _ClassVar[int]
+    error: str
+    approval_id: str
+    state: str
+    decided_by: str
+    comment: str
+    def __init__(self, error: _Optional[str] = ..., approval_id: _Optional[str] = ..., state: _Optional[str] = ..., decided_by: _Optional[str] = ..., comment: _Optional[str] = ...) -> None: ...
+
+class RegisterCompensationRequest(_message.Message):
+    __slots__ = ["runner_id", "name", "data"]
+    RUNNER_ID_FIELD_NUMBER: _ClassVar[int]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    DATA_FIELD_NUMBER: _ClassVar[int]
+    runner_id: str
+    name: str
+    data: bytes
+    def __init__(self, runner_id: _Optional[str] = ..., name: _Optional[str] = ..., data: _Optional[bytes] = ...) -> None: ...
+
+class RegisterCompensationResponse(_message.Message):
+    __slots__ = ["error"]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
+    error: str
+    def __init__(self, error: _Optional[str] = ...) -> None: ...
+
 class StartSessionRequest(_message.Message):
     __slots__ = ["runner_id", "loc", "data", "memo", "project"]
     RUNNER_ID_FIELD_NUMBER: _ClassVar[int]
@@ -293,6 +351,36 @@ class StartSessionResponse(_message.Message):
     error: str
     def __init__(self, session_id: _Optional[str] = ..., error: _Optional[str] = ...) -> None: ...
 
+class WaitSessionsRequest(_message.Message):
+    __slots__ = ["runner_id", "session_ids", "timeout_ms"]
+    RUNNER_ID_FIELD_NUMBER: _ClassVar[int]
+    SESSION_IDS_FIELD_NUMBER: _ClassVar[int]
+    TIMEOUT_MS_FIELD_NUMBER: _ClassVar[int]
+    runner_id: str
+    session_ids: _containers.RepeatedScalarFieldContainer[str]
+    timeout_ms: int
+    def __init__(self, runner_id: _Optional[str] = ..., session_ids: _Optional[_Iterable[str]] = ..., timeout_ms: _Optional[int] = ...) -> None: ...
+
+class SessionResult(_message.Message):
+    __slots__ = ["session_id", "state", "value", "error"]
+    SESSION_ID_FIELD_NUMBER: _ClassVar[int]
+    STATE_FIELD_NUMBER: _ClassVar[int]
+    VALUE_FIELD_NUMBER: _ClassVar[int]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
+    session_id: str
+    state: str
+    value: _values_pb2.Value
+    error: str
+    def __init__(self, session_id: _Optional[str] = ..., state: _Optional[str] = ..., value: _Optional[_Union[_values_pb2.Value, _Mapping]] = ..., error: _Optional[str] = ...) -> None: ...
+
+class WaitSessionsResponse(_message.Message):
+    __slots__ = ["results", "error"]
+    RESULTS_FIELD_NUMBER: _ClassVar[int]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
+    results: _containers.RepeatedCompositeFieldContainer[SessionResult]
+    error: str
+    def __init__(self, results: _Optional[_Iterable[_Union[SessionResult, _Mapping]]] = ..., error: _Optional[str] = ...) -> None: ...
+
 class EncodeJWTRequest(_message.Message):
     __slots__ = ["runner_id", "payload", "connection", "algorithm"]
     class PayloadEntry(_message.Message):
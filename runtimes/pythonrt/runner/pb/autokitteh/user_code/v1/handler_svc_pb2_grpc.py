@@ -64,6 +64,11 @@ class HandlerServiceStub(object):
                 request_serializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.StartSessionRequest.SerializeToString,
                 response_deserializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.StartSessionResponse.FromString,
                 _registered_method=True)
+        self.WaitSessions = channel.unary_unary(
+                '/autokitteh.user_code.v1.HandlerService/WaitSessions',
+                request_serializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.WaitSessionsRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.WaitSessionsResponse.FromString,
+                _registered_method=True)
         self.Signal = channel.unary_unary(
                 '/autokitteh.user_code.v1.HandlerService/Signal',
                 request_serializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.SignalRequest.SerializeToString,
@@ -99,6 +104,21 @@ class HandlerServiceStub(object):
                 request_serializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.OutcomeRequest.SerializeToString,
                 response_deserializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.OutcomeResponse.FromString,
                 _registered_method=True)
+        self.SetSessionTag = channel.unary_unary(
+                '/autokitteh.user_code.v1.HandlerService/SetSessionTag',
+                request_serializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.SetSessionTagRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.SetSessionTagResponse.FromString,
+                _registered_method=True)
+        self.RequestApproval = channel.unary_unary(
+                '/autokitteh.user_code.v1.HandlerService/RequestApproval',
+                request_serializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.RequestApprovalRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.RequestApprovalResponse.FromString,
+                _registered_method=True)
+        self.RegisterCompensation = channel.unary_unary(
+                '/autokitteh.user_code.v1.HandlerService/RegisterCompensation',
+                request_serializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.RegisterCompensationRequest.SerializeToString,
+                response_deserializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.RegisterCompensationResponse.FromString,
+                _registered_method=True)
         self.EncodeJWT = channel.unary_unary(
                 '/autokitteh.user_code.v1.HandlerService/EncodeJWT',
                 request_serializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.EncodeJWTRequest.SerializeToString,
@@ -190,6 +210,12 @@ class HandlerServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def WaitSessions(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def Signal(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -232,6 +258,24 @@ class HandlerServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def SetSessionTag(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RequestApproval(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RegisterCompensation(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def EncodeJWT(self, request, context):
         """Utility functions
         """
@@ -310,6 +354,11 @@ def add_HandlerServiceServicer_to_server(servicer, server):
                     request_deserializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.StartSessionRequest.FromString,
                     response_serializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.StartSessionResponse.SerializeToString,
             ),
+            'WaitSessions': grpc.unary_unary_rpc_method_handler(
+                    servicer.WaitSessions,
+                    request_deserializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.WaitSessionsRequest.FromString,
+                    response_serializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.WaitSessionsResponse.SerializeToString,
+            ),
             'Signal': grpc.unary_unary_rpc_method_handler(
                     servicer.Signal,
                     request_deserializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.SignalRequest.FromString,
@@ -345,6 +394,21 @@ def add_HandlerServiceServicer_to_server(servicer, server):
                     request_deserializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.OutcomeRequest.FromString,
                     response_serializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.OutcomeResponse.SerializeToString,
             ),
+            'SetSessionTag': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetSessionTag,
+                    request_deserializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.SetSessionTagRequest.FromString,
+                    response_serializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.SetSessionTagResponse.SerializeToString,
+            ),
+            'RequestApproval': grpc.unary_unary_rpc_method_handler(
+                    servicer.RequestApproval,
+                    request_deserializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.RequestApprovalRequest.FromString,
+                    response_serializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.RequestApprovalResponse.SerializeToString,
+            ),
+            'RegisterCompensation': grpc.unary_unary_rpc_method_handler(
+                    servicer.RegisterCompensation,
+                    request_deserializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.RegisterCompensationRequest.FromString,
+                    response_serializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.RegisterCompensationResponse.SerializeToString,
+            ),
             'EncodeJWT': grpc.unary_unary_rpc_method_handler(
                     servicer.EncodeJWT,
                     request_deserializer=autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.EncodeJWTRequest.FromString,
@@ -646,6 +710,33 @@ class HandlerService(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def WaitSessions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/autokitteh.user_code.v1.HandlerService/WaitSessions',
+            autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.WaitSessionsRequest.SerializeToString,
+            autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.WaitSessionsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def Signal(request,
             target,
@@ -835,6 +926,87 @@ class HandlerService(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def SetSessionTag(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/autokitteh.user_code.v1.HandlerService/SetSessionTag',
+            autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.SetSessionTagRequest.SerializeToString,
+            autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.SetSessionTagResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def RequestApproval(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/autokitteh.user_code.v1.HandlerService/RequestApproval',
+            autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.RequestApprovalRequest.SerializeToString,
+            autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.RequestApprovalResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def RegisterCompensation(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/autokitteh.user_code.v1.HandlerService/RegisterCompensation',
+            autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.RegisterCompensationRequest.SerializeToString,
+            autokitteh_dot_user__code_dot_v1_dot_handler__svc__pb2.RegisterCompensationResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def EncodeJWT(request,
             target,
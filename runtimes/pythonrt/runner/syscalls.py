@@ -6,11 +6,12 @@ matching function in autokitteh (e.g. autokitteh.start).
 
 import json
 import os
+from collections.abc import Iterable
 from datetime import timedelta
 from typing import Any
 
 import grpc
-from autokitteh import AttrDict, AutoKittehError, Event, Signal
+from autokitteh import Approval, AttrDict, AutoKittehError, Event, Signal
 from autokitteh.activities import ACTIVITY_ATTR
 
 import log
@@ -36,11 +37,28 @@ def _timeout_arg_into_ms(timeout: timedelta | int | float) -> int:
     raise TypeError(f"timeout {timeout!r} should be a timedelta or number of seconds")
 
 
+def _duration_operand(
+    d: timedelta | int | float | None, name: str = "ttl"
+) -> list[timedelta]:
+    """Store operations take ttls and leases as duration operands."""
+    if d is None:
+        return []
+
+    if isinstance(d, int | float):
+        d = timedelta(seconds=d)
+    elif not isinstance(d, timedelta):
+        raise TypeError(f"{name} {d!r} should be a timedelta or number of seconds")
+
+    return [d]
+
+
 class SysCalls:
     def __init__(self, runner_id, worker, log):
         self.runner_id = runner_id
         self.worker = worker
         self.log = log
+        # Registered compensations, by the data sent to ak on registration.
+        self.compensations: dict[bytes, tuple] = {}
         self.mark_ak_no_activity()
 
     def ak_start(
@@ -74,6 +92,41 @@ class SysCalls:
         resp = call_grpc("start", self.worker.StartSession, req)
         return resp.session_id
 
+    def ak_wait_session(
+        self, session_id: str, *, timeout: timedelta | int | float = None
+    ) -> Any:
+        log.debug("ak_wait_session: %r %r", session_id, timeout)
+        return self._wait_sessions([session_id], timeout)[0]
+
+    def ak_join_all(
+        self, session_ids: list[str], *, timeout: timedelta | int | float = None
+    ) -> list[Any]:
+        log.debug("ak_join_all: %r %r", session_ids, timeout)
+        return self._wait_sessions(session_ids, timeout)
+
+    def _wait_sessions(
+        self, session_ids: list[str], timeout: timedelta | int | float
+    ) -> list[Any]:
+        req = pb.WaitSessionsRequest(
+            runner_id=self.runner_id,
+            session_ids=session_ids,
+            timeout_ms=_timeout_arg_into_ms(timeout),
+        )
+        resp = call_grpc("wait_sessions", self.worker.WaitSessions, req)
+
+        results = []
+        for r in resp.results:
+            if r.state == "completed":
+                results.append(values.unwrap(r.value))
+            elif r.state == "error":
+                raise AutoKittehError(f"session {r.session_id}: {r.error}")
+            elif r.state == "stopped":
+                raise AutoKittehError(f"session {r.session_id} stopped: {r.error}")
+            else:
+                raise TimeoutError(f"session {r.session_id}: timed out")
+
+        return results
+
     def ak_sleep(self, seconds):
         log.debug("ak_sleep: %r", seconds)
         if seconds < 0:
@@ -221,13 +274,34 @@ class SysCalls:
         resp = call_grpc("store_mutate", self.worker.StoreMutate, req)
         return values.unwrap(resp.result)
 
-    def ak_set_value(self, key: str, value: Any) -> None:
-        self.ak_mutate_value(key, "set", value)
+    def ak_set_value(
+        self, key: str, value: Any, ttl: timedelta | float | None = None
+    ) -> None:
+        self.ak_mutate_value(key, "set", value, *_duration_operand(ttl))
 
     def ak_check_and_set_value(
-        self, key: str, expected_value: Any, new_value: Any
+        self,
+        key: str,
+        expected_value: Any,
+        new_value: Any,
+        ttl: timedelta | float | None = None,
     ) -> bool:
-        return self.ak_mutate_value(key, "check_and_set", new_value, expected_value)
+        return self.ak_mutate_value(
+            key, "check_and_set", new_value, expected_value, *_duration_operand(ttl)
+        )
+
+    def ak_acquire_lock(self, key: str, lease: timedelta | float) -> bool:
+        return self.ak_mutate_value(
+            key, "acquire_lock", *_duration_operand(lease, "lease")
+        )
+
+    def ak_renew_lock(self, key: str, lease: timedelta | float) -> bool:
+        return self.ak_mutate_value(
+            key, "renew_lock", *_duration_operand(lease, "lease")
+        )
+
+    def ak_release_lock(self, key: str) -> bool:
+        return self.ak_mutate_value(key, "release_lock")
 
     def ak_add_values(self, key: str, value: int | float) -> int | float:
         return self.ak_mutate_value(key, "add", value)
@@ -266,6 +340,8 @@ class SysCalls:
         json: Any = None,
         headers: dict[str, str] = {},
         more: bool = False,
+        stream: str | None = None,
+        sse_event: str | None = None,
         event_id: str | None = None,
     ) -> None:
         out = {
@@ -283,8 +359,49 @@ class SysCalls:
         if json is not None:
             out["json"] = json
 
+        if stream is not None:
+            if stream not in ("chunked", "sse"):
+                raise ValueError(f"Invalid stream {stream!r}, must be 'chunked' or 'sse'")
+            out["stream"] = stream
+
+        if sse_event is not None:
+            out["sse_event"] = sse_event
+
         self.ak_outcome(out, event_id=event_id)
 
+    def ak_http_stream(
+        self,
+        chunks: Iterable[Any],
+        *,
+        sse: bool = False,
+        sse_event: str | None = None,
+        status_code: int = 200,
+        headers: dict[str, str] = {},
+        event_id: str | None = None,
+    ) -> None:
+        # The first outcome determines the status, headers and stream type of
+        # the response. The last one, without more, ends it.
+        self.ak_http_outcome(
+            status_code,
+            headers=headers,
+            more=True,
+            stream="sse" if sse else "chunked",
+            event_id=event_id,
+        )
+
+        # End the response even if producing the chunks fails, otherwise the
+        # client waits until it times out.
+        try:
+            for chunk in chunks:
+                self.ak_http_outcome(
+                    body=chunk,
+                    more=True,
+                    sse_event=sse_event,
+                    event_id=event_id,
+                )
+        finally:
+            self.ak_http_outcome(event_id=event_id)
+
     def ak_outcome(self, v: Any, *, event_id: str | None = None) -> None:
         log.debug("ak_outcome: %r", v)
         req = pb.OutcomeRequest(
@@ -294,6 +411,54 @@ class SysCalls:
         )
         call_grpc("outcome", self.worker.Outcome, req)
 
+    def ak_set_session_tag(self, key: str, value: str) -> None:
+        log.debug("ak_set_session_tag: %r=%r", key, value)
+        req = pb.SetSessionTagRequest(
+            runner_id=self.runner_id,
+            key=key,
+            value=value,
+        )
+        call_grpc("set_session_tag", self.worker.SetSessionTag, req)
+
+    def ak_request_approval(
+        self,
+        title: str,
+        approvers: list[str] | None = None,
+        *,
+        timeout: timedelta | int | float = None,
+    ) -> Approval:
+        log.debug("ak_request_approval: %r %r %r", title, approvers, timeout)
+        req = pb.RequestApprovalRequest(
+            runner_id=self.runner_id,
+            title=title,
+            approvers=approvers or [],
+            timeout_ms=_timeout_arg_into_ms(timeout),
+        )
+
+        resp = call_grpc("request_approval", self.worker.RequestApproval, req)
+
+        return Approval(
+            id=resp.approval_id,
+            state=resp.state,
+            decided_by=resp.decided_by,
+            comment=resp.comment,
+        )
+
+    def ak_register_compensation(self, fn, *args, **kw) -> None:
+        log.debug("ak_register_compensation: %r", fn)
+        if not callable(fn):
+            raise TypeError(f"compensation {fn!r} is not callable")
+
+        data = f"compensation:{len(self.compensations) + 1}".encode()
+        req = pb.RegisterCompensationRequest(
+            runner_id=self.runner_id,
+            name=getattr(fn, "__name__", repr(fn)),
+            data=data,
+        )
+        call_grpc("register_compensation", self.worker.RegisterCompensation, req)
+
+        self.compensations[data] = (fn, args, kw)
+
     @classmethod
     def mark_ak_no_activity(cls):
         """Mark ak_* methods as not activity."""
@@ -103,6 +103,37 @@ def test_execute():
     assert result.value == -6
 
 
+def test_execute_compensation():
+    runner = new_test_runner(workflows.simple)
+
+    class Worker:
+        def __init__(self):
+            self.event = Event()
+
+        def RegisterCompensation(self, msg):
+            self.registered = msg
+            return MagicMock(error="")
+
+        def ExecuteReply(self, msg):
+            self.msg = msg
+            self.event.set()
+            return MagicMock()
+
+    runner.worker = Worker()
+    runner.syscalls = main.SysCalls(runner.id, runner.worker, MagicMock())
+    runner.syscalls.ak_register_compensation(sub, 10, b=3)
+
+    req = runner_pb.ExecuteRequest(data=runner.worker.registered.data)
+    resp = runner.Execute(req, None)
+    assert resp.error == ""
+
+    triggered = runner.worker.event.wait(1)
+    assert triggered, "timeout waiting for worker event"
+
+    result = pickle.loads(runner.worker.msg.result.custom.data)
+    assert result.value == 7
+
+
 def test_activity_reply():
     runner = new_test_runner(workflows.simple)
     fut = Future()
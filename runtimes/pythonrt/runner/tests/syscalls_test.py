@@ -1,6 +1,10 @@
 from unittest.mock import MagicMock
 
+import pb.autokitteh.user_code.v1.handler_svc_pb2 as pb
+import pytest
 import syscalls
+import values
+from autokitteh import AutoKittehError
 
 
 def test_ak_unsubscribe(monkeypatch):
@@ -15,3 +19,85 @@ def test_ak_unsubscribe(monkeypatch):
     req = mock.call_args[0][2]
     assert req.runner_id == rid
     assert req.signal_id == sid
+
+
+def test_ak_join_all(monkeypatch):
+    resp = pb.WaitSessionsResponse(
+        results=[
+            pb.SessionResult(
+                session_id="s1", state="completed", value=values.wrap(1)
+            ),
+            pb.SessionResult(
+                session_id="s2", state="completed", value=values.wrap("two")
+            ),
+        ]
+    )
+    mock = MagicMock(return_value=resp)
+    monkeypatch.setattr(syscalls, "call_grpc", mock)
+
+    sc = syscalls.SysCalls("r1", mock, mock)
+    assert sc.ak_join_all(["s1", "s2"], timeout=3) == [1, "two"]
+
+    req = mock.call_args[0][2]
+    assert list(req.session_ids) == ["s1", "s2"]
+    assert req.timeout_ms == 3000
+
+
+wait_session_errors = [
+    ("error", AutoKittehError),
+    ("stopped", AutoKittehError),
+    ("", TimeoutError),
+]
+
+
+@pytest.mark.parametrize("state, exc", wait_session_errors)
+def test_ak_wait_session_not_completed(monkeypatch, state, exc):
+    resp = pb.WaitSessionsResponse(
+        results=[pb.SessionResult(session_id="s1", state=state, error="oops")]
+    )
+    mock = MagicMock(return_value=resp)
+    monkeypatch.setattr(syscalls, "call_grpc", mock)
+
+    sc = syscalls.SysCalls("r1", mock, mock)
+    with pytest.raises(exc):
+        sc.ak_wait_session("s1")
+
+
+def test_ak_http_stream(monkeypatch):
+    mock = MagicMock()
+    monkeypatch.setattr(syscalls, "call_grpc", mock)
+
+    sc = syscalls.SysCalls("r1", mock, mock)
+    sc.ak_http_stream(iter(["hello", "world"]), sse=True, sse_event="token")
+
+    outs = [values.unwrap(call[0][2].value) for call in mock.call_args_list]
+    assert [out.get("stream") for out in outs] == ["sse", None, None, None]
+    assert [out.get("body") for out in outs] == [None, "hello", "world", None]
+    assert [out["more"] for out in outs] == [True, True, True, False]
+    assert outs[1]["sse_event"] == "token"
+
+
+def test_ak_http_stream_error(monkeypatch):
+    mock = MagicMock()
+    monkeypatch.setattr(syscalls, "call_grpc", mock)
+
+    def chunks():
+        yield "hello"
+        raise RuntimeError("oops")
+
+    sc = syscalls.SysCalls("r1", mock, mock)
+    with pytest.raises(RuntimeError):
+        sc.ak_http_stream(chunks())
+
+    outs = [values.unwrap(call[0][2].value) for call in mock.call_args_list]
+    assert [out.get("body") for out in outs] == [None, "hello", None]
+    assert [out["more"] for out in outs] == [True, True, False]
+
+
+def test_ak_http_outcome_bad_stream(monkeypatch):
+    mock = MagicMock()
+    monkeypatch.setattr(syscalls, "call_grpc", mock)
+
+    sc = syscalls.SysCalls("r1", mock, mock)
+    with pytest.raises(ValueError):
+        sc.ak_http_outcome(stream="carrier-pigeon")
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -305,6 +306,54 @@ func (s *workerGRPCHandler) StartSession(ctx context.Context, req *userCode.Star
 	return &userCode.StartSessionResponse{SessionId: resp.value.(sdktypes.SessionID).String()}, nil
 }
 
+func (s *workerGRPCHandler) WaitSessions(ctx context.Context, req *userCode.WaitSessionsRequest) (*userCode.WaitSessionsResponse, error) {
+	if req.TimeoutMs < 0 {
+		return nil, status.Error(codes.InvalidArgument, "timeout < 0")
+	}
+
+	sids, err := kittehs.TransformError(req.SessionIds, sdktypes.StrictParseSessionID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "can't parse session id: %v", err)
+	}
+
+	fn := func(ctx context.Context, cbs *sdkservices.RunCallbacks, rid sdktypes.RunID) (any, error) {
+		return cbs.WaitSessions(ctx, rid, sids, time.Duration(req.TimeoutMs)*time.Millisecond)
+	}
+
+	resp, err := s.callback(ctx, req.RunnerId, "wait_sessions", fn)
+	if err != nil {
+		return &userCode.WaitSessionsResponse{Error: err.Error()}, nil
+	}
+
+	if resp.err != nil {
+		err = status.Errorf(codes.Internal, "wait_sessions(%v) -> %v", req.SessionIds, resp.err)
+		return &userCode.WaitSessionsResponse{Error: err.Error()}, nil
+	}
+
+	states := resp.value.([]sdktypes.SessionState)
+
+	results := make([]*userCode.SessionResult, len(states))
+	for i, state := range states {
+		r := &userCode.SessionResult{SessionId: req.SessionIds[i]}
+
+		switch state.Type() {
+		case sdktypes.SessionStateTypeCompleted:
+			r.State = "completed"
+			r.Value = state.GetCompleted().ReturnValue().ToProto()
+		case sdktypes.SessionStateTypeError:
+			r.State = "error"
+			r.Error = state.GetError().GetProgramError().ErrorString()
+		case sdktypes.SessionStateTypeStopped:
+			r.State = "stopped"
+			r.Error = state.GetStopped().Reason()
+		}
+
+		results[i] = r
+	}
+
+	return &userCode.WaitSessionsResponse{Results: results}, nil
+}
+
 func (s *workerGRPCHandler) Subscribe(ctx context.Context, req *userCode.SubscribeRequest) (*userCode.SubscribeResponse, error) {
 	if req.Connection == "" {
 		return nil, status.Error(codes.InvalidArgument, "missing connection name or filter")
@@ -646,3 +695,86 @@ func (s *workerGRPCHandler) Outcome(ctx context.Context, req *userCode.OutcomeRe
 
 	return &userCode.OutcomeResponse{}, nil
 }
+
+func (s *workerGRPCHandler) SetSessionTag(ctx context.Context, req *userCode.SetSessionTagRequest) (*userCode.SetSessionTagResponse, error) {
+	fn := func(ctx context.Context, cbs *sdkservices.RunCallbacks, rid sdktypes.RunID) (any, error) {
+		return nil, cbs.SetSessionTag(ctx, rid, req.Key, req.Value)
+	}
+
+	resp, err := s.callback(ctx, req.RunnerId, "set_session_tag", fn)
+	if err != nil {
+		return &userCode.SetSessionTagResponse{Error: err.Error()}, nil
+	}
+
+	if resp.err != nil {
+		err = status.Errorf(codes.Internal, "set_session_tag(%v) -> %v", req.Key, resp.err)
+		return &userCode.SetSessionTagResponse{Error: err.Error()}, nil
+	}
+
+	return &userCode.SetSessionTagResponse{}, nil
+}
+
+func (s *workerGRPCHandler) RegisterCompensation(ctx context.Context, req *userCode.RegisterCompensationRequest) (*userCode.RegisterCompensationResponse, error) {
+	runner := s.runnerByID(req.RunnerId)
+	if runner == nil {
+		s.log.Error("unknown runner ID", zap.String("id", req.RunnerId))
+		return &userCode.RegisterCompensationResponse{Error: "unknown runner ID"}, nil
+	}
+
+	// The runner identifies the compensation by its data once it is called.
+	v, err := sdktypes.NewFunctionValue(runner.xid, req.Name, req.Data, nil, pyModuleFunc)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "new function value: %v", err)
+	}
+
+	fn := func(ctx context.Context, cbs *sdkservices.RunCallbacks, rid sdktypes.RunID) (any, error) {
+		if err := cbs.RegisterCompensation(ctx, rid, v, nil, nil); err != nil {
+			return nil, err
+		}
+
+		runner.hasCompensations.Store(true)
+
+		return nil, nil
+	}
+
+	resp, err := s.callback(ctx, req.RunnerId, "register_compensation", fn)
+	if err != nil {
+		return &userCode.RegisterCompensationResponse{Error: err.Error()}, nil
+	}
+
+	if resp.err != nil {
+		err = status.Errorf(codes.Internal, "register_compensation(%q) -> %v", req.Name, resp.err)
+		return &userCode.RegisterCompensationResponse{Error: err.Error()}, nil
+	}
+
+	return &userCode.RegisterCompensationResponse{}, nil
+}
+
+func (s *workerGRPCHandler) RequestApproval(ctx context.Context, req *userCode.RequestApprovalRequest) (*userCode.RequestApprovalResponse, error) {
+	if req.TimeoutMs < 0 {
+		return nil, status.Error(codes.InvalidArgument, "timeout < 0")
+	}
+
+	fn := func(ctx context.Context, cbs *sdkservices.RunCallbacks, rid sdktypes.RunID) (any, error) {
+		return cbs.RequestApproval(ctx, rid, req.Title, req.Approvers, time.Duration(req.TimeoutMs)*time.Millisecond)
+	}
+
+	resp, err := s.callback(ctx, req.RunnerId, "request_approval", fn)
+	if err != nil {
+		return &userCode.RequestApprovalResponse{Error: err.Error()}, nil
+	}
+
+	if resp.err != nil {
+		err = status.Errorf(codes.Internal, "request_approval(%q) -> %v", req.Title, resp.err)
+		return &userCode.RequestApprovalResponse{Error: err.Error()}, nil
+	}
+
+	a := resp.value.(sdktypes.Approval)
+
+	return &userCode.RequestApprovalResponse{
+		ApprovalId: a.ID().String(),
+		State:      strings.ToLower(a.State().String()),
+		DecidedBy:  a.DecidedBy(),
+		Comment:    a.Comment(),
+	}, nil
+}
@@ -19,14 +19,17 @@ var eventStructCtor = sdktypes.NewSymbolValue(sdktypes.NewSymbol("event"))
 
 func LoadModule() starlark.StringDict {
 	return starlark.StringDict{
-		"is_deployment_active": starlark.NewBuiltin("is_deployment_active", IsDeploymentActive),
-		"next_event":           starlark.NewBuiltin("next_event", nextEvent),
-		"next_signal":          starlark.NewBuiltin("next_signal", nextSignal),
-		"signal":               starlark.NewBuiltin("signal", signal),
-		"start":                starlark.NewBuiltin("start", start),
-		"subscribe":            starlark.NewBuiltin("subscribe", subscribe),
-		"unsubscribe":          starlark.NewBuiltin("unsubscribe", unsubscribe),
-		"store":                store,
+		"is_deployment_active":  starlark.NewBuiltin("is_deployment_active", IsDeploymentActive),
+		"join_all":              starlark.NewBuiltin("join_all", joinAll),
+		"next_event":            starlark.NewBuiltin("next_event", nextEvent),
+		"next_signal":           starlark.NewBuiltin("next_signal", nextSignal),
+		"register_compensation": starlark.NewBuiltin("register_compensation", registerCompensation),
+		"signal":                starlark.NewBuiltin("signal", signal),
+		"start":                 starlark.NewBuiltin("start", start),
+		"subscribe":             starlark.NewBuiltin("subscribe", subscribe),
+		"unsubscribe":           starlark.NewBuiltin("unsubscribe", unsubscribe),
+		"wait_session":          starlark.NewBuiltin("wait_session", waitSession),
+		"store":                 store,
 	}
 }
 
@@ -99,6 +102,156 @@ func start(th *starlark.Thread, bi *starlark.Builtin, args starlark.Tuple, kwarg
 	return starlark.String(sid.String()), nil
 }
 
+func waitSession(th *starlark.Thread, bi *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		sid     string
+		timeout starlark.Value
+	)
+
+	if err := starlark.UnpackArgs(bi.Name(), args, kwargs, "session_id", &sid, "timeout?", &timeout); err != nil {
+		return nil, err
+	}
+
+	vs, err := waitSessions(th, []string{sid}, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return vs[0], nil
+}
+
+func joinAll(th *starlark.Thread, bi *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var timeout starlark.Value
+
+	if err := starlark.UnpackArgs(bi.Name(), nil, kwargs, "timeout?", &timeout); err != nil {
+		return nil, err
+	}
+
+	sids, err := kittehs.TransformError(args, func(v starlark.Value) (string, error) {
+		s, ok := v.(starlark.String)
+		if !ok {
+			return "", errors.New("session_ids: value must be a list of strings")
+		}
+
+		return s.GoString(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vs, err := waitSessions(th, sids, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return starlark.NewList(vs), nil
+}
+
+// waitSessions waits for the given child sessions and returns their return
+// values. It fails if any of them ended with an error, was stopped, or did
+// not finish before the timeout.
+func waitSessions(th *starlark.Thread, rawSIDs []string, timeout starlark.Value) ([]starlark.Value, error) {
+	sids, err := kittehs.TransformError(rawSIDs, sdktypes.StrictParseSessionID)
+	if err != nil {
+		return nil, sdkerrors.NewInvalidArgumentError("session_id: %w", err)
+	}
+
+	var duration time.Duration
+	if timeout != nil {
+		errInvalid := errors.New("timeout: value must be a valid integer or float")
+
+		switch t := timeout.(type) {
+		case starlark.NoneType:
+		case starlark.Int:
+			ui64, ok := t.Int64()
+			if !ok {
+				return nil, errInvalid
+			}
+			duration = time.Duration(ui64) * time.Second
+		case starlark.Float:
+			duration = time.Duration(float64(time.Second) * float64(t))
+		default:
+			return nil, errInvalid
+		}
+	}
+
+	tls := tls.Get(th)
+
+	wait := tls.Callbacks.WaitSessions
+	if wait == nil {
+		return nil, errors.New("not supported")
+	}
+
+	states, err := wait(tls.GoCtx, tls.RunID, sids, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	vs := make([]starlark.Value, len(states))
+
+	for i, state := range states {
+		sid := sids[i]
+
+		switch state.Type() {
+		case sdktypes.SessionStateTypeCompleted:
+			if vs[i], err = values.FromTLS(th).ToStarlarkValue(state.GetCompleted().ReturnValue()); err != nil {
+				return nil, fmt.Errorf("session %v: return value: %w", sid, err)
+			}
+		case sdktypes.SessionStateTypeError:
+			return nil, fmt.Errorf("session %v: %w", sid, state.GetError().GetProgramError().ToError())
+		case sdktypes.SessionStateTypeStopped:
+			return nil, fmt.Errorf("session %v stopped: %s", sid, state.GetStopped().Reason())
+		default:
+			return nil, fmt.Errorf("session %v: timed out", sid)
+		}
+	}
+
+	return vs, nil
+}
+
+func registerCompensation(th *starlark.Thread, bi *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("%s: missing function argument", bi.Name())
+	}
+
+	if _, ok := args[0].(starlark.Callable); !ok {
+		return nil, fmt.Errorf("%s: first argument must be a function", bi.Name())
+	}
+
+	vctx := values.FromTLS(th)
+
+	fn, err := vctx.FromStarlarkValue(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("function: %w", err)
+	}
+
+	fnArgs, err := kittehs.TransformError(args[1:], vctx.FromStarlarkValue)
+	if err != nil {
+		return nil, fmt.Errorf("args: %w", err)
+	}
+
+	fnKwargs := make(map[string]sdktypes.Value, len(kwargs))
+	for _, kv := range kwargs {
+		k := string(kv[0].(starlark.String))
+		if fnKwargs[k], err = vctx.FromStarlarkValue(kv[1]); err != nil {
+			return nil, fmt.Errorf("value for %v: %w", k, err)
+		}
+	}
+
+	tls := tls.Get(th)
+
+	register := tls.Callbacks.RegisterCompensation
+	if register == nil {
+		return nil, errors.New("not supported")
+	}
+
+	if err := register(tls.GoCtx, tls.RunID, fn, fnArgs, fnKwargs); err != nil {
+		return nil, err
+	}
+
+	return starlark.None, nil
+}
+
 func subscribe(th *starlark.Thread, bi *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
 	var name, filter string
 
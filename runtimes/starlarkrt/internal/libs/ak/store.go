@@ -14,9 +14,12 @@ import (
 var store = &starlarkstruct.Module{
 	Name: "store",
 	Members: starlark.StringDict{
-		"get":       starlark.NewBuiltin("get", get),
-		"list_keys": starlark.NewBuiltin("list_keys", listKeys),
-		"mutate":    starlark.NewBuiltin("mutate", mutate),
+		"get":          starlark.NewBuiltin("get", get),
+		"list_keys":    starlark.NewBuiltin("list_keys", listKeys),
+		"mutate":       starlark.NewBuiltin("mutate", mutate),
+		"acquire_lock": starlark.NewBuiltin("acquire_lock", acquireLock),
+		"renew_lock":   starlark.NewBuiltin("renew_lock", renewLock),
+		"release_lock": starlark.NewBuiltin("release_lock", releaseLock),
 	},
 }
 
@@ -30,6 +33,37 @@ func get(th *starlark.Thread, bi *starlark.Builtin, args starlark.Tuple, kwargs
 	return mutate(th, bi, starlark.Tuple{starlark.String(key), starlark.String("get")}, nil)
 }
 
+func acquireLock(th *starlark.Thread, bi *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	return leaseLock(th, bi, args, kwargs, "acquire_lock")
+}
+
+func renewLock(th *starlark.Thread, bi *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	return leaseLock(th, bi, args, kwargs, "renew_lock")
+}
+
+func leaseLock(th *starlark.Thread, bi *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple, op string) (starlark.Value, error) {
+	var (
+		key   string
+		lease starlark.Value
+	)
+
+	if err := starlark.UnpackArgs(bi.Name(), args, kwargs, "key", &key, "lease", &lease); err != nil {
+		return nil, err
+	}
+
+	return mutate(th, bi, starlark.Tuple{starlark.String(key), starlark.String(op), starlark.Tuple{lease}}, nil)
+}
+
+func releaseLock(th *starlark.Thread, bi *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var key string
+
+	if err := starlark.UnpackArgs(bi.Name(), args, kwargs, "key", &key); err != nil {
+		return nil, err
+	}
+
+	return mutate(th, bi, starlark.Tuple{starlark.String(key), starlark.String("release_lock")}, nil)
+}
+
 func listKeys(th *starlark.Thread, bi *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
 	if err := starlark.UnpackArgs(bi.Name(), args, kwargs); err != nil {
 		return nil, err
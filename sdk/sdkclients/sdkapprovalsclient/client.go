@@ -0,0 +1,73 @@
+package sdkapprovalsclient
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	approvalsv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/approvals/v1"
+	"go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/approvals/v1/approvalsv1connect"
+	"go.autokitteh.dev/autokitteh/sdk/internal/rpcerrors"
+	"go.autokitteh.dev/autokitteh/sdk/sdkclients/internal"
+	"go.autokitteh.dev/autokitteh/sdk/sdkclients/sdkclient"
+	"go.autokitteh.dev/autokitteh/sdk/sdkservices"
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+type client struct {
+	client approvalsv1connect.ApprovalsServiceClient
+}
+
+func New(p sdkclient.Params) sdkservices.Approvals {
+	return &client{client: internal.New(approvalsv1connect.NewApprovalsServiceClient, p)}
+}
+
+func (c *client) Get(ctx context.Context, id sdktypes.ApprovalID) (sdktypes.Approval, error) {
+	resp, err := c.client.Get(ctx, connect.NewRequest(&approvalsv1.GetRequest{ApprovalId: id.String()}))
+	if err != nil {
+		return sdktypes.InvalidApproval, rpcerrors.ToSDKError(err)
+	}
+
+	if err := internal.Validate(resp.Msg); err != nil {
+		return sdktypes.InvalidApproval, err
+	}
+
+	return sdktypes.StrictApprovalFromProto(resp.Msg.Approval)
+}
+
+func (c *client) List(ctx context.Context, filter sdkservices.ListApprovalsFilter) ([]sdktypes.Approval, error) {
+	resp, err := c.client.List(ctx, connect.NewRequest(&approvalsv1.ListRequest{
+		OrgId:     filter.OrgID.String(),
+		ProjectId: filter.ProjectID.String(),
+		SessionId: filter.SessionID.String(),
+		State:     filter.State.ToProto(),
+		Limit:     uint32(filter.Limit),
+	}))
+	if err != nil {
+		return nil, rpcerrors.ToSDKError(err)
+	}
+
+	if err := internal.Validate(resp.Msg); err != nil {
+		return nil, err
+	}
+
+	return kittehs.TransformError(resp.Msg.Approvals, sdktypes.StrictApprovalFromProto)
+}
+
+func (c *client) Decide(ctx context.Context, id sdktypes.ApprovalID, approve bool, comment string) (sdktypes.Approval, error) {
+	resp, err := c.client.Decide(ctx, connect.NewRequest(&approvalsv1.DecideRequest{
+		ApprovalId: id.String(),
+		Approve:    approve,
+		Comment:    comment,
+	}))
+	if err != nil {
+		return sdktypes.InvalidApproval, rpcerrors.ToSDKError(err)
+	}
+
+	if err := internal.Validate(resp.Msg); err != nil {
+		return sdktypes.InvalidApproval, err
+	}
+
+	return sdktypes.StrictApprovalFromProto(resp.Msg.Approval)
+}
@@ -2,6 +2,7 @@ package sdkclients
 
 import (
 	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	"go.autokitteh.dev/autokitteh/sdk/sdkclients/sdkapprovalsclient"
 	"go.autokitteh.dev/autokitteh/sdk/sdkclients/sdkauthclient"
 	"go.autokitteh.dev/autokitteh/sdk/sdkclients/sdkbuildsclient"
 	"go.autokitteh.dev/autokitteh/sdk/sdkclients/sdkclient"
@@ -22,6 +23,7 @@ import (
 )
 
 type client struct {
+	approvals    func() sdkservices.Approvals
 	auth         func() sdkservices.Auth
 	builds       func() sdkservices.Builds
 	connections  func() sdkservices.Connections
@@ -44,6 +46,7 @@ func New(params sdkclient.Params) sdkservices.Services {
 	return &client{
 		params: params, // just a dumb struct, no need to be lazy here.
 
+		approvals:    kittehs.LazyCache(sdkapprovalsclient.New, params),
 		auth:         kittehs.LazyCache(sdkauthclient.New, params),
 		builds:       kittehs.LazyCache(sdkbuildsclient.New, params),
 		connections:  kittehs.LazyCache(sdkconnectionsclient.New, params),
@@ -62,6 +65,7 @@ func New(params sdkclient.Params) sdkservices.Services {
 	}
 }
 
+func (c *client) Approvals() sdkservices.Approvals       { return c.approvals() }
 func (c *client) Auth() sdkservices.Auth                 { return c.auth() }
 func (c *client) Builds() sdkservices.Builds             { return c.builds() }
 func (c *client) Connections() sdkservices.Connections   { return c.connections() }
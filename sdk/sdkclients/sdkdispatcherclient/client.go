@@ -108,3 +108,68 @@ func (c *client) Dispatch(ctx context.Context, event sdktypes.Event, opts *sdkse
 		SignaledSessionIDs: signaledSids,
 	}, nil
 }
+
+func (c *client) ListDeadLetters(ctx context.Context, filter sdkservices.ListDeadLettersFilter) ([]sdktypes.DeadLetter, error) {
+	resp, err := c.client.ListDeadLetters(ctx, connect.NewRequest(
+		&dispatcherv1.ListDeadLettersRequest{
+			OrgId:          filter.OrgID.String(),
+			ProjectId:      filter.ProjectID.String(),
+			EventId:        filter.EventID.String(),
+			IncludeRetried: filter.IncludeRetried,
+			Limit:          uint32(filter.Limit),
+		},
+	))
+	if err != nil {
+		return nil, rpcerrors.ToSDKError(err)
+	}
+
+	if err := internal.Validate(resp.Msg); err != nil {
+		return nil, err
+	}
+
+	return kittehs.TransformError(resp.Msg.DeadLetters, sdktypes.StrictDeadLetterFromProto)
+}
+
+func (c *client) RetryDeadLetter(ctx context.Context, id sdktypes.DeadLetterID, opts *sdkservices.DispatchOptions) (*sdkservices.DispatchResponse, error) {
+	if opts == nil {
+		opts = &sdkservices.DispatchOptions{}
+	}
+
+	resp, err := c.client.RetryDeadLetter(
+		ctx,
+		connect.NewRequest(
+			&dispatcherv1.RetryDeadLetterRequest{
+				DeadLetterId: id.String(),
+				Wait:         opts.Wait,
+			},
+		),
+	)
+	if err != nil {
+		return nil, rpcerrors.ToSDKError(err)
+	}
+
+	if err := internal.Validate(resp.Msg); err != nil {
+		return nil, err
+	}
+
+	eventId, err := sdktypes.Strict(sdktypes.ParseEventID(resp.Msg.EventId))
+	if err != nil {
+		return nil, fmt.Errorf("invalid event id: %w", err)
+	}
+
+	startedSids, err := kittehs.TransformError(resp.Msg.StartedSessionIds, sdktypes.ParseSessionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session id: %w", err)
+	}
+
+	signaledSids, err := kittehs.TransformError(resp.Msg.SignaledSessionIds, sdktypes.ParseSessionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session id: %w", err)
+	}
+
+	return &sdkservices.DispatchResponse{
+		EventID:            eventId,
+		StartedSessionIDs:  startedSids,
+		SignaledSessionIDs: signaledSids,
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.autokitteh.dev/autokitteh/internal/kittehs"
 	sessionsv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/sessions/v1"
@@ -149,6 +150,41 @@ func (c *client) GetLog(ctx context.Context, filter sdkservices.SessionLogRecord
 	}, nil
 }
 
+func (c *client) WatchLog(ctx context.Context, filter sdkservices.SessionLogRecordsFilter, f func(sdktypes.SessionLogRecord) error) error {
+	stream, err := c.client.WatchLog(ctx, connect.NewRequest(&sessionsv1.WatchLogRequest{
+		SessionId: filter.SessionID.String(),
+		Types:     filter.Types,
+	}))
+	if err != nil {
+		return rpcerrors.ToSDKError(err)
+	}
+
+	defer stream.Close()
+
+	for stream.Receive() {
+		msg := stream.Msg()
+
+		if err := internal.Validate(msg); err != nil {
+			return err
+		}
+
+		r, err := sdktypes.SessionLogRecordFromProto(msg.Record)
+		if err != nil {
+			return err
+		}
+
+		if err := f(r); err != nil {
+			return err
+		}
+	}
+
+	if err := stream.Err(); err != nil {
+		return rpcerrors.ToSDKError(err)
+	}
+
+	return nil
+}
+
 func (c *client) DownloadLogs(ctx context.Context, sessionID sdktypes.SessionID) ([]byte, error) {
 	res, err := c.client.DownloadLogs(ctx, connect.NewRequest(&sessionsv1.DownloadLogsRequest{
 		SessionId: sessionID.String(),
@@ -159,28 +195,34 @@ func (c *client) DownloadLogs(ctx context.Context, sessionID sdktypes.SessionID)
 	return res.Msg.Data, nil
 }
 
-func (c *client) List(ctx context.Context, filter sdkservices.ListSessionsFilter) (*sdkservices.ListSessionResult, error) {
-	resp, err := c.client.List(ctx, connect.NewRequest(&sessionsv1.ListRequest{
+func listRequest(filter sdkservices.ListSessionsFilter) *sessionsv1.ListRequest {
+	req := &sessionsv1.ListRequest{
 		DeploymentId: filter.DeploymentID.String(),
 		OrgId:        filter.OrgID.String(),
 		ProjectId:    filter.ProjectID.String(),
 		EventId:      filter.EventID.String(),
 		BuildId:      filter.BuildID.String(),
 		StateType:    filter.StateType.ToProto(),
+		Tags:         filter.Tags,
 		CountOnly:    filter.CountOnly,
 		PageSize:     filter.PageSize,
 		Skip:         filter.Skip,
 		PageToken:    filter.PageToken,
-	}))
-	if err != nil {
-		return nil, rpcerrors.ToSDKError(err)
 	}
 
-	if err := internal.Validate(resp.Msg); err != nil {
-		return nil, err
+	if !filter.CreatedAfter.IsZero() {
+		req.CreatedAfter = timestamppb.New(filter.CreatedAfter)
+	}
+
+	if !filter.CreatedBefore.IsZero() {
+		req.CreatedBefore = timestamppb.New(filter.CreatedBefore)
 	}
 
-	xs, err := kittehs.TransformError(resp.Msg.Sessions, sdktypes.SessionFromProto)
+	return req
+}
+
+func listResult(pbsessions []*sdktypes.SessionPB, count int64, nextPageToken string) (*sdkservices.ListSessionResult, error) {
+	xs, err := kittehs.TransformError(pbsessions, sdktypes.SessionFromProto)
 	if err != nil {
 		return nil, err
 	}
@@ -188,12 +230,46 @@ func (c *client) List(ctx context.Context, filter sdkservices.ListSessionsFilter
 	return &sdkservices.ListSessionResult{
 		Sessions: xs,
 		PaginationResult: sdktypes.PaginationResult{
-			TotalCount:    resp.Msg.Count,
-			NextPageToken: resp.Msg.NextPageToken,
+			TotalCount:    count,
+			NextPageToken: nextPageToken,
 		},
 	}, nil
 }
 
+func (c *client) List(ctx context.Context, filter sdkservices.ListSessionsFilter) (*sdkservices.ListSessionResult, error) {
+	resp, err := c.client.List(ctx, connect.NewRequest(listRequest(filter)))
+	if err != nil {
+		return nil, rpcerrors.ToSDKError(err)
+	}
+
+	if err := internal.Validate(resp.Msg); err != nil {
+		return nil, err
+	}
+
+	return listResult(resp.Msg.Sessions, resp.Msg.Count, resp.Msg.NextPageToken)
+}
+
+func (c *client) Search(ctx context.Context, filter sdkservices.SearchSessionsFilter) (*sdkservices.ListSessionResult, error) {
+	req := sessionsv1.SearchRequest{
+		Filter:        listRequest(filter.ListSessionsFilter),
+		PrintsContain: filter.PrintsContain,
+		ErrorContains: filter.ErrorContains,
+		TriggerName:   filter.TriggerName,
+		EntryPoint:    filter.EntryPoint,
+	}
+
+	resp, err := c.client.Search(ctx, connect.NewRequest(&req))
+	if err != nil {
+		return nil, rpcerrors.ToSDKError(err)
+	}
+
+	if err := internal.Validate(resp.Msg); err != nil {
+		return nil, err
+	}
+
+	return listResult(resp.Msg.Sessions, resp.Msg.Count, resp.Msg.NextPageToken)
+}
+
 func (c *client) Delete(ctx context.Context, sessionID sdktypes.SessionID) error {
 	resp, err := c.client.Delete(ctx, connect.NewRequest(&sessionsv1.DeleteRequest{SessionId: sessionID.String()}))
 	if err != nil {
@@ -206,3 +282,233 @@ func (c *client) Delete(ctx context.Context, sessionID sdktypes.SessionID) error
 
 	return nil
 }
+
+func (c *client) StopMany(ctx context.Context, filter sdkservices.ListSessionsFilter, reason string, force bool) (string, error) {
+	resp, err := c.client.StopMany(ctx, connect.NewRequest(&sessionsv1.StopManyRequest{
+		Filter:    listRequest(filter),
+		Reason:    reason,
+		Terminate: force,
+	}))
+	if err != nil {
+		return "", rpcerrors.ToSDKError(err)
+	}
+
+	if err := internal.Validate(resp.Msg); err != nil {
+		return "", err
+	}
+
+	return resp.Msg.OperationId, nil
+}
+
+func (c *client) DeleteMany(ctx context.Context, filter sdkservices.ListSessionsFilter) (string, error) {
+	resp, err := c.client.DeleteMany(ctx, connect.NewRequest(&sessionsv1.DeleteManyRequest{Filter: listRequest(filter)}))
+	if err != nil {
+		return "", rpcerrors.ToSDKError(err)
+	}
+
+	if err := internal.Validate(resp.Msg); err != nil {
+		return "", err
+	}
+
+	return resp.Msg.OperationId, nil
+}
+
+func (c *client) RestartMany(ctx context.Context, filter sdkservices.ListSessionsFilter) (string, error) {
+	resp, err := c.client.RestartMany(ctx, connect.NewRequest(&sessionsv1.RestartManyRequest{Filter: listRequest(filter)}))
+	if err != nil {
+		return "", rpcerrors.ToSDKError(err)
+	}
+
+	if err := internal.Validate(resp.Msg); err != nil {
+		return "", err
+	}
+
+	return resp.Msg.OperationId, nil
+}
+
+func (c *client) GetBulkOperation(ctx context.Context, operationID string) (*sdkservices.BulkSessionsOperation, error) {
+	resp, err := c.client.GetBulkOperation(ctx, connect.NewRequest(&sessionsv1.GetBulkOperationRequest{OperationId: operationID}))
+	if err != nil {
+		return nil, rpcerrors.ToSDKError(err)
+	}
+
+	if err := internal.Validate(resp.Msg); err != nil {
+		return nil, err
+	}
+
+	op := resp.Msg.Operation
+
+	return &sdkservices.BulkSessionsOperation{
+		ID:        op.OperationId,
+		Kind:      sdkservices.BulkSessionsOperationKind(op.Kind),
+		Done:      op.Done,
+		Total:     op.Total,
+		Succeeded: op.Succeeded,
+		Failed:    op.Failed,
+		Errors:    op.Errors,
+	}, nil
+}
+
+func (c *client) Replay(ctx context.Context, sessionID sdktypes.SessionID, opts sdkservices.ReplaySessionOptions) (*sdkservices.ReplaySessionResult, error) {
+	resp, err := c.client.Replay(ctx, connect.NewRequest(&sessionsv1.ReplayRequest{
+		SessionId: sessionID.String(),
+		StopAtSeq: opts.StopAtSeq,
+	}))
+	if err != nil {
+		return nil, rpcerrors.ToSDKError(err)
+	}
+
+	if err := internal.Validate(resp.Msg); err != nil {
+		return nil, err
+	}
+
+	calls, err := kittehs.TransformError(resp.Msg.Calls, func(c *sessionsv1.ReplayResponse_ReplayedCall) (sdkservices.ReplayedSessionCall, error) {
+		spec, err := sdktypes.StrictSessionCallSpecFromProto(c.Spec)
+		if err != nil {
+			return sdkservices.ReplayedSessionCall{}, fmt.Errorf("spec: %w", err)
+		}
+
+		result, err := sdktypes.SessionCallAttemptResultFromProto(c.Result)
+		if err != nil {
+			return sdkservices.ReplayedSessionCall{}, fmt.Errorf("result: %w", err)
+		}
+
+		return sdkservices.ReplayedSessionCall{Spec: spec, Result: result}, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("calls: %w", err)
+	}
+
+	values, err := kittehs.TransformMapValuesError(resp.Msg.Values, sdktypes.StrictValueFromProto)
+	if err != nil {
+		return nil, fmt.Errorf("values: %w", err)
+	}
+
+	retval, err := sdktypes.ValueFromProto(resp.Msg.ReturnValue)
+	if err != nil {
+		return nil, fmt.Errorf("return value: %w", err)
+	}
+
+	perr, err := sdktypes.ProgramErrorFromProto(resp.Msg.Error)
+	if err != nil {
+		return nil, fmt.Errorf("error: %w", err)
+	}
+
+	return &sdkservices.ReplaySessionResult{
+		Calls:       calls,
+		Prints:      resp.Msg.Prints,
+		Stopped:     resp.Msg.Stopped,
+		Values:      values,
+		ReturnValue: retval,
+		Error:       perr,
+	}, nil
+}
+
+func (c *client) Export(ctx context.Context, sessionID sdktypes.SessionID) ([]byte, error) {
+	resp, err := c.client.Export(ctx, connect.NewRequest(&sessionsv1.ExportRequest{
+		SessionId: sessionID.String(),
+	}))
+	if err != nil {
+		return nil, rpcerrors.ToSDKError(err)
+	}
+
+	if err := internal.Validate(resp.Msg); err != nil {
+		return nil, err
+	}
+
+	return resp.Msg.Bundle, nil
+}
+
+func (c *client) Import(ctx context.Context, projectID sdktypes.ProjectID, bundle []byte) (sdktypes.SessionID, error) {
+	resp, err := c.client.Import(ctx, connect.NewRequest(&sessionsv1.ImportRequest{
+		ProjectId: projectID.String(),
+		Bundle:    bundle,
+	}))
+	if err != nil {
+		return sdktypes.InvalidSessionID, rpcerrors.ToSDKError(err)
+	}
+
+	if err := internal.Validate(resp.Msg); err != nil {
+		return sdktypes.InvalidSessionID, err
+	}
+
+	sid, err := sdktypes.StrictParseSessionID(resp.Msg.SessionId)
+	if err != nil {
+		return sdktypes.InvalidSessionID, fmt.Errorf("invalid session id: %w", err)
+	}
+
+	return sid, nil
+}
+
+func retentionScopeToProto(scope sdkservices.SessionRetentionScope) (oid, pid string) {
+	if scope.OrgID.IsValid() {
+		oid = scope.OrgID.String()
+	}
+
+	if scope.ProjectID.IsValid() {
+		pid = scope.ProjectID.String()
+	}
+
+	return
+}
+
+func (c *client) SetRetentionPolicy(ctx context.Context, policy sdkservices.SessionRetentionPolicy) error {
+	oid, pid := retentionScopeToProto(policy.SessionRetentionScope)
+
+	pb := &sessionsv1.RetentionPolicy{
+		OrgId:     oid,
+		ProjectId: pid,
+		MaxCount:  int32(policy.MaxCount),
+		States:    kittehs.Transform(policy.States, sdktypes.SessionStateType.ToProto),
+	}
+
+	if policy.MaxAge != 0 {
+		pb.MaxAge = durationpb.New(policy.MaxAge)
+	}
+
+	if _, err := c.client.SetRetentionPolicy(ctx, connect.NewRequest(&sessionsv1.SetRetentionPolicyRequest{Policy: pb})); err != nil {
+		return rpcerrors.ToSDKError(err)
+	}
+
+	return nil
+}
+
+func (c *client) GetRetentionPolicy(ctx context.Context, scope sdkservices.SessionRetentionScope) (*sdkservices.SessionRetentionPolicy, error) {
+	oid, pid := retentionScopeToProto(scope)
+
+	resp, err := c.client.GetRetentionPolicy(ctx, connect.NewRequest(&sessionsv1.GetRetentionPolicyRequest{OrgId: oid, ProjectId: pid}))
+	if err != nil {
+		return nil, rpcerrors.ToSDKError(err)
+	}
+
+	if err := internal.Validate(resp.Msg); err != nil {
+		return nil, err
+	}
+
+	pb := resp.Msg.Policy
+	if pb == nil {
+		return nil, nil
+	}
+
+	states, err := kittehs.TransformError(pb.States, sdktypes.SessionStateTypeFromProto)
+	if err != nil {
+		return nil, fmt.Errorf("states: %w", err)
+	}
+
+	return &sdkservices.SessionRetentionPolicy{
+		SessionRetentionScope: scope,
+		MaxAge:                pb.MaxAge.AsDuration(),
+		MaxCount:              int(pb.MaxCount),
+		States:                states,
+	}, nil
+}
+
+func (c *client) DeleteRetentionPolicy(ctx context.Context, scope sdkservices.SessionRetentionScope) error {
+	oid, pid := retentionScopeToProto(scope)
+
+	if _, err := c.client.DeleteRetentionPolicy(ctx, connect.NewRequest(&sessionsv1.DeleteRetentionPolicyRequest{OrgId: oid, ProjectId: pid})); err != nil {
+		return rpcerrors.ToSDKError(err)
+	}
+
+	return nil
+}
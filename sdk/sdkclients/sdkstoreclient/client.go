@@ -23,9 +23,14 @@ func New(p sdkclient.Params) sdkservices.Store {
 	return &client{client: internal.New(storev1connect.NewStoreServiceClient, p)}
 }
 
-func (c *client) List(ctx context.Context, pid sdktypes.ProjectID) ([]string, error) {
+func (c *client) List(ctx context.Context, pid sdktypes.ProjectID, f sdkservices.ListStoreValuesFilter) (*sdkservices.ListStoreValuesResult, error) {
 	resp, err := c.client.List(ctx, connect.NewRequest(&storev1.ListRequest{
-		ProjectId: pid.String(),
+		ProjectId:     pid.String(),
+		Prefix:        f.Prefix,
+		PublishedOnly: f.PublishedOnly,
+		PageSize:      f.PageSize,
+		Skip:          f.Skip,
+		PageToken:     f.PageToken,
 	}))
 	if err != nil {
 		return nil, rpcerrors.ToSDKError(err)
@@ -35,7 +40,10 @@ func (c *client) List(ctx context.Context, pid sdktypes.ProjectID) ([]string, er
 		return nil, err
 	}
 
-	return resp.Msg.Keys, nil
+	return &sdkservices.ListStoreValuesResult{
+		Keys:             resp.Msg.Keys,
+		PaginationResult: sdktypes.PaginationResult{TotalCount: resp.Msg.Count, NextPageToken: resp.Msg.NextPageToken},
+	}, nil
 }
 
 func (c *client) Get(ctx context.Context, pid sdktypes.ProjectID, keys []string) (map[string]sdktypes.Value, error) {
@@ -0,0 +1,33 @@
+package sdkservices
+
+import (
+	"context"
+
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+type ListApprovalsFilter struct {
+	OrgID     sdktypes.OrgID
+	ProjectID sdktypes.ProjectID
+	SessionID sdktypes.SessionID
+
+	// If unspecified, approvals in all states are returned.
+	State sdktypes.ApprovalState
+
+	Limit int
+}
+
+func (f ListApprovalsFilter) AnyIDSpecified() bool {
+	return f.OrgID.IsValid() || f.ProjectID.IsValid() || f.SessionID.IsValid()
+}
+
+type Approvals interface {
+	Get(ctx context.Context, id sdktypes.ApprovalID) (sdktypes.Approval, error)
+
+	// List returns approvals, newest first.
+	List(ctx context.Context, filter ListApprovalsFilter) ([]sdktypes.Approval, error)
+
+	// Decide approves or rejects a pending approval on behalf of the
+	// authenticated user, and signals the waiting session with the decision.
+	Decide(ctx context.Context, id sdktypes.ApprovalID, approve bool, comment string) (sdktypes.Approval, error)
+}
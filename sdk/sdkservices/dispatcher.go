@@ -22,10 +22,36 @@ type DispatchResponse struct {
 
 	// Returned only if Wait was true.
 	SignaledSessionIDs []sdktypes.SessionID
+
+	// Set if the event has the same idempotency key as an earlier one, which is
+	// EventID. The event was not dispatched again.
+	Duplicate bool
+}
+
+type ListDeadLettersFilter struct {
+	OrgID     sdktypes.OrgID
+	ProjectID sdktypes.ProjectID
+	EventID   sdktypes.EventID
+
+	// If false, only dead letters that were not retried yet are returned.
+	IncludeRetried bool
+
+	Limit int
 }
+
+func (f ListDeadLettersFilter) AnyIDSpecified() bool {
+	return f.OrgID.IsValid() || f.ProjectID.IsValid() || f.EventID.IsValid()
+}
+
 type Dispatcher interface {
 	Dispatch(ctx context.Context, event sdktypes.Event, opts *DispatchOptions) (*DispatchResponse, error)
 	Redispatch(ctx context.Context, eventID sdktypes.EventID, opts *DispatchOptions) (*DispatchResponse, error)
+
+	// ListDeadLetters returns events that were dropped during dispatching, newest first.
+	ListDeadLetters(ctx context.Context, filter ListDeadLettersFilter) ([]sdktypes.DeadLetter, error)
+
+	// RetryDeadLetter redispatches the event of a dead letter and marks it as retried.
+	RetryDeadLetter(ctx context.Context, id sdktypes.DeadLetterID, opts *DispatchOptions) (*DispatchResponse, error)
 }
 
 type DispatchFunc func(ctx context.Context, event sdktypes.Event, opts *DispatchOptions) (*DispatchResponse, error)
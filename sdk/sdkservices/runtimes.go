@@ -61,6 +61,11 @@ type RunCallbacks struct {
 	Sleep              func(ctx context.Context, rid sdktypes.RunID, d time.Duration) error
 	Start              func(ctx context.Context, rid sdktypes.RunID, project sdktypes.Symbol, loc sdktypes.CodeLocation, inputs map[string]sdktypes.Value, memo map[string]string) (sdktypes.SessionID, error)
 
+	// Blocks until all given child sessions reach a final state or the timeout
+	// passes, in which case the states of unfinished sessions are invalid.
+	// A zero timeout waits forever.
+	WaitSessions func(ctx context.Context, rid sdktypes.RunID, sids []sdktypes.SessionID, timeout time.Duration) ([]sdktypes.SessionState, error)
+
 	// Events
 	Subscribe   func(ctx context.Context, rid sdktypes.RunID, name, filter string) (string, error)
 	Unsubscribe func(ctx context.Context, rid sdktypes.RunID, signalID string) error
@@ -76,8 +81,21 @@ type RunCallbacks struct {
 	PublishStoreValue   func(ctx context.Context, rid sdktypes.RunID, key string) error
 	UnpublishStoreValue func(ctx context.Context, rid sdktypes.RunID, key string) error
 
-	// Outcome
+	// Outcome. HTTP outcomes of sync webhooks with "more" set can be streamed
+	// to the client as they arrive, see the "stream" field in webhookssvc.
 	Outcome func(ctx context.Context, rid sdktypes.RunID, v sdktypes.Value, eid sdktypes.EventID) error
+
+	// Tags. An empty value removes the tag.
+	SetSessionTag func(ctx context.Context, rid sdktypes.RunID, key, value string) error
+
+	// Approvals. Blocks until the approval is decided or the timeout passes,
+	// in which case the returned approval is expired. A zero timeout waits forever.
+	RequestApproval func(ctx context.Context, rid sdktypes.RunID, title string, approvers []string, timeout time.Duration) (sdktypes.Approval, error)
+
+	// Compensations. A registered compensation is called with the given
+	// arguments if the session ends with an error or is stopped. Compensations
+	// are called in reverse order of registration.
+	RegisterCompensation func(ctx context.Context, rid sdktypes.RunID, fn sdktypes.Value, args []sdktypes.Value, kwargs map[string]sdktypes.Value) error
 }
 
 type Run interface {
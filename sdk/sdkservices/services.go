@@ -5,6 +5,7 @@ import "go.uber.org/fx"
 type Services interface {
 	DBServices
 
+	Approvals() Approvals
 	Auth() Auth
 	Dispatcher() Dispatcher
 	Runtimes() Runtimes
@@ -28,6 +29,7 @@ type DBServices interface {
 type ServicesStruct struct {
 	fx.In // this can also be used using uber's Fx.
 
+	Approvals_    Approvals    `optional:"true"`
 	Auth_         Auth         `optional:"true"`
 	Builds_       Builds       `optional:"true"`
 	Connections_  Connections  `optional:"true"`
@@ -47,6 +49,7 @@ type ServicesStruct struct {
 
 var _ Services = &ServicesStruct{}
 
+func (s *ServicesStruct) Approvals() Approvals       { return s.Approvals_ }
 func (s *ServicesStruct) Auth() Auth                 { return s.Auth_ }
 func (s *ServicesStruct) Builds() Builds             { return s.Builds_ }
 func (s *ServicesStruct) Connections() Connections   { return s.Connections_ }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
 )
 
@@ -14,8 +15,12 @@ type ListSessionsFilter struct {
 	EventID      sdktypes.EventID
 	BuildID      sdktypes.BuildID
 	StateType    sdktypes.SessionStateType
+	Tags         map[string]string // all must match.
 	CountOnly    bool
 
+	// Session creation time range. Zero means unbounded.
+	CreatedAfter, CreatedBefore time.Time
+
 	sdktypes.PaginationRequest
 }
 
@@ -23,6 +28,101 @@ func (f ListSessionsFilter) AnyIDSpecified() bool {
 	return f.DeploymentID.IsValid() || f.OrgID.IsValid() || f.ProjectID.IsValid() || f.EventID.IsValid() || f.BuildID.IsValid()
 }
 
+type SearchSessionsFilter struct {
+	ListSessionsFilter
+
+	// Case insensitive substring of any of the session's prints.
+	PrintsContain string
+
+	// Case insensitive substring of the session's error message.
+	ErrorContains string
+
+	TriggerName string
+
+	// Either a full entry point ("main.py:on_event") or just
+	// its path ("main.py"), matching all entry points in it.
+	EntryPoint string
+}
+
+type BulkSessionsOperationKind string
+
+const (
+	BulkSessionsStop    BulkSessionsOperationKind = "stop"
+	BulkSessionsDelete  BulkSessionsOperationKind = "delete"
+	BulkSessionsRestart BulkSessionsOperationKind = "restart"
+)
+
+type BulkSessionsOperation struct {
+	ID   string
+	Kind BulkSessionsOperationKind
+
+	// True once all matching sessions were processed.
+	Done bool
+
+	// Number of sessions that matched the filter when the operation started.
+	Total int64
+
+	Succeeded, Failed int64
+
+	// Errors of the first failed sessions, prefixed by their session id.
+	Errors []string
+}
+
+// SessionRetentionScope is the org or the project a retention policy
+// applies to. Exactly one must be valid.
+type SessionRetentionScope struct {
+	OrgID     sdktypes.OrgID
+	ProjectID sdktypes.ProjectID
+}
+
+func (s SessionRetentionScope) Validate() error {
+	if s.OrgID.IsValid() == s.ProjectID.IsValid() {
+		return sdkerrors.NewInvalidArgumentError("exactly one of org_id or project_id must be specified")
+	}
+
+	return nil
+}
+
+// SessionRetentionPolicy determines which sessions of an org or a project
+// are purged, along with their log records and events that are not
+// referenced by other sessions. A project policy takes precedence over the
+// policy of its org. Sessions that are not in a final state are never purged.
+type SessionRetentionPolicy struct {
+	SessionRetentionScope
+
+	// Purge sessions older than this. Zero means no age limit.
+	MaxAge time.Duration
+
+	// Purge all but this many most recent sessions per project.
+	// Zero means no count limit.
+	MaxCount int
+
+	// Only purge sessions in these states. Empty means all final states.
+	States []sdktypes.SessionStateType
+}
+
+func (p SessionRetentionPolicy) Validate() error {
+	if err := p.SessionRetentionScope.Validate(); err != nil {
+		return err
+	}
+
+	if p.MaxAge < 0 || p.MaxCount < 0 {
+		return sdkerrors.NewInvalidArgumentError("max_age and max_count must not be negative")
+	}
+
+	if p.MaxAge == 0 && p.MaxCount == 0 {
+		return sdkerrors.NewInvalidArgumentError("either max_age or max_count must be positive")
+	}
+
+	for _, st := range p.States {
+		if !st.IsFinal() {
+			return sdkerrors.NewInvalidArgumentError("state %q is not final", st.String())
+		}
+	}
+
+	return nil
+}
+
 type ListSessionResult struct {
 	Sessions []sdktypes.Session
 	sdktypes.PaginationResult
@@ -49,6 +149,33 @@ type GetPrintsResults struct {
 	sdktypes.PaginationResult
 }
 
+type ReplaySessionOptions struct {
+	// Stop when the session makes the call with this sequence number,
+	// before its recorded result is returned to the program.
+	// Zero replays the session to completion.
+	StopAtSeq uint32
+}
+
+type ReplayedSessionCall struct {
+	Spec   sdktypes.SessionCallSpec
+	Result sdktypes.SessionCallAttemptResult // as recorded in the session history.
+}
+
+type ReplaySessionResult struct {
+	// Calls made by the replayed session, in order.
+	Calls  []ReplayedSessionCall
+	Prints []string
+
+	// Set if the replay stopped at StopAtSeq, in which case the last
+	// call is the one it stopped at.
+	Stopped bool
+
+	// Top level values of the entry point module.
+	Values      map[string]sdktypes.Value
+	ReturnValue sdktypes.Value
+	Error       sdktypes.ProgramError
+}
+
 type Sessions interface {
 	Start(ctx context.Context, session sdktypes.Session) (sdktypes.SessionID, error)
 	// Will always try first to gracefully terminate the session.
@@ -56,9 +183,40 @@ type Sessions interface {
 	Stop(ctx context.Context, sessionID sdktypes.SessionID, reason string, force bool, forceDelay time.Duration) error
 	// List returns sessions without their data.
 	List(ctx context.Context, filter ListSessionsFilter) (*ListSessionResult, error)
+	// Search is like List, but can also match on prints, errors and
+	// additional session attributes.
+	Search(ctx context.Context, filter SearchSessionsFilter) (*ListSessionResult, error)
 	Get(ctx context.Context, sessionID sdktypes.SessionID) (sdktypes.Session, error)
 	GetLog(ctx context.Context, filter SessionLogRecordsFilter) (*GetLogResults, error)
+	// WatchLog calls f with each record of the session log, including prints,
+	// in order and as it is written. It returns once the session reaches a
+	// final state and all of its records were passed to f, or when either ctx
+	// or f fail. Pagination in the filter is ignored.
+	WatchLog(ctx context.Context, filter SessionLogRecordsFilter, f func(sdktypes.SessionLogRecord) error) error
 	DownloadLogs(ctx context.Context, sessionID sdktypes.SessionID) ([]byte, error)
 	GetPrints(ctx context.Context, sid sdktypes.SessionID, pagination sdktypes.PaginationRequest) (*GetPrintsResults, error)
 	Delete(ctx context.Context, sessionID sdktypes.SessionID) error
+	// Replay re-runs a finished durable session, feeding each call its
+	// recorded result instead of executing it.
+	Replay(ctx context.Context, sessionID sdktypes.SessionID, opts ReplaySessionOptions) (*ReplaySessionResult, error)
+	// Export returns a portable bundle of a finished session, which can
+	// be imported into another autokitteh instance for reproduction.
+	Export(ctx context.Context, sessionID sdktypes.SessionID) ([]byte, error)
+	// Import creates a new session in the project from an exported bundle.
+	Import(ctx context.Context, projectID sdktypes.ProjectID, bundle []byte) (sdktypes.SessionID, error)
+	// StopMany, DeleteMany and RestartMany apply the respective operation to
+	// all sessions matching the filter in a background workflow. Pagination
+	// in the filter is ignored. They return an operation id, which can be
+	// used with GetBulkOperation to track the operation's progress.
+	StopMany(ctx context.Context, filter ListSessionsFilter, reason string, force bool) (string, error)
+	DeleteMany(ctx context.Context, filter ListSessionsFilter) (string, error)
+	RestartMany(ctx context.Context, filter ListSessionsFilter) (string, error)
+	GetBulkOperation(ctx context.Context, operationID string) (*BulkSessionsOperation, error)
+	// SetRetentionPolicy sets the session retention policy of an org or a
+	// project, replacing the previous one if any. Policies are enforced
+	// periodically by the server.
+	SetRetentionPolicy(ctx context.Context, policy SessionRetentionPolicy) error
+	// GetRetentionPolicy returns nil if the scope has no retention policy.
+	GetRetentionPolicy(ctx context.Context, scope SessionRetentionScope) (*SessionRetentionPolicy, error)
+	DeleteRetentionPolicy(ctx context.Context, scope SessionRetentionScope) error
 }
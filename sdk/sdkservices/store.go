@@ -6,10 +6,26 @@ import (
 	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
 )
 
+type ListStoreValuesFilter struct {
+	// If not empty, only keys that start with this prefix are listed.
+	Prefix        string
+	PublishedOnly bool
+
+	// Keys are always listed in ascending order, and the page token is the last key
+	// of the previous page. Ascending is ignored. Zero PageSize means no limit.
+	sdktypes.PaginationRequest
+}
+
+type ListStoreValuesResult struct {
+	Keys []string
+
+	sdktypes.PaginationResult
+}
+
 type Store interface {
 	Mutate(ctx context.Context, pid sdktypes.ProjectID, key, op string, operarnds ...sdktypes.Value) (sdktypes.Value, error)
 	Publish(ctx context.Context, pid sdktypes.ProjectID, key string) error
 	Unpublish(ctx context.Context, pid sdktypes.ProjectID, key string) error
 	Get(ctx context.Context, pid sdktypes.ProjectID, keys []string) (map[string]sdktypes.Value, error)
-	List(ctx context.Context, pid sdktypes.ProjectID) ([]string, error)
+	List(ctx context.Context, pid sdktypes.ProjectID, f ListStoreValuesFilter) (*ListStoreValuesResult, error)
 }
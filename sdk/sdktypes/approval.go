@@ -0,0 +1,105 @@
+package sdktypes
+
+import (
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	approvalsv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/approvals/v1"
+)
+
+// Approval is a request made by a session for a human decision.
+// The session waits until the approval is approved, rejected or expires.
+type Approval struct {
+	object[*ApprovalPB, ApprovalTraits]
+}
+
+func init() { registerObject[Approval]() }
+
+var InvalidApproval Approval
+
+type ApprovalPB = approvalsv1.Approval
+
+type ApprovalTraits struct{ immutableObjectTrait }
+
+func (ApprovalTraits) Validate(m *ApprovalPB) error {
+	return errors.Join(
+		idField[ApprovalID]("approval_id", m.ApprovalId),
+		idField[SessionID]("session_id", m.SessionId),
+		idField[ProjectID]("project_id", m.ProjectId),
+		enumField[ApprovalState]("state", m.State),
+	)
+}
+
+func (ApprovalTraits) StrictValidate(m *ApprovalPB) error {
+	return errors.Join(
+		mandatory("approval_id", m.ApprovalId),
+		mandatory("session_id", m.SessionId),
+		mandatory("project_id", m.ProjectId),
+		mandatory("title", m.Title),
+		mandatory("state", m.State),
+	)
+}
+
+func ApprovalFromProto(m *ApprovalPB) (Approval, error) { return FromProto[Approval](m) }
+func StrictApprovalFromProto(m *ApprovalPB) (Approval, error) {
+	return Strict(ApprovalFromProto(m))
+}
+
+func NewApproval(sid SessionID, pid ProjectID, title string, approvers []string) Approval {
+	return kittehs.Must1(ApprovalFromProto(&ApprovalPB{
+		ApprovalId: NewApprovalID().String(),
+		SessionId:  sid.String(),
+		ProjectId:  pid.String(),
+		Title:      title,
+		Approvers:  approvers,
+		State:      ApprovalStatePending.ToProto(),
+	}))
+}
+
+func (p Approval) ID() ApprovalID       { return kittehs.Must1(ParseApprovalID(p.read().ApprovalId)) }
+func (p Approval) SessionID() SessionID { return kittehs.Must1(ParseSessionID(p.read().SessionId)) }
+func (p Approval) ProjectID() ProjectID { return kittehs.Must1(ParseProjectID(p.read().ProjectId)) }
+func (p Approval) Title() string        { return p.read().Title }
+func (p Approval) Approvers() []string  { return p.read().Approvers }
+func (p Approval) State() ApprovalState { return forceEnumFromProto[ApprovalState](p.read().State) }
+func (p Approval) DecidedBy() string    { return p.read().DecidedBy }
+func (p Approval) Comment() string      { return p.read().Comment }
+func (p Approval) CreatedAt() time.Time { return p.read().CreatedAt.AsTime() }
+
+// ExpiresAt returns the zero time if the approval never expires.
+func (p Approval) ExpiresAt() time.Time {
+	if t := p.read().ExpiresAt; t != nil {
+		return t.AsTime()
+	}
+
+	return time.Time{}
+}
+
+// DecidedAt returns the zero time if the approval is still pending.
+func (p Approval) DecidedAt() time.Time {
+	if t := p.read().DecidedAt; t != nil {
+		return t.AsTime()
+	}
+
+	return time.Time{}
+}
+
+func (p Approval) WithCreatedAt(t time.Time) Approval {
+	return Approval{p.forceUpdate(func(m *ApprovalPB) { m.CreatedAt = timestamppb.New(t) })}
+}
+
+func (p Approval) WithExpiresAt(t time.Time) Approval {
+	return Approval{p.forceUpdate(func(m *ApprovalPB) { m.ExpiresAt = timestamppb.New(t) })}
+}
+
+func (p Approval) WithDecision(state ApprovalState, by, comment string, at time.Time) Approval {
+	return Approval{p.forceUpdate(func(m *ApprovalPB) {
+		m.State = state.ToProto()
+		m.DecidedBy = by
+		m.Comment = comment
+		m.DecidedAt = timestamppb.New(at)
+	})}
+}
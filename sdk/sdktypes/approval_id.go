@@ -0,0 +1,15 @@
+package sdktypes
+
+const ApprovalIDKind = "apr"
+
+type ApprovalID = id[approvalIDTraits]
+
+type approvalIDTraits struct{}
+
+func (approvalIDTraits) Prefix() string { return ApprovalIDKind }
+
+func NewApprovalID() ApprovalID                          { return newID[ApprovalID]() }
+func ParseApprovalID(s string) (ApprovalID, error)       { return ParseID[ApprovalID](s) }
+func StrictParseApprovalID(s string) (ApprovalID, error) { return Strict(ParseApprovalID(s)) }
+
+var InvalidApprovalID ApprovalID
@@ -0,0 +1,42 @@
+package sdktypes
+
+import (
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	approvalsv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/approvals/v1"
+)
+
+type approvalStateTraits struct{}
+
+var _ enumTraits = approvalStateTraits{}
+
+func (approvalStateTraits) Prefix() string           { return "APPROVAL_STATE_" }
+func (approvalStateTraits) Names() map[int32]string  { return approvalsv1.ApprovalState_name }
+func (approvalStateTraits) Values() map[string]int32 { return approvalsv1.ApprovalState_value }
+
+type ApprovalStatePB = approvalsv1.ApprovalState
+
+type ApprovalState struct {
+	enum[approvalStateTraits, approvalsv1.ApprovalState]
+}
+
+func approvalStateFromProto(e approvalsv1.ApprovalState) ApprovalState {
+	return kittehs.Must1(ApprovalStateFromProto(e))
+}
+
+var (
+	PossibleApprovalStatesNames = AllEnumNames[approvalStateTraits]()
+
+	ApprovalStateUnspecified = approvalStateFromProto(approvalsv1.ApprovalState_APPROVAL_STATE_UNSPECIFIED)
+	ApprovalStatePending     = approvalStateFromProto(approvalsv1.ApprovalState_APPROVAL_STATE_PENDING)
+	ApprovalStateApproved    = approvalStateFromProto(approvalsv1.ApprovalState_APPROVAL_STATE_APPROVED)
+	ApprovalStateRejected    = approvalStateFromProto(approvalsv1.ApprovalState_APPROVAL_STATE_REJECTED)
+	ApprovalStateExpired     = approvalStateFromProto(approvalsv1.ApprovalState_APPROVAL_STATE_EXPIRED)
+)
+
+func ApprovalStateFromProto(e approvalsv1.ApprovalState) (ApprovalState, error) {
+	return EnumFromProto[ApprovalState](e)
+}
+
+func ParseApprovalState(raw string) (ApprovalState, error) {
+	return ParseEnum[ApprovalState](raw)
+}
@@ -0,0 +1,110 @@
+package sdktypes
+
+import (
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	dispatcherv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/dispatcher/v1"
+)
+
+// DeadLetter records an event that could not be dispatched to a session,
+// either because it had no destinations or because starting the session failed.
+type DeadLetter struct {
+	object[*DeadLetterPB, DeadLetterTraits]
+}
+
+func init() { registerObject[DeadLetter]() }
+
+var InvalidDeadLetter DeadLetter
+
+type DeadLetterPB = dispatcherv1.DeadLetter
+
+type DeadLetterTraits struct{ immutableObjectTrait }
+
+func (DeadLetterTraits) Validate(m *DeadLetterPB) error {
+	return errors.Join(
+		idField[DeadLetterID]("dead_letter_id", m.DeadLetterId),
+		idField[EventID]("event_id", m.EventId),
+		idField[ProjectID]("project_id", m.ProjectId),
+		idField[TriggerID]("trigger_id", m.TriggerId),
+		idField[DeploymentID]("deployment_id", m.DeploymentId),
+		enumField[DeadLetterReason]("reason", m.Reason),
+		idField[EventID]("retry_event_id", m.RetryEventId),
+	)
+}
+
+func (DeadLetterTraits) StrictValidate(m *DeadLetterPB) error {
+	return errors.Join(
+		mandatory("dead_letter_id", m.DeadLetterId),
+		mandatory("event_id", m.EventId),
+		mandatory("project_id", m.ProjectId),
+		mandatory("reason", m.Reason),
+	)
+}
+
+func DeadLetterFromProto(m *DeadLetterPB) (DeadLetter, error) { return FromProto[DeadLetter](m) }
+func StrictDeadLetterFromProto(m *DeadLetterPB) (DeadLetter, error) {
+	return Strict(DeadLetterFromProto(m))
+}
+
+func NewDeadLetter(eid EventID, pid ProjectID, reason DeadLetterReason) DeadLetter {
+	return kittehs.Must1(DeadLetterFromProto(&DeadLetterPB{
+		DeadLetterId: NewDeadLetterID().String(),
+		EventId:      eid.String(),
+		ProjectId:    pid.String(),
+		Reason:       reason.ToProto(),
+	}))
+}
+
+func (p DeadLetter) ID() DeadLetterID { return kittehs.Must1(ParseDeadLetterID(p.read().DeadLetterId)) }
+func (p DeadLetter) EventID() EventID { return kittehs.Must1(ParseEventID(p.read().EventId)) }
+func (p DeadLetter) ProjectID() ProjectID {
+	return kittehs.Must1(ParseProjectID(p.read().ProjectId))
+}
+func (p DeadLetter) TriggerID() TriggerID { return kittehs.Must1(ParseTriggerID(p.read().TriggerId)) }
+func (p DeadLetter) DeploymentID() DeploymentID {
+	return kittehs.Must1(ParseDeploymentID(p.read().DeploymentId))
+}
+func (p DeadLetter) Reason() DeadLetterReason {
+	return forceEnumFromProto[DeadLetterReason](p.read().Reason)
+}
+func (p DeadLetter) Error() string        { return p.read().Error }
+func (p DeadLetter) CreatedAt() time.Time { return p.read().CreatedAt.AsTime() }
+func (p DeadLetter) RetryEventID() EventID {
+	return kittehs.Must1(ParseEventID(p.read().RetryEventId))
+}
+
+// RetriedAt returns the zero time if the dead letter was not retried yet.
+func (p DeadLetter) RetriedAt() time.Time {
+	if t := p.read().RetriedAt; t != nil {
+		return t.AsTime()
+	}
+
+	return time.Time{}
+}
+
+func (p DeadLetter) WithError(text string) DeadLetter {
+	return DeadLetter{p.forceUpdate(func(m *DeadLetterPB) { m.Error = text })}
+}
+
+func (p DeadLetter) WithTriggerID(id TriggerID) DeadLetter {
+	return DeadLetter{p.forceUpdate(func(m *DeadLetterPB) { m.TriggerId = id.String() })}
+}
+
+func (p DeadLetter) WithDeploymentID(id DeploymentID) DeadLetter {
+	return DeadLetter{p.forceUpdate(func(m *DeadLetterPB) { m.DeploymentId = id.String() })}
+}
+
+func (p DeadLetter) WithCreatedAt(t time.Time) DeadLetter {
+	return DeadLetter{p.forceUpdate(func(m *DeadLetterPB) { m.CreatedAt = timestamppb.New(t) })}
+}
+
+func (p DeadLetter) WithRetry(eid EventID, t time.Time) DeadLetter {
+	return DeadLetter{p.forceUpdate(func(m *DeadLetterPB) {
+		m.RetryEventId = eid.String()
+		m.RetriedAt = timestamppb.New(t)
+	})}
+}
@@ -0,0 +1,15 @@
+package sdktypes
+
+const DeadLetterIDKind = "dlq"
+
+type DeadLetterID = id[deadLetterIDTraits]
+
+type deadLetterIDTraits struct{}
+
+func (deadLetterIDTraits) Prefix() string { return DeadLetterIDKind }
+
+func NewDeadLetterID() DeadLetterID                          { return newID[DeadLetterID]() }
+func ParseDeadLetterID(s string) (DeadLetterID, error)       { return ParseID[DeadLetterID](s) }
+func StrictParseDeadLetterID(s string) (DeadLetterID, error) { return Strict(ParseDeadLetterID(s)) }
+
+var InvalidDeadLetterID DeadLetterID
@@ -0,0 +1,43 @@
+package sdktypes
+
+import (
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	dispatcherv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/dispatcher/v1"
+)
+
+type deadLetterReasonTraits struct{}
+
+var _ enumTraits = deadLetterReasonTraits{}
+
+func (deadLetterReasonTraits) Prefix() string           { return "DEAD_LETTER_REASON_" }
+func (deadLetterReasonTraits) Names() map[int32]string  { return dispatcherv1.DeadLetterReason_name }
+func (deadLetterReasonTraits) Values() map[string]int32 { return dispatcherv1.DeadLetterReason_value }
+
+type DeadLetterReasonPB = dispatcherv1.DeadLetterReason
+
+type DeadLetterReason struct {
+	enum[deadLetterReasonTraits, dispatcherv1.DeadLetterReason]
+}
+
+func deadLetterReasonFromProto(e dispatcherv1.DeadLetterReason) DeadLetterReason {
+	return kittehs.Must1(DeadLetterReasonFromProto(e))
+}
+
+var (
+	PossibleDeadLetterReasonsNames = AllEnumNames[deadLetterReasonTraits]()
+
+	DeadLetterReasonUnspecified        = deadLetterReasonFromProto(dispatcherv1.DeadLetterReason_DEAD_LETTER_REASON_UNSPECIFIED)
+	DeadLetterReasonNoDestinations     = deadLetterReasonFromProto(dispatcherv1.DeadLetterReason_DEAD_LETTER_REASON_NO_DESTINATIONS)
+	DeadLetterReasonSessionInitFailed  = deadLetterReasonFromProto(dispatcherv1.DeadLetterReason_DEAD_LETTER_REASON_SESSION_INIT_FAILED)
+	DeadLetterReasonSessionStartFailed = deadLetterReasonFromProto(dispatcherv1.DeadLetterReason_DEAD_LETTER_REASON_SESSION_START_FAILED)
+	DeadLetterReasonResourceExhausted  = deadLetterReasonFromProto(dispatcherv1.DeadLetterReason_DEAD_LETTER_REASON_RESOURCE_EXHAUSTED)
+	DeadLetterReasonConcurrencyLimit   = deadLetterReasonFromProto(dispatcherv1.DeadLetterReason_DEAD_LETTER_REASON_CONCURRENCY_LIMIT)
+)
+
+func DeadLetterReasonFromProto(e dispatcherv1.DeadLetterReason) (DeadLetterReason, error) {
+	return EnumFromProto[DeadLetterReason](e)
+}
+
+func ParseDeadLetterReason(raw string) (DeadLetterReason, error) {
+	return ParseEnum[DeadLetterReason](raw)
+}
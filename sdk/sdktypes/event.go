@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
 	"github.com/google/cel-go/ext"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -81,6 +83,11 @@ func (e Event) DestinationID() EventDestinationID {
 
 func (e Event) Memo() map[string]string { return e.read().Memo }
 
+func (e Event) IdempotencyKey() string { return e.read().IdempotencyKey }
+func (e Event) WithIdempotencyKey(k string) Event {
+	return Event{e.forceUpdate(func(m *EventPB) { m.IdempotencyKey = k })}
+}
+
 func (e Event) Type() string { return e.read().EventType }
 func (e Event) WithType(t string) Event {
 	return Event{e.forceUpdate(func(m *EventPB) { m.EventType = t })}
@@ -171,29 +178,38 @@ var matchUnwrapper = ValueWrapper{
 	},
 }
 
-func (e Event) Matches(expr string) (bool, error) {
-	if expr == "" {
-		return true, nil
-	}
-
+func (e Event) eval(expr string) (ref.Val, error) {
 	ast, issues := eventFilterEnv.Compile(expr)
 	if err := issues.Err(); err != nil {
-		return false, fmt.Errorf("compile: %w", err)
+		return nil, fmt.Errorf("compile: %w", err)
 	}
 
 	prg, err := eventFilterEnv.Program(ast)
 	if err != nil {
-		return false, fmt.Errorf("program: %w", err)
+		return nil, fmt.Errorf("program: %w", err)
 	}
 
 	data, err := kittehs.TransformMapValuesError(e.Data(), matchUnwrapper.Unwrap)
 	if err != nil {
-		return false, fmt.Errorf("unwrap event: %w", err)
+		return nil, fmt.Errorf("unwrap event: %w", err)
 	}
 
 	out, _, err := prg.Eval(map[string]any{"data": data, "event_type": e.Type()})
 	if err != nil {
-		return false, fmt.Errorf("program eval: %w", err)
+		return nil, fmt.Errorf("program eval: %w", err)
+	}
+
+	return out, nil
+}
+
+func (e Event) Matches(expr string) (bool, error) {
+	if expr == "" {
+		return true, nil
+	}
+
+	out, err := e.eval(expr)
+	if err != nil {
+		return false, err
 	}
 
 	b, err := out.ConvertToNative(reflect.TypeOf(true))
@@ -203,3 +219,23 @@ func (e Event) Matches(expr string) (bool, error) {
 
 	return b.(bool), nil
 }
+
+// Key evaluates expr, which is in the same language as the filter, and returns
+// its result as a string. Used to group similar events together.
+func (e Event) Key(expr string) (string, error) {
+	if expr == "" {
+		return "", nil
+	}
+
+	out, err := e.eval(expr)
+	if err != nil {
+		return "", err
+	}
+
+	if s, ok := out.ConvertToType(types.StringType).(types.String); ok {
+		return string(s), nil
+	}
+
+	// Not convertible to a string directly, ie lists or maps.
+	return fmt.Sprint(out.Value()), nil
+}
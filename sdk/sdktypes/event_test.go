@@ -39,3 +39,38 @@ func TestEventFilter(t *testing.T) {
 		assert.False(t, matches)
 	}
 }
+
+func TestEventKey(t *testing.T) {
+	e := kittehs.Must1(sdktypes.EventFromProto(
+		&sdktypes.EventPB{
+			EventType: "push",
+			Data: map[string]*valuev1.Value{
+				"repo": sdktypes.NewStringValue("meow").ToProto(),
+				"n":    sdktypes.NewIntegerValue(42).ToProto(),
+			},
+		},
+	))
+
+	key, err := e.Key("")
+	if assert.NoError(t, err) {
+		assert.Empty(t, key)
+	}
+
+	key, err = e.Key("data.repo")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "meow", key)
+	}
+
+	key, err = e.Key("data.n")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "42", key)
+	}
+
+	key, err = e.Key("event_type + '/' + data.repo")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "push/meow", key)
+	}
+
+	_, err = e.Key("data.missing")
+	assert.Error(t, err)
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"time"
 
+	"google.golang.org/protobuf/types/known/durationpb"
+
 	"go.autokitteh.dev/autokitteh/internal/kittehs"
 	sessionv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/sessions/v1"
 )
@@ -21,13 +23,22 @@ type SessionPB = sessionv1.Session
 type SessionTraits struct{}
 
 func (SessionTraits) Validate(m *SessionPB) error {
+	var err error
+	if m.Timeout.AsDuration() < 0 {
+		err = errors.New("timeout must not be negative")
+	}
+
 	return errors.Join(
+		err,
 		enumField[SessionStateType]("state", m.State),
 		idField[BuildID]("build_id", m.BuildId),
 		idField[ProjectID]("project_id", m.ProjectId),
 		idField[DeploymentID]("deployment_id", m.DeploymentId),
 		idField[EventID]("event_id", m.EventId),
+		idField[TriggerID]("trigger_id", m.TriggerId),
 		idField[SessionID]("parent_session_id", m.ParentSessionId),
+		idField[SessionID]("retry_of_session_id", m.RetryOfSessionId),
+		objectField[CodeLocation]("on_stop", m.OnStop),
 		idField[SessionID]("session_id", m.SessionId),
 		objectField[CodeLocation]("entrypoint", m.Entrypoint),
 		valuesMapField("inputs", m.Inputs),
@@ -63,10 +74,12 @@ func (p Session) DeploymentID() DeploymentID {
 	return kittehs.Must1(ParseDeploymentID(p.read().DeploymentId))
 }
 func (p Session) EventID() EventID         { return kittehs.Must1(ParseEventID(p.read().EventId)) }
+func (p Session) TriggerID() TriggerID     { return kittehs.Must1(ParseTriggerID(p.read().TriggerId)) }
 func (p Session) BuildID() BuildID         { return kittehs.Must1(ParseBuildID(p.read().BuildId)) }
 func (p Session) ProjectID() ProjectID     { return kittehs.Must1(ParseProjectID(p.read().ProjectId)) }
 func (p Session) EntryPoint() CodeLocation { return forceFromProto[CodeLocation](p.read().Entrypoint) }
 func (p Session) Memo() map[string]string  { return p.read().Memo }
+func (p Session) Tags() map[string]string  { return p.read().Tags }
 func (p Session) Inputs() map[string]Value {
 	return kittehs.TransformMapValues(p.read().Inputs, forceFromProto[Value])
 }
@@ -75,6 +88,20 @@ func (p Session) ParentSessionID() SessionID {
 	return kittehs.Must1(ParseSessionID(p.read().ParentSessionId))
 }
 
+// RetryOfSessionID is the first attempt this session is an automatic retry of.
+func (p Session) RetryOfSessionID() SessionID {
+	return kittehs.Must1(ParseSessionID(p.read().RetryOfSessionId))
+}
+
+// Attempt is 1-based for retries, and zero for sessions that are not retries.
+func (p Session) Attempt() int { return int(p.read().Attempt) }
+
+// Timeout is the session's execution timeout. Zero if none.
+func (p Session) Timeout() time.Duration { return p.read().Timeout.AsDuration() }
+
+// OnStop is called when the session is stopped, to let it clean up.
+func (p Session) OnStop() CodeLocation { return forceFromProto[CodeLocation](p.read().OnStop) }
+
 func (p Session) State() SessionStateType {
 	return forceEnumFromProto[SessionStateType](p.read().State)
 }
@@ -83,6 +110,14 @@ func (p Session) WithInputs(inputs map[string]Value) Session {
 	return Session{p.forceUpdate(func(pb *SessionPB) { pb.Inputs = kittehs.TransformMapValues(inputs, ToProto) })}
 }
 
+func (p Session) WithMemo(memo map[string]string) Session {
+	return Session{p.forceUpdate(func(pb *SessionPB) { pb.Memo = memo })}
+}
+
+func (p Session) WithTags(tags map[string]string) Session {
+	return Session{p.forceUpdate(func(pb *SessionPB) { pb.Tags = tags })}
+}
+
 func NewSession(buildID BuildID, ep CodeLocation, inputs map[string]Value, memo map[string]string) Session {
 	return kittehs.Must1(SessionFromProto(
 		&SessionPB{
@@ -98,6 +133,26 @@ func (s Session) WithParentSessionID(id SessionID) Session {
 	return Session{s.forceUpdate(func(pb *SessionPB) { pb.ParentSessionId = id.String() })}
 }
 
+func (s Session) WithRetryOfSessionID(id SessionID) Session {
+	return Session{s.forceUpdate(func(pb *SessionPB) { pb.RetryOfSessionId = id.String() })}
+}
+
+func (s Session) WithAttempt(attempt int) Session {
+	return Session{s.forceUpdate(func(pb *SessionPB) { pb.Attempt = uint32(attempt) })}
+}
+
+func (s Session) WithTimeout(d time.Duration) Session {
+	return Session{s.forceUpdate(func(pb *SessionPB) {
+		if pb.Timeout = nil; d > 0 {
+			pb.Timeout = durationpb.New(d)
+		}
+	})}
+}
+
+func (s Session) WithOnStop(loc CodeLocation) Session {
+	return Session{s.forceUpdate(func(pb *SessionPB) { pb.OnStop = loc.ToProto() })}
+}
+
 func (s Session) WithDeploymentID(id DeploymentID) Session {
 	return Session{s.forceUpdate(func(pb *SessionPB) { pb.DeploymentId = id.String() })}
 }
@@ -110,6 +165,10 @@ func (s Session) WithEventID(id EventID) Session {
 	return Session{s.forceUpdate(func(pb *SessionPB) { pb.EventId = id.String() })}
 }
 
+func (s Session) WithTriggerID(id TriggerID) Session {
+	return Session{s.forceUpdate(func(pb *SessionPB) { pb.TriggerId = id.String() })}
+}
+
 func (s Session) WithBuildID(id BuildID) Session {
 	return Session{s.forceUpdate(func(pb *SessionPB) { pb.BuildId = id.String() })}
 }
@@ -63,6 +63,10 @@ func (s SessionLogRecord) GetCallSpec() SessionCallSpec {
 	return forceFromProto[SessionCallSpec](s.read().CallSpec)
 }
 
+func (s SessionLogRecord) GetCallAttemptComplete() SessionCallAttemptComplete {
+	return forceFromProto[SessionCallAttemptComplete](s.read().CallAttemptComplete)
+}
+
 func (s SessionLogRecord) GetState() SessionState {
 	return forceFromProto[SessionState](s.read().State)
 }
@@ -83,6 +87,49 @@ func (s SessionLogRecord) GetStopRequest() (string, bool) {
 	return "", false
 }
 
+// GetCompensation returns the compensation function that was run, the call
+// sequence it was run with, and its error if it failed.
+func (s SessionLogRecord) GetCompensation() (fn Value, callSeq uint32, perr ProgramError, ok bool) {
+	c := s.read().Compensation
+	if c == nil {
+		return
+	}
+
+	fn = kittehs.Must1(ValueFromProto(c.Function))
+	callSeq = c.CallSeq
+
+	if c.Error != nil {
+		perr = kittehs.Must1(ProgramErrorFromProto(c.Error))
+	}
+
+	ok = true
+	return
+}
+
+// Type returns the type of the record, according to which of its fields is set.
+func (s SessionLogRecord) Type() SessionLogRecordType {
+	switch m := s.read(); {
+	case m.CallAttemptStart != nil:
+		return CallAttemptStartSessionLogRecordType
+	case m.CallAttemptComplete != nil:
+		return CallAttemptCompleteSessionLogRecordType
+	case m.CallSpec != nil:
+		return CallSpecSessionLogRecordType
+	case m.State != nil:
+		return StateSessionLogRecordType
+	case m.Print != nil:
+		return PrintSessionLogRecordType
+	case m.StopRequest != nil:
+		return StopRequestSessionLogRecordType
+	case m.Outcome != nil:
+		return OutcomeSessionLogRecordType
+	case m.Compensation != nil:
+		return CompensationSessionLogRecordType
+	default:
+		return UnspecifiedSessionLogRecordType
+	}
+}
+
 func NewOutcomeSessionLogRecord(t time.Time, v Value, eid EventID) SessionLogRecord {
 	return forceFromProto[SessionLogRecord](&SessionLogRecordPB{
 		T: timestamppb.New(t),
@@ -93,6 +140,17 @@ func NewOutcomeSessionLogRecord(t time.Time, v Value, eid EventID) SessionLogRec
 	})
 }
 
+func NewCompensationSessionLogRecord(t time.Time, fn Value, callSeq uint32, perr ProgramError) SessionLogRecord {
+	return forceFromProto[SessionLogRecord](&SessionLogRecordPB{
+		T: timestamppb.New(t),
+		Compensation: &sessionv1.SessionLogRecord_Compensation{
+			Function: fn.ToProto(),
+			CallSeq:  callSeq,
+			Error:    perr.ToProto(),
+		},
+	})
+}
+
 func NewPrintSessionLogRecord(t time.Time, v Value, callSeq uint32) SessionLogRecord {
 	var text string
 	if v.IsString() {
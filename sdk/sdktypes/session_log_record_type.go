@@ -13,4 +13,5 @@ const (
 	PrintSessionLogRecordType               SessionLogRecordType = sessionv1.SessionLogRecord_TYPE_PRINT
 	StopRequestSessionLogRecordType         SessionLogRecordType = sessionv1.SessionLogRecord_TYPE_STOP_REQUEST
 	OutcomeSessionLogRecordType             SessionLogRecordType = sessionv1.SessionLogRecord_TYPE_OUTCOME
+	CompensationSessionLogRecordType        SessionLogRecordType = sessionv1.SessionLogRecord_TYPE_COMPENSATION
 )
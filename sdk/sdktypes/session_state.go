@@ -12,6 +12,8 @@ type SessionState struct {
 
 func init() { registerObject[SessionState]() }
 
+var InvalidSessionState SessionState
+
 type SessionStatePB = sessionv1.SessionState
 
 type SessionStateTraits struct{ immutableObjectTrait }
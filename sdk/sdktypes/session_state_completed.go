@@ -44,6 +44,10 @@ func (s SessionState) GetCompleted() SessionStateCompleted {
 	return forceFromProto[SessionStateCompleted](s.read().Completed)
 }
 
+func (s SessionStateCompleted) ReturnValue() Value {
+	return forceFromProto[Value](s.read().ReturnValue)
+}
+
 func NewSessionStateCompleted(prints []string, exports map[string]Value, ret Value) SessionState {
 	return forceFromProto[SessionState](&sessionv1.SessionState{
 		Completed: &SessionStateCompletedPB{
@@ -38,6 +38,9 @@ func (s SessionState) GetStopped() SessionStateStopped {
 	return forceFromProto[SessionStateStopped](s.read().Stopped)
 }
 
+func (s SessionStateStopped) Reason() string { return s.read().Reason }
+func (s SessionStateStopped) TimedOut() bool { return s.read().TimedOut }
+
 func NewSessionStateStopped(reason string) SessionState {
 	return forceFromProto[SessionState](&sessionv1.SessionState{
 		Stopped: &SessionStateStoppedPB{
@@ -45,3 +48,14 @@ func NewSessionStateStopped(reason string) SessionState {
 		},
 	})
 }
+
+// NewSessionStateTimedOut is a stopped state for a session that was stopped
+// due to its execution timeout.
+func NewSessionStateTimedOut(reason string) SessionState {
+	return forceFromProto[SessionState](&sessionv1.SessionState{
+		Stopped: &SessionStateStoppedPB{
+			Reason:   reason,
+			TimedOut: true,
+		},
+	})
+}
@@ -0,0 +1,37 @@
+package sdktypes
+
+import (
+	"strings"
+
+	"go.autokitteh.dev/autokitteh/sdk/sdkerrors"
+)
+
+const (
+	MaxSessionTags           = 32
+	MaxSessionTagKeyLength   = 64
+	MaxSessionTagValueLength = 256
+)
+
+// ValidateSessionTag validates a tag to be set on a session.
+// An empty value means the tag is to be removed.
+func ValidateSessionTag(key, value string) error {
+	if key == "" {
+		return sdkerrors.NewInvalidArgumentError("empty tag key")
+	}
+
+	if len(key) > MaxSessionTagKeyLength {
+		return sdkerrors.NewInvalidArgumentError("tag key longer than %d", MaxSessionTagKeyLength)
+	}
+
+	// Keys are specified as "key=value" when filtering, and are
+	// used in json paths when querying.
+	if strings.ContainsAny(key, `="`) {
+		return sdkerrors.NewInvalidArgumentError(`tag key must not contain '=' or '"'`)
+	}
+
+	if len(value) > MaxSessionTagValueLength {
+		return sdkerrors.NewInvalidArgumentError("tag value longer than %d", MaxSessionTagValueLength)
+	}
+
+	return nil
+}
@@ -2,6 +2,9 @@ package sdktypes
 
 import (
 	"errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"go.autokitteh.dev/autokitteh/internal/kittehs"
 	triggerv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/triggers/v1"
@@ -20,14 +23,32 @@ type TriggerPB = triggerv1.Trigger
 type TriggerTraits struct{}
 
 func (TriggerTraits) Validate(m *TriggerPB) error {
+	var err error
+	if m.Timeout.AsDuration() < 0 {
+		err = errors.New("timeout must not be negative")
+	}
+
+	// A sync response is for the session of a single event.
+	if m.IsSync && m.Batch != nil {
+		err = errors.Join(err, errors.New("sync triggers cannot batch events"))
+	}
+
 	return errors.Join(
+		err,
 		eventFilterField("filter", m.Filter),
 		idField[ProjectID]("project_id", m.ProjectId),
 		idField[TriggerID]("trigger_id", m.TriggerId),
 		objectField[CodeLocation]("code_location", m.CodeLocation),
+		objectField[CodeLocation]("on_stop", m.OnStop),
 		symbolField("name", m.Name),
 		idField[ConnectionID]("connection_id", m.ConnectionId),
 		enumField[TriggerSourceType]("source_type", m.SourceType),
+		objectField[ConcurrencyPolicy]("concurrency", m.Concurrency),
+		objectField[BatchPolicy]("batch", m.Batch),
+		objectField[RetryPolicy]("retry", m.Retry),
+		objectField[WebhookAuth]("webhook_auth", m.WebhookAuth),
+		objectField[WebhookIdempotency]("webhook_idempotency", m.WebhookIdempotency),
+		webhookRouteField("webhook_route", m.WebhookRoute),
 	)
 }
 
@@ -56,7 +77,7 @@ func (TriggerTraits) StrictValidate(m *TriggerPB) error {
 }
 
 func (TriggerTraits) Mutables() []string {
-	return []string{"filter", "code_location", "name", "source_type", "timezone", "sync", "is_durable"}
+	return []string{"filter", "code_location", "name", "source_type", "timezone", "sync", "is_durable", "concurrency", "batch", "retry", "timeout", "on_stop", "webhook_auth", "webhook_idempotency", "webhook_route"}
 }
 
 func TriggerFromProto(m *TriggerPB) (Trigger, error)       { return FromProto[Trigger](m) }
@@ -152,3 +173,76 @@ func (p Trigger) IsSync() bool { return p.read().IsSync }
 func (p Trigger) SetIsSync(sync bool) Trigger {
 	return Trigger{p.forceUpdate(func(m *TriggerPB) { m.IsSync = sync })}
 }
+
+func (p Trigger) Concurrency() ConcurrencyPolicy {
+	return forceFromProto[ConcurrencyPolicy](p.read().Concurrency)
+}
+
+func (p Trigger) WithConcurrency(c ConcurrencyPolicy) Trigger {
+	return Trigger{p.forceUpdate(func(m *TriggerPB) { m.Concurrency = c.ToProto() })}
+}
+
+func (p Trigger) Batch() BatchPolicy {
+	return forceFromProto[BatchPolicy](p.read().Batch)
+}
+
+func (p Trigger) WithBatch(b BatchPolicy) Trigger {
+	return Trigger{p.forceUpdate(func(m *TriggerPB) { m.Batch = b.ToProto() })}
+}
+
+func (p Trigger) Retry() RetryPolicy {
+	return forceFromProto[RetryPolicy](p.read().Retry)
+}
+
+func (p Trigger) WithRetry(r RetryPolicy) Trigger {
+	return Trigger{p.forceUpdate(func(m *TriggerPB) { m.Retry = r.ToProto() })}
+}
+
+// WebhookAuth is the request authentication for webhook triggers. Invalid if none.
+func (p Trigger) WebhookAuth() WebhookAuth {
+	return forceFromProto[WebhookAuth](p.read().WebhookAuth)
+}
+
+func (p Trigger) WithWebhookAuth(a WebhookAuth) Trigger {
+	return Trigger{p.forceUpdate(func(m *TriggerPB) { m.WebhookAuth = a.ToProto() })}
+}
+
+// WebhookIdempotency derives idempotency keys for webhook triggers. Invalid if none.
+func (p Trigger) WebhookIdempotency() WebhookIdempotency {
+	return forceFromProto[WebhookIdempotency](p.read().WebhookIdempotency)
+}
+
+func (p Trigger) WithWebhookIdempotency(i WebhookIdempotency) Trigger {
+	return Trigger{p.forceUpdate(func(m *TriggerPB) { m.WebhookIdempotency = i.ToProto() })}
+}
+
+// WebhookRoute is invalid if the trigger handles all requests to its webhook.
+func (p Trigger) WebhookRoute() WebhookRoute {
+	if raw := p.read().WebhookRoute; raw != "" {
+		return kittehs.Must1(ParseWebhookRoute(raw))
+	}
+
+	return InvalidWebhookRoute
+}
+
+func (p Trigger) WithWebhookRoute(r WebhookRoute) Trigger {
+	return Trigger{p.forceUpdate(func(m *TriggerPB) { m.WebhookRoute = r.String() })}
+}
+
+// Timeout is the execution timeout for sessions started by the trigger. Zero if none.
+func (p Trigger) Timeout() time.Duration { return p.read().Timeout.AsDuration() }
+
+func (p Trigger) WithTimeout(d time.Duration) Trigger {
+	return Trigger{p.forceUpdate(func(m *TriggerPB) {
+		if m.Timeout = nil; d > 0 {
+			m.Timeout = durationpb.New(d)
+		}
+	})}
+}
+
+// OnStop is called when a session started by the trigger is stopped.
+func (p Trigger) OnStop() CodeLocation { return forceFromProto[CodeLocation](p.read().OnStop) }
+
+func (p Trigger) WithOnStop(loc CodeLocation) Trigger {
+	return Trigger{p.forceUpdate(func(m *TriggerPB) { m.OnStop = loc.ToProto() })}
+}
@@ -0,0 +1,64 @@
+package sdktypes
+
+import (
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	triggersv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/triggers/v1"
+)
+
+// BatchPolicy makes a trigger collect events and start a single session
+// for all of them, instead of a session per event.
+type BatchPolicy struct {
+	object[*BatchPolicyPB, BatchPolicyTraits]
+}
+
+func init() { registerObject[BatchPolicy]() }
+
+var InvalidBatchPolicy BatchPolicy
+
+type BatchPolicyPB = triggersv1.BatchPolicy
+
+type BatchPolicyTraits struct{ immutableObjectTrait }
+
+func (BatchPolicyTraits) Validate(m *BatchPolicyPB) error {
+	var err error
+
+	if m.Window != nil && m.Window.AsDuration() < 0 {
+		err = errors.New("window must not be negative")
+	}
+
+	if m.Window.AsDuration() <= 0 && m.MaxEvents == 0 {
+		err = errors.Join(err, errors.New("either window or max_events must be specified"))
+	}
+
+	return errors.Join(
+		err,
+		eventFilterField("key", m.Key),
+	)
+}
+
+func (BatchPolicyTraits) StrictValidate(m *BatchPolicyPB) error { return nil }
+
+func BatchPolicyFromProto(m *BatchPolicyPB) (BatchPolicy, error) {
+	return FromProto[BatchPolicy](m)
+}
+
+func NewBatchPolicy(key string, window time.Duration, maxEvents int) (BatchPolicy, error) {
+	var w *durationpb.Duration
+	if window != 0 {
+		w = durationpb.New(window)
+	}
+
+	return BatchPolicyFromProto(&BatchPolicyPB{
+		Key:       key,
+		Window:    w,
+		MaxEvents: uint32(maxEvents),
+	})
+}
+
+func (p BatchPolicy) Key() string           { return p.read().Key }
+func (p BatchPolicy) Window() time.Duration { return p.read().Window.AsDuration() }
+func (p BatchPolicy) MaxEvents() int        { return int(p.read().MaxEvents) }
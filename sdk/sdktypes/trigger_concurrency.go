@@ -0,0 +1,103 @@
+package sdktypes
+
+import (
+	"errors"
+
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	triggersv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/triggers/v1"
+)
+
+type concurrencyOverlapTraits struct{}
+
+var _ enumTraits = concurrencyOverlapTraits{}
+
+func (concurrencyOverlapTraits) Prefix() string { return "OVERLAP_" }
+func (concurrencyOverlapTraits) Names() map[int32]string {
+	return triggersv1.ConcurrencyPolicy_Overlap_name
+}
+
+func (concurrencyOverlapTraits) Values() map[string]int32 {
+	return triggersv1.ConcurrencyPolicy_Overlap_value
+}
+
+// ConcurrencyOverlap determines what is done with an event when a trigger
+// already has the maximum number of concurrent sessions running.
+type ConcurrencyOverlap struct {
+	enum[concurrencyOverlapTraits, triggersv1.ConcurrencyPolicy_Overlap]
+}
+
+func concurrencyOverlapFromProto(e triggersv1.ConcurrencyPolicy_Overlap) ConcurrencyOverlap {
+	return kittehs.Must1(ConcurrencyOverlapFromProto(e))
+}
+
+var (
+	PossibleConcurrencyOverlapsNames = AllEnumNames[concurrencyOverlapTraits]()
+
+	ConcurrencyOverlapUnspecified    = concurrencyOverlapFromProto(triggersv1.ConcurrencyPolicy_OVERLAP_UNSPECIFIED)
+	ConcurrencyOverlapQueue          = concurrencyOverlapFromProto(triggersv1.ConcurrencyPolicy_OVERLAP_QUEUE)
+	ConcurrencyOverlapSkip           = concurrencyOverlapFromProto(triggersv1.ConcurrencyPolicy_OVERLAP_SKIP)
+	ConcurrencyOverlapCancelPrevious = concurrencyOverlapFromProto(triggersv1.ConcurrencyPolicy_OVERLAP_CANCEL_PREVIOUS)
+)
+
+func ConcurrencyOverlapFromProto(e triggersv1.ConcurrencyPolicy_Overlap) (ConcurrencyOverlap, error) {
+	return EnumFromProto[ConcurrencyOverlap](e)
+}
+
+func ParseConcurrencyOverlap(raw string) (ConcurrencyOverlap, error) {
+	return ParseEnum[ConcurrencyOverlap](raw)
+}
+
+type ConcurrencyPolicy struct {
+	object[*ConcurrencyPolicyPB, ConcurrencyPolicyTraits]
+}
+
+func init() { registerObject[ConcurrencyPolicy]() }
+
+var InvalidConcurrencyPolicy ConcurrencyPolicy
+
+type ConcurrencyPolicyPB = triggersv1.ConcurrencyPolicy
+
+type ConcurrencyPolicyTraits struct{ immutableObjectTrait }
+
+func (ConcurrencyPolicyTraits) Validate(m *ConcurrencyPolicyPB) error {
+	return errors.Join(
+		enumField[ConcurrencyOverlap]("overlap", m.Overlap),
+	)
+}
+
+func (ConcurrencyPolicyTraits) StrictValidate(m *ConcurrencyPolicyPB) error { return nil }
+
+func ConcurrencyPolicyFromProto(m *ConcurrencyPolicyPB) (ConcurrencyPolicy, error) {
+	return FromProto[ConcurrencyPolicy](m)
+}
+
+func NewConcurrencyPolicy(max int, overlap ConcurrencyOverlap) ConcurrencyPolicy {
+	return kittehs.Must1(ConcurrencyPolicyFromProto(&ConcurrencyPolicyPB{
+		MaxConcurrent: uint32(max),
+		Overlap:       overlap.ToProto(),
+	}))
+}
+
+func (p ConcurrencyPolicy) MaxConcurrent() int { return int(p.read().MaxConcurrent) }
+
+func (p ConcurrencyPolicy) Overlap() ConcurrencyOverlap {
+	return forceEnumFromProto[ConcurrencyOverlap](p.read().Overlap)
+}
+
+// Limit returns the effective maximum number of concurrent sessions, or
+// zero if unlimited.
+func (p ConcurrencyPolicy) Limit() int {
+	if !p.IsValid() {
+		return 0
+	}
+
+	if n := p.MaxConcurrent(); n > 0 {
+		return n
+	}
+
+	if !p.Overlap().IsZero() {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,127 @@
+package sdktypes
+
+import (
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	triggersv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/triggers/v1"
+)
+
+const (
+	defaultRetryInitialInterval    = time.Second
+	defaultRetryBackoffCoefficient = 2.0
+	defaultRetryMaxIntervalFactor  = 100
+)
+
+// RetryPolicy makes sessions started by a trigger be retried automatically
+// when they end in an error.
+type RetryPolicy struct {
+	object[*RetryPolicyPB, RetryPolicyTraits]
+}
+
+func init() { registerObject[RetryPolicy]() }
+
+var InvalidRetryPolicy RetryPolicy
+
+type RetryPolicyPB = triggersv1.RetryPolicy
+
+type RetryPolicyTraits struct{ immutableObjectTrait }
+
+func (RetryPolicyTraits) Validate(m *RetryPolicyPB) error {
+	var errs []error
+
+	if m.MaxAttempts == 0 {
+		errs = append(errs, errors.New("max_attempts must be specified"))
+	}
+
+	if m.InitialInterval != nil && m.InitialInterval.AsDuration() < 0 {
+		errs = append(errs, errors.New("initial_interval must not be negative"))
+	}
+
+	if m.MaxInterval != nil && m.MaxInterval.AsDuration() < 0 {
+		errs = append(errs, errors.New("max_interval must not be negative"))
+	}
+
+	if m.BackoffCoefficient != 0 && m.BackoffCoefficient < 1 {
+		errs = append(errs, errors.New("backoff_coefficient must be at least 1"))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (RetryPolicyTraits) StrictValidate(m *RetryPolicyPB) error { return nil }
+
+func RetryPolicyFromProto(m *RetryPolicyPB) (RetryPolicy, error) {
+	return FromProto[RetryPolicy](m)
+}
+
+func NewRetryPolicy(maxAttempts int, initial time.Duration, coef float64, maxInterval time.Duration, retryOnProgramError bool) (RetryPolicy, error) {
+	dur := func(d time.Duration) *durationpb.Duration {
+		if d == 0 {
+			return nil
+		}
+
+		return durationpb.New(d)
+	}
+
+	return RetryPolicyFromProto(&RetryPolicyPB{
+		MaxAttempts:         uint32(maxAttempts),
+		InitialInterval:     dur(initial),
+		BackoffCoefficient:  coef,
+		MaxInterval:         dur(maxInterval),
+		RetryOnProgramError: retryOnProgramError,
+	})
+}
+
+func (p RetryPolicy) MaxAttempts() int { return int(p.read().MaxAttempts) }
+
+func (p RetryPolicy) InitialInterval() time.Duration {
+	if d := p.read().InitialInterval.AsDuration(); d > 0 {
+		return d
+	}
+
+	return defaultRetryInitialInterval
+}
+
+func (p RetryPolicy) BackoffCoefficient() float64 {
+	if c := p.read().BackoffCoefficient; c != 0 {
+		return c
+	}
+
+	return defaultRetryBackoffCoefficient
+}
+
+func (p RetryPolicy) MaxInterval() time.Duration {
+	if d := p.read().MaxInterval.AsDuration(); d > 0 {
+		return d
+	}
+
+	return p.InitialInterval() * defaultRetryMaxIntervalFactor
+}
+
+func (p RetryPolicy) RetryOnProgramError() bool { return p.read().RetryOnProgramError }
+
+// ShouldRetry tells if a session that failed on its given (1-based) attempt
+// should be retried. programError is true if the failure was raised by the
+// program itself, rather than by the infrastructure running it.
+func (p RetryPolicy) ShouldRetry(attempt int, programError bool) bool {
+	if !p.IsValid() || attempt >= p.MaxAttempts() {
+		return false
+	}
+
+	return !programError || p.RetryOnProgramError()
+}
+
+// Delay returns how long to wait before retrying a session that failed on
+// its given (1-based) attempt.
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	d, maxd := float64(p.InitialInterval()), float64(p.MaxInterval())
+
+	for i := 1; i < attempt && d < maxd; i++ {
+		d *= p.BackoffCoefficient()
+	}
+
+	return time.Duration(min(d, maxd))
+}
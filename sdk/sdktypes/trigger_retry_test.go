@@ -0,0 +1,42 @@
+package sdktypes_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	_, err := sdktypes.NewRetryPolicy(0, 0, 0, 0, false)
+	assert.Error(t, err)
+
+	_, err = sdktypes.NewRetryPolicy(3, 0, 0.5, 0, false)
+	assert.Error(t, err)
+
+	p, err := sdktypes.NewRetryPolicy(3, 0, 0, 0, false)
+	require.NoError(t, err)
+
+	assert.True(t, p.ShouldRetry(1, false))
+	assert.True(t, p.ShouldRetry(2, false))
+	assert.False(t, p.ShouldRetry(3, false))
+	assert.False(t, p.ShouldRetry(1, true))
+
+	assert.Equal(t, time.Second, p.Delay(1))
+	assert.Equal(t, 2*time.Second, p.Delay(2))
+	assert.Equal(t, 4*time.Second, p.Delay(3))
+	assert.Equal(t, 100*time.Second, p.Delay(20))
+
+	p, err = sdktypes.NewRetryPolicy(5, time.Minute, 3, 5*time.Minute, true)
+	require.NoError(t, err)
+
+	assert.True(t, p.ShouldRetry(1, true))
+	assert.Equal(t, time.Minute, p.Delay(1))
+	assert.Equal(t, 3*time.Minute, p.Delay(2))
+	assert.Equal(t, 5*time.Minute, p.Delay(3))
+
+	assert.False(t, sdktypes.InvalidRetryPolicy.ShouldRetry(1, false))
+}
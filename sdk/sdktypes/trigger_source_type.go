@@ -28,6 +28,7 @@ var (
 	TriggerSourceTypeConnection  = triggerStateFromProto(triggersv1.Trigger_SOURCE_TYPE_CONNECTION)
 	TriggerSourceTypeWebhook     = triggerStateFromProto(triggersv1.Trigger_SOURCE_TYPE_WEBHOOK)
 	TriggerSourceTypeSchedule    = triggerStateFromProto(triggersv1.Trigger_SOURCE_TYPE_SCHEDULE)
+	TriggerSourceTypeStore       = triggerStateFromProto(triggersv1.Trigger_SOURCE_TYPE_STORE)
 )
 
 func TriggerSourceTypeFromProto(e triggersv1.Trigger_SourceType) (TriggerSourceType, error) {
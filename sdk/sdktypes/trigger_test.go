@@ -0,0 +1,24 @@
+package sdktypes_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func TestSyncBatchTrigger(t *testing.T) {
+	policy, err := sdktypes.NewBatchPolicy("", time.Minute, 0)
+	require.NoError(t, err)
+
+	tr := sdktypes.NewTrigger(sdktypes.NewSymbol("t")).WithBatch(policy)
+
+	_, err = sdktypes.TriggerFromProto(tr.ToProto())
+	assert.NoError(t, err)
+
+	_, err = sdktypes.TriggerFromProto(tr.SetIsSync(true).ToProto())
+	assert.Error(t, err)
+}
@@ -0,0 +1,167 @@
+package sdktypes
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+	triggersv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/triggers/v1"
+)
+
+type webhookSignatureFormatTraits struct{}
+
+var _ enumTraits = webhookSignatureFormatTraits{}
+
+func (webhookSignatureFormatTraits) Prefix() string { return "SIGNATURE_FORMAT_" }
+func (webhookSignatureFormatTraits) Names() map[int32]string {
+	return triggersv1.WebhookAuth_SignatureFormat_name
+}
+
+func (webhookSignatureFormatTraits) Values() map[string]int32 {
+	return triggersv1.WebhookAuth_SignatureFormat_value
+}
+
+// WebhookSignatureFormat determines how an HMAC signature is encoded in the
+// request header, following the conventions of common webhook senders.
+type WebhookSignatureFormat struct {
+	enum[webhookSignatureFormatTraits, triggersv1.WebhookAuth_SignatureFormat]
+}
+
+func webhookSignatureFormatFromProto(e triggersv1.WebhookAuth_SignatureFormat) WebhookSignatureFormat {
+	return kittehs.Must1(WebhookSignatureFormatFromProto(e))
+}
+
+var (
+	PossibleWebhookSignatureFormatsNames = AllEnumNames[webhookSignatureFormatTraits]()
+
+	WebhookSignatureFormatUnspecified = webhookSignatureFormatFromProto(triggersv1.WebhookAuth_SIGNATURE_FORMAT_UNSPECIFIED)
+	WebhookSignatureFormatHex         = webhookSignatureFormatFromProto(triggersv1.WebhookAuth_SIGNATURE_FORMAT_HEX)
+	WebhookSignatureFormatBase64      = webhookSignatureFormatFromProto(triggersv1.WebhookAuth_SIGNATURE_FORMAT_BASE64)
+	WebhookSignatureFormatStripe      = webhookSignatureFormatFromProto(triggersv1.WebhookAuth_SIGNATURE_FORMAT_STRIPE)
+)
+
+func WebhookSignatureFormatFromProto(e triggersv1.WebhookAuth_SignatureFormat) (WebhookSignatureFormat, error) {
+	return EnumFromProto[WebhookSignatureFormat](e)
+}
+
+func ParseWebhookSignatureFormat(raw string) (WebhookSignatureFormat, error) {
+	return ParseEnum[WebhookSignatureFormat](raw)
+}
+
+// WebhookAuth verifies requests to a webhook trigger before they are
+// dispatched. Secrets are referenced by the names of the project variables
+// holding them, and are resolved when a request arrives.
+type WebhookAuth struct {
+	object[*WebhookAuthPB, WebhookAuthTraits]
+}
+
+func init() { registerObject[WebhookAuth]() }
+
+var InvalidWebhookAuth WebhookAuth
+
+type WebhookAuthPB = triggersv1.WebhookAuth
+
+type WebhookAuthTraits struct{ immutableObjectTrait }
+
+func (WebhookAuthTraits) Validate(m *WebhookAuthPB) error {
+	var errs []error
+
+	if s := m.Signature; s != nil {
+		errs = append(
+			errs,
+			mandatory("signature.header", s.Header),
+			mandatory("signature.secret_var", s.SecretVar),
+			symbolField("signature.secret_var", s.SecretVar),
+			enumField[WebhookSignatureFormat]("signature.format", s.Format),
+		)
+	}
+
+	errs = append(errs, symbolField("bearer_token_var", m.BearerTokenVar))
+
+	if b := m.Basic; b != nil {
+		errs = append(
+			errs,
+			mandatory("basic.username", b.Username),
+			mandatory("basic.password_var", b.PasswordVar),
+			symbolField("basic.password_var", b.PasswordVar),
+		)
+	}
+
+	for _, cidr := range m.AllowedCidrs {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			errs = append(errs, fmt.Errorf("allowed_cidrs: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (WebhookAuthTraits) StrictValidate(m *WebhookAuthPB) error { return nil }
+
+func WebhookAuthFromProto(m *WebhookAuthPB) (WebhookAuth, error) {
+	return FromProto[WebhookAuth](m)
+}
+
+// Signature returns the HMAC-SHA256 signature requirement, if any.
+func (a WebhookAuth) Signature() (header string, secretVar Symbol, format WebhookSignatureFormat, ok bool) {
+	s := a.read().Signature
+	if s == nil {
+		return "", InvalidSymbol, WebhookSignatureFormatUnspecified, false
+	}
+
+	return s.Header, kittehs.Must1(ParseSymbol(s.SecretVar)), webhookSignatureFormatFromProto(s.Format), true
+}
+
+// BearerTokenVar returns the variable holding the expected bearer token, if any.
+func (a WebhookAuth) BearerTokenVar() Symbol {
+	return kittehs.Must1(ParseSymbol(a.read().BearerTokenVar))
+}
+
+// Basic returns the basic authentication requirement, if any.
+func (a WebhookAuth) Basic() (username string, passwordVar Symbol, ok bool) {
+	b := a.read().Basic
+	if b == nil {
+		return "", InvalidSymbol, false
+	}
+
+	return b.Username, kittehs.Must1(ParseSymbol(b.PasswordVar)), true
+}
+
+func (a WebhookAuth) AllowedCIDRs() []netip.Prefix {
+	return kittehs.Transform(a.read().AllowedCidrs, func(cidr string) netip.Prefix {
+		return kittehs.Must1(netip.ParsePrefix(cidr))
+	})
+}
+
+// NewWebhookAuth returns an authentication without any checks, to be
+// completed using the With* methods.
+func NewWebhookAuth() WebhookAuth {
+	return kittehs.Must1(WebhookAuthFromProto(&WebhookAuthPB{}))
+}
+
+func (a WebhookAuth) WithSignature(header string, secretVar Symbol, format WebhookSignatureFormat) WebhookAuth {
+	return WebhookAuth{a.forceUpdate(func(m *WebhookAuthPB) {
+		m.Signature = &triggersv1.WebhookAuth_Signature{
+			Header:    header,
+			SecretVar: secretVar.String(),
+			Format:    format.ToProto(),
+		}
+	})}
+}
+
+func (a WebhookAuth) WithBearerTokenVar(v Symbol) WebhookAuth {
+	return WebhookAuth{a.forceUpdate(func(m *WebhookAuthPB) { m.BearerTokenVar = v.String() })}
+}
+
+func (a WebhookAuth) WithBasic(username string, passwordVar Symbol) WebhookAuth {
+	return WebhookAuth{a.forceUpdate(func(m *WebhookAuthPB) {
+		m.Basic = &triggersv1.WebhookAuth_Basic{Username: username, PasswordVar: passwordVar.String()}
+	})}
+}
+
+func (a WebhookAuth) WithAllowedCIDRs(cidrs []netip.Prefix) WebhookAuth {
+	return WebhookAuth{a.forceUpdate(func(m *WebhookAuthPB) {
+		m.AllowedCidrs = kittehs.TransformToStrings(cidrs)
+	})}
+}
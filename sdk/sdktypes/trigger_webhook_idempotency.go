@@ -0,0 +1,52 @@
+package sdktypes
+
+import (
+	"errors"
+
+	triggersv1 "go.autokitteh.dev/autokitteh/proto/gen/go/autokitteh/triggers/v1"
+)
+
+// WebhookIdempotency derives an idempotency key from requests to a webhook
+// trigger, either from a header or by evaluating an expression over the event.
+type WebhookIdempotency struct {
+	object[*WebhookIdempotencyPB, WebhookIdempotencyTraits]
+}
+
+func init() { registerObject[WebhookIdempotency]() }
+
+var InvalidWebhookIdempotency WebhookIdempotency
+
+type WebhookIdempotencyPB = triggersv1.WebhookIdempotency
+
+type WebhookIdempotencyTraits struct{ immutableObjectTrait }
+
+func (WebhookIdempotencyTraits) Validate(m *WebhookIdempotencyPB) error {
+	if (m.Header == "") == (m.Key == "") {
+		return errors.New("exactly one of header or key must be specified")
+	}
+
+	return eventFilterField("key", m.Key)
+}
+
+func (WebhookIdempotencyTraits) StrictValidate(m *WebhookIdempotencyPB) error { return nil }
+
+func WebhookIdempotencyFromProto(m *WebhookIdempotencyPB) (WebhookIdempotency, error) {
+	return FromProto[WebhookIdempotency](m)
+}
+
+func NewWebhookIdempotency(header, key string) (WebhookIdempotency, error) {
+	return WebhookIdempotencyFromProto(&WebhookIdempotencyPB{Header: header, Key: key})
+}
+
+func (p WebhookIdempotency) Header() string { return p.read().Header }
+func (p WebhookIdempotency) Key() string    { return p.read().Key }
+
+// Eval returns the idempotency key for the given event, which was received
+// with the given request headers. An empty key means that there is none.
+func (p WebhookIdempotency) Eval(event Event, header func(string) string) (string, error) {
+	if h := p.Header(); h != "" {
+		return header(h), nil
+	}
+
+	return event.Key(p.Key())
+}
@@ -0,0 +1,44 @@
+package sdktypes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.autokitteh.dev/autokitteh/sdk/sdktypes"
+)
+
+func TestWebhookIdempotency(t *testing.T) {
+	_, err := sdktypes.NewWebhookIdempotency("", "")
+	assert.Error(t, err)
+
+	_, err = sdktypes.NewWebhookIdempotency("X-Delivery", "data.id")
+	assert.Error(t, err)
+
+	_, err = sdktypes.NewWebhookIdempotency("", "data.")
+	assert.Error(t, err)
+
+	event := sdktypes.NewEvent(sdktypes.NewTriggerID()).WithData(map[string]sdktypes.Value{
+		"body": sdktypes.NewDictValueFromStringMap(map[string]sdktypes.Value{
+			"id": sdktypes.NewStringValue("meow"),
+		}),
+	})
+
+	headers := map[string]string{"X-Delivery": "woof"}
+	header := func(k string) string { return headers[k] }
+
+	p, err := sdktypes.NewWebhookIdempotency("X-Delivery", "")
+	require.NoError(t, err)
+
+	k, err := p.Eval(event, header)
+	assert.NoError(t, err)
+	assert.Equal(t, "woof", k)
+
+	p, err = sdktypes.NewWebhookIdempotency("", "data.body.id")
+	require.NoError(t, err)
+
+	k, err = p.Eval(event, header)
+	assert.NoError(t, err)
+	assert.Equal(t, "meow", k)
+}
@@ -0,0 +1,188 @@
+package sdktypes
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// WebhookRoute matches webhook requests by method and by path relative to the
+// webhook URL. It is parsed from strings such as "POST /orders/{id}":
+//   - The method is optional. If omitted, any method matches. GET also matches HEAD.
+//   - "{name}" matches a single non-empty path segment.
+//   - "{name...}" matches the rest of the path, and must be last.
+//   - Trailing slashes are ignored, both in the route and in the request path.
+type WebhookRoute struct {
+	raw      string
+	method   string
+	segments []webhookRouteSegment
+}
+
+type webhookRouteSegment struct {
+	literal string
+	param   string
+	rest    bool
+}
+
+var InvalidWebhookRoute WebhookRoute
+
+func ParseWebhookRoute(raw string) (WebhookRoute, error) {
+	if raw == "" {
+		return InvalidWebhookRoute, errors.New("empty route")
+	}
+
+	method, path, ok := strings.Cut(strings.TrimSpace(raw), " ")
+	if !ok {
+		method, path = "", method
+	}
+
+	path = strings.TrimSpace(path)
+
+	if method != "" && method != strings.ToUpper(method) {
+		return InvalidWebhookRoute, fmt.Errorf("method %q must be uppercase", method)
+	}
+
+	if !strings.HasPrefix(path, "/") {
+		return InvalidWebhookRoute, fmt.Errorf("path %q must start with /", path)
+	}
+
+	r := WebhookRoute{raw: raw, method: method}
+
+	names := make(map[string]bool)
+
+	parts := splitWebhookRoutePath(path)
+
+	for i, part := range parts {
+		name, ok := strings.CutPrefix(part, "{")
+		if !ok {
+			if strings.ContainsAny(part, "{}") {
+				return InvalidWebhookRoute, fmt.Errorf("segment %q: parameters must be whole segments", part)
+			}
+
+			r.segments = append(r.segments, webhookRouteSegment{literal: part})
+			continue
+		}
+
+		if name, ok = strings.CutSuffix(name, "}"); !ok {
+			return InvalidWebhookRoute, fmt.Errorf("segment %q: missing }", part)
+		}
+
+		var rest bool
+		if name, rest = strings.CutSuffix(name, "..."); rest && i != len(parts)-1 {
+			return InvalidWebhookRoute, fmt.Errorf("segment %q: must be last", part)
+		}
+
+		if _, err := StrictParseSymbol(name); err != nil {
+			return InvalidWebhookRoute, fmt.Errorf("segment %q: %w", part, err)
+		}
+
+		if names[name] {
+			return InvalidWebhookRoute, fmt.Errorf("duplicate parameter %q", name)
+		}
+
+		names[name] = true
+
+		r.segments = append(r.segments, webhookRouteSegment{param: name, rest: rest})
+	}
+
+	return r, nil
+}
+
+func webhookRouteField(name string, raw string) error {
+	if raw == "" {
+		return nil
+	}
+
+	if _, err := ParseWebhookRoute(raw); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	return nil
+}
+
+func splitWebhookRoutePath(path string) []string {
+	if path = strings.Trim(path, "/"); path == "" {
+		return nil
+	}
+
+	return strings.Split(path, "/")
+}
+
+func (r WebhookRoute) IsValid() bool  { return r.raw != "" }
+func (r WebhookRoute) String() string { return r.raw }
+
+// Method returns the method the route matches, or an empty string if it matches any.
+func (r WebhookRoute) Method() string { return r.method }
+
+func (r WebhookRoute) MatchMethod(method string) bool {
+	return r.method == "" || r.method == method || (r.method == "GET" && method == "HEAD")
+}
+
+// MatchPath returns the values of the route parameters if the path matches the route.
+func (r WebhookRoute) MatchPath(path string) (map[string]string, bool) {
+	parts := splitWebhookRoutePath(path)
+
+	params := make(map[string]string)
+
+	for i, seg := range r.segments {
+		if seg.rest {
+			params[seg.param] = strings.Join(parts[min(i, len(parts)):], "/")
+			return params, true
+		}
+
+		if i >= len(parts) {
+			return nil, false
+		}
+
+		switch {
+		case seg.param != "":
+			params[seg.param] = parts[i]
+		case seg.literal != parts[i]:
+			return nil, false
+		}
+	}
+
+	if len(parts) != len(r.segments) {
+		return nil, false
+	}
+
+	return params, true
+}
+
+// MoreSpecificThan reports whether the route should be preferred over another
+// route when both match a request: routes with more literal segments are
+// preferred, then routes without a rest parameter, then routes with a method.
+func (r WebhookRoute) MoreSpecificThan(o WebhookRoute) bool {
+	if a, b := r.literals(), o.literals(); a != b {
+		return a > b
+	}
+
+	if a, b := r.hasRest(), o.hasRest(); a != b {
+		return b
+	}
+
+	return r.method != "" && o.method == ""
+}
+
+// Equivalent reports whether both routes have the same method and path, which
+// means they match exactly the same requests. Parameter names are ignored.
+func (r WebhookRoute) Equivalent(o WebhookRoute) bool {
+	return r.method == o.method && slices.EqualFunc(r.segments, o.segments, func(a, b webhookRouteSegment) bool {
+		return a.literal == b.literal && (a.param == "") == (b.param == "") && a.rest == b.rest
+	})
+}
+
+func (r WebhookRoute) literals() (n int) {
+	for _, seg := range r.segments {
+		if seg.literal != "" {
+			n++
+		}
+	}
+
+	return
+}
+
+func (r WebhookRoute) hasRest() bool {
+	return len(r.segments) > 0 && r.segments[len(r.segments)-1].rest
+}
@@ -0,0 +1,102 @@
+package sdktypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.autokitteh.dev/autokitteh/internal/kittehs"
+)
+
+func TestParseWebhookRoute(t *testing.T) {
+	for _, raw := range []string{"/", "GET /", "/orders", "POST /orders/{id}", "/files/{path...}", "PUT /a/{x}/b/{y}/"} {
+		r, err := ParseWebhookRoute(raw)
+		if assert.NoError(t, err, raw) {
+			assert.Equal(t, raw, r.String())
+		}
+	}
+
+	for _, raw := range []string{"", "orders", "post /orders", "/orders/{id", "/orders/x{id}", "/{path...}/x", "/{a}/{a}", "/{1}"} {
+		_, err := ParseWebhookRoute(raw)
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestWebhookRouteMatch(t *testing.T) {
+	tests := []struct {
+		route  string
+		method string
+		path   string
+		params map[string]string // nil if not matching.
+	}{
+		{route: "/", method: "GET", path: "/", params: map[string]string{}},
+		{route: "/", method: "GET", path: "/x"},
+		{route: "POST /orders", method: "POST", path: "/orders/", params: map[string]string{}},
+		{route: "POST /orders/{id}", method: "POST", path: "/orders/42", params: map[string]string{"id": "42"}},
+		{route: "POST /orders/{id}", method: "POST", path: "/orders"},
+		{route: "POST /orders/{id}", method: "POST", path: "/orders/42/items"},
+		{route: "/files/{path...}", method: "PUT", path: "/files/a/b/c", params: map[string]string{"path": "a/b/c"}},
+		{route: "/files/{path...}", method: "PUT", path: "/files", params: map[string]string{"path": ""}},
+	}
+
+	for _, test := range tests {
+		r := kittehs.Must1(ParseWebhookRoute(test.route))
+
+		params, ok := r.MatchPath(test.path)
+		assert.Equal(t, test.params != nil, ok, "%s %s", test.route, test.path)
+		assert.Equal(t, test.params, params, "%s %s", test.route, test.path)
+	}
+
+	get := kittehs.Must1(ParseWebhookRoute("GET /"))
+	assert.True(t, get.MatchMethod("GET"))
+	assert.True(t, get.MatchMethod("HEAD"))
+	assert.False(t, get.MatchMethod("POST"))
+	assert.True(t, kittehs.Must1(ParseWebhookRoute("/")).MatchMethod("POST"))
+}
+
+func TestWebhookRouteMoreSpecificThan(t *testing.T) {
+	// Each is more specific than the next.
+	routes := []string{"GET /orders/new", "/orders/new", "GET /orders/{id}", "/orders/{id}", "/orders/{rest...}", "/{rest...}"}
+
+	for i := range len(routes) - 1 {
+		a, b := kittehs.Must1(ParseWebhookRoute(routes[i])), kittehs.Must1(ParseWebhookRoute(routes[i+1]))
+		assert.True(t, a.MoreSpecificThan(b), "%s > %s", a, b)
+		assert.False(t, b.MoreSpecificThan(a), "%s > %s", b, a)
+	}
+}
+
+func TestWebhookRouteEquivalent(t *testing.T) {
+	tests := []struct {
+		a, b string
+		eq   bool
+	}{
+		{a: "/orders", b: "/orders", eq: true},
+		{a: "/orders", b: "/orders/", eq: true},
+		{a: "POST /orders/{id}", b: "POST /orders/{oid}", eq: true},
+		{a: "/files/{path...}", b: "/files/{rest...}", eq: true},
+		{a: "GET /orders", b: "POST /orders"},
+		{a: "GET /orders", b: "/orders"},
+		{a: "/orders/{id}", b: "/orders/new"},
+		{a: "/orders/{id}", b: "/orders/{id...}"},
+		{a: "/orders", b: "/orders/{id}"},
+	}
+
+	for _, test := range tests {
+		a, b := kittehs.Must1(ParseWebhookRoute(test.a)), kittehs.Must1(ParseWebhookRoute(test.b))
+		assert.Equal(t, test.eq, a.Equivalent(b), "%s == %s", a, b)
+		assert.Equal(t, test.eq, b.Equivalent(a), "%s == %s", b, a)
+	}
+}
+
+func TestTriggerWebhookRoute(t *testing.T) {
+	tr := NewTrigger(NewSymbol("t"))
+	assert.False(t, tr.WebhookRoute().IsValid())
+
+	r := kittehs.Must1(ParseWebhookRoute("POST /orders/{id}"))
+	tr = tr.WithWebhookRoute(r)
+	assert.Equal(t, r.String(), tr.WebhookRoute().String())
+
+	_, err := TriggerFromProto(&TriggerPB{Name: "t", WebhookRoute: "orders"})
+	require.Error(t, err)
+}